@@ -15,12 +15,14 @@ type Controller struct {
 
 type ControllerConfig struct {
 	repoConfig *repository.RepositoryEntConfig
+	Stages     []string // Enabled pipeline stages in order, service.DefaultStages if nil
 }
 
-func NewController() *Controller {
+func NewController(config *ControllerConfig) *Controller {
 	ctx := context.Background()
 	logger := log.NewLogger()
 	service := service.NewService(ctx, logger, &service.ServiceConfig{
+		FetchEndpoint: "https://indexer.onbloc.xyz/graphql/query",
 		EntConfig: &repository.RepositoryEntConfig{
 			Host:     "localhost",
 			Port:     5432,
@@ -32,6 +34,7 @@ func NewController() *Controller {
 			Endpoint: "http://localhost:4566",
 			Region:   "us-east-1",
 		},
+		Stages: config.Stages,
 	})
 
 	return &Controller{
@@ -39,6 +42,22 @@ func NewController() *Controller {
 	}
 }
 
+// ImportGenesis imports a genesis.json as the block at height 0
+func (c *Controller) ImportGenesis(ctx context.Context, genesisPath string) error {
+	return c.service.ImportGenesis(ctx, genesisPath)
+}
+
+// ReconcileBalances reports the balances which drifted from the transfer history, repairing them if asked
+func (c *Controller) ReconcileBalances(ctx context.Context, repair bool) (int, error) {
+	discrepancies, err := c.service.ReconcileBalances(ctx, repair)
+	return len(discrepancies), err
+}
+
+// RebuildDerivedTables replays the stored transactions into fresh derived tables and swaps them in
+func (c *Controller) RebuildDerivedTables(ctx context.Context) error {
+	return c.service.RebuildDerivedTables(ctx)
+}
+
 func (c *Controller) Run(ctx context.Context) error {
 	go c.service.SubscribeAndHandle(ctx)
 	return nil
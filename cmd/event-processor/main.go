@@ -2,12 +2,52 @@ package main
 
 import (
 	"context"
+	"flag"
+	"strings"
+
 	"gno.land-block-indexer/cmd/event-processor/controller"
+	"gno.land-block-indexer/lib/log"
 )
 
 func main() {
+	genesisPath := flag.String("genesis", "", "import the given genesis.json as height 0 and exit")
+	reconcile := flag.Bool("reconcile", false, "report balances which drifted from the transfer history and exit")
+	repair := flag.Bool("repair", false, "with -reconcile, also fix the drifted balances")
+	rebuild := flag.Bool("rebuild", false, "rebuild the derived tables from the stored transactions and exit")
+	stages := flag.String("stages", "", "comma-separated pipeline stages to run on each block in order, all built-in stages if empty")
+	flag.Parse()
+
 	ctx := context.Background()
-	controller := controller.NewController()
+	controller := controller.NewController(&controller.ControllerConfig{
+		Stages: parseStages(*stages),
+	})
+
+	if *genesisPath != "" {
+		if err := controller.ImportGenesis(ctx, *genesisPath); err != nil {
+			log.Fatalf("failed to import genesis %s: %v", *genesisPath, err)
+		}
+		return
+	}
+
+	if *rebuild {
+		if err := controller.RebuildDerivedTables(ctx); err != nil {
+			log.Fatalf("failed to rebuild derived tables: %v", err)
+		}
+		return
+	}
+
+	if *reconcile || *repair {
+		count, err := controller.ReconcileBalances(ctx, *repair)
+		if err != nil {
+			log.Fatalf("failed to reconcile balances: %v", err)
+		}
+		if count > 0 && !*repair {
+			// Non-zero exit so scheduled checks can alert on drift
+			log.Fatalf("found %d balance discrepancies", count)
+		}
+		return
+	}
+
 	controller.Run(ctx)
 
 	// Wait Signal C-c
@@ -20,3 +60,14 @@ func main() {
 		// Application terminated gracefully
 	}
 }
+
+// parseStages splits the -stages flag, returning nil for the default stages
+func parseStages(value string) []string {
+	var stages []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			stages = append(stages, name)
+		}
+	}
+	return stages
+}
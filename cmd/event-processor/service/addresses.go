@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+const (
+	ROLE_SIGNER   = "signer"   // Signer of a message, paying the fee for the first one
+	ROLE_SENDER   = "sender"   // Sender of a bank send
+	ROLE_RECEIVER = "receiver" // Receiver of a bank send or a genesis balance
+	ROLE_CALLER   = "caller"   // Caller of a MsgCall or MsgRun
+	ROLE_CREATOR  = "creator"  // Creator of a MsgAddPackage
+	ROLE_EVENT    = "event"    // Address found in the attributes of an event
+)
+
+// addressPattern matches bech32 gno.land addresses
+var addressPattern = regexp.MustCompile(`^g1[02-9ac-hj-np-z]{38}$`)
+
+// processAddressTransactions records the addresses taking part in a transaction with their roles
+func (s *service) processAddressTransactions(ctx context.Context, blockTime time.Time, tx *model.Transaction) error {
+	txs := parseAddressTransactions(tx)
+	for i := range txs {
+		txs[i].BlockTime = blockTime
+	}
+
+	if err := s.repo.AddAddressTransactions(ctx, txs); err != nil {
+		return s.logger.Errorf("Failed to add address transactions for transaction %s: %v", tx.Hash, err)
+	}
+
+	return nil
+}
+
+// parseAddressTransactions collects the addresses of a transaction from its messages
+// and event attributes, in order of appearance
+func parseAddressTransactions(tx *model.Transaction) []model.AddressTransaction {
+	var txs []model.AddressTransaction
+	byAddress := make(map[string]int)
+	add := func(address string, role string) {
+		if address == "" {
+			return
+		}
+		i, ok := byAddress[address]
+		if !ok {
+			i = len(txs)
+			byAddress[address] = i
+			txs = append(txs, model.AddressTransaction{
+				Address:     address,
+				Hash:        tx.Hash,
+				BlockHeight: tx.BlockHeight,
+				TxIndex:     tx.Index,
+				Success:     tx.Success,
+			})
+		}
+		for _, existing := range txs[i].Roles {
+			if existing == role {
+				return
+			}
+		}
+		txs[i].Roles = append(txs[i].Roles, role)
+	}
+
+	for _, msg := range tx.Messages {
+		add(messageSigner(msg), ROLE_SIGNER)
+		switch {
+		case msg.Route == "bank" && msg.TypeUrl == "send":
+			fromAddress, _ := msg.Value["from_address"].(string)
+			toAddress, _ := msg.Value["to_address"].(string)
+			add(fromAddress, ROLE_SENDER)
+			add(toAddress, ROLE_RECEIVER)
+		case msg.Route == "vm" && (msg.TypeUrl == "exec" || msg.TypeUrl == "run"):
+			caller, _ := msg.Value["caller"].(string)
+			add(caller, ROLE_CALLER)
+		case msg.Route == "vm" && msg.TypeUrl == "add_package":
+			creator, _ := msg.Value["creator"].(string)
+			add(creator, ROLE_CREATOR)
+		case msg.Route == GENESIS_ROUTE && msg.TypeUrl == GENESIS_TYPE_BALANCE:
+			address, _ := msg.Value["address"].(string)
+			add(address, ROLE_RECEIVER)
+		}
+	}
+
+	for _, event := range tx.Response.Events {
+		for _, attr := range event.Attrs {
+			if addressPattern.MatchString(attr.Value) {
+				add(attr.Value, ROLE_EVENT)
+			}
+		}
+	}
+
+	return txs
+}
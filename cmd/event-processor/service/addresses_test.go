@@ -0,0 +1,48 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestParseAddressTransactions(t *testing.T) {
+	const sender = "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5"
+	const receiver = "g17290cwvmrapvp869xfnhhawa8sm9edpufzat7d"
+	tx := &model.Transaction{
+		Hash:        "TX",
+		Index:       1,
+		BlockHeight: 10,
+		Success:     true,
+		Messages: []model.Message{
+			{Route: "bank", TypeUrl: "send", Value: map[string]any{"from_address": sender, "to_address": receiver, "amount": "1ugnot"}},
+			{Route: "vm", TypeUrl: "exec", Value: map[string]any{"caller": sender, "pkg_path": "gno.land/r/demo/foo20", "func": "Transfer"}},
+		},
+	}
+	tx.Response.Events = []model.Event{{Type: "Transfer", PkgPath: "gno.land/r/demo/foo20"}}
+	tx.Response.Events[0].Attrs = append(tx.Response.Events[0].Attrs,
+		struct {
+			Key   string "json:\"key\""
+			Value string "json:\"value\""
+		}{Key: "to", Value: receiver},
+		struct {
+			Key   string "json:\"key\""
+			Value string "json:\"value\""
+		}{Key: "value", Value: "100"},
+	)
+
+	txs := parseAddressTransactions(tx)
+	if len(txs) != 2 {
+		t.Fatalf("Expected 2 addresses, got %+v", txs)
+	}
+	if txs[0].Address != sender || !reflect.DeepEqual(txs[0].Roles, []string{ROLE_SIGNER, ROLE_SENDER, ROLE_CALLER}) {
+		t.Errorf("Unexpected sender roles %+v", txs[0])
+	}
+	if txs[1].Address != receiver || !reflect.DeepEqual(txs[1].Roles, []string{ROLE_RECEIVER, ROLE_EVENT}) {
+		t.Errorf("Unexpected receiver roles %+v", txs[1])
+	}
+	if txs[1].Hash != "TX" || txs[1].BlockHeight != 10 || txs[1].TxIndex != 1 || !txs[1].Success {
+		t.Errorf("Unexpected transaction %+v", txs[1])
+	}
+}
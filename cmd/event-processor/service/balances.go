@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+)
+
+const (
+	BALANCE_CHECKPOINT_INTERVAL = 10000 // Number of blocks between balance checkpoints
+	EVENT_INDEX_FEE             = -1    // Event index recorded for gas fee balance changes
+)
+
+// messageEventIndex returns the event index recorded for balance changes derived
+// from a message rather than an event, which never collides with real event indexes
+func messageEventIndex(msgIndex int) int {
+	return -(msgIndex + 2)
+}
+
+// checkpointBalances takes the balance checkpoint of the previous interval when a
+// block at an interval boundary is processed. Lagging one interval behind leaves
+// time for out-of-order blocks to land; later changes invalidate checkpoints anyway.
+func (s *service) checkpointBalances(ctx context.Context, height int) error {
+	if height <= 0 || height%BALANCE_CHECKPOINT_INTERVAL != 0 {
+		return nil
+	}
+
+	checkpointHeight := height - BALANCE_CHECKPOINT_INTERVAL
+	if checkpointHeight <= 0 {
+		return nil
+	}
+	previousHeight := checkpointHeight - BALANCE_CHECKPOINT_INTERVAL
+	if previousHeight <= 0 {
+		// The first checkpoint also covers the balances set at genesis
+		previousHeight = GENESIS_HEIGHT - 1
+	}
+
+	s.logger.Infof("Creating balance checkpoints at height %d", checkpointHeight)
+	if err := s.repo.CreateBalanceCheckpoints(ctx, checkpointHeight, previousHeight); err != nil {
+		return s.logger.Errorf("Failed to create balance checkpoints at height %d: %v", checkpointHeight, err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+// processRealmCalls records the MsgCall messages of a transaction, including
+// the calls of failed transactions so failures can be analyzed as well
+func (s *service) processRealmCalls(ctx context.Context, blockTime time.Time, tx *model.Transaction) error {
+	var calls []model.RealmCall
+	for i, msg := range tx.Messages {
+		if msg.Route != "vm" || msg.TypeUrl != "exec" {
+			continue
+		}
+
+		call, ok := parseRealmCall(tx, i, msg)
+		if !ok {
+			s.logger.Warnf("MsgCall %d without package path or function in transaction %s, skipping", i, tx.Hash)
+			continue
+		}
+		call.BlockTime = blockTime
+		calls = append(calls, *call)
+	}
+
+	if err := s.repo.AddRealmCalls(ctx, calls); err != nil {
+		return s.logger.Errorf("Failed to add realm calls for transaction %s: %v", tx.Hash, err)
+	}
+
+	return nil
+}
+
+// parseRealmCall extracts the realm call of a MsgCall message
+func parseRealmCall(tx *model.Transaction, msgIndex int, msg model.Message) (*model.RealmCall, bool) {
+	pkgPath, _ := msg.Value["pkg_path"].(string)
+	fn, _ := msg.Value["func"].(string)
+	if pkgPath == "" || fn == "" {
+		return nil, false
+	}
+
+	caller, _ := msg.Value["caller"].(string)
+	send, _ := msg.Value["send"].(string)
+
+	var args []string
+	rawArgs, _ := msg.Value["args"].([]any)
+	for _, rawArg := range rawArgs {
+		if arg, ok := rawArg.(string); ok {
+			args = append(args, arg)
+		} else {
+			args = append(args, fmt.Sprint(rawArg))
+		}
+	}
+
+	return &model.RealmCall{
+		Hash:        tx.Hash,
+		MsgIndex:    msgIndex,
+		BlockHeight: tx.BlockHeight,
+		PkgPath:     pkgPath,
+		Func:        fn,
+		Caller:      caller,
+		Args:        args,
+		Send:        send,
+		Success:     tx.Success,
+	}, true
+}
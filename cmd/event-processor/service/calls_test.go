@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestParseRealmCall(t *testing.T) {
+	tx := &model.Transaction{Hash: "hash", BlockHeight: 10, Success: false}
+	msg := model.Message{
+		Route:   "vm",
+		TypeUrl: "exec",
+		Value: map[string]any{
+			"caller":   "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5",
+			"send":     "1000ugnot",
+			"pkg_path": "gno.land/r/demo/foo",
+			"func":     "Transfer",
+			"args":     []any{"g1cuhgyjzwvz5hjec70xvfh4zqfx079c3r8rnrth", "100"},
+		},
+	}
+
+	call, ok := parseRealmCall(tx, 1, msg)
+	if !ok {
+		t.Fatalf("parseRealmCall failed")
+	}
+	if call.PkgPath != "gno.land/r/demo/foo" || call.Func != "Transfer" || call.MsgIndex != 1 || call.Send != "1000ugnot" || call.Success {
+		t.Errorf("unexpected call: %+v", call)
+	}
+	if len(call.Args) != 2 || call.Args[1] != "100" {
+		t.Errorf("unexpected args: %v", call.Args)
+	}
+
+	delete(msg.Value, "func")
+	if _, ok := parseRealmCall(tx, 1, msg); ok {
+		t.Errorf("parseRealmCall without func should fail")
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"errors"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+// ErrEventNotHandled is returned by a decoder to let the next matching decoder try the event
+var ErrEventNotHandled = errors.New("event not handled by decoder")
+
+// DecodedEvent holds the typed records and state mutations decoded from a GnoEvent
+type DecodedEvent struct {
+	Transfers    []model.Transfer    // Token transfers to record
+	Mutations    []BalanceMutation   // Balance changes to apply
+	NftTransfers []model.NftTransfer // NFT ownership changes to apply and record
+}
+
+// BalanceMutation is a signed change of the balance of an address for a token.
+// EventIndex is set by the event loop, decoders only need to set the reason.
+type BalanceMutation struct {
+	Address    string
+	Token      string
+	Delta      int64
+	Reason     string // Reason recorded with the balance change, e.g. transfer or fee
+	EventIndex int    // Index of the event, or a negative message index (see messageEventIndex)
+}
+
+// EventDecoder decodes a GnoEvent emitted by a transaction
+type EventDecoder func(tx *model.Transaction, event model.Event) (*DecodedEvent, error)
+
+// DecoderRegistration binds a decoder to the events it handles. PkgPattern is
+// matched against the event pkg_path: "" and "*" match any package, a trailing
+// "/..." matches a path prefix and anything else is a path.Match pattern.
+// EventType is compared case-insensitively, "" and "*" match any type.
+type DecoderRegistration struct {
+	PkgPattern string
+	EventType  string
+	Decoder    EventDecoder
+}
+
+// UnknownEventKey identifies events no decoder handled
+type UnknownEventKey struct {
+	PkgPath string
+	Type    string
+}
+
+// DecoderRegistry dispatches GnoEvents to the decoders registered for them,
+// in registration order, and counts the events no decoder handled
+type DecoderRegistry struct {
+	mu       sync.RWMutex
+	decoders []DecoderRegistration
+	unknown  map[UnknownEventKey]int64
+}
+
+func NewDecoderRegistry() *DecoderRegistry {
+	return &DecoderRegistry{
+		unknown: make(map[UnknownEventKey]int64),
+	}
+}
+
+// Register adds a decoder for events matching the package pattern and event type
+func (r *DecoderRegistry) Register(registration DecoderRegistration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.decoders = append(r.decoders, registration)
+}
+
+// Decode runs the first matching decoder which handles the event. It returns
+// false if no decoder handled it, in which case the event is counted as unknown.
+func (r *DecoderRegistry) Decode(tx *model.Transaction, event model.Event) (*DecodedEvent, bool, error) {
+	r.mu.RLock()
+	decoders := r.decoders
+	r.mu.RUnlock()
+
+	for _, registration := range decoders {
+		if !matchEventType(registration.EventType, event.Type) || !matchPkgPath(registration.PkgPattern, event.PkgPath) {
+			continue
+		}
+
+		decoded, err := registration.Decoder(tx, event)
+		if errors.Is(err, ErrEventNotHandled) {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if decoded == nil {
+			decoded = &DecodedEvent{}
+		}
+		return decoded, true, nil
+	}
+
+	r.mu.Lock()
+	r.unknown[UnknownEventKey{PkgPath: event.PkgPath, Type: event.Type}]++
+	r.mu.Unlock()
+	return nil, false, nil
+}
+
+// UnknownEvents returns how many events of each package and type were not handled
+func (r *DecoderRegistry) UnknownEvents() map[UnknownEventKey]int64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	unknown := make(map[UnknownEventKey]int64, len(r.unknown))
+	for key, count := range r.unknown {
+		unknown[key] = count
+	}
+	return unknown
+}
+
+func matchEventType(pattern, eventType string) bool {
+	return pattern == "" || pattern == "*" || strings.EqualFold(pattern, eventType)
+}
+
+func matchPkgPath(pattern, pkgPath string) bool {
+	switch {
+	case pattern == "" || pattern == "*":
+		return true
+	case strings.HasSuffix(pattern, "/..."):
+		prefix := strings.TrimSuffix(pattern, "/...")
+		return pkgPath == prefix || strings.HasPrefix(pkgPath, prefix+"/")
+	default:
+		matched, err := path.Match(pattern, pkgPath)
+		return err == nil && matched
+	}
+}
+
+// newDecoderRegistry creates the registry with the built-in decoders, followed by extra ones
+func (s *service) newDecoderRegistry(extra []DecoderRegistration) *DecoderRegistry {
+	registry := NewDecoderRegistry()
+	// GRC721 decoders come first, they skip events without a token ID
+	for _, eventType := range []string{"transfer", "mint", "burn"} {
+		registry.Register(DecoderRegistration{PkgPattern: "*", EventType: eventType, Decoder: s.decodeGRC721Transfer})
+	}
+	registry.Register(DecoderRegistration{PkgPattern: "*", EventType: "transfer", Decoder: s.decodeGRC20Transfer})
+	for _, registration := range extra {
+		registry.Register(registration)
+	}
+	return registry
+}
+
+// decodeGRC20Transfer decodes the Transfer events emitted by GRC20 mint, burn and transfer
+func (s *service) decodeGRC20Transfer(tx *model.Transaction, event model.Event) (*DecodedEvent, error) {
+	var fromAddress, toAddress string
+	var numValue int64
+	var hasValue bool
+	var err error
+
+	for _, attr := range event.Attrs {
+		if attr.Key == "from" {
+			fromAddress = attr.Value
+		} else if attr.Key == "to" {
+			toAddress = attr.Value
+		} else if attr.Key == "value" {
+			numValue, err = strconv.ParseInt(attr.Value, 10, 64)
+			if err != nil {
+				return nil, s.logger.Errorf("Invalid 'value' attribute in transfer event for transaction %s: %v", tx.Hash, err)
+			}
+			hasValue = true
+		}
+	}
+	if !hasValue {
+		return nil, ErrEventNotHandled
+	}
+
+	decoded := &DecodedEvent{}
+	reason := strings.ToLower(event.Func)
+	switch reason {
+	case "mint":
+		decoded.Mutations = []BalanceMutation{
+			{Address: toAddress, Token: event.PkgPath, Delta: numValue, Reason: reason},
+		}
+	case "burn":
+		decoded.Mutations = []BalanceMutation{
+			{Address: fromAddress, Token: event.PkgPath, Delta: -numValue, Reason: reason},
+		}
+	case "transfer":
+		decoded.Mutations = []BalanceMutation{
+			{Address: fromAddress, Token: event.PkgPath, Delta: -numValue, Reason: reason},
+			{Address: toAddress, Token: event.PkgPath, Delta: numValue, Reason: reason},
+		}
+	default:
+		s.logger.Warnf("Unknown transfer func %s in transaction %s", event.Func, tx.Hash)
+	}
+
+	decoded.Transfers = []model.Transfer{{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Token:       event.PkgPath,
+		CreatedAt:   time.Now(),
+		Amount:      float64(numValue),
+		Denom:       event.PkgPath, // GRC20 tokens are identified by their package path
+		Func:        event.Func,
+	}}
+
+	return decoded, nil
+}
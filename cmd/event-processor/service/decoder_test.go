@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestDecoderRegistry(t *testing.T) {
+	registry := NewDecoderRegistry()
+	registry.Register(DecoderRegistration{
+		PkgPattern: "gno.land/r/demo/...",
+		EventType:  "Transfer",
+		Decoder: func(tx *model.Transaction, event model.Event) (*DecodedEvent, error) {
+			return nil, ErrEventNotHandled
+		},
+	})
+	registry.Register(DecoderRegistration{
+		PkgPattern: "gno.land/r/*/foo",
+		EventType:  "transfer",
+		Decoder: func(tx *model.Transaction, event model.Event) (*DecodedEvent, error) {
+			return &DecodedEvent{Mutations: []BalanceMutation{{Address: "g1", Token: event.PkgPath, Delta: 1}}}, nil
+		},
+	})
+
+	tx := &model.Transaction{Hash: "TX"}
+	decoded, ok, err := registry.Decode(tx, model.Event{Type: "Transfer", PkgPath: "gno.land/r/demo/foo"})
+	if err != nil || !ok || len(decoded.Mutations) != 1 {
+		t.Fatalf("Expected the second decoder to handle the event, got %+v, %v, %v", decoded, ok, err)
+	}
+
+	_, ok, err = registry.Decode(tx, model.Event{Type: "Approval", PkgPath: "gno.land/r/demo/foo"})
+	if err != nil || ok {
+		t.Fatalf("Expected no decoder for Approval, got %v, %v", ok, err)
+	}
+	unknown := registry.UnknownEvents()
+	if unknown[UnknownEventKey{PkgPath: "gno.land/r/demo/foo", Type: "Approval"}] != 1 {
+		t.Errorf("Expected the Approval event to be counted as unknown, got %v", unknown)
+	}
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+// processGnoEvents records every GnoEvent of a transaction, whether a decoder handles it or not.
+// decoded tells for each event whether a decoder handled it, nil if unknown.
+func (s *service) processGnoEvents(ctx context.Context, blockTime time.Time, tx *model.Transaction, decoded []bool) error {
+	events := parseGnoEvents(tx, blockTime, decoded)
+	if err := s.repo.AddGnoEvents(ctx, events); err != nil {
+		return s.logger.Errorf("Failed to add events for transaction %s: %v", tx.Hash, err)
+	}
+
+	return nil
+}
+
+// parseGnoEvents converts the events of a transaction response to indexed events
+func parseGnoEvents(tx *model.Transaction, blockTime time.Time, decoded []bool) []model.GnoEvent {
+	events := make([]model.GnoEvent, len(tx.Response.Events))
+	for i, event := range tx.Response.Events {
+		attrs := make([]model.EventAttr, len(event.Attrs))
+		for j, attr := range event.Attrs {
+			attrs[j] = model.EventAttr{Key: attr.Key, Value: attr.Value}
+		}
+		events[i] = model.GnoEvent{
+			Hash:        tx.Hash,
+			EventIndex:  i,
+			BlockHeight: tx.BlockHeight,
+			TxIndex:     tx.Index,
+			BlockTime:   blockTime,
+			Type:        event.Type,
+			Func:        event.Func,
+			PkgPath:     event.PkgPath,
+			Attrs:       attrs,
+		}
+		if i < len(decoded) {
+			events[i].Decoded = &decoded[i]
+		}
+	}
+	return events
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestParseGnoEvents(t *testing.T) {
+	blockTime := time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC)
+	tx := &model.Transaction{Hash: "TX", Index: 2, BlockHeight: 10}
+	tx.Response.Events = []model.Event{
+		{Type: "StorageDeposit", PkgPath: "gno.land/r/demo/boards"},
+		{Type: "CreateThread", Func: "CreateThread", PkgPath: "gno.land/r/demo/boards"},
+	}
+	tx.Response.Events[1].Attrs = append(tx.Response.Events[1].Attrs, struct {
+		Key   string "json:\"key\""
+		Value string "json:\"value\""
+	}{Key: "threadId", Value: "7"})
+
+	events := parseGnoEvents(tx, blockTime, []bool{false, true})
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+	if events[0].Decoded == nil || *events[0].Decoded || events[1].Decoded == nil || !*events[1].Decoded {
+		t.Errorf("Unexpected decoded flags %v, %v", events[0].Decoded, events[1].Decoded)
+	}
+	if events := parseGnoEvents(tx, blockTime, nil); events[0].Decoded != nil {
+		t.Errorf("Expected an unknown decoded flag without decoding, got %v", *events[0].Decoded)
+	}
+	event := events[1]
+	if event.Hash != "TX" || event.EventIndex != 1 || event.BlockHeight != 10 || event.TxIndex != 2 || !event.BlockTime.Equal(blockTime) {
+		t.Errorf("Unexpected event position %+v", event)
+	}
+	if event.Type != "CreateThread" || len(event.Attrs) != 1 || event.Attrs[0] != (model.EventAttr{Key: "threadId", Value: "7"}) {
+		t.Errorf("Unexpected event %+v", event)
+	}
+	if len(events[0].Attrs) != 0 || events[0].Attrs == nil {
+		t.Errorf("Expected empty attributes, got %+v", events[0].Attrs)
+	}
+}
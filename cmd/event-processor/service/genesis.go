@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/machinebox/graphql"
+	"gno.land-block-indexer/externals/msgbroker"
+	"gno.land-block-indexer/model"
+)
+
+const (
+	GENESIS_HEIGHT        = 0         // Height used for the genesis block
+	GENESIS_ROUTE         = "genesis" // Message route of the synthetic genesis balance messages
+	GENESIS_TYPE_BALANCE  = "balance" // Message type of a genesis balance entry
+	FUNC_GENESIS          = "genesis" // Function name recorded for genesis balances
+	genesisBalancesTxMemo = "genesis balances"
+)
+
+// genesisDoc is the subset of a gno.land genesis.json used by the indexer
+type genesisDoc struct {
+	GenesisTime time.Time `json:"genesis_time"`
+	ChainID     string    `json:"chain_id"`
+	AppState    struct {
+		Balances []string          `json:"balances"`
+		Txs      []json.RawMessage `json:"txs"`
+	} `json:"app_state"`
+}
+
+// genesisTx is a std.Tx as found in the genesis app state
+type genesisTx struct {
+	Msg []map[string]any `json:"msg"`
+	Fee struct {
+		GasWanted json.Number `json:"gas_wanted"`
+		GasFee    string      `json:"gas_fee"`
+	} `json:"fee"`
+	Memo string `json:"memo"`
+}
+
+// genesisMessageTypes maps amino message types to the route/typeUrl pairs used by the tx-indexer
+var genesisMessageTypes = map[string][2]string{
+	"/bank.MsgSend": {"bank", "send"},
+	"/vm.m_call":    {"vm", "exec"},
+	"/vm.m_addpkg":  {"vm", "add_package"},
+	"/vm.m_run":     {"vm", "run"},
+}
+
+// genesisResultsQuery fetches the execution results of the genesis txs, which the
+// tx-indexer stores at GENESIS_HEIGHT in app state order
+const genesisResultsQuery = `
+	query {
+		getTransactions(where: {block_height: {gt: -1, lt: 1}}) {
+			index
+			hash
+			success
+			gas_used
+			response {
+				log
+				info
+				error
+				data
+				events {
+					... on GnoEvent {
+						type
+						func
+						pkg_path
+						attrs {
+							key
+							value
+						}
+					}
+				}
+			}
+		}
+	}`
+
+// ImportGenesis implements Service.
+//
+// Genesis balances are turned into a synthetic transaction of genesis/balance
+// messages, and genesis transactions follow it, so the whole genesis state goes
+// through the regular block pipeline as height 0. Genesis files carry no
+// execution results, so the results and events of the genesis txs are fetched
+// from the tx-indexer; the import fails rather than silently dropping the
+// event-derived state (GRC20 mints, NFTs) when they are not available.
+func (s *service) ImportGenesis(ctx context.Context, genesisPath string) error {
+	data, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return s.logger.Errorf("failed to read genesis file %s: %v", genesisPath, err)
+	}
+
+	blockWithTxs, err := parseGenesis(data)
+	if err != nil {
+		return s.logger.Errorf("failed to parse genesis file %s: %v", genesisPath, err)
+	}
+
+	genesisTxs := blockWithTxs.Transactions
+	if len(genesisTxs) > 0 && genesisTxs[0].Messages[0].Route == GENESIS_ROUTE {
+		genesisTxs = genesisTxs[1:] // The synthetic balances tx has no results
+	}
+	if len(genesisTxs) > 0 {
+		results, err := s.fetchGenesisResults(ctx)
+		if err != nil {
+			return s.logger.Errorf("failed to fetch the events of %d genesis txs: %v", len(genesisTxs), err)
+		}
+		if err := applyGenesisResults(genesisTxs, results); err != nil {
+			return s.logger.Errorf("failed to apply the results of the genesis txs: %v", err)
+		}
+	}
+
+	s.logger.Infof("Importing genesis with %d transactions", len(blockWithTxs.Transactions))
+	return s.ProcessBlockWithTransactions(ctx, blockWithTxs)
+}
+
+// fetchGenesisResults fetches the genesis tx results from the tx-indexer
+func (s *service) fetchGenesisResults(ctx context.Context) ([]model.Transaction, error) {
+	if s.txIndexer == nil {
+		return nil, fmt.Errorf("no tx-indexer endpoint is configured")
+	}
+
+	var resp struct {
+		GetTransactions []model.Transaction `json:"getTransactions"`
+	}
+	if err := s.txIndexer.Run(ctx, graphql.NewRequest(genesisResultsQuery), &resp); err != nil {
+		return nil, fmt.Errorf("failed to execute GraphQL query: %w", err)
+	}
+
+	return resp.GetTransactions, nil
+}
+
+// applyGenesisResults copies the tx-indexer results onto the genesis txs, matching them by order
+func applyGenesisResults(txs []model.Transaction, results []model.Transaction) error {
+	if len(results) != len(txs) {
+		return fmt.Errorf("tx-indexer has %d genesis tx results for %d genesis txs", len(results), len(txs))
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+	for i := range txs {
+		txs[i].Hash = results[i].Hash
+		txs[i].Success = results[i].Success
+		txs[i].GasUsed = results[i].GasUsed
+		txs[i].Response = results[i].Response
+	}
+
+	return nil
+}
+
+// parseGenesis converts a genesis document into the block at GENESIS_HEIGHT
+func parseGenesis(data []byte) (msgbroker.BlockWithTransactions, error) {
+	var doc genesisDoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return msgbroker.BlockWithTransactions{}, fmt.Errorf("invalid genesis document: %w", err)
+	}
+
+	transactions := make([]model.Transaction, 0, len(doc.AppState.Txs)+1)
+
+	balanceMessages := make([]model.Message, 0, len(doc.AppState.Balances))
+	for _, balance := range doc.AppState.Balances {
+		address, amount, ok := strings.Cut(balance, "=")
+		if !ok {
+			return msgbroker.BlockWithTransactions{}, fmt.Errorf("invalid genesis balance %q", balance)
+		}
+		if _, err := parseCoins(amount); err != nil {
+			return msgbroker.BlockWithTransactions{}, fmt.Errorf("invalid genesis balance %q: %w", balance, err)
+		}
+		balanceMessages = append(balanceMessages, model.Message{
+			Route:   GENESIS_ROUTE,
+			TypeUrl: GENESIS_TYPE_BALANCE,
+			Value: map[string]any{
+				"address": strings.TrimSpace(address),
+				"amount":  strings.TrimSpace(amount),
+			},
+		})
+	}
+	if len(balanceMessages) > 0 {
+		transactions = append(transactions, model.Transaction{
+			Hash:        genesisHash([]byte(strings.Join(doc.AppState.Balances, "\n"))),
+			Success:     true,
+			BlockHeight: GENESIS_HEIGHT,
+			Memo:        genesisBalancesTxMemo,
+			GasFee:      model.GasFee{Denom: UNIT_NAME},
+			Messages:    balanceMessages,
+		})
+	}
+
+	for _, raw := range doc.AppState.Txs {
+		tx, err := parseGenesisTx(raw)
+		if err != nil {
+			return msgbroker.BlockWithTransactions{}, err
+		}
+		tx.Index = len(transactions)
+		transactions = append(transactions, tx)
+	}
+
+	return msgbroker.BlockWithTransactions{
+		Block: &model.Block{
+			Hash:     genesisHash(data),
+			Height:   GENESIS_HEIGHT,
+			Time:     doc.GenesisTime,
+			TotalTxs: len(transactions),
+			NumTxs:   len(transactions),
+		},
+		Transactions: transactions,
+	}, nil
+}
+
+// parseGenesisTx converts a genesis tx, either a bare std.Tx or one wrapped
+// with metadata ({"tx": ..., "metadata": ...}), into a model transaction
+func parseGenesisTx(raw json.RawMessage) (model.Transaction, error) {
+	var wrapped struct {
+		Tx *genesisTx `json:"tx"`
+	}
+	if err := json.Unmarshal(raw, &wrapped); err != nil {
+		return model.Transaction{}, fmt.Errorf("invalid genesis tx: %w", err)
+	}
+	tx := wrapped.Tx
+	if tx == nil {
+		tx = &genesisTx{}
+		if err := json.Unmarshal(raw, tx); err != nil {
+			return model.Transaction{}, fmt.Errorf("invalid genesis tx: %w", err)
+		}
+	}
+
+	messages := make([]model.Message, 0, len(tx.Msg))
+	for _, msg := range tx.Msg {
+		msgType, _ := msg["@type"].(string)
+		route, ok := genesisMessageTypes[msgType]
+		if !ok {
+			return model.Transaction{}, fmt.Errorf("unsupported genesis message type %q", msgType)
+		}
+
+		value := make(map[string]any, len(msg))
+		for k, v := range msg {
+			if k != "@type" {
+				value[k] = v
+			}
+		}
+		messages = append(messages, model.Message{
+			Route:   route[0],
+			TypeUrl: route[1],
+			Value:   value,
+		})
+	}
+
+	gasWanted, _ := tx.Fee.GasWanted.Float64()
+
+	// Genesis transactions are delivered without deducting fees
+	return model.Transaction{
+		Hash:        genesisHash(raw),
+		Success:     true,
+		BlockHeight: GENESIS_HEIGHT,
+		GasWanted:   gasWanted,
+		Memo:        tx.Memo,
+		GasFee:      model.GasFee{Denom: UNIT_NAME},
+		Messages:    messages,
+	}, nil
+}
+
+// genesisHash derives a deterministic hash for genesis data, encoded like chain hashes
+func genesisHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
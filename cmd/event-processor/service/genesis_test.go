@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestParseGenesis(t *testing.T) {
+	genesis := []byte(`{
+	  "genesis_time": "2024-01-01T00:00:00Z",
+	  "chain_id": "test",
+	  "app_state": {
+	    "@type": "/gno.GenesisState",
+	    "balances": ["g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5=10000000ugnot"],
+	    "txs": [
+	      {"tx": {"msg": [{"@type": "/bank.MsgSend", "from_address": "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5", "to_address": "g1cuhgyjzwvz5hjec70xvfh4zqfx079c3r8rnrth", "amount": "100ugnot"}], "fee": {"gas_wanted": "50000", "gas_fee": "1000000ugnot"}, "memo": ""}},
+	      {"msg": [{"@type": "/vm.m_call", "caller": "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5", "pkg_path": "gno.land/r/demo/foo", "func": "Foo", "args": null, "send": ""}], "fee": {"gas_wanted": "50000", "gas_fee": "1ugnot"}, "memo": "call"}
+	    ]
+	  }
+	}`)
+
+	blockWithTxs, err := parseGenesis(genesis)
+	if err != nil {
+		t.Fatalf("Failed to parse genesis: %v", err)
+	}
+	if blockWithTxs.Block.Height != GENESIS_HEIGHT || blockWithTxs.Block.Hash == "" {
+		t.Fatalf("Unexpected genesis block: %+v", blockWithTxs.Block)
+	}
+	if len(blockWithTxs.Transactions) != 3 {
+		t.Fatalf("Expected 3 transactions, got %d", len(blockWithTxs.Transactions))
+	}
+
+	balances := blockWithTxs.Transactions[0]
+	if balances.Messages[0].Route != GENESIS_ROUTE || balances.Messages[0].Value["amount"] != "10000000ugnot" {
+		t.Errorf("Unexpected genesis balance message: %+v", balances.Messages[0])
+	}
+	send := blockWithTxs.Transactions[1]
+	if send.Index != 1 || send.Messages[0].Route != "bank" || send.Messages[0].TypeUrl != "send" || send.GasFee.Amount != 0 {
+		t.Errorf("Unexpected genesis bank send: %+v", send)
+	}
+	call := blockWithTxs.Transactions[2]
+	if call.Messages[0].TypeUrl != "exec" || call.Memo != "call" || feePayer(&call) != "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5" {
+		t.Errorf("Unexpected genesis call: %+v", call)
+	}
+}
+
+func TestApplyGenesisResults(t *testing.T) {
+	txs := []model.Transaction{{Hash: "a", Index: 1}, {Hash: "b", Index: 2}}
+	results := []model.Transaction{
+		{Index: 1, Hash: "HASH2", Success: false},
+		{Index: 0, Hash: "HASH1", Success: true, Response: model.Response{Events: []model.Event{{Type: "Transfer", PkgPath: "gno.land/r/demo/foo"}}}},
+	}
+
+	if err := applyGenesisResults(txs, results); err != nil {
+		t.Fatalf("Failed to apply genesis results: %v", err)
+	}
+	if txs[0].Hash != "HASH1" || !txs[0].Success || len(txs[0].Response.Events) != 1 || txs[0].Index != 1 {
+		t.Errorf("Unexpected first genesis tx: %+v", txs[0])
+	}
+	if txs[1].Hash != "HASH2" || txs[1].Success {
+		t.Errorf("Unexpected second genesis tx: %+v", txs[1])
+	}
+
+	if err := applyGenesisResults(txs, results[:1]); err == nil {
+		t.Errorf("Expected an error for missing genesis tx results")
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+const (
+	GRC721_ATTR_TOKEN_ID = "tokenId" // Attribute carrying the token ID of GRC721 events
+)
+
+// decodeGRC721Transfer decodes the Mint, Burn and Transfer events of GRC721
+// collections, which unlike GRC20 events carry a token ID instead of a value
+func (s *service) decodeGRC721Transfer(tx *model.Transaction, event model.Event) (*DecodedEvent, error) {
+	var fromAddress, toAddress, tokenID string
+	for _, attr := range event.Attrs {
+		switch attr.Key {
+		case "from":
+			fromAddress = attr.Value
+		case "to":
+			toAddress = attr.Value
+		case GRC721_ATTR_TOKEN_ID:
+			tokenID = attr.Value
+		}
+	}
+	if tokenID == "" {
+		return nil, ErrEventNotHandled
+	}
+
+	kind := strings.ToLower(event.Type)
+	if kind == "transfer" && fromAddress == "" {
+		kind = "mint"
+	} else if kind == "transfer" && toAddress == "" {
+		kind = "burn"
+	}
+
+	return &DecodedEvent{
+		NftTransfers: []model.NftTransfer{{
+			Hash:        tx.Hash,
+			Func:        kind,
+			Collection:  event.PkgPath,
+			TokenID:     tokenID,
+			FromAddress: fromAddress,
+			ToAddress:   toAddress,
+			BlockHeight: tx.BlockHeight,
+			CreatedAt:   time.Now(),
+		}},
+	}, nil
+}
+
+// applyNftTransfers moves NFT ownership and records the ownership history
+func (s *service) applyNftTransfers(ctx context.Context, tx *model.Transaction, transfers []model.NftTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	for _, transfer := range transfers {
+		token := &model.Nft{
+			Collection: transfer.Collection,
+			TokenID:    transfer.TokenID,
+			LastHeight: tx.BlockHeight,
+		}
+		switch transfer.Func {
+		case "burn":
+			token.Burned = true
+		case "mint":
+			token.Owner = transfer.ToAddress
+			token.MintedHeight = tx.BlockHeight
+		default:
+			token.Owner = transfer.ToAddress
+		}
+
+		if err := s.repo.UpdateNftOwner(ctx, token); err != nil {
+			return s.logger.Errorf("Failed to update owner of nft %s/%s: %v", transfer.Collection, transfer.TokenID, err)
+		}
+	}
+
+	if err := s.repo.AddNftTransfers(ctx, tx, transfers); err != nil {
+		return s.logger.Errorf("Failed to add nft transfers for transaction %s: %v", tx.Hash, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/lib/log"
+	"gno.land-block-indexer/model"
+)
+
+func TestDecodeGRC721Transfer(t *testing.T) {
+	s := &service{logger: log.NewLogger()}
+	s.decoders = s.newDecoderRegistry(nil)
+	tx := &model.Transaction{Hash: "TX", BlockHeight: 10}
+
+	attrs := func(kv ...string) []struct {
+		Key   string "json:\"key\""
+		Value string "json:\"value\""
+	} {
+		var list []struct {
+			Key   string "json:\"key\""
+			Value string "json:\"value\""
+		}
+		for i := 0; i < len(kv); i += 2 {
+			list = append(list, struct {
+				Key   string "json:\"key\""
+				Value string "json:\"value\""
+			}{Key: kv[i], Value: kv[i+1]})
+		}
+		return list
+	}
+
+	decoded, ok, err := s.decoders.Decode(tx, model.Event{
+		Type:    "Mint",
+		PkgPath: "gno.land/r/demo/nft",
+		Attrs:   attrs("to", "g17290cwvmrapvp869xfnhhawa8sm9edpufzat7d", "tokenId", "1"),
+	})
+	if err != nil || !ok || len(decoded.NftTransfers) != 1 || decoded.NftTransfers[0].Func != "mint" {
+		t.Fatalf("Expected a GRC721 mint, got %+v, %v, %v", decoded, ok, err)
+	}
+
+	decoded, ok, err = s.decoders.Decode(tx, model.Event{
+		Type:    "Transfer",
+		Func:    "Transfer",
+		PkgPath: "gno.land/r/demo/foo20",
+		Attrs:   attrs("from", "g16a7etgm9z2r653ucl36rj0l2yqcxgrz2jyegzx", "to", "g17290cwvmrapvp869xfnhhawa8sm9edpufzat7d", "value", "100"),
+	})
+	if err != nil || !ok || len(decoded.NftTransfers) != 0 || len(decoded.Mutations) != 2 {
+		t.Fatalf("Expected a GRC20 transfer, got %+v, %v, %v", decoded, ok, err)
+	}
+	if decoded.Mutations[0].Reason != "transfer" || decoded.Mutations[0].Delta != -100 {
+		t.Errorf("Expected a -100 transfer balance change, got %+v", decoded.Mutations[0])
+	}
+	if decoded.Transfers[0].Denom != "gno.land/r/demo/foo20" {
+		t.Errorf("Expected the token path as denom, got %s", decoded.Transfers[0].Denom)
+	}
+}
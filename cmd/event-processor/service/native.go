@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+const (
+	FUNC_FEE = "fee" // Function name recorded for gas fee payments
+)
+
+type coin struct {
+	Amount int64
+	Denom  string
+}
+
+// parseCoins parses a coin string such as "1000000ugnot" or "100ugnot,5foo"
+func parseCoins(coins string) ([]coin, error) {
+	coins = strings.TrimSpace(coins)
+	if coins == "" {
+		return nil, nil
+	}
+
+	var parsed []coin
+	for _, raw := range strings.Split(coins, ",") {
+		raw = strings.TrimSpace(raw)
+		i := 0
+		for i < len(raw) && raw[i] >= '0' && raw[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(raw) {
+			return nil, fmt.Errorf("invalid coin %q", raw)
+		}
+
+		amount, err := strconv.ParseInt(raw[:i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coin amount %q: %w", raw, err)
+		}
+		parsed = append(parsed, coin{Amount: amount, Denom: raw[i:]})
+	}
+
+	return parsed, nil
+}
+
+// messageSigner returns the address signing a message, which is the sender for
+// bank sends, the caller for MsgCall/MsgRun and the creator for MsgAddPackage
+func messageSigner(msg model.Message) string {
+	for _, key := range []string{"from_address", "caller", "creator"} {
+		if address, ok := msg.Value[key].(string); ok && address != "" {
+			return address
+		}
+	}
+	return ""
+}
+
+// feePayer returns the address paying the gas fee of a transaction (the first signer)
+func feePayer(tx *model.Transaction) string {
+	for _, msg := range tx.Messages {
+		if signer := messageSigner(msg); signer != "" {
+			return signer
+		}
+	}
+	return ""
+}
+
+// processNativeTransfers applies native coin movements of a transaction, which are
+// carried by bank/send messages and the gas fee rather than by GnoEvents
+func (s *service) processNativeTransfers(ctx context.Context, tx *model.Transaction) ([]model.Transfer, error) {
+	transfers := make([]model.Transfer, 0)
+
+	// Gas fees are charged to the fee payer even when the transaction fails
+	if tx.GasFee.Amount > 0 {
+		payer := feePayer(tx)
+		denom := tx.GasFee.Denom
+		if denom == "" {
+			denom = UNIT_NAME
+		}
+		if payer == "" {
+			s.logger.Warnf("Transaction %s has a gas fee but no signer, skipping fee", tx.Hash)
+		} else {
+			mutations := []BalanceMutation{{Address: payer, Token: denom, Delta: -int64(tx.GasFee.Amount), Reason: FUNC_FEE, EventIndex: EVENT_INDEX_FEE}}
+			if err := s.applyBalanceMutations(ctx, tx, mutations); err != nil {
+				return nil, s.logger.Errorf("Failed to charge gas fee to %s: %v", payer, err)
+			}
+			transfers = append(transfers, model.Transfer{
+				Func:        FUNC_FEE,
+				FromAddress: payer,
+				Token:       denom,
+				Amount:      tx.GasFee.Amount,
+				Denom:       denom,
+				MsgIndex:    -1,
+				EventIndex:  EVENT_INDEX_FEE,
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	if !tx.Success {
+		return transfers, nil
+	}
+
+	for i, msg := range tx.Messages {
+		switch {
+		case msg.Route == GENESIS_ROUTE && msg.TypeUrl == GENESIS_TYPE_BALANCE:
+			genesisTransfers, err := s.processGenesisBalance(ctx, tx, i, msg)
+			if err != nil {
+				return nil, err
+			}
+			transfers = append(transfers, genesisTransfers...)
+		case msg.Route == "bank" && msg.TypeUrl == "send":
+			bankTransfers, err := s.processBankSend(ctx, tx, i, msg)
+			if err != nil {
+				return nil, err
+			}
+			transfers = append(transfers, bankTransfers...)
+		}
+	}
+
+	return transfers, nil
+}
+
+// processBankSend moves the coins of a bank/send message from sender to receiver
+func (s *service) processBankSend(ctx context.Context, tx *model.Transaction, msgIndex int, msg model.Message) ([]model.Transfer, error) {
+	fromAddress, _ := msg.Value["from_address"].(string)
+	toAddress, _ := msg.Value["to_address"].(string)
+	amount, _ := msg.Value["amount"].(string)
+	coins, err := parseCoins(amount)
+	if err != nil {
+		return nil, s.logger.Errorf("Invalid amount in bank send of transaction %s: %v", tx.Hash, err)
+	}
+
+	transfers := make([]model.Transfer, 0, len(coins))
+	for _, c := range coins {
+		if c.Amount == 0 {
+			continue
+		}
+		mutations := []BalanceMutation{
+			{Address: fromAddress, Token: c.Denom, Delta: -c.Amount, Reason: "transfer", EventIndex: messageEventIndex(msgIndex)},
+			{Address: toAddress, Token: c.Denom, Delta: c.Amount, Reason: "transfer", EventIndex: messageEventIndex(msgIndex)},
+		}
+		if err := s.applyBalanceMutations(ctx, tx, mutations); err != nil {
+			return nil, s.logger.Errorf("Failed to handle bank send for transaction %s: %v", tx.Hash, err)
+		}
+		transfers = append(transfers, model.Transfer{
+			Func:        "transfer",
+			FromAddress: fromAddress,
+			ToAddress:   toAddress,
+			Token:       c.Denom,
+			Amount:      float64(c.Amount),
+			Denom:       c.Denom,
+			MsgIndex:    msgIndex,
+			EventIndex:  messageEventIndex(msgIndex),
+			CreatedAt:   time.Now(),
+		})
+	}
+
+	return transfers, nil
+}
+
+// processGenesisBalance credits an initial balance from the genesis app state
+func (s *service) processGenesisBalance(ctx context.Context, tx *model.Transaction, msgIndex int, msg model.Message) ([]model.Transfer, error) {
+	address, _ := msg.Value["address"].(string)
+	amount, _ := msg.Value["amount"].(string)
+	coins, err := parseCoins(amount)
+	if err != nil {
+		return nil, s.logger.Errorf("Invalid genesis balance for %s: %v", address, err)
+	}
+
+	transfers := make([]model.Transfer, 0, len(coins))
+	for _, c := range coins {
+		if c.Amount == 0 {
+			continue
+		}
+		mutations := []BalanceMutation{{Address: address, Token: c.Denom, Delta: c.Amount, Reason: FUNC_GENESIS, EventIndex: messageEventIndex(msgIndex)}}
+		if err := s.applyBalanceMutations(ctx, tx, mutations); err != nil {
+			return nil, s.logger.Errorf("Failed to credit genesis balance for %s: %v", address, err)
+		}
+		transfers = append(transfers, model.Transfer{
+			Func:       FUNC_GENESIS,
+			ToAddress:  address,
+			Token:      c.Denom,
+			Amount:     float64(c.Amount),
+			Denom:      c.Denom,
+			MsgIndex:   msgIndex,
+			EventIndex: messageEventIndex(msgIndex),
+			CreatedAt:  time.Now(),
+		})
+	}
+
+	return transfers, nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+)
+
+func TestParseCoins(t *testing.T) {
+	tcs := []struct {
+		input   string
+		want    []coin
+		wantErr bool
+	}{
+		{input: "", want: nil},
+		{input: "1000000ugnot", want: []coin{{Amount: 1000000, Denom: "ugnot"}}},
+		{input: "100ugnot, 5foo", want: []coin{{Amount: 100, Denom: "ugnot"}, {Amount: 5, Denom: "foo"}}},
+		{input: "ugnot", wantErr: true},
+		{input: "100", wantErr: true},
+	}
+
+	for _, tc := range tcs {
+		got, err := parseCoins(tc.input)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("parseCoins(%q) expected error", tc.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parseCoins(%q) failed: %v", tc.input, err)
+		}
+		if len(got) != len(tc.want) {
+			t.Fatalf("parseCoins(%q) = %v, want %v", tc.input, got, tc.want)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("parseCoins(%q)[%d] = %v, want %v", tc.input, i, got[i], tc.want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+// processPackages records the packages deployed by the MsgAddPackage messages of a transaction
+func (s *service) processPackages(ctx context.Context, tx *model.Transaction) error {
+	if !tx.Success {
+		return nil
+	}
+
+	for _, msg := range tx.Messages {
+		if msg.Route != "vm" || msg.TypeUrl != "add_package" {
+			continue
+		}
+
+		pkg, ok := parseAddPackage(tx, msg)
+		if !ok {
+			s.logger.Warnf("MsgAddPackage without package path in transaction %s, skipping", tx.Hash)
+			continue
+		}
+		if err := s.repo.AddPackage(ctx, pkg); err != nil {
+			return s.logger.Errorf("Failed to add package %s: %v", pkg.Path, err)
+		}
+		if err := s.processTokenMetadata(ctx, pkg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseAddPackage extracts the package deployed by a MsgAddPackage message
+func parseAddPackage(tx *model.Transaction, msg model.Message) (*model.Package, bool) {
+	pkgValue, _ := msg.Value["package"].(map[string]any)
+	path, _ := pkgValue["path"].(string)
+	if path == "" {
+		return nil, false
+	}
+
+	name, _ := pkgValue["name"].(string)
+	creator, _ := msg.Value["creator"].(string)
+	deposit, _ := msg.Value["deposit"].(string)
+
+	var files []model.PackageFile
+	rawFiles, _ := pkgValue["files"].([]any)
+	for _, rawFile := range rawFiles {
+		file, _ := rawFile.(map[string]any)
+		fileName, _ := file["name"].(string)
+		body, _ := file["body"].(string)
+		if fileName == "" {
+			continue
+		}
+		files = append(files, model.PackageFile{Name: fileName, Body: body})
+	}
+
+	return &model.Package{
+		Path:        path,
+		Name:        name,
+		Namespace:   packageNamespace(path),
+		Creator:     creator,
+		Deposit:     deposit,
+		BlockHeight: tx.BlockHeight,
+		Hash:        tx.Hash,
+		Files:       files,
+		CreatedAt:   time.Now(),
+	}, true
+}
+
+// packageNamespace returns the namespace of a package path, e.g. demo for gno.land/r/demo/foo
+func packageNamespace(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[2]
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestParseAddPackage(t *testing.T) {
+	tx := &model.Transaction{Hash: "hash", BlockHeight: 10, Success: true}
+	msg := model.Message{
+		Route:   "vm",
+		TypeUrl: "add_package",
+		Value: map[string]any{
+			"creator": "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5",
+			"deposit": "",
+			"package": map[string]any{
+				"name": "foo",
+				"path": "gno.land/r/demo/foo",
+				"files": []any{
+					map[string]any{"name": "foo.gno", "body": "package foo"},
+				},
+			},
+		},
+	}
+
+	pkg, ok := parseAddPackage(tx, msg)
+	if !ok {
+		t.Fatalf("parseAddPackage failed")
+	}
+	if pkg.Path != "gno.land/r/demo/foo" || pkg.Name != "foo" || pkg.Namespace != "demo" || pkg.BlockHeight != 10 {
+		t.Errorf("unexpected package: %+v", pkg)
+	}
+	if len(pkg.Files) != 1 || pkg.Files[0].Name != "foo.gno" || pkg.Files[0].Body != "package foo" {
+		t.Errorf("unexpected files: %+v", pkg.Files)
+	}
+
+	delete(msg.Value["package"].(map[string]any), "path")
+	if _, ok := parseAddPackage(tx, msg); ok {
+		t.Errorf("parseAddPackage without path should fail")
+	}
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gno.land-block-indexer/model"
+	"gno.land-block-indexer/repository"
+)
+
+const (
+	STAGE_PERSIST     = "persist"     // Stores the block and its transactions
+	STAGE_TRANSFERS   = "transfers"   // Applies balance and NFT changes and records the transfers
+	STAGE_PACKAGES    = "packages"    // Records deployed packages and their token metadata
+	STAGE_CALLS       = "calls"       // Records realm calls
+	STAGE_EVENTS      = "events"      // Records every GnoEvent
+	STAGE_ADDRESSES   = "addresses"   // Records the transactions of each address
+	STAGE_WEBHOOKS    = "webhooks"    // Queues webhooks for the transfers matching watch rules
+	STAGE_TOKEN_STATS = "token_stats" // Updates the token supply and holder statistics
+	STAGE_CHECKPOINTS = "checkpoints" // Takes the periodic balance checkpoints
+
+	PIPELINE_MAX_ATTEMPTS = 3 // Attempts of a block, e.g. when its database transaction hits a deadlock
+)
+
+// DefaultStages are the built-in stages run on each block, in order
+var DefaultStages = []string{
+	STAGE_PERSIST,
+	STAGE_TRANSFERS,
+	STAGE_PACKAGES,
+	STAGE_CALLS,
+	STAGE_EVENTS,
+	STAGE_ADDRESSES,
+	STAGE_WEBHOOKS,
+	STAGE_TOKEN_STATS,
+	STAGE_CHECKPOINTS,
+}
+
+// BlockContext is a block going through the pipeline. Repo is bound to the database
+// transaction of the block, so the work of all stages is committed or rolled back together.
+type BlockContext struct {
+	Block        *model.Block
+	Transactions []model.Transaction
+	Repo         repository.Repository
+	Replay       bool               // Whether the block is replayed by a rebuild, which neither stores blocks nor notifies
+	Transfers    [][]model.Transfer // Transfers of each transaction, set by the transfers stage
+	Decoded      [][]bool           // Whether a decoder handled each event of each transaction, set by the transfers stage
+	Skip         bool               // Set by a stage to end the pipeline early, e.g. for an already stored block
+}
+
+// BlockProcessor is a stage of the block pipeline
+type BlockProcessor interface {
+	Name() string
+	ProcessBlock(ctx context.Context, block *BlockContext) error
+}
+
+type blockProcessorFunc struct {
+	name string
+	fn   func(ctx context.Context, block *BlockContext) error
+}
+
+// NewBlockProcessor makes a stage from a function
+func NewBlockProcessor(name string, fn func(ctx context.Context, block *BlockContext) error) BlockProcessor {
+	return &blockProcessorFunc{name: name, fn: fn}
+}
+
+func (p *blockProcessorFunc) Name() string {
+	return p.name
+}
+
+func (p *blockProcessorFunc) ProcessBlock(ctx context.Context, block *BlockContext) error {
+	return p.fn(ctx, block)
+}
+
+// StageStats are the timings and errors of a pipeline stage
+type StageStats struct {
+	Name          string
+	Blocks        int64
+	Errors        int64
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+	LastError     string
+}
+
+// Pipeline runs the stages of each block in order
+type Pipeline struct {
+	stages []BlockProcessor
+
+	mu    sync.Mutex
+	stats map[string]*StageStats
+}
+
+func NewPipeline(stages []BlockProcessor) *Pipeline {
+	stats := make(map[string]*StageStats, len(stages))
+	for _, stage := range stages {
+		stats[stage.Name()] = &StageStats{Name: stage.Name()}
+	}
+	return &Pipeline{stages: stages, stats: stats}
+}
+
+// Process runs the stages on a block until one fails or skips the rest
+func (p *Pipeline) Process(ctx context.Context, block *BlockContext) error {
+	for _, stage := range p.stages {
+		start := time.Now()
+		err := stage.ProcessBlock(ctx, block)
+		p.record(stage.Name(), time.Since(start), err)
+		if err != nil {
+			return fmt.Errorf("stage %s: %w", stage.Name(), err)
+		}
+		if block.Skip {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (p *Pipeline) record(name string, duration time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := p.stats[name]
+	stats.Blocks++
+	stats.TotalDuration += duration
+	stats.MaxDuration = max(stats.MaxDuration, duration)
+	if err != nil {
+		stats.Errors++
+		stats.LastError = err.Error()
+	}
+}
+
+// Stats returns the statistics of the stages, in pipeline order
+func (p *Pipeline) Stats() []StageStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]StageStats, len(p.stages))
+	for i, stage := range p.stages {
+		stats[i] = *p.stats[stage.Name()]
+	}
+	return stats
+}
+
+// newPipeline builds the pipeline of the enabled stages, built-in or custom, in order
+func (s *service) newPipeline(enabled []string, custom []BlockProcessor) (*Pipeline, error) {
+	available := make(map[string]BlockProcessor)
+	for _, stage := range s.builtinStages() {
+		available[stage.Name()] = stage
+	}
+	for _, stage := range custom {
+		if _, ok := available[stage.Name()]; ok {
+			return nil, fmt.Errorf("stage %s is already registered", stage.Name())
+		}
+		available[stage.Name()] = stage
+	}
+
+	if enabled == nil {
+		enabled = DefaultStages
+	}
+	stages := make([]BlockProcessor, 0, len(enabled))
+	seen := make(map[string]bool)
+	for _, name := range enabled {
+		stage, ok := available[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown stage %s", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("stage %s is enabled twice", name)
+		}
+		seen[name] = true
+		stages = append(stages, stage)
+	}
+
+	return NewPipeline(stages), nil
+}
+
+// withRepo returns a copy of the service working with repo, e.g. the one of a block transaction
+func (s *service) withRepo(repo repository.Repository) *service {
+	bound := *s
+	bound.repo = repo
+	return &bound
+}
+
+// transactionStage makes a stage running fn on each transaction of the block
+func (s *service) transactionStage(name string, fn func(s *service, ctx context.Context, block *model.Block, tx *model.Transaction) error) BlockProcessor {
+	return NewBlockProcessor(name, func(ctx context.Context, block *BlockContext) error {
+		bound := s.withRepo(block.Repo)
+		for i := range block.Transactions {
+			if err := fn(bound, ctx, block.Block, &block.Transactions[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *service) builtinStages() []BlockProcessor {
+	return []BlockProcessor{
+		NewBlockProcessor(STAGE_PERSIST, s.persistBlock),
+		NewBlockProcessor(STAGE_TRANSFERS, s.processBlockTransfers),
+		s.transactionStage(STAGE_PACKAGES, func(s *service, ctx context.Context, block *model.Block, tx *model.Transaction) error {
+			return s.processPackages(ctx, tx)
+		}),
+		s.transactionStage(STAGE_CALLS, func(s *service, ctx context.Context, block *model.Block, tx *model.Transaction) error {
+			return s.processRealmCalls(ctx, block.Time, tx)
+		}),
+		NewBlockProcessor(STAGE_EVENTS, func(ctx context.Context, block *BlockContext) error {
+			bound := s.withRepo(block.Repo)
+			for i := range block.Transactions {
+				var decoded []bool // Unknown without the transfers stage
+				if block.Decoded != nil {
+					decoded = block.Decoded[i]
+				}
+				if err := bound.processGnoEvents(ctx, block.Block.Time, &block.Transactions[i], decoded); err != nil {
+					return err
+				}
+			}
+			return nil
+		}),
+		s.transactionStage(STAGE_ADDRESSES, func(s *service, ctx context.Context, block *model.Block, tx *model.Transaction) error {
+			return s.processAddressTransactions(ctx, block.Time, tx)
+		}),
+		NewBlockProcessor(STAGE_WEBHOOKS, func(ctx context.Context, block *BlockContext) error {
+			if block.Replay {
+				// Replayed transfers were already notified
+				return nil
+			}
+			bound := s.withRepo(block.Repo)
+			for i, transfers := range block.Transfers {
+				if err := bound.queueWebhooks(ctx, block.Block, &block.Transactions[i], transfers); err != nil {
+					return err
+				}
+			}
+			return nil
+		}),
+		NewBlockProcessor(STAGE_TOKEN_STATS, func(ctx context.Context, block *BlockContext) error {
+			var transfers []model.Transfer
+			for _, txTransfers := range block.Transfers {
+				transfers = append(transfers, txTransfers...)
+			}
+			return s.withRepo(block.Repo).applyTokenStats(ctx, block.Block.Height, transfers)
+		}),
+		NewBlockProcessor(STAGE_CHECKPOINTS, func(ctx context.Context, block *BlockContext) error {
+			return s.withRepo(block.Repo).checkpointBalances(ctx, block.Block.Height)
+		}),
+	}
+}
+
+// persistBlock stores the block and its transactions, skipping the block if it is already stored
+func (s *service) persistBlock(ctx context.Context, block *BlockContext) error {
+	if block.Replay {
+		return nil
+	}
+
+	exists, err := block.Repo.AddBlock(ctx, block.Block)
+	if err != nil {
+		return s.logger.Errorf("failed to add block %d: %v", block.Block.Height, err)
+	}
+	if exists {
+		s.logger.Debugf("Block %d already exists, skipping", block.Block.Height)
+		block.Skip = true
+		return nil
+	}
+
+	if err := block.Repo.AddTransactions(ctx, block.Block.Height, block.Transactions); err != nil {
+		return s.logger.Errorf("failed to add transactions for block %d: %v", block.Block.Height, err)
+	}
+	return nil
+}
+
+// processBlockTransfers applies the native and decoded token movements of each
+// transaction and records the transfers for the later stages
+func (s *service) processBlockTransfers(ctx context.Context, block *BlockContext) error {
+	bound := s.withRepo(block.Repo)
+	block.Transfers = make([][]model.Transfer, len(block.Transactions))
+	block.Decoded = make([][]bool, len(block.Transactions))
+	for i := range block.Transactions {
+		transfers, decoded, err := bound.processTransfers(ctx, block.Block, &block.Transactions[i])
+		if err != nil {
+			return err
+		}
+		block.Transfers[i] = transfers
+		block.Decoded[i] = decoded
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"gno.land-block-indexer/lib/log"
+	"gno.land-block-indexer/model"
+)
+
+func TestPipeline(t *testing.T) {
+	s := &service{logger: log.NewLogger()}
+	var ran []string
+	stage := func(name string, err error, skip bool) BlockProcessor {
+		return NewBlockProcessor(name, func(ctx context.Context, block *BlockContext) error {
+			ran = append(ran, name)
+			block.Skip = skip
+			return err
+		})
+	}
+
+	if _, err := s.newPipeline([]string{"unknown"}, nil); err == nil {
+		t.Errorf("newPipeline should reject unknown stages")
+	}
+	if _, err := s.newPipeline(nil, []BlockProcessor{stage(STAGE_PERSIST, nil, false)}); err == nil {
+		t.Errorf("newPipeline should reject custom stages named like built-in ones")
+	}
+
+	pipeline, err := s.newPipeline([]string{"first", "skip", "last"}, []BlockProcessor{
+		stage("last", nil, false),
+		stage("first", nil, false),
+		stage("skip", nil, true),
+	})
+	if err != nil {
+		t.Fatalf("newPipeline failed: %v", err)
+	}
+	if err := pipeline.Process(context.Background(), &BlockContext{Block: &model.Block{Height: 1}}); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if !reflect.DeepEqual(ran, []string{"first", "skip"}) {
+		t.Errorf("Expected the stages before the skip to run in order, got %v", ran)
+	}
+
+	pipeline, _ = s.newPipeline([]string{"fail", "last"}, []BlockProcessor{
+		stage("fail", errors.New("boom"), false),
+		stage("last", nil, false),
+	})
+	ran = nil
+	if err := pipeline.Process(context.Background(), &BlockContext{Block: &model.Block{Height: 1}}); err == nil {
+		t.Fatalf("Process should fail with its stage")
+	}
+	stats := pipeline.Stats()
+	if len(ran) != 1 || stats[0].Name != "fail" || stats[0].Errors != 1 || stats[0].LastError != "boom" || stats[1].Blocks != 0 {
+		t.Errorf("Unexpected stage stats %+v after running %v", stats, ran)
+	}
+}
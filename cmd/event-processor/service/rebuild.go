@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+
+	"gno.land-block-indexer/model"
+	"gno.land-block-indexer/repository"
+)
+
+const (
+	REBUILD_BATCH_SIZE = 1000 // Number of heights loaded at once when replaying transactions
+)
+
+// RebuildDerivedTables implements Service.
+//
+// The derived tables (accounts, transfers, balances, NFTs, packages, calls, events,
+// address activity and tokens) are rebuilt in a separate schema by replaying the stored transactions
+// in height order, each block in its own database transaction together with its row in
+// the rebuild blocks table, so an interrupted rebuild resumes where it stopped without
+// replaying a block twice. Once the replay caught up, the rebuilt tables are swapped in
+// atomically; stored blocks which were not replayed, including ones committed below the
+// progress during the rebuild, are replayed first.
+func (s *service) RebuildDerivedTables(ctx context.Context) error {
+	if err := s.repo.CreateRebuildSchema(ctx); err != nil {
+		return s.logger.Errorf("Failed to create rebuild schema: %v", err)
+	}
+
+	rebuildConfig := *s.entConfig
+	rebuildConfig.SearchPath = repository.RebuildSchema
+	rebuild := *s
+	rebuild.repo = repository.NewRepositoryEnt(s.logger, &rebuildConfig)
+	if err := rebuild.registerNativeToken(ctx); err != nil {
+		return err
+	}
+
+	lastHeight, err := rebuild.repo.GetRebuildProgress(ctx)
+	if err != nil {
+		return s.logger.Errorf("Failed to get rebuild progress: %v", err)
+	}
+	if lastHeight >= 0 {
+		s.logger.Infof("Resuming rebuild after height %d", lastHeight)
+	}
+
+	for {
+		highestBlock, err := s.repo.GetHighestBlock(ctx)
+		if err != nil {
+			return s.logger.Errorf("Failed to get highest block: %v", err)
+		}
+
+		for fromHeight := lastHeight + 1; fromHeight <= highestBlock.Height; fromHeight += REBUILD_BATCH_SIZE {
+			toHeight := min(fromHeight+REBUILD_BATCH_SIZE-1, highestBlock.Height)
+			if err := rebuild.replayBlocks(ctx, s.repo, fromHeight, toHeight); err != nil {
+				return err
+			}
+			if err := rebuild.repo.SetRebuildProgress(ctx, toHeight); err != nil {
+				return s.logger.Errorf("Failed to update rebuild progress: %v", err)
+			}
+			lastHeight = toHeight
+			s.logger.Infof("Rebuild replayed blocks up to %d of %d", lastHeight, highestBlock.Height)
+		}
+
+		missingHeights, err := s.repo.SwapRebuiltTables(ctx)
+		if err != nil {
+			return s.logger.Errorf("Failed to swap rebuilt tables: %v", err)
+		}
+		if len(missingHeights) == 0 {
+			s.logger.Infof("Rebuilt tables swapped in at height %d", lastHeight)
+			return nil
+		}
+
+		// Blocks committed out of order may have landed below the progress during the replay
+		s.logger.Infof("%d blocks were indexed during the rebuild, replaying them before the swap", len(missingHeights))
+		for _, height := range missingHeights {
+			if err := rebuild.replayBlocks(ctx, s.repo, height, height); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// replayBlocks replays the transactions of the blocks in a height range from the
+// source repository into the repository of s, which is the rebuild one
+func (s *service) replayBlocks(ctx context.Context, source repository.Repository, fromHeight int, toHeight int) error {
+	blocks, err := source.GetBlocksInRange(ctx, fromHeight, toHeight)
+	if err != nil {
+		return s.logger.Errorf("Failed to get blocks %d to %d: %v", fromHeight, toHeight, err)
+	}
+	txs, err := source.GetTransactionsInRange(ctx, fromHeight, toHeight)
+	if err != nil {
+		return s.logger.Errorf("Failed to get transactions of blocks %d to %d: %v", fromHeight, toHeight, err)
+	}
+	txsByHeight := make(map[int][]model.Transaction)
+	for _, tx := range txs {
+		txsByHeight[tx.BlockHeight] = append(txsByHeight[tx.BlockHeight], tx)
+	}
+
+	for _, block := range blocks {
+		err := s.repo.WithTx(ctx, func(repo repository.Repository) error {
+			// The blocks of the rebuild schema record which blocks were replayed
+			replayed, err := repo.AddBlock(ctx, &block)
+			if err != nil || replayed {
+				return err
+			}
+			return s.pipeline.Process(ctx, &BlockContext{
+				Block:        &block,
+				Transactions: txsByHeight[block.Height],
+				Repo:         repo,
+				Replay:       true,
+			})
+		})
+		if err != nil {
+			return s.logger.Errorf("Failed to replay block %d: %v", block.Height, err)
+		}
+	}
+
+	return nil
+}
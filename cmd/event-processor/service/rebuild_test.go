@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"gno.land-block-indexer/lib/log"
+	"gno.land-block-indexer/model"
+	"gno.land-block-indexer/repository"
+)
+
+// replayRepository serves the stored blocks as the source repository and records
+// the replayed ones as the rebuild repository, committing them with their transaction
+type replayRepository struct {
+	repository.Repository
+	blocks   []model.Block
+	replayed map[int]bool
+	pending  []int // Blocks added by the running transaction
+}
+
+func (r *replayRepository) GetBlocksInRange(ctx context.Context, fromHeight int, toHeight int) ([]model.Block, error) {
+	var blocks []model.Block
+	for _, block := range r.blocks {
+		if block.Height >= fromHeight && block.Height <= toHeight {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+func (r *replayRepository) GetTransactionsInRange(ctx context.Context, fromHeight int, toHeight int) ([]model.Transaction, error) {
+	return nil, nil
+}
+
+func (r *replayRepository) ApplyTokenStats(ctx context.Context, height int, deltas []model.TokenStat) error {
+	return nil
+}
+
+func (r *replayRepository) AddBlock(ctx context.Context, block *model.Block) (bool, error) {
+	if r.replayed[block.Height] {
+		return true, nil
+	}
+	r.pending = append(r.pending, block.Height)
+	return false, nil
+}
+
+func (r *replayRepository) WithTx(ctx context.Context, fn func(repo repository.Repository) error) error {
+	r.pending = nil
+	if err := fn(r); err != nil {
+		return err
+	}
+	for _, height := range r.pending {
+		r.replayed[height] = true
+	}
+	return nil
+}
+
+func TestReplayBlocksResumesFromStaleProgress(t *testing.T) {
+	// The rebuild stopped in the middle of a batch: blocks 1 and 2 were replayed,
+	// but the progress was last saved before them
+	repo := &replayRepository{
+		blocks:   []model.Block{{Height: 1}, {Height: 2}, {Height: 3}},
+		replayed: map[int]bool{1: true, 2: true},
+	}
+	var processed []int
+	s := &service{
+		logger: log.NewLogger(),
+		repo:   repo,
+		pipeline: NewPipeline([]BlockProcessor{
+			NewBlockProcessor(STAGE_TRANSFERS, func(ctx context.Context, block *BlockContext) error {
+				if !block.Replay {
+					t.Errorf("Block %d is not marked as replayed", block.Block.Height)
+				}
+				processed = append(processed, block.Block.Height)
+				return nil
+			}),
+		}),
+	}
+
+	if err := s.replayBlocks(context.Background(), repo, 1, 3); err != nil {
+		t.Fatalf("replayBlocks failed: %v", err)
+	}
+	if !reflect.DeepEqual(processed, []int{3}) {
+		t.Errorf("Expected only block 3 to be replayed, got %v", processed)
+	}
+	if !repo.replayed[3] {
+		t.Errorf("Expected block 3 to be recorded as replayed")
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"gno.land-block-indexer/model"
+)
+
+// ReconcileBalances implements Service.
+//
+// Every stored balance is compared with the balance recomputed from the transfer
+// history. Discrepancies are logged with the first height at which the history
+// diverges and, in repair mode, the stored balances are replaced in one transaction.
+// Blocks should not be processed meanwhile, or in-flight blocks show up as drift.
+func (s *service) ReconcileBalances(ctx context.Context, repair bool) ([]model.BalanceDiscrepancy, error) {
+	discrepancies, err := s.repo.GetBalanceDiscrepancies(ctx)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to compute balance discrepancies: %v", err)
+	}
+
+	for i, discrepancy := range discrepancies {
+		height, err := s.repo.GetFirstDivergingHeight(ctx, discrepancy.Address, discrepancy.Token)
+		if err != nil {
+			return nil, s.logger.Errorf("Failed to find diverging height of %s for %s: %v", discrepancy.Address, discrepancy.Token, err)
+		}
+		discrepancies[i].FirstDivergingHeight = height
+
+		s.logger.Warnf("Balance discrepancy: address=%s token=%s recorded=%.0f expected=%.0f first_diverging_height=%d",
+			discrepancy.Address, discrepancy.Token, discrepancy.Recorded, discrepancy.Expected, height)
+	}
+	s.logger.Infof("Found %d balance discrepancies", len(discrepancies))
+
+	if !repair || len(discrepancies) == 0 {
+		return discrepancies, nil
+	}
+
+	if err := s.repo.RepairBalances(ctx, discrepancies); err != nil {
+		return nil, s.logger.Errorf("Failed to repair balances: %v", err)
+	}
+	s.logger.Infof("Repaired %d balances", len(discrepancies))
+
+	return discrepancies, nil
+}
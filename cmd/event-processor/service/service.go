@@ -3,11 +3,12 @@ package service
 import (
 	"context"
 	"encoding/json"
-	"strconv"
-	"strings"
+	"net/http"
 	"time"
 
+	"github.com/machinebox/graphql"
 	"gno.land-block-indexer/externals/msgbroker"
+	"gno.land-block-indexer/externals/webhook"
 	"gno.land-block-indexer/lib/log"
 	"gno.land-block-indexer/model"
 	"gno.land-block-indexer/repository"
@@ -15,26 +16,46 @@ import (
 
 const (
 	TOPIC_BLOCK_WITH_TXS = "block_with_txs"
+	TOPIC_INDEXED_BLOCK  = "indexed_block" // Blocks committed by the pipeline, for live streams
 	UNIT_NAME            = "ugnot" // The unit name for the token, can be changed as needed
+
+	UNKNOWN_EVENTS_REPORT_INTERVAL = 5 * time.Minute // How often undecoded event counts are reported
 )
 
 type Service interface {
 	// usecase (from controller)
 	SubscribeAndHandle(ctx context.Context) error
+	ImportGenesis(ctx context.Context, genesisPath string) error
+	ReconcileBalances(ctx context.Context, repair bool) ([]model.BalanceDiscrepancy, error)
+	RebuildDerivedTables(ctx context.Context) error
 
 	//
 	ProcessBlockWithTransactions(ctx context.Context, blockWithTxs msgbroker.BlockWithTransactions) error
+	UnknownEvents() map[UnknownEventKey]int64
+	StageStats() []StageStats
 }
 
 type service struct {
 	logger    log.Logger
 	repo      repository.Repository
 	msgBroker msgbroker.MsgBroker
+	decoders  *DecoderRegistry
+	pipeline  *Pipeline
+	entConfig *repository.RepositoryEntConfig
+	txIndexer *graphql.Client
+
+	webhook    webhook.Webhook
+	watchRules *watchRuleCache
 }
 
 type ServiceConfig struct {
 	EntConfig        *repository.RepositoryEntConfig
 	LocalStackConfig *msgbroker.LocalStackConfig
+	WebhookConfig    *webhook.HTTPConfig
+	FetchEndpoint    string                // tx-indexer GraphQL endpoint the genesis tx results are fetched from
+	Decoders         []DecoderRegistration // Decoders for additional realm events
+	Stages           []string              // Enabled pipeline stages in order, DefaultStages if nil
+	Processors       []BlockProcessor      // Custom pipeline stages, run when enabled in Stages
 }
 
 func NewService(ctx context.Context, logger log.Logger, config *ServiceConfig) Service {
@@ -45,11 +66,40 @@ func NewService(ctx context.Context, logger log.Logger, config *ServiceConfig) S
 		logger.Fatalf("Failed to create local stack message broker", "error", err)
 	}
 
-	return &service{
-		logger:    logger,
-		repo:      repo,
-		msgBroker: localStack,
+	s, err := newService(logger, repo, localStack, config)
+	if err != nil {
+		logger.Fatalf("Failed to create block pipeline: %v", err)
 	}
+	if err := s.registerNativeToken(ctx); err != nil {
+		logger.Fatalf("Failed to register native token: %v", err)
+	}
+
+	return s
+}
+
+// newService wires the decoders, the pipeline and the webhook around the given repository and broker
+func newService(logger log.Logger, repo repository.Repository, msgBroker msgbroker.MsgBroker, config *ServiceConfig) (*service, error) {
+	s := &service{
+		logger:     logger,
+		repo:       repo,
+		msgBroker:  msgBroker,
+		entConfig:  config.EntConfig,
+		webhook:    webhook.NewWebhookHTTP(logger, config.WebhookConfig),
+		watchRules: &watchRuleCache{},
+	}
+	if config.FetchEndpoint != "" {
+		s.txIndexer = graphql.NewClient(config.FetchEndpoint, graphql.WithHTTPClient(&http.Client{
+			Timeout: 30 * time.Second,
+		}))
+	}
+	s.decoders = s.newDecoderRegistry(config.Decoders)
+
+	var err error
+	if s.pipeline, err = s.newPipeline(config.Stages, config.Processors); err != nil {
+		return nil, err
+	}
+
+	return s, nil
 }
 
 // SubscribeAndHandle implements Service.
@@ -92,11 +142,30 @@ func (s *service) SubscribeAndHandle(ctx context.Context) error {
 	}
 	s.logger.Infof("Subscribed to topic %s successfully", TOPIC_BLOCK_WITH_TXS)
 
-	// Keep running until context is cancelled
-	<-ctx.Done()
-	s.logger.Infof("Context done, stopping subscription")
-	close(workCh)
-	return ctx.Err()
+	go s.deliverWebhooks(ctx)
+
+	// Keep running until context is cancelled, periodically reporting undecoded events
+	ticker := time.NewTicker(UNKNOWN_EVENTS_REPORT_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Infof("Context done, stopping subscription")
+			close(workCh)
+			return ctx.Err()
+		case <-ticker.C:
+			for key, count := range s.UnknownEvents() {
+				s.logger.Infof("Undecoded events: type=%s pkg_path=%s count=%d", key.Type, key.PkgPath, count)
+			}
+			for _, stats := range s.StageStats() {
+				if stats.Blocks == 0 {
+					continue
+				}
+				s.logger.Infof("Stage %s: blocks=%d errors=%d avg=%s max=%s", stats.Name, stats.Blocks, stats.Errors,
+					stats.TotalDuration/time.Duration(stats.Blocks), stats.MaxDuration)
+			}
+		}
+	}
 }
 
 // messageWorker processes messages in a worker pool pattern
@@ -122,196 +191,161 @@ func (s *service) messageWorker(ctx context.Context, workCh <-chan msgbroker.Blo
 	}
 }
 
-// processBlockWithTransactions handles the actual message processing
+// ProcessBlockWithTransactions runs a block through the pipeline in one database transaction
 func (s *service) ProcessBlockWithTransactions(ctx context.Context, blockWithTxs msgbroker.BlockWithTransactions) error {
-	exists, err := s.repo.AddBlock(ctx, blockWithTxs.Block)
-	if err != nil {
-		return s.logger.Errorf("failed to add block %d: %w", blockWithTxs.Block.Height, err)
-	}
-	if exists {
-		s.logger.Debugf("Block %d already exists, skipping", blockWithTxs.Block.Height)
-		return nil
-	}
-
-	err = s.repo.AddTransactions(ctx, blockWithTxs.Block.Height, blockWithTxs.Transactions)
-	if err != nil {
-		return s.logger.Errorf("failed to add transactions for block %d: %w", blockWithTxs.Block.Height, err)
-	}
-
-	s.logger.Infof("Successfully processed block %d with %d transactions", blockWithTxs.Block.Height, len(blockWithTxs.Transactions))
-
-	// Parse transactions to extract transfers and account updates
-	if err := s.parseAndProcessTransactions(ctx, blockWithTxs.Transactions); err != nil {
-		return s.logger.Errorf("failed to parse and process transactions for block %d: %w", blockWithTxs.Block.Height, err)
-	}
-
-	return nil
-}
-
-// parseAndProcessTransactions parses transactions to extract transfers and account information
-func (s *service) parseAndProcessTransactions(ctx context.Context, transactions []model.Transaction) error {
 	var err error
-
-	for _, tx := range transactions {
-		transfers := make([]model.Transfer, 0)
-		for _, event := range tx.Response.Events {
-			if strings.ToLower(event.Type) == "transfer" {
-				var fromAddress, toAddress string
-				var numValue int64
-
-				for _, attr := range event.Attrs {
-					if attr.Key == "from" {
-						fromAddress = attr.Value
-					} else if attr.Key == "to" {
-						toAddress = attr.Value
-					} else if attr.Key == "value" {
-						numValue, err = strconv.ParseInt(attr.Value, 10, 64)
-						if err != nil {
-							return s.logger.Errorf("Invalid 'value' attribute in transfer event for transaction %s: %v", tx.Hash, err)
-						}
-					}
-				}
-
-				switch strings.ToLower(event.Func) {
-				case "mint":
-					if err := s.handleMintEvent(ctx, &tx, event.PkgPath, toAddress, numValue); err != nil {
-						return s.logger.Errorf("Failed to handle mint event for transaction %s: %v", tx.Hash, err)
-					}
-				case "burn":
-					if err := s.handleBurnEvent(ctx, &tx, event.PkgPath, fromAddress, int64(numValue)); err != nil {
-						return s.logger.Errorf("Failed to handle burn event for transaction %s: %v", tx.Hash, err)
-					}
-				case "transfer":
-					if err := s.handleTransferEvent(ctx, &tx, event.PkgPath, fromAddress, toAddress, int64(numValue)); err != nil {
-						return s.logger.Errorf("Failed to handle transfer event for transaction %s: %v", tx.Hash, err)
-					}
-				default:
-					s.logger.Warnf("Unknown event type %s in transaction %s", event.Type, tx.Hash)
-				}
-
-				transfers = append(transfers, model.Transfer{
-					FromAddress: fromAddress,
-					ToAddress:   toAddress,
-					Token:       event.PkgPath,
-					CreatedAt:   time.Now(),
-					Amount:      float64(numValue),
-					Denom:       UNIT_NAME,
-					Func:        event.Func,
-				})
-			}
+	for attempt := 1; attempt <= PIPELINE_MAX_ATTEMPTS; attempt++ {
+		block := &BlockContext{
+			Block:        blockWithTxs.Block,
+			Transactions: blockWithTxs.Transactions,
 		}
-
-		s.logger.Debugf("😀 Transfer count for transaction %s: %d", tx.Hash, len(transfers))
-		err := s.repo.AddTransfers(ctx, &tx, transfers)
-		if err != nil {
-			return s.logger.Errorf("Failed to add transfers for transaction %s: %v", tx.Hash, err)
+		err = s.repo.WithTx(ctx, func(repo repository.Repository) error {
+			block.Repo = repo
+			return s.pipeline.Process(ctx, block)
+		})
+		if err == nil {
+			if !block.Skip {
+				s.logger.Infof("Successfully processed block %d with %d transactions", blockWithTxs.Block.Height, len(blockWithTxs.Transactions))
+			}
+			// A redelivered block is announced again, as its message is redelivered
+			// when the announcement failed; subscribers skip the delivered heights
+			return s.publishIndexedBlock(block)
 		}
+		s.logger.Warnf("Attempt %d of block %d failed: %v", attempt, blockWithTxs.Block.Height, err)
 	}
 
-	return nil
+	return s.logger.Errorf("failed to process block %d: %w", blockWithTxs.Block.Height, err)
 }
 
-func (s *service) handleMintEvent(ctx context.Context, tx *model.Transaction, pkg string, toAddress string, value int64) error {
-	// For mint events, tokens are created and added to the toAddress
-	// Check if account exists, create if not
-	if toAddress == "" {
-		s.logger.Warnf("Transfer event for transaction %s has empty 'to' address, skipping", tx.Hash)
-		return nil
+// publishIndexedBlock announces a committed block to the live streams. Live
+// subscribers wait for every height, so a failure fails the block message, which
+// is redelivered and announces the block again.
+func (s *service) publishIndexedBlock(block *BlockContext) error {
+	message := msgbroker.IndexedBlock{
+		Height:   block.Block.Height,
+		Hash:     block.Block.Hash,
+		TxHashes: make([]string, len(block.Transactions)),
+	}
+	for i, tx := range block.Transactions {
+		message.TxHashes[i] = tx.Hash
 	}
 
-	toAccount, err := s.repo.GetAccount(ctx, toAddress, pkg)
+	msgBytes, err := json.Marshal(message)
 	if err != nil {
-		return err
+		return s.logger.Errorf("Failed to marshal indexed block %d: %v", block.Block.Height, err)
 	}
-	if toAccount == nil {
-		err := s.repo.AddAccount(ctx, &model.Account{
-			Address:   toAddress,
-			Token:     pkg,
-			Amount:    0, // Initialize with zero balance
-			CreatedAt: time.Now(),
-		})
-		if err != nil {
-			return s.logger.Errorf("Failed to add account %s: %v", toAddress, err)
-		}
-	}
-
-	// Increment the balance for the minted tokens
-	if err := s.repo.IncrementAccountBalance(ctx, toAddress, pkg, value); err != nil {
-		return s.logger.Errorf("Failed to increment balance for account %s: %v", toAddress, err)
+	if err := s.msgBroker.Publish(TOPIC_INDEXED_BLOCK, msgBytes); err != nil {
+		return s.logger.Errorf("Failed to publish indexed block %d: %v", block.Block.Height, err)
 	}
-
 	return nil
 }
 
-func (s *service) handleBurnEvent(ctx context.Context, tx *model.Transaction, pkg string, fromAddress string, value int64) error {
-	// For burn events, tokens are destroyed from the fromAddress
-	// Check if account exists
-	if fromAddress == "" {
-		s.logger.Warnf("Transfer event for transaction %s has empty 'from' address, skipping", tx.Hash)
-		return nil
-	}
-
-	fromAccount, err := s.repo.GetAccount(ctx, fromAddress, pkg)
+// processTransfers applies the token movements of a transaction, carried by its
+// messages, fee and GnoEvents, and records its transfers. It also returns whether
+// a decoder handled each GnoEvent.
+func (s *service) processTransfers(ctx context.Context, block *model.Block, tx *model.Transaction) ([]model.Transfer, []bool, error) {
+	// Native coin movements come from messages and fees rather than GnoEvents
+	transfers, err := s.processNativeTransfers(ctx, tx)
 	if err != nil {
-		return err
+		return nil, nil, s.logger.Errorf("Failed to process native transfers for transaction %s: %v", tx.Hash, err)
 	}
-	if fromAccount == nil {
-		// If account doesn't exist, create it with zero balance (shouldn't happen in normal burn scenario)
-		err := s.repo.AddAccount(ctx, &model.Account{
-			Address:   fromAddress,
-			Token:     pkg,
-			Amount:    0,
-			CreatedAt: time.Now(),
-		})
+
+	handled := make([]bool, len(tx.Response.Events))
+	for i, event := range tx.Response.Events {
+		decoded, ok, err := s.decoders.Decode(tx, event)
 		if err != nil {
-			return s.logger.Errorf("Failed to add account %s: %v", fromAddress, err)
+			return nil, nil, s.logger.Errorf("Failed to decode %s event of %s in transaction %s: %v", event.Type, event.PkgPath, tx.Hash, err)
+		}
+		if !ok {
+			s.logger.Debugf("No decoder for %s event of %s in transaction %s", event.Type, event.PkgPath, tx.Hash)
+			continue
+		}
+		handled[i] = true
+
+		for j := range decoded.Mutations {
+			decoded.Mutations[j].EventIndex = i
+		}
+		for j := range decoded.Transfers {
+			decoded.Transfers[j].MsgIndex = eventMsgIndex(tx)
+			decoded.Transfers[j].EventIndex = i
 		}
+		if err := s.applyBalanceMutations(ctx, tx, decoded.Mutations); err != nil {
+			return nil, nil, s.logger.Errorf("Failed to apply %s event of %s in transaction %s: %v", event.Type, event.PkgPath, tx.Hash, err)
+		}
+		if err := s.applyNftTransfers(ctx, tx, decoded.NftTransfers); err != nil {
+			return nil, nil, s.logger.Errorf("Failed to apply %s event of %s in transaction %s: %v", event.Type, event.PkgPath, tx.Hash, err)
+		}
+		transfers = append(transfers, decoded.Transfers...)
 	}
 
-	// Decrement the balance for the burned tokens (negative value for burn)
-	if err := s.repo.IncrementAccountBalance(ctx, fromAddress, pkg, -value); err != nil {
-		return s.logger.Errorf("Failed to decrement balance for account %s: %v", fromAddress, err)
+	for j := range transfers {
+		transfers[j].BlockTime = block.Time
+	}
+	s.logger.Debugf("😀 Transfer count for transaction %s: %d", tx.Hash, len(transfers))
+	if err := s.repo.AddTransfers(ctx, tx, transfers); err != nil {
+		return nil, nil, s.logger.Errorf("Failed to add transfers for transaction %s: %v", tx.Hash, err)
 	}
 
-	return nil
+	return transfers, handled, nil
 }
 
-func (s *service) handleTransferEvent(ctx context.Context, tx *model.Transaction, pkg string, fromAddress string, toAddress string, value int64) error {
-	fromAccount, err := s.repo.GetAccount(ctx, fromAddress, pkg)
-	if err != nil {
-		return err
+// eventMsgIndex returns the message emitting the events of a transaction. Events
+// do not carry their message, so it is only known for single message transactions.
+func eventMsgIndex(tx *model.Transaction) int {
+	if len(tx.Messages) == 1 {
+		return 0
 	}
-	if fromAccount == nil {
-		err := s.repo.AddAccount(ctx, &model.Account{
-			Address: fromAddress,
-			Token:   pkg,
-			Amount:  0, // Initialize with zero balance
-		})
-		if err != nil {
-			return s.logger.Errorf("Failed to add account %s: %v", fromAddress, err)
+	return -1
+}
+
+// StageStats implements Service.
+func (s *service) StageStats() []StageStats {
+	return s.pipeline.Stats()
+}
+
+// UnknownEvents implements Service.
+func (s *service) UnknownEvents() map[UnknownEventKey]int64 {
+	return s.decoders.UnknownEvents()
+}
+
+// applyBalanceMutations applies balance changes, creating accounts on first use,
+// and records them so balances can be computed at any height
+func (s *service) applyBalanceMutations(ctx context.Context, tx *model.Transaction, mutations []BalanceMutation) error {
+	changes := make([]model.BalanceChange, 0, len(mutations))
+	for _, mutation := range mutations {
+		if mutation.Address == "" {
+			s.logger.Warnf("Balance change of %s for transaction %s has an empty address, skipping", mutation.Token, tx.Hash)
+			continue
 		}
-	}
 
-	toAccount, err := s.repo.GetAccount(ctx, toAddress, pkg)
-	if err != nil {
-		return err
-	}
-	if toAccount == nil {
+		// Accounts are created on first use, which is a no-op afterwards
 		err := s.repo.AddAccount(ctx, &model.Account{
-			Address: toAddress,
-			Token:   pkg,
-			Amount:  0, // Initialize with zero balance
+			Address:         mutation.Address,
+			Token:           mutation.Token,
+			Amount:          0, // Initialize with zero balance
+			FirstSeenHeight: tx.BlockHeight,
+			CreatedAt:       time.Now(),
 		})
 		if err != nil {
-			return s.logger.Errorf("Failed to add account %s: %v", toAddress, err)
+			return s.logger.Errorf("Failed to add account %s: %v", mutation.Address, err)
 		}
-	}
 
-	if err := s.repo.IncrementAccountBalance(ctx, fromAddress, pkg, -(value)); err != nil {
-		return s.logger.Errorf("Failed to decrement balance for account %s: %v", fromAddress, err)
+		if err := s.repo.IncrementAccountBalance(ctx, mutation.Address, mutation.Token, mutation.Delta, tx.BlockHeight, tx.Hash); err != nil {
+			return s.logger.Errorf("Failed to change balance for account %s: %v", mutation.Address, err)
+		}
+		changes = append(changes, model.BalanceChange{
+			Address:     mutation.Address,
+			Token:       mutation.Token,
+			BlockHeight: tx.BlockHeight,
+			Delta:       mutation.Delta,
+			Reason:      mutation.Reason,
+			Hash:        tx.Hash,
+			EventIndex:  mutation.EventIndex,
+		})
 	}
-	if err := s.repo.IncrementAccountBalance(ctx, toAddress, pkg, value); err != nil {
-		return s.logger.Errorf("Failed to increment balance for account %s: %v", toAddress, err)
+
+	if err := s.repo.AddBalanceChanges(ctx, changes); err != nil {
+		return s.logger.Errorf("Failed to record balance changes for transaction %s: %v", tx.Hash, err)
 	}
 
 	return nil
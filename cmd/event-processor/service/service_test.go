@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
@@ -11,7 +12,17 @@ import (
 	"gno.land-block-indexer/repository"
 )
 
-func GetTestService(ctx context.Context) Service {
+// GetTestService connects to the LocalStack and Postgres instances of the local
+// environment, skipping the test when they are not running
+func GetTestService(ctx context.Context, t *testing.T) Service {
+	for _, address := range []string{"localhost:4566", "localhost:5432"} {
+		conn, err := net.DialTimeout("tcp", address, time.Second)
+		if err != nil {
+			t.Skipf("Local environment is not running: %v", err)
+		}
+		conn.Close()
+	}
+
 	logger := log.NewLogger()
 	mb, err := msgbroker.NewMsgBrokerLocalStack(ctx, logger, &msgbroker.LocalStackConfig{
 		Endpoint: "http://localhost:4566",
@@ -21,22 +32,26 @@ func GetTestService(ctx context.Context) Service {
 		logger.Fatalf("Failed to create local stack message broker", "error", err)
 	}
 
-	return &service{
-		logger: logger,
-		repo: repository.NewRepositoryEnt(logger, &repository.RepositoryEntConfig{
-			Database: "postgres",
-			User:     "postgres",
-			Password: "postgres",
-			Host:     "localhost",
-			Port:     5432,
-		}),
-		msgBroker: mb,
+	entConfig := &repository.RepositoryEntConfig{
+		Database: "postgres",
+		User:     "postgres",
+		Password: "postgres",
+		Host:     "localhost",
+		Port:     5432,
+	}
+	s, err := newService(logger, repository.NewRepositoryEnt(logger, entConfig), mb, &ServiceConfig{
+		EntConfig: entConfig,
+	})
+	if err != nil {
+		logger.Fatalf("Failed to create block pipeline: %v", err)
 	}
+
+	return s
 }
 
 func TestProcessBlockWithTransactions(t *testing.T) {
 	ctx := context.Background()
-	s := GetTestService(ctx)
+	s := GetTestService(ctx, t)
 	tcs := []msgbroker.BlockWithTransactions{
 		{
 			Block: &model.Block{
@@ -140,4 +155,9 @@ func TestProcessBlockWithTransactions(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to process block with transactions: %v", err)
 	}
+
+	// A redelivered block is skipped without failing the transaction of the block
+	if err := s.ProcessBlockWithTransactions(ctx, tcs[0]); err != nil {
+		t.Fatalf("Failed to process a redelivered block: %v", err)
+	}
 }
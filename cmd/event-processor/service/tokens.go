@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gno.land-block-indexer/model"
+)
+
+const (
+	NATIVE_TOKEN_NAME     = "Gno.land" // Name of the native coin
+	NATIVE_TOKEN_SYMBOL   = "GNOT"     // Symbol of the native coin
+	NATIVE_TOKEN_DECIMALS = 6          // Decimals of the native coin, 1 GNOT = 1,000,000 ugnot
+)
+
+// grc20ConstructorPattern matches GRC20 token constructors called with literal
+// arguments, e.g. grc20.NewToken("Foo", "FOO", 6) or grc20.NewBanker("Foo", "FOO", 6)
+var grc20ConstructorPattern = regexp.MustCompile(`grc20\.New(?:Token|Banker)\(\s*"([^"]*)"\s*,\s*"([^"]*)"\s*,\s*(\d+)\s*\)`)
+
+// nativeToken is the registry entry of the native coin
+var nativeToken = model.Token{
+	Path:     UNIT_NAME,
+	Name:     NATIVE_TOKEN_NAME,
+	Symbol:   NATIVE_TOKEN_SYMBOL,
+	Decimals: NATIVE_TOKEN_DECIMALS,
+}
+
+// registerNativeToken makes sure the native coin is in the token registry
+func (s *service) registerNativeToken(ctx context.Context) error {
+	token := nativeToken
+	if err := s.repo.AddToken(ctx, &token); err != nil {
+		return s.logger.Errorf("Failed to register native token %s: %v", UNIT_NAME, err)
+	}
+	return nil
+}
+
+// processTokenMetadata registers the GRC20 token declared by a deployed package, if any
+func (s *service) processTokenMetadata(ctx context.Context, pkg *model.Package) error {
+	token, ok := parseTokenMetadata(pkg)
+	if !ok {
+		return nil
+	}
+
+	s.logger.Infof("Registering token %s (%s) deployed at height %d", token.Path, token.Symbol, token.FirstSeenHeight)
+	if err := s.repo.AddToken(ctx, token); err != nil {
+		return s.logger.Errorf("Failed to register token %s: %v", token.Path, err)
+	}
+	return nil
+}
+
+// parseTokenMetadata looks for a GRC20 token constructor in the source files of a
+// package. Only literal arguments are recognized, test files are ignored.
+func parseTokenMetadata(pkg *model.Package) (*model.Token, bool) {
+	for _, file := range pkg.Files {
+		if strings.HasSuffix(file.Name, "_test.gno") || strings.HasSuffix(file.Name, "_filetest.gno") {
+			continue
+		}
+
+		match := grc20ConstructorPattern.FindStringSubmatch(file.Body)
+		if match == nil {
+			continue
+		}
+		decimals, err := strconv.Atoi(match[3])
+		if err != nil {
+			continue
+		}
+
+		return &model.Token{
+			Path:            pkg.Path,
+			Name:            match[1],
+			Symbol:          match[2],
+			Decimals:        decimals,
+			Creator:         pkg.Creator,
+			FirstSeenHeight: pkg.BlockHeight,
+		}, true
+	}
+
+	return nil, false
+}
+
+// applyTokenStats updates the supply and holder statistics of the tokens moved by the transfers of a block
+func (s *service) applyTokenStats(ctx context.Context, height int, transfers []model.Transfer) error {
+	if err := s.repo.ApplyTokenStats(ctx, height, tokenStatDeltas(transfers)); err != nil {
+		return s.logger.Errorf("Failed to apply token statistics of block %d: %v", height, err)
+	}
+	return nil
+}
+
+// tokenStatDeltas sums the minted, burned and transferred amounts of transfers by token.
+// Genesis balances count as minted. Gas fees are not burned, they go to the fee collector
+// and stay in the supply.
+func tokenStatDeltas(transfers []model.Transfer) []model.TokenStat {
+	var deltas []model.TokenStat
+	byToken := make(map[string]int)
+	for _, transfer := range transfers {
+		i, ok := byToken[transfer.Token]
+		if !ok {
+			i = len(deltas)
+			byToken[transfer.Token] = i
+			deltas = append(deltas, model.TokenStat{Token: transfer.Token})
+		}
+
+		switch strings.ToLower(transfer.Func) {
+		case "mint", FUNC_GENESIS:
+			deltas[i].Minted += int64(transfer.Amount)
+		case "burn":
+			deltas[i].Burned += int64(transfer.Amount)
+		case "transfer":
+			deltas[i].TransferCount++
+		}
+	}
+
+	for i := range deltas {
+		deltas[i].Supply = deltas[i].Minted - deltas[i].Burned
+	}
+	return deltas
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestParseTokenMetadata(t *testing.T) {
+	pkg := &model.Package{
+		Path:        "gno.land/r/demo/foo20",
+		Creator:     "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5",
+		BlockHeight: 10,
+		Files: []model.PackageFile{
+			{Name: "foo20_test.gno", Body: `var token, _ = grc20.NewToken("Test", "TEST", 2)`},
+			{Name: "foo20.gno", Body: "var Token, privateLedger = grc20.NewToken(\"Foo\",\n\t\"FOO\", 4)"},
+		},
+	}
+
+	token, ok := parseTokenMetadata(pkg)
+	if !ok {
+		t.Fatalf("parseTokenMetadata failed")
+	}
+	want := model.Token{Path: pkg.Path, Name: "Foo", Symbol: "FOO", Decimals: 4, Creator: pkg.Creator, FirstSeenHeight: 10}
+	if *token != want {
+		t.Errorf("parseTokenMetadata = %+v, want %+v", *token, want)
+	}
+
+	pkg.Files = pkg.Files[:1]
+	if _, ok := parseTokenMetadata(pkg); ok {
+		t.Errorf("parseTokenMetadata should ignore test files")
+	}
+}
+
+func TestTokenStatDeltas(t *testing.T) {
+	deltas := tokenStatDeltas([]model.Transfer{
+		{Func: FUNC_GENESIS, Token: "ugnot", Amount: 1000},
+		{Func: FUNC_FEE, Token: "ugnot", Amount: 10},
+		{Func: "transfer", Token: "ugnot", Amount: 500},
+		{Func: "Mint", Token: "gno.land/r/demo/foo20", Amount: 300},
+		{Func: "Burn", Token: "gno.land/r/demo/foo20", Amount: 100},
+		{Func: "Transfer", Token: "gno.land/r/demo/foo20", Amount: 50},
+	})
+
+	want := []model.TokenStat{
+		{Token: "ugnot", Minted: 1000, Supply: 1000, TransferCount: 1},
+		{Token: "gno.land/r/demo/foo20", Minted: 300, Burned: 100, Supply: 200, TransferCount: 1},
+	}
+	if !reflect.DeepEqual(deltas, want) {
+		t.Errorf("tokenStatDeltas = %+v, want %+v", deltas, want)
+	}
+}
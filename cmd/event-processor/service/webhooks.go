@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"gno.land-block-indexer/model"
+)
+
+const (
+	WEBHOOK_RULES_REFRESH_INTERVAL = 30 * time.Second // How often the watch rules are reloaded
+	WEBHOOK_POLL_INTERVAL          = 2 * time.Second  // How often due deliveries are looked for
+	WEBHOOK_BATCH_SIZE             = 100              // Deliveries attempted per poll
+	WEBHOOK_MAX_ATTEMPTS           = 8                // Attempts before a delivery is marked failed
+	WEBHOOK_BASE_BACKOFF           = 10 * time.Second // Delay before the first retry, doubled on each retry
+	WEBHOOK_MAX_BACKOFF            = time.Hour        // Longest delay between retries
+)
+
+// watchRuleCache holds the active watch rules, reloaded periodically so transfers
+// are not matched against the database one by one
+type watchRuleCache struct {
+	mu       sync.Mutex
+	rules    []model.WatchRule
+	loadedAt time.Time
+}
+
+// webhookPayload is the JSON body of a transfer webhook
+type webhookPayload struct {
+	Type        string    `json:"type"`
+	RuleID      int       `json:"ruleId"`
+	TxHash      string    `json:"txHash"`
+	EventIndex  int       `json:"eventIndex"`
+	BlockHeight int       `json:"blockHeight"`
+	BlockTime   time.Time `json:"blockTime"`
+	Func        string    `json:"func"`
+	FromAddress string    `json:"fromAddress"`
+	ToAddress   string    `json:"toAddress"`
+	Token       string    `json:"token"`
+	Amount      int64     `json:"amount"`
+}
+
+// activeWatchRules returns the cached active watch rules, reloading them when stale
+func (s *service) activeWatchRules(ctx context.Context) ([]model.WatchRule, error) {
+	s.watchRules.mu.Lock()
+	defer s.watchRules.mu.Unlock()
+
+	if time.Since(s.watchRules.loadedAt) < WEBHOOK_RULES_REFRESH_INTERVAL {
+		return s.watchRules.rules, nil
+	}
+	rules, err := s.repo.GetWatchRules(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	s.watchRules.rules = rules
+	s.watchRules.loadedAt = time.Now()
+	return rules, nil
+}
+
+// queueWebhooks records a pending delivery for each transfer matching a watch rule.
+// Deliveries are written with the transfers, so a webhook is sent for every stored transfer.
+func (s *service) queueWebhooks(ctx context.Context, block *model.Block, tx *model.Transaction, transfers []model.Transfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	rules, err := s.activeWatchRules(ctx)
+	if err != nil {
+		return s.logger.Errorf("Failed to load watch rules: %v", err)
+	}
+
+	var deliveries []model.WebhookDelivery
+	for _, rule := range rules {
+		for _, transfer := range transfers {
+			if !matchWatchRule(rule, transfer) {
+				continue
+			}
+
+			payload, err := json.Marshal(webhookPayload{
+				Type:        "transfer",
+				RuleID:      rule.ID,
+				TxHash:      tx.Hash,
+				EventIndex:  transfer.EventIndex,
+				BlockHeight: tx.BlockHeight,
+				BlockTime:   block.Time,
+				Func:        strings.ToLower(transfer.Func),
+				FromAddress: transfer.FromAddress,
+				ToAddress:   transfer.ToAddress,
+				Token:       transfer.Token,
+				Amount:      int64(transfer.Amount),
+			})
+			if err != nil {
+				return s.logger.Errorf("Failed to encode webhook for transaction %s: %v", tx.Hash, err)
+			}
+			deliveries = append(deliveries, model.WebhookDelivery{
+				RuleID:        rule.ID,
+				Hash:          tx.Hash,
+				EventIndex:    transfer.EventIndex,
+				Token:         transfer.Token,
+				BlockHeight:   tx.BlockHeight,
+				Payload:       string(payload),
+				NextAttemptAt: time.Now(),
+			})
+		}
+	}
+
+	if err := s.repo.AddWebhookDeliveries(ctx, deliveries); err != nil {
+		return s.logger.Errorf("Failed to queue webhooks for transaction %s: %v", tx.Hash, err)
+	}
+
+	return nil
+}
+
+// matchWatchRule tells whether a transfer matches a watch rule, empty rule fields matching any transfer
+func matchWatchRule(rule model.WatchRule, transfer model.Transfer) bool {
+	if rule.Address != "" && rule.Address != transfer.FromAddress && rule.Address != transfer.ToAddress {
+		return false
+	}
+	if rule.Token != "" && rule.Token != transfer.Token {
+		return false
+	}
+	if rule.EventType != "" && !strings.EqualFold(rule.EventType, transfer.Func) {
+		return false
+	}
+	return transfer.Amount >= rule.MinAmount
+}
+
+// webhookBackoff returns the delay before retrying a delivery which failed the given number of times
+func webhookBackoff(attempts int) time.Duration {
+	backoff := WEBHOOK_BASE_BACKOFF
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= WEBHOOK_MAX_BACKOFF {
+			return WEBHOOK_MAX_BACKOFF
+		}
+	}
+	return backoff
+}
+
+// deliverWebhooks sends the due webhooks until the context is cancelled
+func (s *service) deliverWebhooks(ctx context.Context) {
+	s.logger.Infof("Starting webhook delivery")
+	ticker := time.NewTicker(WEBHOOK_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Infof("Webhook delivery stopping")
+			return
+		case <-ticker.C:
+			deliveries, err := s.repo.GetDueWebhookDeliveries(ctx, time.Now(), WEBHOOK_BATCH_SIZE)
+			if err != nil {
+				s.logger.Errorf("Failed to get due webhook deliveries: %v", err)
+				continue
+			}
+			for i := range deliveries {
+				s.deliverWebhook(ctx, &deliveries[i])
+			}
+		}
+	}
+}
+
+// deliverWebhook attempts a delivery and records the outcome, scheduling a retry on failure
+func (s *service) deliverWebhook(ctx context.Context, delivery *model.WebhookDelivery) {
+	if delivery.Rule == nil {
+		return
+	}
+
+	status, err := s.webhook.Send(ctx, delivery.Rule.URL, delivery.Rule.Secret, delivery.ID, []byte(delivery.Payload))
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = status
+	delivery.LastError = ""
+	switch {
+	case err == nil:
+		delivery.Status = "delivered"
+	case delivery.Attempts >= WEBHOOK_MAX_ATTEMPTS:
+		delivery.Status = "failed"
+		delivery.LastError = err.Error()
+		s.logger.Warnf("Webhook delivery %d to %s failed after %d attempts: %v", delivery.ID, delivery.Rule.URL, delivery.Attempts, err)
+	default:
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts))
+	}
+
+	if err := s.repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		s.logger.Errorf("Failed to record webhook delivery %d: %v", delivery.ID, err)
+	}
+}
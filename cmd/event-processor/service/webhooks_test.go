@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestMatchWatchRule(t *testing.T) {
+	const address = "g17290cwvmrapvp869xfnhhawa8sm9edpufzat7d"
+	transfer := model.Transfer{Func: "Transfer", FromAddress: "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5", ToAddress: address, Token: "ugnot", Amount: 100}
+
+	tcs := []struct {
+		rule model.WatchRule
+		want bool
+	}{
+		{model.WatchRule{}, true},
+		{model.WatchRule{Address: address, Token: "ugnot", EventType: "transfer", MinAmount: 100}, true},
+		{model.WatchRule{Address: "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5"}, true},
+		{model.WatchRule{Address: "g16a7etgm9z2r653ucl36rj0l2yqcxgrz2jyegzx"}, false},
+		{model.WatchRule{Token: "gno.land/r/demo/foo20"}, false},
+		{model.WatchRule{EventType: "mint"}, false},
+		{model.WatchRule{MinAmount: 101}, false},
+	}
+	for _, tc := range tcs {
+		if got := matchWatchRule(tc.rule, transfer); got != tc.want {
+			t.Errorf("matchWatchRule(%+v) = %v, want %v", tc.rule, got, tc.want)
+		}
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	if got := webhookBackoff(1); got != WEBHOOK_BASE_BACKOFF {
+		t.Errorf("webhookBackoff(1) = %v, want %v", got, WEBHOOK_BASE_BACKOFF)
+	}
+	if got := webhookBackoff(3); got != 4*WEBHOOK_BASE_BACKOFF {
+		t.Errorf("webhookBackoff(3) = %v, want %v", got, 4*WEBHOOK_BASE_BACKOFF)
+	}
+	if got := webhookBackoff(20); got != WEBHOOK_MAX_BACKOFF {
+		t.Errorf("webhookBackoff(20) = %v, want %v", got, WEBHOOK_MAX_BACKOFF)
+	}
+}
@@ -0,0 +1,283 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gno.land-block-indexer/model"
+)
+
+type blockResponse struct {
+	Height   int       `json:"height"`
+	Hash     string    `json:"hash"`
+	Time     time.Time `json:"time"`
+	NumTxs   int       `json:"numTxs"`
+	TotalTxs int       `json:"totalTxs"`
+}
+
+func newBlockResponse(block model.Block) blockResponse {
+	return blockResponse{
+		Height:   block.Height,
+		Hash:     block.Hash,
+		Time:     block.Time,
+		NumTxs:   block.NumTxs,
+		TotalTxs: block.TotalTxs,
+	}
+}
+
+type messageResponse struct {
+	Route   string         `json:"route"`
+	TypeUrl string         `json:"typeUrl"`
+	Value   map[string]any `json:"value"`
+}
+
+type eventAttrResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type eventResponse struct {
+	EventIndex int                 `json:"eventIndex"`
+	Type       string              `json:"type"`
+	Func       string              `json:"func"`
+	PkgPath    string              `json:"pkgPath"`
+	Attrs      []eventAttrResponse `json:"attrs"`
+}
+
+type transactionResponse struct {
+	Hash        string            `json:"hash"`
+	BlockHeight int               `json:"blockHeight"`
+	Index       int               `json:"index"`
+	Success     bool              `json:"success"`
+	GasWanted   float64           `json:"gasWanted"`
+	GasUsed     float64           `json:"gasUsed"`
+	GasFee      string            `json:"gasFee"`
+	Memo        string            `json:"memo"`
+	Error       string            `json:"error,omitempty"`
+	Log         string            `json:"log,omitempty"`
+	Messages    []messageResponse `json:"messages"`
+	Events      []eventResponse   `json:"events"`
+}
+
+func newTransactionResponse(tx model.Transaction) transactionResponse {
+	messages := make([]messageResponse, len(tx.Messages))
+	for i, msg := range tx.Messages {
+		messages[i] = messageResponse{
+			Route:   msg.Route,
+			TypeUrl: msg.TypeUrl,
+			Value:   msg.Value,
+		}
+	}
+	events := make([]eventResponse, len(tx.Response.Events))
+	for i, event := range tx.Response.Events {
+		attrs := make([]eventAttrResponse, len(event.Attrs))
+		for j, attr := range event.Attrs {
+			attrs[j] = eventAttrResponse{Key: attr.Key, Value: attr.Value}
+		}
+		events[i] = eventResponse{
+			EventIndex: i,
+			Type:       event.Type,
+			Func:       event.Func,
+			PkgPath:    event.PkgPath,
+			Attrs:      attrs,
+		}
+	}
+
+	var gasFee string
+	if tx.GasFee.Denom != "" {
+		gasFee = strconv.FormatFloat(tx.GasFee.Amount, 'f', -1, 64) + tx.GasFee.Denom
+	}
+	return transactionResponse{
+		Hash:        tx.Hash,
+		BlockHeight: tx.BlockHeight,
+		Index:       tx.Index,
+		Success:     tx.Success,
+		GasWanted:   tx.GasWanted,
+		GasUsed:     tx.GasUsed,
+		GasFee:      gasFee,
+		Memo:        tx.Memo,
+		Error:       tx.Response.Error,
+		Log:         tx.Response.Log,
+		Messages:    messages,
+		Events:      events,
+	}
+}
+
+// parseHeight reads a block height path parameter
+func parseHeight(gCtx *gin.Context) (int, bool) {
+	height, err := strconv.Atoi(gCtx.Param("height"))
+	if err != nil || height < 0 {
+		gCtx.JSON(400, gin.H{"error": "Invalid height"})
+		return 0, false
+	}
+	return height, true
+}
+
+// GetBlocks lists blocks, latest first
+func (c *Controller) GetBlocks(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	blocks, err := c.service.GetBlocks(ctx, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get blocks: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get blocks"})
+		return
+	}
+
+	var response struct {
+		Blocks []blockResponse `json:"blocks"`
+	}
+	response.Blocks = make([]blockResponse, len(blocks))
+	for i, block := range blocks {
+		response.Blocks[i] = newBlockResponse(block)
+	}
+
+	gCtx.JSON(200, response)
+}
+
+func (c *Controller) GetBlock(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	height, ok := parseHeight(gCtx)
+	if !ok {
+		return
+	}
+
+	block, err := c.service.GetBlock(ctx, height)
+	if err != nil {
+		c.logger.Errorf("Failed to get block %d: %v", height, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get block"})
+		return
+	}
+	if block == nil {
+		gCtx.JSON(404, gin.H{"error": "block not found"})
+		return
+	}
+
+	gCtx.JSON(200, newBlockResponse(*block))
+}
+
+// GetBlockTransactions lists the transactions of a block in their order in the block
+func (c *Controller) GetBlockTransactions(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	height, ok := parseHeight(gCtx)
+	if !ok {
+		return
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	block, err := c.service.GetBlock(ctx, height)
+	if err != nil {
+		c.logger.Errorf("Failed to get block %d: %v", height, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get transactions"})
+		return
+	}
+	if block == nil {
+		gCtx.JSON(404, gin.H{"error": "block not found"})
+		return
+	}
+	txs, err := c.service.GetBlockTransactions(ctx, height, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get transactions of block %d: %v", height, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get transactions"})
+		return
+	}
+
+	var response struct {
+		Block        blockResponse         `json:"block"`
+		Transactions []transactionResponse `json:"transactions"`
+	}
+	response.Block = newBlockResponse(*block)
+	response.Transactions = make([]transactionResponse, len(txs))
+	for i, tx := range txs {
+		response.Transactions[i] = newTransactionResponse(tx)
+	}
+
+	gCtx.JSON(200, response)
+}
+
+// GetTransaction returns a transaction by hash. Hashes are base64 and may contain
+// slashes, which the OpenAPI routes allow.
+func (c *Controller) GetTransaction(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	hash := gCtx.Param("hash")
+
+	tx, err := c.service.GetTransaction(ctx, hash)
+	if err != nil {
+		c.logger.Errorf("Failed to get transaction %s: %v", hash, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get transaction"})
+		return
+	}
+	if tx == nil {
+		gCtx.JSON(404, gin.H{"error": "transaction not found"})
+		return
+	}
+
+	gCtx.JSON(200, newTransactionResponse(*tx))
+}
+
+// parseTransactionFilter reads the transaction filter query parameters
+func parseTransactionFilter(gCtx *gin.Context) (model.TransactionFilter, error) {
+	var request struct {
+		Success     *bool  `form:"success"`
+		MessageType string `form:"message_type"`
+		FromHeight  int    `form:"from_height"`
+		ToHeight    int    `form:"to_height"`
+	}
+	if err := gCtx.ShouldBindQuery(&request); err != nil {
+		return model.TransactionFilter{}, fmt.Errorf("invalid request")
+	}
+	if request.FromHeight < 0 || request.ToHeight < 0 || (request.ToHeight > 0 && request.ToHeight < request.FromHeight) {
+		return model.TransactionFilter{}, fmt.Errorf("invalid height range")
+	}
+
+	return model.TransactionFilter{
+		Success:     request.Success,
+		MessageType: request.MessageType,
+		FromHeight:  request.FromHeight,
+		ToHeight:    request.ToHeight,
+	}, nil
+}
+
+// GetTransactions lists transactions, latest first, filtered on success, message
+// type (e.g. message_type=exec) and height range
+func (c *Controller) GetTransactions(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	filter, err := parseTransactionFilter(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	txs, err := c.service.GetTransactions(ctx, filter, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get transactions: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get transactions"})
+		return
+	}
+
+	var response struct {
+		Transactions []transactionResponse `json:"transactions"`
+	}
+	response.Transactions = make([]transactionResponse, len(txs))
+	for i, tx := range txs {
+		response.Transactions[i] = newTransactionResponse(tx)
+	}
+
+	gCtx.JSON(200, response)
+}
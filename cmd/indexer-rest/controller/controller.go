@@ -3,17 +3,27 @@ package controller
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/coocood/freecache"
 	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
 	"gno.land-block-indexer/cmd/indexer-rest/service"
+	"gno.land-block-indexer/externals/msgbroker"
 	"gno.land-block-indexer/lib/log"
+	"gno.land-block-indexer/model"
 	"gno.land-block-indexer/repository"
 )
 
+const (
+	defaultPageLimit = 20  // Page size used when no limit is given
+	maxPageLimit     = 100 // Largest page size accepted
+)
+
 type Controller struct {
 	logger     log.Logger
 	localCache *freecache.Cache
@@ -21,18 +31,31 @@ type Controller struct {
 	listenPort int
 	service    service.Service
 	engine     *gin.Engine
+
+	openapi       *openapiDocument
+	graphqlSchema graphql.Schema
 }
 
-func NewController(logger log.Logger) *Controller {
-	engine := gin.Default()
-	repo := repository.NewRepositoryEnt(logger, &repository.RepositoryEntConfig{
+func newRepository(logger log.Logger) repository.Repository {
+	return repository.NewRepositoryEnt(logger, &repository.RepositoryEntConfig{
 		Host:     "localhost",
 		Port:     5432,
 		User:     "postgres",
 		Password: "postgres",
 		Database: "postgres",
 	})
-	service := service.NewService(logger, repo)
+}
+
+func NewController(logger log.Logger) *Controller {
+	engine := gin.Default()
+	repo := newRepository(logger)
+	// Live streams are optional, the rest of the API works without the broker
+	msgBroker, err := msgbroker.NewMsgBrokerLocalStack(context.Background(), logger, nil)
+	if err != nil {
+		logger.Warnf("Failed to create message broker, live streams are disabled: %v", err)
+		msgBroker = nil
+	}
+	service := service.NewService(logger, repo, msgBroker)
 
 	cacheSize := 100 * 1024 * 1024 // 100 MB
 	localCache := freecache.NewCache(cacheSize)
@@ -40,7 +63,7 @@ func NewController(logger log.Logger) *Controller {
 		logger.Fatalf("Failed to create local cache with size %d bytes", cacheSize)
 	}
 
-	return &Controller{
+	c := &Controller{
 		engine:     engine,
 		logger:     logger,
 		service:    service,
@@ -48,6 +71,31 @@ func NewController(logger log.Logger) *Controller {
 		listenPort: 8080,
 		localCache: localCache,
 	}
+	doc, err := loadOpenapiDocument(openapiJSON)
+	if err != nil {
+		logger.Fatalf("Failed to load OpenAPI document: %v", err)
+	}
+	c.openapi = doc
+	schema, err := newGraphqlSchema(c)
+	if err != nil {
+		logger.Fatalf("Failed to create GraphQL schema: %v", err)
+	}
+	c.graphqlSchema = schema
+
+	return c
+}
+
+// uncachedPathPrefixes are the routes whose responses change on writes through the API,
+// and the live streams and exports, which are too large to buffer
+var uncachedPathPrefixes = []string{"/webhooks/", "/stream/", "/exports/"}
+
+func isUncachedPath(path string) bool {
+	for _, prefix := range uncachedPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 func (c *Controller) getMemcacheKey(ctx *gin.Context) string {
@@ -71,6 +119,10 @@ func (c *Controller) Run(ctx context.Context) error {
 			gCtx.Next()
 			return
 		}
+		if isUncachedPath(gCtx.Request.URL.Path) {
+			gCtx.Next()
+			return
+		}
 		key := c.getMemcacheKey(gCtx)
 		if value, found := c.findFromLocalCache(key); found {
 			c.logger.Infof("Cache hit for key: %s", key)
@@ -83,6 +135,11 @@ func (c *Controller) Run(ctx context.Context) error {
 	})
 
 	c.engine.Use(func(gCtx *gin.Context) {
+		if isUncachedPath(gCtx.Request.URL.Path) {
+			// Streams never end, their body must not be buffered
+			gCtx.Next()
+			return
+		}
 		blw := &bodyLogWriter{body: bytes.NewBufferString(""), ResponseWriter: gCtx.Writer}
 		gCtx.Writer = blw
 
@@ -108,7 +165,13 @@ func (c *Controller) Run(ctx context.Context) error {
 		}
 	})
 
-	c.engine.GET("/tokens/*any", c.handleTokenRoutes)
+	if err := c.service.StartStream(ctx); err != nil {
+		c.logger.Warnf("Live streams are disabled: %v", err)
+	}
+
+	if err := registerRoutes(c.engine, c.openapi, c.operationHandlers()); err != nil {
+		return c.logger.Errorf("Failed to register routes: %v", err)
+	}
 
 	// Start the HTTP server
 	address := c.listenHost + ":" + strconv.Itoa(c.listenPort)
@@ -123,28 +186,94 @@ func (c *Controller) Run(ctx context.Context) error {
 	return nil
 }
 
-func (c *Controller) handleTokenRoutes(gCtx *gin.Context) {
-	path := gCtx.Param("any")
+// parsePagination reads the offset and limit query parameters
+func parsePagination(gCtx *gin.Context) (int, int, error) {
+	offset, err := strconv.Atoi(gCtx.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		return 0, 0, fmt.Errorf("invalid offset %q", gCtx.Query("offset"))
+	}
+	limit, err := parseLimit(gCtx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return offset, limit, nil
+}
 
-	switch {
-	case path == "/balances":
-		c.GetTokenBalances(gCtx)
-	case path == "/transfer-history":
-		c.GetTransferHistory(gCtx)
-	case strings.HasSuffix(path, "/balances"):
-		tokenPath := strings.TrimSuffix(path, "/balances")
-		if tokenPath == "" {
-			c.logger.Errorf("Token path is empty")
-			gCtx.JSON(400, gin.H{"error": "Token path cannot be empty"})
-			return
+// parseLimit reads the limit query parameter
+func parseLimit(gCtx *gin.Context) (int, error) {
+	limit, err := strconv.Atoi(gCtx.DefaultQuery("limit", strconv.Itoa(defaultPageLimit)))
+	if err != nil || limit <= 0 || limit > maxPageLimit {
+		return 0, fmt.Errorf("invalid limit %q, must be between 1 and %d", gCtx.Query("limit"), maxPageLimit)
+	}
+	return limit, nil
+}
+
+// encodeTransferCursor formats a transfer cursor as an opaque URL-safe string
+func encodeTransferCursor(cursor *model.TransferCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", cursor.BlockHeight, cursor.ID)))
+}
+
+// decodeTransferCursor parses a cursor made by encodeTransferCursor
+func decodeTransferCursor(value string) (*model.TransferCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	height, id, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid cursor %q", value)
+	}
+	cursor := &model.TransferCursor{}
+	if cursor.BlockHeight, err = strconv.Atoi(height); err != nil {
+		return nil, err
+	}
+	if cursor.ID, err = strconv.Atoi(id); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// formatAmount formats a raw token amount with the decimals of the token, e.g. 1500000 with 6 decimals is "1.5"
+func formatAmount(amount int64, decimals int) string {
+	if decimals <= 0 {
+		return strconv.FormatInt(amount, 10)
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	digits := strconv.FormatInt(amount, 10)
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	whole, fraction := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if fraction == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + fraction
+}
+
+// parseTimeRange reads the from and to query parameters as RFC3339 timestamps
+func parseTimeRange(gCtx *gin.Context) (time.Time, time.Time, error) {
+	var from, to time.Time
+	var err error
+	if value := gCtx.Query("from"); value != "" {
+		if from, err = time.Parse(time.RFC3339, value); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from %q, must be an RFC3339 timestamp", value)
 		}
-		if strings.HasPrefix(tokenPath, "/") {
-			tokenPath = strings.TrimPrefix(tokenPath, "/")
+	}
+	if value := gCtx.Query("to"); value != "" {
+		if to, err = time.Parse(time.RFC3339, value); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to %q, must be an RFC3339 timestamp", value)
 		}
-		c.GetTokenAccountBalances(gCtx, tokenPath)
-	default:
-		gCtx.JSON(404, gin.H{"error": "endpoint not found"})
 	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must be before to")
+	}
+	return from, to, nil
 }
 
 func (c *Controller) findFromLocalCache(key string) ([]byte, bool) {
@@ -159,6 +288,37 @@ func (c *Controller) findFromLocalCache(key string) ([]byte, bool) {
 	return value, true
 }
 
+type balanceResponse struct {
+	TokenPath       string `json:"tokenPath"`
+	Symbol          string `json:"symbol"`
+	Amount          int64  `json:"amount"`
+	AmountFormatted string `json:"amountFormatted"`
+}
+
+// newBalanceResponses formats token balances with the metadata of their tokens
+func (c *Controller) newBalanceResponses(ctx context.Context, balances []model.TokenBalance) ([]balanceResponse, error) {
+	paths := make([]string, len(balances))
+	for i, balance := range balances {
+		paths[i] = balance.Token
+	}
+	tokens, err := c.service.GetTokenMetadata(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]balanceResponse, len(balances))
+	for i, balance := range balances {
+		token := tokens[balance.Token]
+		responses[i] = balanceResponse{
+			TokenPath:       balance.Token,
+			Symbol:          token.Symbol,
+			Amount:          int64(balance.Amount),
+			AmountFormatted: formatAmount(int64(balance.Amount), token.Decimals),
+		}
+	}
+	return responses, nil
+}
+
 func (c *Controller) GetTokenBalances(gCtx *gin.Context) {
 	ctx := gCtx.Request.Context()
 	var request struct {
@@ -176,47 +336,107 @@ func (c *Controller) GetTokenBalances(gCtx *gin.Context) {
 		return
 	}
 
-	type Balance struct {
-		TokenPath string `json:"tokenPath"`
-		Amount    int64  `json:"amount"`
+	balances, err := c.newBalanceResponses(ctx, accounts)
+	if err != nil {
+		c.logger.Errorf("Failed to get token metadata: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get account balances"})
+		return
 	}
+
 	var response struct {
-		Balances []Balance `json:"balances"`
+		Balances []balanceResponse `json:"balances"`
 	}
-	for _, account := range accounts {
-		response.Balances = append(response.Balances, Balance{
-			TokenPath: account.Token,
-			Amount:    int64(account.Amount),
-		})
+	response.Balances = balances
+
+	gCtx.JSON(200, response)
+}
+
+// GetAccountBalances returns the balances of an address, at the given height if any
+func (c *Controller) GetAccountBalances(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	address := gCtx.Param("address")
+
+	var balances []model.TokenBalance
+	var err error
+	height := -1
+	if value := gCtx.Query("height"); value != "" {
+		height, err = strconv.Atoi(value)
+		if err != nil || height < 0 {
+			gCtx.JSON(400, gin.H{"error": "Invalid height"})
+			return
+		}
+		balances, err = c.service.GetBalancesAtHeight(ctx, address, height)
+	} else {
+		balances, err = c.service.GetTokenBalances(ctx, address)
+	}
+	if err != nil {
+		c.logger.Errorf("Failed to get balances for address %s: %v", address, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get account balances"})
+		return
+	}
+
+	responses, err := c.newBalanceResponses(ctx, balances)
+	if err != nil {
+		c.logger.Errorf("Failed to get token metadata: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get account balances"})
+		return
+	}
+
+	var response struct {
+		Address  string            `json:"address"`
+		Height   *int              `json:"height,omitempty"`
+		Balances []balanceResponse `json:"balances"`
 	}
-	if len(response.Balances) == 0 {
-		response.Balances = []Balance{}
+	response.Address = address
+	if height >= 0 {
+		response.Height = &height
 	}
+	response.Balances = responses
 
 	gCtx.JSON(200, response)
 }
 
-func (c *Controller) GetTokenAccountBalances(gCtx *gin.Context, tokenPath string) {
+func (c *Controller) GetTokenAccountBalances(gCtx *gin.Context) {
 	ctx := gCtx.Request.Context()
+	tokenPath := gCtx.Param("tokenPath")
 	address := gCtx.Query("address")
 	tokenAccountBalances, err := c.service.GetTokenAccountBalances(ctx, tokenPath, address)
 	if err != nil {
 		return
 	}
 
+	token, err := c.service.GetToken(ctx, tokenPath)
+	if err != nil {
+		c.logger.Errorf("Failed to get token %s: %v", tokenPath, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get token balances"})
+		return
+	}
+	if token == nil {
+		// Unknown tokens are reported in raw amounts
+		token = &model.Token{Path: tokenPath}
+	}
+
 	type TokenAccountBalance struct {
-		Address   string `json:"address"`
-		TokenPath string `json:"tokenPath"`
-		Amount    int64  `json:"amount"`
+		Address          string `json:"address"`
+		TokenPath        string `json:"tokenPath"`
+		Amount           int64  `json:"amount"`
+		AmountFormatted  string `json:"amountFormatted"`
+		FirstSeenHeight  int    `json:"firstSeenHeight"`
+		LastActiveHeight int    `json:"lastActiveHeight"`
+		TxCount          int    `json:"txCount"`
 	}
 	var response struct {
 		AccountBalances []TokenAccountBalance `json:"accountBalances"`
 	}
 	for _, account := range tokenAccountBalances {
 		response.AccountBalances = append(response.AccountBalances, TokenAccountBalance{
-			Address:   account.Address,
-			TokenPath: account.Token,
-			Amount:    int64(account.Amount),
+			Address:          account.Address,
+			TokenPath:        account.Token,
+			Amount:           int64(account.Amount),
+			AmountFormatted:  formatAmount(int64(account.Amount), token.Decimals),
+			FirstSeenHeight:  account.FirstSeenHeight,
+			LastActiveHeight: account.LastActiveHeight,
+			TxCount:          account.TxCount,
 		})
 	}
 	if len(response.AccountBalances) == 0 {
@@ -226,32 +446,146 @@ func (c *Controller) GetTokenAccountBalances(gCtx *gin.Context, tokenPath string
 	gCtx.JSON(200, response)
 }
 
+// transferFuncAll selects transfers of every kind, only the transfer ones being listed by default
+const transferFuncAll = "all"
+
+// transferFunc maps the func parameter to the filter, defaulting to transfers only
+func transferFunc(value string) string {
+	switch value {
+	case "":
+		return "transfer"
+	case transferFuncAll:
+		return ""
+	}
+	return value
+}
+
+// parseTransferFilter reads the transfer filter query parameters. As before the
+// filters were added, only transfers (no mint, burn, fee or genesis rows) are
+// selected unless func says otherwise, oldest first unless sort=desc.
+func parseTransferFilter(gCtx *gin.Context) (model.TransferFilter, error) {
+	var request struct {
+		Address    string  `form:"address"`
+		Direction  string  `form:"direction"`
+		Token      string  `form:"token"`
+		Func       string  `form:"func"`
+		FromHeight int     `form:"from_height"`
+		ToHeight   int     `form:"to_height"`
+		MinAmount  float64 `form:"min_amount"`
+		MaxAmount  float64 `form:"max_amount"`
+		Sort       string  `form:"sort"`
+	}
+	if err := gCtx.ShouldBindQuery(&request); err != nil {
+		return model.TransferFilter{}, fmt.Errorf("invalid request")
+	}
+	switch request.Direction {
+	case "", repository.TransferDirectionBoth:
+	case repository.TransferDirectionIn, repository.TransferDirectionOut:
+		if request.Address == "" {
+			return model.TransferFilter{}, fmt.Errorf("direction requires an address")
+		}
+	default:
+		return model.TransferFilter{}, fmt.Errorf("direction must be in, out or both")
+	}
+	if request.Sort != "" && request.Sort != "asc" && request.Sort != "desc" {
+		return model.TransferFilter{}, fmt.Errorf("sort must be asc or desc")
+	}
+	if request.FromHeight < 0 || request.ToHeight < 0 || (request.ToHeight > 0 && request.ToHeight < request.FromHeight) {
+		return model.TransferFilter{}, fmt.Errorf("invalid height range")
+	}
+	if request.MinAmount < 0 || request.MaxAmount < 0 || (request.MaxAmount > 0 && request.MaxAmount < request.MinAmount) {
+		return model.TransferFilter{}, fmt.Errorf("invalid amount range")
+	}
+	from, to, err := parseTimeRange(gCtx)
+	if err != nil {
+		return model.TransferFilter{}, err
+	}
+
+	return model.TransferFilter{
+		Address:    request.Address,
+		Direction:  request.Direction,
+		Token:      request.Token,
+		Func:       transferFunc(request.Func),
+		FromHeight: request.FromHeight,
+		ToHeight:   request.ToHeight,
+		From:       from,
+		To:         to,
+		MinAmount:  request.MinAmount,
+		MaxAmount:  request.MaxAmount,
+		Ascending:  request.Sort != "desc",
+	}, nil
+}
+
+// GetTransferHistory lists transfers, oldest first unless sort=desc. Pages are
+// chained by passing the next_cursor of a response as the cursor parameter.
 func (c *Controller) GetTransferHistory(gCtx *gin.Context) {
 	ctx := gCtx.Request.Context()
-	var request struct {
-		Address string `form:"address"`
+	filter, err := parseTransferFilter(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
 	}
-	transferHistories, err := c.service.GetTransferHistory(ctx, request.Address)
+	limit, err := parseLimit(gCtx)
 	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	var after *model.TransferCursor
+	if cursor := gCtx.Query("cursor"); cursor != "" {
+		if after, err = decodeTransferCursor(cursor); err != nil {
+			gCtx.JSON(400, gin.H{"error": "Invalid cursor"})
+			return
+		}
+	}
+
+	transferHistories, next, err := c.service.GetTransferHistory(ctx, filter, after, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get transfer history: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get transfer history"})
+		return
+	}
+
+	var paths []string
+	for _, transferHistory := range transferHistories {
+		paths = append(paths, transferHistory.Token)
+	}
+	tokens, err := c.service.GetTokenMetadata(ctx, paths)
+	if err != nil {
+		c.logger.Errorf("Failed to get token metadata: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get transfer history"})
 		return
 	}
 
 	type Transfer struct {
-		FromAddress string `json:"fromAddress"`
-		ToAddress   string `json:"toAddress"`
-		Token       string `json:"token"`
-		Amount      int64  `json:"amount"`
+		TxHash          string    `json:"txHash"`
+		BlockHeight     int       `json:"blockHeight"`
+		BlockTime       time.Time `json:"blockTime"`
+		Func            string    `json:"func"`
+		FromAddress     string    `json:"fromAddress"`
+		ToAddress       string    `json:"toAddress"`
+		Token           string    `json:"token"`
+		Amount          int64     `json:"amount"`
+		AmountFormatted string    `json:"amountFormatted"`
 	}
 	var response struct {
-		Transfer []Transfer `json:"transfers"`
+		Transfer   []Transfer `json:"transfers"`
+		NextCursor string     `json:"next_cursor,omitempty"`
+	}
+	if next != nil {
+		response.NextCursor = encodeTransferCursor(next)
 	}
 
 	for _, transferHistory := range transferHistories {
 		response.Transfer = append(response.Transfer, Transfer{
-			FromAddress: transferHistory.FromAddress,
-			ToAddress:   transferHistory.ToAddress,
-			Token:       transferHistory.Token,
-			Amount:      int64(transferHistory.Amount),
+			TxHash:          transferHistory.Hash,
+			BlockHeight:     transferHistory.BlockHeight,
+			BlockTime:       transferHistory.BlockTime,
+			Func:            transferHistory.Func,
+			FromAddress:     transferHistory.FromAddress,
+			ToAddress:       transferHistory.ToAddress,
+			Token:           transferHistory.Token,
+			Amount:          int64(transferHistory.Amount),
+			AmountFormatted: formatAmount(int64(transferHistory.Amount), tokens[transferHistory.Token].Decimals),
 		})
 	}
 	if len(response.Transfer) == 0 {
@@ -260,3 +594,548 @@ func (c *Controller) GetTransferHistory(gCtx *gin.Context) {
 
 	gCtx.JSON(200, response)
 }
+
+func (c *Controller) GetAccountNfts(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	address := gCtx.Param("address")
+	collection := gCtx.Query("collection")
+	nfts, err := c.service.GetAccountNfts(ctx, address, collection)
+	if err != nil {
+		c.logger.Errorf("Failed to get nfts for address %s: %v", address, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get nfts"})
+		return
+	}
+
+	type Nft struct {
+		Collection   string `json:"collection"`
+		TokenID      string `json:"tokenId"`
+		MintedHeight int    `json:"mintedHeight"`
+		LastHeight   int    `json:"lastHeight"`
+	}
+	var response struct {
+		Address string `json:"address"`
+		Nfts    []Nft  `json:"nfts"`
+	}
+	response.Address = address
+	for _, nft := range nfts {
+		response.Nfts = append(response.Nfts, Nft{
+			Collection:   nft.Collection,
+			TokenID:      nft.TokenID,
+			MintedHeight: nft.MintedHeight,
+			LastHeight:   nft.LastHeight,
+		})
+	}
+	if len(response.Nfts) == 0 {
+		response.Nfts = []Nft{}
+	}
+
+	gCtx.JSON(200, response)
+}
+
+// GetNftHistory lists the transfers of an NFT, the collection being a package path
+func (c *Controller) GetNftHistory(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	collection, tokenID := gCtx.Param("collection"), gCtx.Param("tokenId")
+	transfers, err := c.service.GetNftHistory(ctx, collection, tokenID)
+	if err != nil {
+		c.logger.Errorf("Failed to get history of nft %s/%s: %v", collection, tokenID, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get nft history"})
+		return
+	}
+
+	type Transfer struct {
+		Func        string `json:"func"`
+		FromAddress string `json:"fromAddress"`
+		ToAddress   string `json:"toAddress"`
+		BlockHeight int    `json:"blockHeight"`
+		TxHash      string `json:"txHash"`
+	}
+	var response struct {
+		Collection string     `json:"collection"`
+		TokenID    string     `json:"tokenId"`
+		Transfers  []Transfer `json:"transfers"`
+	}
+	response.Collection = collection
+	response.TokenID = tokenID
+	for _, transfer := range transfers {
+		response.Transfers = append(response.Transfers, Transfer{
+			Func:        transfer.Func,
+			FromAddress: transfer.FromAddress,
+			ToAddress:   transfer.ToAddress,
+			BlockHeight: transfer.BlockHeight,
+			TxHash:      transfer.Hash,
+		})
+	}
+	if len(response.Transfers) == 0 {
+		response.Transfers = []Transfer{}
+	}
+
+	gCtx.JSON(200, response)
+}
+
+type packageResponse struct {
+	Path        string   `json:"path"`
+	Name        string   `json:"name"`
+	Namespace   string   `json:"namespace"`
+	Creator     string   `json:"creator"`
+	Deposit     string   `json:"deposit"`
+	BlockHeight int      `json:"blockHeight"`
+	TxHash      string   `json:"txHash"`
+	Files       []string `json:"files"`
+}
+
+func newPackageResponse(pkg model.Package) packageResponse {
+	files := make([]string, len(pkg.Files))
+	for i, file := range pkg.Files {
+		files[i] = file.Name
+	}
+	return packageResponse{
+		Path:        pkg.Path,
+		Name:        pkg.Name,
+		Namespace:   pkg.Namespace,
+		Creator:     pkg.Creator,
+		Deposit:     pkg.Deposit,
+		BlockHeight: pkg.BlockHeight,
+		TxHash:      pkg.Hash,
+		Files:       files,
+	}
+}
+
+func (c *Controller) GetPackages(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	var request struct {
+		Creator   string `form:"creator"`
+		Namespace string `form:"namespace"`
+	}
+	if err := gCtx.ShouldBindQuery(&request); err != nil {
+		c.logger.Errorf("Failed to bind request: %v", err)
+		gCtx.JSON(400, gin.H{"error": "Invalid request"})
+		return
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	packages, err := c.service.GetPackages(ctx, request.Creator, request.Namespace, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get packages: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get packages"})
+		return
+	}
+
+	var response struct {
+		Packages []packageResponse `json:"packages"`
+	}
+	response.Packages = make([]packageResponse, len(packages))
+	for i, pkg := range packages {
+		response.Packages[i] = newPackageResponse(pkg)
+	}
+
+	gCtx.JSON(200, response)
+}
+
+func (c *Controller) GetPackage(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	path := gCtx.Param("pkgPath")
+	pkg, err := c.service.GetPackage(ctx, path)
+	if err != nil {
+		c.logger.Errorf("Failed to get package %s: %v", path, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get package"})
+		return
+	}
+	if pkg == nil {
+		gCtx.JSON(404, gin.H{"error": "package not found"})
+		return
+	}
+
+	gCtx.JSON(200, newPackageResponse(*pkg))
+}
+
+func (c *Controller) GetPackageFile(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	path, name := gCtx.Param("pkgPath"), gCtx.Param("name")
+	file, err := c.service.GetPackageFile(ctx, path, name)
+	if err != nil {
+		c.logger.Errorf("Failed to get file %s of package %s: %v", name, path, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get package file"})
+		return
+	}
+	if file == nil {
+		gCtx.JSON(404, gin.H{"error": "file not found"})
+		return
+	}
+
+	gCtx.JSON(200, gin.H{
+		"path": path,
+		"name": file.Name,
+		"body": file.Body,
+	})
+}
+
+// GetRealmCalls lists realm calls, filtered on the caller when served under /accounts/:address
+func (c *Controller) GetRealmCalls(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	var request struct {
+		PkgPath string `form:"pkg_path"`
+		Func    string `form:"func"`
+		Caller  string `form:"caller"`
+	}
+	if err := gCtx.ShouldBindQuery(&request); err != nil {
+		c.logger.Errorf("Failed to bind request: %v", err)
+		gCtx.JSON(400, gin.H{"error": "Invalid request"})
+		return
+	}
+	if address := gCtx.Param("address"); address != "" {
+		request.Caller = address
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	from, to, err := parseTimeRange(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	calls, err := c.service.GetRealmCalls(ctx, model.RealmCallFilter{
+		PkgPath: request.PkgPath,
+		Func:    request.Func,
+		Caller:  request.Caller,
+		From:    from,
+		To:      to,
+	}, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get realm calls: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get realm calls"})
+		return
+	}
+
+	type Call struct {
+		TxHash      string    `json:"txHash"`
+		MsgIndex    int       `json:"msgIndex"`
+		BlockHeight int       `json:"blockHeight"`
+		BlockTime   time.Time `json:"blockTime"`
+		PkgPath     string    `json:"pkgPath"`
+		Func        string    `json:"func"`
+		Caller      string    `json:"caller"`
+		Args        []string  `json:"args"`
+		Send        string    `json:"send"`
+		Success     bool      `json:"success"`
+	}
+	var response struct {
+		Calls []Call `json:"calls"`
+	}
+	response.Calls = make([]Call, len(calls))
+	for i, call := range calls {
+		args := call.Args
+		if args == nil {
+			args = []string{}
+		}
+		response.Calls[i] = Call{
+			TxHash:      call.Hash,
+			MsgIndex:    call.MsgIndex,
+			BlockHeight: call.BlockHeight,
+			BlockTime:   call.BlockTime,
+			PkgPath:     call.PkgPath,
+			Func:        call.Func,
+			Caller:      call.Caller,
+			Args:        args,
+			Send:        call.Send,
+			Success:     call.Success,
+		}
+	}
+
+	gCtx.JSON(200, response)
+}
+
+// GetAccountTransactions lists the transactions an address took part in, newest first.
+// Roles are filtered with role=signer&role=caller or role=signer,caller.
+func (c *Controller) GetAccountTransactions(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	address := gCtx.Param("address")
+	var roles []string
+	for _, value := range gCtx.QueryArray("role") {
+		for _, role := range strings.Split(value, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				roles = append(roles, role)
+			}
+		}
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	txs, err := c.service.GetAccountTransactions(ctx, address, roles, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get transactions for address %s: %v", address, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get transactions"})
+		return
+	}
+
+	type Transaction struct {
+		TxHash      string    `json:"txHash"`
+		BlockHeight int       `json:"blockHeight"`
+		TxIndex     int       `json:"txIndex"`
+		BlockTime   time.Time `json:"blockTime"`
+		Success     bool      `json:"success"`
+		Roles       []string  `json:"roles"`
+	}
+	var response struct {
+		Transactions []Transaction `json:"transactions"`
+	}
+	response.Transactions = make([]Transaction, len(txs))
+	for i, tx := range txs {
+		response.Transactions[i] = Transaction{
+			TxHash:      tx.Hash,
+			BlockHeight: tx.BlockHeight,
+			TxIndex:     tx.TxIndex,
+			BlockTime:   tx.BlockTime,
+			Success:     tx.Success,
+			Roles:       tx.Roles,
+		}
+	}
+
+	gCtx.JSON(200, response)
+}
+
+// GetEvents lists events, filtered on attributes given as attr.<key>=<value>
+func (c *Controller) GetEvents(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	var request struct {
+		PkgPath    string `form:"pkg_path"`
+		Type       string `form:"type"`
+		Func       string `form:"func"`
+		FromHeight int    `form:"from_height"`
+		ToHeight   int    `form:"to_height"`
+	}
+	if err := gCtx.ShouldBindQuery(&request); err != nil {
+		c.logger.Errorf("Failed to bind request: %v", err)
+		gCtx.JSON(400, gin.H{"error": "Invalid request"})
+		return
+	}
+	if request.FromHeight < 0 || request.ToHeight < 0 || (request.ToHeight > 0 && request.ToHeight < request.FromHeight) {
+		gCtx.JSON(400, gin.H{"error": "Invalid height range"})
+		return
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	attrs := make(map[string]string)
+	for key, values := range gCtx.Request.URL.Query() {
+		attrKey, ok := strings.CutPrefix(key, "attr.")
+		if !ok {
+			continue
+		}
+		if attrKey == "" || len(values) != 1 {
+			gCtx.JSON(400, gin.H{"error": fmt.Sprintf("Invalid attribute filter %q", key)})
+			return
+		}
+		attrs[attrKey] = values[0]
+	}
+
+	events, err := c.service.GetEvents(ctx, model.GnoEventFilter{
+		PkgPath:    request.PkgPath,
+		Type:       request.Type,
+		Func:       request.Func,
+		Attrs:      attrs,
+		FromHeight: request.FromHeight,
+		ToHeight:   request.ToHeight,
+	}, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get events: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get events"})
+		return
+	}
+
+	type Attr struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	type Event struct {
+		TxHash      string    `json:"txHash"`
+		EventIndex  int       `json:"eventIndex"`
+		BlockHeight int       `json:"blockHeight"`
+		BlockTime   time.Time `json:"blockTime"`
+		Type        string    `json:"type"`
+		Func        string    `json:"func"`
+		PkgPath     string    `json:"pkgPath"`
+		Attrs       []Attr    `json:"attrs"`
+		Decoded     *bool     `json:"decoded"`
+	}
+	var response struct {
+		Events []Event `json:"events"`
+	}
+	response.Events = make([]Event, len(events))
+	for i, event := range events {
+		attrs := make([]Attr, len(event.Attrs))
+		for j, attr := range event.Attrs {
+			attrs[j] = Attr{Key: attr.Key, Value: attr.Value}
+		}
+		response.Events[i] = Event{
+			TxHash:      event.Hash,
+			EventIndex:  event.EventIndex,
+			BlockHeight: event.BlockHeight,
+			BlockTime:   event.BlockTime,
+			Type:        event.Type,
+			Func:        event.Func,
+			PkgPath:     event.PkgPath,
+			Attrs:       attrs,
+			Decoded:     event.Decoded,
+		}
+	}
+
+	gCtx.JSON(200, response)
+}
+
+// GetUndecodedEvents counts the events no decoder of the event-processor handled,
+// by package and type, the most frequent first
+func (c *Controller) GetUndecodedEvents(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	var request struct {
+		FromHeight int `form:"from_height"`
+		ToHeight   int `form:"to_height"`
+	}
+	if err := gCtx.ShouldBindQuery(&request); err != nil {
+		c.logger.Errorf("Failed to bind request: %v", err)
+		gCtx.JSON(400, gin.H{"error": "Invalid request"})
+		return
+	}
+	if request.FromHeight < 0 || request.ToHeight < 0 || (request.ToHeight > 0 && request.ToHeight < request.FromHeight) {
+		gCtx.JSON(400, gin.H{"error": "Invalid height range"})
+		return
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	undecoded, err := c.service.GetUndecodedEvents(ctx, request.FromHeight, request.ToHeight, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get undecoded events: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get undecoded events"})
+		return
+	}
+
+	type UndecodedEvents struct {
+		PkgPath    string `json:"pkgPath"`
+		Type       string `json:"type"`
+		Count      int64  `json:"count"`
+		LastHeight int    `json:"lastHeight"`
+	}
+	var response struct {
+		Events []UndecodedEvents `json:"events"`
+	}
+	response.Events = make([]UndecodedEvents, len(undecoded))
+	for i, events := range undecoded {
+		response.Events[i] = UndecodedEvents{
+			PkgPath:    events.PkgPath,
+			Type:       events.Type,
+			Count:      events.Count,
+			LastHeight: events.LastHeight,
+		}
+	}
+
+	gCtx.JSON(200, response)
+}
+
+type tokenResponse struct {
+	Path            string              `json:"path"`
+	Name            string              `json:"name"`
+	Symbol          string              `json:"symbol"`
+	Decimals        int                 `json:"decimals"`
+	Creator         string              `json:"creator"`
+	FirstSeenHeight int                 `json:"firstSeenHeight"`
+	Stats           *tokenStatsResponse `json:"stats,omitempty"`
+}
+
+type tokenStatsResponse struct {
+	Minted          int64  `json:"minted"`
+	Burned          int64  `json:"burned"`
+	Supply          int64  `json:"supply"`
+	SupplyFormatted string `json:"supplyFormatted"`
+	Holders         int    `json:"holders"`
+	TransferCount   int    `json:"transferCount"`
+	LastHeight      int    `json:"lastHeight"`
+}
+
+func newTokenResponse(token model.Token) tokenResponse {
+	response := tokenResponse{
+		Path:            token.Path,
+		Name:            token.Name,
+		Symbol:          token.Symbol,
+		Decimals:        token.Decimals,
+		Creator:         token.Creator,
+		FirstSeenHeight: token.FirstSeenHeight,
+	}
+	if token.Stats != nil {
+		response.Stats = &tokenStatsResponse{
+			Minted:          token.Stats.Minted,
+			Burned:          token.Stats.Burned,
+			Supply:          token.Stats.Supply,
+			SupplyFormatted: formatAmount(token.Stats.Supply, token.Decimals),
+			Holders:         token.Stats.Holders,
+			TransferCount:   token.Stats.TransferCount,
+			LastHeight:      token.Stats.LastHeight,
+		}
+	}
+	return response
+}
+
+// GetTokens lists tokens, by first appearance or sorted with sort=supply or sort=holders
+func (c *Controller) GetTokens(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	sort := gCtx.Query("sort")
+	if sort != "" && sort != repository.TokenSortSupply && sort != repository.TokenSortHolders {
+		gCtx.JSON(400, gin.H{"error": "sort must be supply or holders"})
+		return
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	tokens, err := c.service.GetTokens(ctx, sort, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get tokens: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get tokens"})
+		return
+	}
+
+	var response struct {
+		Tokens []tokenResponse `json:"tokens"`
+	}
+	response.Tokens = make([]tokenResponse, len(tokens))
+	for i, token := range tokens {
+		response.Tokens[i] = newTokenResponse(token)
+	}
+
+	gCtx.JSON(200, response)
+}
+
+func (c *Controller) GetToken(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	path := gCtx.Param("tokenPath")
+	token, err := c.service.GetToken(ctx, path)
+	if err != nil {
+		c.logger.Errorf("Failed to get token %s: %v", path, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get token"})
+		return
+	}
+	if token == nil {
+		gCtx.JSON(404, gin.H{"error": "token not found"})
+		return
+	}
+
+	gCtx.JSON(200, newTokenResponse(*token))
+}
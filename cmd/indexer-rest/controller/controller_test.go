@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestTransferCursor(t *testing.T) {
+	cursor := &model.TransferCursor{BlockHeight: 123456, ID: 789}
+	decoded, err := decodeTransferCursor(encodeTransferCursor(cursor))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *cursor {
+		t.Errorf("decoded cursor = %+v, want %+v", decoded, cursor)
+	}
+
+	for _, value := range []string{
+		"not base64!",
+		"MTIz",    // "123", no separator
+		"YToxMjM", // "a:123"
+		"MTIzOmI", // "123:b"
+	} {
+		if _, err := decodeTransferCursor(value); err == nil {
+			t.Errorf("decodeTransferCursor(%q) succeeded, want an error", value)
+		}
+	}
+}
+
+func TestTransferFunc(t *testing.T) {
+	tests := map[string]string{"": "transfer", "all": "", "mint": "mint", "transfer": "transfer"}
+	for value, want := range tests {
+		if got := transferFunc(value); got != want {
+			t.Errorf("transferFunc(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,503 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gno.land-block-indexer/cmd/indexer-rest/service"
+	"gno.land-block-indexer/lib/log"
+	"gno.land-block-indexer/model"
+)
+
+const (
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+
+	exportTransfers    = "transfers"
+	exportTransactions = "transactions"
+	exportBalances     = "balances"
+	exportHolders      = "holders"
+
+	exportSnapshotHeightHeader = "X-Snapshot-Height"
+	exportFlushRows            = 1000    // Rows written between two flushes of the response
+	maxExportAddressesBytes    = 4 << 20 // Largest body of addresses accepted, about 90k addresses
+)
+
+// exportColumns are the columns of the datasets, in the order of their rows
+var exportColumns = map[string][]string{
+	exportTransfers:    {"txHash", "blockHeight", "blockTime", "func", "fromAddress", "toAddress", "token", "amount", "amountFormatted"},
+	exportTransactions: {"hash", "blockHeight", "index", "success", "gasWanted", "gasUsed", "gasFee", "memo", "messageTypes", "error"},
+	exportBalances:     {"address", "token", "height", "amount", "amountFormatted"},
+	exportHolders:      {"rank", "address", "token", "amount", "amountFormatted", "firstSeenHeight", "lastActiveHeight", "txCount"},
+}
+
+var exportContentTypes = map[string]string{
+	exportFormatCSV:    "text/csv; charset=utf-8",
+	exportFormatNDJSON: "application/x-ndjson",
+}
+
+var errExportHeightNotIndexed = errors.New("height is not indexed yet")
+
+// exportWriter writes the rows of an export as CSV, after a header line, or as
+// NDJSON objects keyed by column. Rows are buffered and flushed in batches, so
+// the memory used does not grow with the export.
+type exportWriter struct {
+	format  string
+	columns []string
+	out     *bufio.Writer
+	csv     *csv.Writer
+	flusher http.Flusher // Flushes the response after each batch, nil for files
+	rows    int
+}
+
+func newExportWriter(w io.Writer, format string, columns []string) (*exportWriter, error) {
+	ew := &exportWriter{format: format, columns: columns, out: bufio.NewWriter(w)}
+	ew.flusher, _ = w.(http.Flusher)
+	switch format {
+	case exportFormatCSV:
+		ew.csv = csv.NewWriter(ew.out)
+		if err := ew.csv.Write(columns); err != nil {
+			return nil, err
+		}
+	case exportFormatNDJSON:
+	default:
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+	return ew, nil
+}
+
+// Write writes a row, with one value per column
+func (w *exportWriter) Write(values ...any) error {
+	if len(values) != len(w.columns) {
+		return fmt.Errorf("export row has %d values for %d columns", len(values), len(w.columns))
+	}
+
+	if w.csv != nil {
+		record := make([]string, len(values))
+		for i, value := range values {
+			record[i] = formatExportValue(value)
+		}
+		if err := w.csv.Write(record); err != nil {
+			return err
+		}
+	} else {
+		// Written by hand to keep the keys in the order of the columns
+		w.out.WriteByte('{')
+		for i, value := range values {
+			if i > 0 {
+				w.out.WriteByte(',')
+			}
+			key, _ := json.Marshal(w.columns[i])
+			if t, ok := value.(time.Time); ok {
+				value = formatExportValue(t)
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			w.out.Write(key)
+			w.out.WriteByte(':')
+			w.out.Write(data)
+		}
+		if _, err := w.out.WriteString("}\n"); err != nil {
+			return err
+		}
+	}
+
+	w.rows++
+	if w.rows%exportFlushRows == 0 {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes the buffered rows
+func (w *exportWriter) Flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if err := w.out.Flush(); err != nil {
+		return err
+	}
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+	return nil
+}
+
+// formatExportValue formats a value for a CSV field, times in RFC3339 UTC and
+// empty when unknown
+func formatExportValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// exportRequest is a dataset to export, with the filters of its rows
+type exportRequest struct {
+	Dataset      string
+	Format       string
+	Transfers    model.TransferFilter
+	Transactions model.TransactionFilter
+	Balances     model.BalanceFilter
+	Token        string // Token of the holders
+}
+
+// runExport writes the rows of a dataset to out, read from a snapshot of the
+// database. begin is called with the height of the snapshot before any row.
+func (c *Controller) runExport(ctx context.Context, request exportRequest, out io.Writer, begin func(height int)) error {
+	return c.service.ExportSnapshot(ctx, func(snapshot service.Service, height int) error {
+		if request.Balances.Height > height {
+			return fmt.Errorf("%w: the snapshot is at height %d", errExportHeightNotIndexed, height)
+		}
+		// The rows hold the connection of the snapshot while they are read, so the
+		// token decimals cannot be looked up along the way
+		decimals, err := exportTokenDecimals(ctx, snapshot)
+		if err != nil {
+			return err
+		}
+
+		begin(height)
+		w, err := newExportWriter(out, request.Format, exportColumns[request.Dataset])
+		if err != nil {
+			return err
+		}
+		switch request.Dataset {
+		case exportTransfers:
+			err = snapshot.ExportTransfers(ctx, request.Transfers, func(t model.Transfer) error {
+				return w.Write(t.Hash, t.BlockHeight, t.BlockTime, t.Func, t.FromAddress, t.ToAddress, t.Token,
+					int64(t.Amount), formatAmount(int64(t.Amount), decimals[t.Token]))
+			})
+		case exportTransactions:
+			err = snapshot.ExportTransactions(ctx, request.Transactions, func(tx model.Transaction) error {
+				response := newTransactionResponse(tx)
+				messageTypes := make([]string, len(tx.Messages))
+				for i, msg := range tx.Messages {
+					messageTypes[i] = msg.TypeUrl
+				}
+				return w.Write(tx.Hash, tx.BlockHeight, tx.Index, tx.Success, tx.GasWanted, tx.GasUsed,
+					response.GasFee, tx.Memo, strings.Join(messageTypes, ";"), tx.Response.Error)
+			})
+		case exportBalances:
+			balanceHeight := request.Balances.Height
+			if balanceHeight == 0 {
+				balanceHeight = height
+			}
+			err = snapshot.ExportBalances(ctx, request.Balances, func(account model.Account) error {
+				return w.Write(account.Address, account.Token, balanceHeight,
+					int64(account.Amount), formatAmount(int64(account.Amount), decimals[account.Token]))
+			})
+		case exportHolders:
+			rank := 0
+			err = snapshot.ExportHolders(ctx, request.Token, func(account model.Account) error {
+				rank++
+				return w.Write(rank, account.Address, account.Token, int64(account.Amount),
+					formatAmount(int64(account.Amount), decimals[account.Token]),
+					account.FirstSeenHeight, account.LastActiveHeight, account.TxCount)
+			})
+		default:
+			err = fmt.Errorf("unknown export dataset %q", request.Dataset)
+		}
+		if err != nil {
+			return err
+		}
+		return w.Flush()
+	})
+}
+
+// exportTokenDecimals returns the decimals of the tokens, by path
+func exportTokenDecimals(ctx context.Context, snapshot service.Service) (map[string]int, error) {
+	const pageSize = 1000
+	decimals := make(map[string]int)
+	for offset := 0; ; offset += pageSize {
+		tokens, err := snapshot.GetTokens(ctx, "", offset, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, token := range tokens {
+			decimals[token.Path] = token.Decimals
+		}
+		if len(tokens) < pageSize {
+			return decimals, nil
+		}
+	}
+}
+
+// serveExport streams an export as the response, named after its dataset and
+// snapshot height. Errors after the first rows are sent can only be logged, the
+// response then ends early.
+func (c *Controller) serveExport(gCtx *gin.Context, request exportRequest) {
+	request.Format = gCtx.DefaultQuery("format", exportFormatCSV)
+	err := c.runExport(gCtx.Request.Context(), request, gCtx.Writer, func(height int) {
+		gCtx.Header("Content-Type", exportContentTypes[request.Format])
+		gCtx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%d.%s"`, request.Dataset, height, request.Format))
+		gCtx.Header(exportSnapshotHeightHeader, strconv.Itoa(height))
+	})
+	if err == nil {
+		return
+	}
+	if gCtx.Writer.Written() {
+		c.logger.Errorf("Failed to export %s, the response is incomplete: %v", request.Dataset, err)
+		gCtx.Error(err)
+		return
+	}
+
+	for _, header := range []string{"Content-Type", "Content-Disposition", exportSnapshotHeightHeader} {
+		gCtx.Writer.Header().Del(header)
+	}
+	if errors.Is(err, errExportHeightNotIndexed) {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	c.logger.Errorf("Failed to export %s: %v", request.Dataset, err)
+	gCtx.JSON(500, gin.H{"error": "Failed to export " + request.Dataset})
+}
+
+// ExportTransfers streams the transfers matching the filters of the transfer history
+func (c *Controller) ExportTransfers(gCtx *gin.Context) {
+	filter, err := parseTransferFilter(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.serveExport(gCtx, exportRequest{Dataset: exportTransfers, Transfers: filter})
+}
+
+// ExportTransactions streams the transactions matching the filters of the transaction list
+func (c *Controller) ExportTransactions(gCtx *gin.Context) {
+	filter, err := parseTransactionFilter(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.serveExport(gCtx, exportRequest{Dataset: exportTransactions, Transactions: filter})
+}
+
+// ExportBalances streams the balances of the addresses, at a height if given.
+// Addresses are repeated in the query, or for POST requests also sent one per
+// line in the body.
+func (c *Controller) ExportBalances(gCtx *gin.Context) {
+	filter := model.BalanceFilter{
+		Addresses: gCtx.QueryArray("address"),
+		Token:     gCtx.Query("token"),
+	}
+	if height := gCtx.Query("height"); height != "" {
+		filter.Height, _ = strconv.Atoi(height) // Checked against the OpenAPI document
+	}
+	if gCtx.Request.Method == http.MethodPost {
+		addresses, err := c.readExportAddresses(gCtx)
+		if err != nil {
+			gCtx.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		filter.Addresses = append(filter.Addresses, addresses...)
+	}
+
+	c.serveExport(gCtx, exportRequest{Dataset: exportBalances, Balances: filter})
+}
+
+// readExportAddresses reads the addresses of a request body, one per line
+func (c *Controller) readExportAddresses(gCtx *gin.Context) ([]string, error) {
+	schema := c.openapi.Components.Schemas["Address"]
+	var addresses []string
+	scanner := bufio.NewScanner(http.MaxBytesReader(gCtx.Writer, gCtx.Request.Body, maxExportAddressesBytes))
+	for line := 1; scanner.Scan(); line++ {
+		address := strings.TrimSpace(scanner.Text())
+		if address == "" {
+			continue
+		}
+		if err := schema.validate(address); err != nil {
+			return nil, fmt.Errorf("invalid address on line %d: %v", line, err)
+		}
+		addresses = append(addresses, address)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the addresses: %v", err)
+	}
+	return addresses, nil
+}
+
+// ExportHolders streams the holders of a token, ranked by decreasing balance
+func (c *Controller) ExportHolders(gCtx *gin.Context) {
+	c.serveExport(gCtx, exportRequest{Dataset: exportHolders, Token: gCtx.Query("token")})
+}
+
+// exportResponseWriter writes the body of a successful export response to out,
+// and keeps the body of the others as the error
+type exportResponseWriter struct {
+	out    io.Writer
+	header http.Header
+	status int
+	error  bytes.Buffer
+}
+
+func (w *exportResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *exportResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *exportResponseWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	if w.status != http.StatusOK {
+		return w.error.Write(data)
+	}
+	return w.out.Write(data)
+}
+
+// RunExport runs the export command, which writes a dataset to the standard
+// output or to a file, taking the query parameters of its endpoint as flags:
+//
+//	indexer-rest export transfers -address g1... -token ugnot -format ndjson -out transfers.ndjson
+//
+// Array parameters are repeated, or read one value per line from a file with
+// -<name>-file. The request is served in-process, so the export is filtered and
+// validated exactly as by the API.
+func RunExport(ctx context.Context, logger log.Logger, args []string) error {
+	doc, err := loadOpenapiDocument(openapiJSON)
+	if err != nil {
+		return fmt.Errorf("failed to load OpenAPI document: %v", err)
+	}
+	var dataset string
+	if len(args) > 0 {
+		dataset = args[0]
+	}
+	if _, ok := exportColumns[dataset]; !ok {
+		return fmt.Errorf("usage: export <transfers|transactions|balances|holders> [-<parameter> value]... [-out file]")
+	}
+	var operation *openapiOperation
+	for _, route := range doc.routes {
+		if route.Method == http.MethodGet && route.Template == "/exports/"+dataset {
+			operation = route.Operation
+		}
+	}
+	if operation == nil {
+		return fmt.Errorf("no endpoint exports %s", dataset)
+	}
+
+	flags := flag.NewFlagSet("export "+dataset, flag.ContinueOnError)
+	query := url.Values{}
+	for _, param := range operation.Parameters {
+		if param.In != "query" {
+			continue
+		}
+		name := param.Name
+		usage := param.Description
+		if usage == "" {
+			usage = fmt.Sprintf("%s query parameter", name)
+		}
+		flags.Func(name, usage, func(value string) error {
+			query.Add(name, value)
+			return nil
+		})
+		if param.Schema.Type == "array" {
+			flags.Func(name+"-file", fmt.Sprintf("file of %s values, one per line", name), func(path string) error {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				for _, value := range strings.Split(string(data), "\n") {
+					if value = strings.TrimSpace(value); value != "" {
+						query.Add(name, value)
+					}
+				}
+				return nil
+			})
+		}
+	}
+	outPath := flags.String("out", "", "file to write, the standard output if empty")
+	if err := flags.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %v", *outPath, err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	gin.SetMode(gin.ReleaseMode)
+	c := &Controller{
+		logger:  logger,
+		service: service.NewService(logger, newRepository(logger), nil),
+		openapi: doc,
+	}
+	var exportErr error // Error of an export cut short, after its status was sent
+	engine := gin.New()
+	engine.Use(func(gCtx *gin.Context) {
+		gCtx.Next()
+		if last := gCtx.Errors.Last(); last != nil {
+			exportErr = last.Err
+		}
+	})
+	if err := registerRoutes(engine, doc, c.operationHandlers()); err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "/exports/"+dataset+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	response := &exportResponseWriter{out: out, header: http.Header{}}
+	engine.ServeHTTP(response, request)
+
+	if response.status != 0 && response.status != http.StatusOK {
+		var body struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(response.error.Bytes(), &body); err != nil || body.Error == "" {
+			body.Error = response.error.String()
+		}
+		return fmt.Errorf("failed to export %s: %s", dataset, body.Error)
+	}
+	if exportErr != nil {
+		return fmt.Errorf("failed to export %s, the output is incomplete: %v", dataset, exportErr)
+	}
+	logger.Infof("Exported %s at snapshot height %s", dataset, response.header.Get(exportSnapshotHeightHeader))
+	return nil
+}
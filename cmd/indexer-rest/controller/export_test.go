@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestExportWriterCSV(t *testing.T) {
+	var out bytes.Buffer
+	w, err := newExportWriter(&out, exportFormatCSV, []string{"hash", "height", "time", "memo"})
+	if err != nil {
+		t.Fatalf("newExportWriter() error = %v", err)
+	}
+	blockTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	if err := w.Write("ABC", 10, blockTime, `say "hi", bye`); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Write("DEF", 11, time.Time{}, ""); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Write("GHI"); err == nil {
+		t.Errorf("Write() with missing values succeeded")
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	want := "hash,height,time,memo\n" +
+		"ABC,10,2024-01-02T02:04:05Z,\"say \"\"hi\"\", bye\"\n" +
+		"DEF,11,,\n"
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestExportWriterNDJSON(t *testing.T) {
+	var out bytes.Buffer
+	w, err := newExportWriter(&out, exportFormatNDJSON, []string{"txHash", "blockHeight", "success", "blockTime", "amount"})
+	if err != nil {
+		t.Fatalf("newExportWriter() error = %v", err)
+	}
+	if err := w.Write("ABC", 10, true, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), int64(1500000)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Write("DEF", 11, false, time.Time{}, int64(-1)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	want := `{"txHash":"ABC","blockHeight":10,"success":true,"blockTime":"2024-01-02T03:04:05Z","amount":1500000}` + "\n" +
+		`{"txHash":"DEF","blockHeight":11,"success":false,"blockTime":"","amount":-1}` + "\n"
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestExportWriterFlushesResponse(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	w, err := newExportWriter(recorder, exportFormatCSV, []string{"n"})
+	if err != nil {
+		t.Fatalf("newExportWriter() error = %v", err)
+	}
+	for i := 0; i < exportFlushRows; i++ {
+		if err := w.Write(i); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if !recorder.Flushed {
+		t.Errorf("response not flushed after %d rows", exportFlushRows)
+	}
+	if lines := strings.Count(recorder.Body.String(), "\n"); lines != exportFlushRows+1 {
+		t.Errorf("response has %d lines, want %d", lines, exportFlushRows+1)
+	}
+}
+
+func TestReadExportAddresses(t *testing.T) {
+	doc, err := loadOpenapiDocument(openapiJSON)
+	if err != nil {
+		t.Fatalf("loadOpenapiDocument() error = %v", err)
+	}
+	c := &Controller{openapi: doc}
+
+	tests := []struct {
+		name    string
+		body    string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "one per line",
+			body: "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5\n\n  g17290cwvmrapvp869xfnhhawa8sm9edpufzat7d\r\n",
+			want: []string{"g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5", "g17290cwvmrapvp869xfnhhawa8sm9edpufzat7d"},
+		},
+		{
+			name:    "invalid address",
+			body:    "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5\ncosmos1abc\n",
+			wantErr: "invalid address on line 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			gCtx.Request = httptest.NewRequest("POST", "/exports/balances", strings.NewReader(tt.body))
+
+			got, err := c.readExportAddresses(gCtx)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Errorf("readExportAddresses() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readExportAddresses() error = %v", err)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("readExportAddresses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
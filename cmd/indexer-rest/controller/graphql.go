@@ -0,0 +1,606 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"gno.land-block-indexer/model"
+	"gno.land-block-indexer/repository"
+)
+
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// GraphQL executes a GraphQL query, sent as a JSON body or in the query, operationName
+// and variables (JSON) query parameters
+func (c *Controller) GraphQL(gCtx *gin.Context) {
+	var request graphqlRequest
+	if gCtx.Request.Method == "POST" {
+		if err := gCtx.ShouldBindJSON(&request); err != nil {
+			gCtx.JSON(400, gin.H{"errors": []gin.H{{"message": "Invalid request body"}}})
+			return
+		}
+	} else {
+		request.Query = gCtx.Query("query")
+		request.OperationName = gCtx.Query("operationName")
+		if variables := gCtx.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				gCtx.JSON(400, gin.H{"errors": []gin.H{{"message": "Invalid variables"}}})
+				return
+			}
+		}
+	}
+	if request.Query == "" {
+		gCtx.JSON(400, gin.H{"errors": []gin.H{{"message": "Query is required"}}})
+		return
+	}
+
+	if err := checkGraphqlLimits(c.graphqlSchema, request.Query, request.Variables); err != nil {
+		gCtx.JSON(400, gin.H{"errors": []gin.H{{"message": err.Error()}}})
+		return
+	}
+
+	ctx := context.WithValue(gCtx.Request.Context(), graphqlLoadersKey{}, newGraphqlLoaders(c.service))
+	result := graphql.Do(graphql.Params{
+		Schema:         c.graphqlSchema,
+		RequestString:  request.Query,
+		OperationName:  request.OperationName,
+		VariableValues: request.Variables,
+		Context:        ctx,
+	})
+	if result.HasErrors() {
+		c.logger.Warnf("GraphQL query returned errors: %v", result.Errors)
+	}
+
+	gCtx.JSON(200, result)
+}
+
+// pointers returns pointers to the values, the sources of the GraphQL resolvers being pointers
+func pointers[T any](values []T) []*T {
+	ptrs := make([]*T, len(values))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	return ptrs
+}
+
+// graphqlPage reads the offset and limit arguments of a paginated field
+func graphqlPage(p graphql.ResolveParams) (int, int, error) {
+	offset, _ := p.Args["offset"].(int)
+	limit, ok := p.Args["limit"].(int)
+	if !ok {
+		limit = defaultPageLimit
+	}
+	if offset < 0 {
+		return 0, 0, fmt.Errorf("invalid offset %d", offset)
+	}
+	if limit <= 0 || limit > maxPageLimit {
+		return 0, 0, fmt.Errorf("invalid limit %d, must be between 1 and %d", limit, maxPageLimit)
+	}
+	return offset, limit, nil
+}
+
+// withToken resolves the token of a path through the loader and maps it with fn
+func withToken(p graphql.ResolveParams, path string, fn func(token *model.Token) (interface{}, error)) interface{} {
+	thunk := loadersFromContext(p.Context).tokens.load(p.Context, path)
+	return func() (interface{}, error) {
+		value, err := thunk()
+		if err != nil {
+			return nil, err
+		}
+		return fn(value.(*model.Token))
+	}
+}
+
+// tokenDecimals returns the decimals of a token, unknown tokens being reported in raw amounts
+func tokenDecimals(token *model.Token) int {
+	if token == nil {
+		return 0
+	}
+	return token.Decimals
+}
+
+var paginationArgs = graphql.FieldConfigArgument{
+	"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+	"limit":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultPageLimit},
+}
+
+// withPagination adds the offset and limit arguments to the arguments of a field
+func withPagination(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	for name, arg := range paginationArgs {
+		args[name] = arg
+	}
+	return args
+}
+
+func newGraphqlSchema(c *Controller) (graphql.Schema, error) {
+	var blockType, transactionType, transferType, tokenType *graphql.Object
+
+	tokenStatsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TokenStats",
+		Fields: graphql.Fields{
+			"minted": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return strconv.FormatInt(p.Source.(*model.TokenStat).Minted, 10), nil
+			}},
+			"burned": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return strconv.FormatInt(p.Source.(*model.TokenStat).Burned, 10), nil
+			}},
+			"supply": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return strconv.FormatInt(p.Source.(*model.TokenStat).Supply, 10), nil
+			}},
+			"holders":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"transferCount": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"lastHeight":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	tokenType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Token",
+		Fields: graphql.Fields{
+			"path":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"name":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"symbol":          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"decimals":        &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"creator":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"firstSeenHeight": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"stats": &graphql.Field{
+				Type:        tokenStatsType,
+				Description: "Supply and holder statistics, only loaded by the token and tokens queries",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*model.Token).Stats, nil
+				},
+			},
+		},
+	})
+
+	eventAttrType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EventAttr",
+		Fields: graphql.Fields{
+			"key":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"value": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	eventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Event",
+		Fields: graphql.Fields{
+			"eventIndex": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"type":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"func":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"pkgPath":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"attrs":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(eventAttrType)))},
+		},
+	})
+
+	messageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Message",
+		Fields: graphql.Fields{
+			"route":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"typeUrl": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"value": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.String),
+				Description: "Decoded value of the message, as JSON",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					value, err := json.Marshal(p.Source.(messageResponse).Value)
+					return string(value), err
+				},
+			},
+		},
+	})
+
+	blockType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"height": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"hash":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"time": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*model.Block).Time.Format(time.RFC3339), nil
+				}},
+				"numTxs":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"totalTxs": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"transactions": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(transactionType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						thunk := loadersFromContext(p.Context).blockTxs.load(p.Context, p.Source.(*model.Block).Height)
+						return func() (interface{}, error) {
+							txs, err := thunk()
+							if err != nil {
+								return nil, err
+							}
+							return pointers(txs.([]model.Transaction)), nil
+						}, nil
+					},
+				},
+			}
+		}),
+	})
+
+	transactionType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			// Scalar fields are served as in the REST responses
+			scalar := func(typ graphql.Output, fn func(tx transactionResponse) interface{}) *graphql.Field {
+				return &graphql.Field{Type: typ, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return fn(newTransactionResponse(*p.Source.(*model.Transaction))), nil
+				}}
+			}
+			return graphql.Fields{
+				"hash":        scalar(graphql.NewNonNull(graphql.String), func(tx transactionResponse) interface{} { return tx.Hash }),
+				"blockHeight": scalar(graphql.NewNonNull(graphql.Int), func(tx transactionResponse) interface{} { return tx.BlockHeight }),
+				"index":       scalar(graphql.NewNonNull(graphql.Int), func(tx transactionResponse) interface{} { return tx.Index }),
+				"success":     scalar(graphql.NewNonNull(graphql.Boolean), func(tx transactionResponse) interface{} { return tx.Success }),
+				"gasWanted":   scalar(graphql.NewNonNull(graphql.Float), func(tx transactionResponse) interface{} { return tx.GasWanted }),
+				"gasUsed":     scalar(graphql.NewNonNull(graphql.Float), func(tx transactionResponse) interface{} { return tx.GasUsed }),
+				"gasFee":      scalar(graphql.NewNonNull(graphql.String), func(tx transactionResponse) interface{} { return tx.GasFee }),
+				"memo":        scalar(graphql.NewNonNull(graphql.String), func(tx transactionResponse) interface{} { return tx.Memo }),
+				"error":       scalar(graphql.NewNonNull(graphql.String), func(tx transactionResponse) interface{} { return tx.Error }),
+				"messages": scalar(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(messageType))), func(tx transactionResponse) interface{} {
+					return tx.Messages
+				}),
+				"events": scalar(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(eventType))), func(tx transactionResponse) interface{} {
+					return tx.Events
+				}),
+				"block": &graphql.Field{
+					Type: blockType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadersFromContext(p.Context).blocks.load(p.Context, p.Source.(*model.Transaction).BlockHeight), nil
+					},
+				},
+				"transfers": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(transferType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						thunk := loadersFromContext(p.Context).txTransfers.load(p.Context, p.Source.(*model.Transaction).Hash)
+						return func() (interface{}, error) {
+							transfers, err := thunk()
+							if err != nil {
+								return nil, err
+							}
+							return pointers(transfers.([]model.Transfer)), nil
+						}, nil
+					},
+				},
+			}
+		}),
+	})
+
+	transferType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transfer",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"txHash": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*model.Transfer).Hash, nil
+				}},
+				"blockHeight": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"blockTime": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*model.Transfer).BlockTime.Format(time.RFC3339), nil
+				}},
+				"func":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"fromAddress": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"toAddress":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"tokenPath": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*model.Transfer).Token, nil
+				}},
+				"token": &graphql.Field{Type: tokenType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return withToken(p, p.Source.(*model.Transfer).Token, func(token *model.Token) (interface{}, error) {
+						return token, nil
+					}), nil
+				}},
+				"amount": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return strconv.FormatInt(int64(p.Source.(*model.Transfer).Amount), 10), nil
+				}},
+				"amountFormatted": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					transfer := p.Source.(*model.Transfer)
+					return withToken(p, transfer.Token, func(token *model.Token) (interface{}, error) {
+						return formatAmount(int64(transfer.Amount), tokenDecimals(token)), nil
+					}), nil
+				}},
+				"transaction": &graphql.Field{Type: transactionType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFromContext(p.Context).transactions.load(p.Context, p.Source.(*model.Transfer).Hash), nil
+				}},
+			}
+		}),
+	})
+
+	transferPageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TransferPage",
+		Fields: graphql.Fields{
+			"transfers": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(transferType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return pointers(p.Source.(*graphqlTransferPage).transfers), nil
+				},
+			},
+			"nextCursor": &graphql.Field{
+				Type:        graphql.String,
+				Description: "Cursor of the next page, null on the last page",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if next := p.Source.(*graphqlTransferPage).next; next != nil {
+						return encodeTransferCursor(next), nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	transferArgs := graphql.FieldConfigArgument{
+		"direction":  &graphql.ArgumentConfig{Type: graphql.String, Description: "in, out or both (default)"},
+		"token":      &graphql.ArgumentConfig{Type: graphql.String},
+		"func":       &graphql.ArgumentConfig{Type: graphql.String, Description: "transfer (default), mint, burn, fee, genesis or all"},
+		"fromHeight": &graphql.ArgumentConfig{Type: graphql.Int},
+		"toHeight":   &graphql.ArgumentConfig{Type: graphql.Int},
+		"sort":       &graphql.ArgumentConfig{Type: graphql.String, Description: "asc (default) or desc"},
+		"after":      &graphql.ArgumentConfig{Type: graphql.String, Description: "nextCursor of the previous page"},
+		"limit":      &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultPageLimit},
+	}
+	resolveTransfers := func(p graphql.ResolveParams, address string) (interface{}, error) {
+		filter := model.TransferFilter{Address: address}
+		filter.Direction, _ = p.Args["direction"].(string)
+		filter.Token, _ = p.Args["token"].(string)
+		function, _ := p.Args["func"].(string)
+		filter.Func = transferFunc(function)
+		filter.FromHeight, _ = p.Args["fromHeight"].(int)
+		filter.ToHeight, _ = p.Args["toHeight"].(int)
+		sort, _ := p.Args["sort"].(string)
+		filter.Ascending = sort != "desc"
+
+		switch filter.Direction {
+		case "", repository.TransferDirectionBoth, repository.TransferDirectionIn, repository.TransferDirectionOut:
+		default:
+			return nil, fmt.Errorf("direction must be in, out or both")
+		}
+		_, limit, err := graphqlPage(p)
+		if err != nil {
+			return nil, err
+		}
+		var after *model.TransferCursor
+		if cursor, _ := p.Args["after"].(string); cursor != "" {
+			if after, err = decodeTransferCursor(cursor); err != nil {
+				return nil, fmt.Errorf("invalid cursor")
+			}
+		}
+
+		transfers, next, err := c.service.GetTransferHistory(p.Context, filter, after, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transfers")
+		}
+		return &graphqlTransferPage{transfers: transfers, next: next}, nil
+	}
+
+	balanceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Balance",
+		Fields: graphql.Fields{
+			"tokenPath": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*model.TokenBalance).Token, nil
+			}},
+			"token": &graphql.Field{Type: tokenType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return withToken(p, p.Source.(*model.TokenBalance).Token, func(token *model.Token) (interface{}, error) {
+					return token, nil
+				}), nil
+			}},
+			"amount": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return strconv.FormatInt(int64(p.Source.(*model.TokenBalance).Amount), 10), nil
+			}},
+			"amountFormatted": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				balance := p.Source.(*model.TokenBalance)
+				return withToken(p, balance.Token, func(token *model.Token) (interface{}, error) {
+					return formatAmount(int64(balance.Amount), tokenDecimals(token)), nil
+				}), nil
+			}},
+		},
+	})
+
+	accountTransactionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AccountTransaction",
+		Fields: graphql.Fields{
+			"roles": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*model.AddressTransaction).Roles, nil
+			}},
+			"transaction": &graphql.Field{Type: transactionType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return loadersFromContext(p.Context).transactions.load(p.Context, p.Source.(*model.AddressTransaction).Hash), nil
+			}},
+		},
+	})
+
+	accountType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Account",
+		Fields: graphql.Fields{
+			"address": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(string), nil
+			}},
+			"balances": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(balanceType))),
+				Args: graphql.FieldConfigArgument{
+					"height": &graphql.ArgumentConfig{Type: graphql.Int, Description: "Height of the balances, the latest if omitted"},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					address := p.Source.(string)
+					var balances []model.TokenBalance
+					var err error
+					if height, ok := p.Args["height"].(int); ok {
+						balances, err = c.service.GetBalancesAtHeight(p.Context, address, height)
+					} else {
+						balances, err = c.service.GetTokenBalances(p.Context, address)
+					}
+					if err != nil {
+						return nil, fmt.Errorf("failed to get balances")
+					}
+					return pointers(balances), nil
+				},
+			},
+			"transactions": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(accountTransactionType))),
+				Args: withPagination(graphql.FieldConfigArgument{
+					"roles": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					offset, limit, err := graphqlPage(p)
+					if err != nil {
+						return nil, err
+					}
+					var roles []string
+					if values, ok := p.Args["roles"].([]interface{}); ok {
+						for _, value := range values {
+							roles = append(roles, value.(string))
+						}
+					}
+					txs, err := c.service.GetAccountTransactions(p.Context, p.Source.(string), roles, offset, limit)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get transactions")
+					}
+					return pointers(txs), nil
+				},
+			},
+			"transfers": &graphql.Field{
+				Type: graphql.NewNonNull(transferPageType),
+				Args: transferArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return resolveTransfers(p, p.Source.(string))
+				},
+			},
+		},
+	})
+
+	queryTransferArgs := graphql.FieldConfigArgument{
+		"address": &graphql.ArgumentConfig{Type: graphql.String},
+	}
+	for name, arg := range transferArgs {
+		queryTransferArgs[name] = arg
+	}
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"block": &graphql.Field{
+				Type: blockType,
+				Args: graphql.FieldConfigArgument{
+					"height": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFromContext(p.Context).blocks.load(p.Context, p.Args["height"].(int)), nil
+				},
+			},
+			"blocks": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(blockType))),
+				Args: withPagination(graphql.FieldConfigArgument{}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					offset, limit, err := graphqlPage(p)
+					if err != nil {
+						return nil, err
+					}
+					blocks, err := c.service.GetBlocks(p.Context, offset, limit)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get blocks")
+					}
+					return pointers(blocks), nil
+				},
+			},
+			"transaction": &graphql.Field{
+				Type: transactionType,
+				Args: graphql.FieldConfigArgument{
+					"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFromContext(p.Context).transactions.load(p.Context, p.Args["hash"].(string)), nil
+				},
+			},
+			"transactions": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(transactionType))),
+				Args: withPagination(graphql.FieldConfigArgument{
+					"success":     &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"messageType": &graphql.ArgumentConfig{Type: graphql.String},
+					"fromHeight":  &graphql.ArgumentConfig{Type: graphql.Int},
+					"toHeight":    &graphql.ArgumentConfig{Type: graphql.Int},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					offset, limit, err := graphqlPage(p)
+					if err != nil {
+						return nil, err
+					}
+					var filter model.TransactionFilter
+					if success, ok := p.Args["success"].(bool); ok {
+						filter.Success = &success
+					}
+					filter.MessageType, _ = p.Args["messageType"].(string)
+					filter.FromHeight, _ = p.Args["fromHeight"].(int)
+					filter.ToHeight, _ = p.Args["toHeight"].(int)
+
+					txs, err := c.service.GetTransactions(p.Context, filter, offset, limit)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get transactions")
+					}
+					return pointers(txs), nil
+				},
+			},
+			"transfers": &graphql.Field{
+				Type: graphql.NewNonNull(transferPageType),
+				Args: queryTransferArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					address, _ := p.Args["address"].(string)
+					return resolveTransfers(p, address)
+				},
+			},
+			"account": &graphql.Field{
+				Type: graphql.NewNonNull(accountType),
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return strings.TrimSpace(p.Args["address"].(string)), nil
+				},
+			},
+			"token": &graphql.Field{
+				Type: tokenType,
+				Args: graphql.FieldConfigArgument{
+					"path": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, err := c.service.GetToken(p.Context, p.Args["path"].(string))
+					if err != nil {
+						return nil, fmt.Errorf("failed to get token")
+					}
+					return token, nil
+				},
+			},
+			"tokens": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tokenType))),
+				Args: withPagination(graphql.FieldConfigArgument{
+					"sort": &graphql.ArgumentConfig{Type: graphql.String, Description: "supply or holders, by first appearance if omitted"},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					offset, limit, err := graphqlPage(p)
+					if err != nil {
+						return nil, err
+					}
+					sort, _ := p.Args["sort"].(string)
+					if sort != "" && sort != repository.TokenSortSupply && sort != repository.TokenSortHolders {
+						return nil, fmt.Errorf("sort must be supply or holders")
+					}
+					tokens, err := c.service.GetTokens(p.Context, sort, offset, limit)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get tokens")
+					}
+					return pointers(tokens), nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// graphqlTransferPage is a page of transfers with the cursor of the next one
+type graphqlTransferPage struct {
+	transfers []model.Transfer
+	next      *model.TransferCursor
+}
@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+const (
+	graphqlMaxDepth      = 8     // Deepest field nesting accepted in a query
+	graphqlMaxComplexity = 10000 // Largest estimated number of resolved fields accepted in a query
+	graphqlListCost      = 10    // Estimated length of lists without a limit argument
+)
+
+// checkGraphqlLimits rejects queries nested deeper than graphqlMaxDepth or whose
+// complexity exceeds graphqlMaxComplexity. Every field costs one plus the cost of
+// its selection, which is multiplied for lists by their limit argument.
+func checkGraphqlLimits(schema graphql.Schema, query string, variables map[string]any) error {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return err
+	}
+
+	checker := &graphqlLimitChecker{
+		schema:    schema,
+		variables: variables,
+		fragments: make(map[string]*ast.FragmentDefinition),
+	}
+	for _, definition := range doc.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			checker.fragments[fragment.Name.Value] = fragment
+		}
+	}
+	for _, definition := range doc.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok || operation.Operation != ast.OperationTypeQuery {
+			continue
+		}
+		complexity, err := checker.selectionCost(schema.QueryType(), operation.SelectionSet, 1, map[string]bool{})
+		if err != nil {
+			return err
+		}
+		if complexity > graphqlMaxComplexity {
+			return fmt.Errorf("query complexity %d exceeds the maximum of %d", complexity, graphqlMaxComplexity)
+		}
+	}
+	return nil
+}
+
+type graphqlLimitChecker struct {
+	schema    graphql.Schema
+	variables map[string]any
+	fragments map[string]*ast.FragmentDefinition
+}
+
+// selectionCost returns the cost of the selection of an object at the depth, spreading
+// fragments once per path so cycles, rejected later by validation, terminate
+func (c *graphqlLimitChecker) selectionCost(parent *graphql.Object, selectionSet *ast.SelectionSet, depth int, spread map[string]bool) (int, error) {
+	if selectionSet == nil || parent == nil {
+		return 0, nil
+	}
+	if depth > graphqlMaxDepth {
+		return 0, fmt.Errorf("query depth exceeds the maximum of %d", graphqlMaxDepth)
+	}
+
+	cost := 0
+	for _, selection := range selectionSet.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			fieldCost, err := c.fieldCost(parent, selection, depth, spread)
+			if err != nil {
+				return 0, err
+			}
+			cost += fieldCost
+		case *ast.InlineFragment:
+			fragmentCost, err := c.selectionCost(c.conditionType(parent, selection.TypeCondition), selection.SelectionSet, depth, spread)
+			if err != nil {
+				return 0, err
+			}
+			cost += fragmentCost
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := c.fragments[name]
+			if !ok || spread[name] {
+				continue
+			}
+			spread[name] = true
+			fragmentCost, err := c.selectionCost(c.conditionType(parent, fragment.TypeCondition), fragment.SelectionSet, depth, spread)
+			delete(spread, name)
+			if err != nil {
+				return 0, err
+			}
+			cost += fragmentCost
+		}
+	}
+	return cost, nil
+}
+
+func (c *graphqlLimitChecker) fieldCost(parent *graphql.Object, field *ast.Field, depth int, spread map[string]bool) (int, error) {
+	definition, ok := parent.Fields()[field.Name.Value]
+	if !ok {
+		// Unknown and introspection fields are left to validation
+		return 1, nil
+	}
+
+	fieldType := definition.Type
+	if nonNull, ok := fieldType.(*graphql.NonNull); ok {
+		fieldType = nonNull.OfType
+	}
+	isList := false
+	if list, ok := fieldType.(*graphql.List); ok {
+		isList = true
+		fieldType = list.OfType
+		if nonNull, ok := fieldType.(*graphql.NonNull); ok {
+			fieldType = nonNull.OfType
+		}
+	}
+
+	// Paginated fields, lists or pages like TransferPage, hold up to limit items
+	multiplier := 1
+	if limit, ok := c.intArgument(field, "limit"); ok {
+		multiplier = limit
+	} else if hasArgument(definition, "limit") {
+		multiplier = defaultPageLimit
+	} else if isList {
+		multiplier = graphqlListCost
+	}
+	multiplier = max(1, min(multiplier, maxPageLimit))
+
+	object, _ := fieldType.(*graphql.Object)
+	childCost, err := c.selectionCost(object, field.SelectionSet, depth+1, spread)
+	if err != nil {
+		return 0, err
+	}
+	return 1 + multiplier*childCost, nil
+}
+
+// conditionType returns the object type of a fragment condition, the parent if none
+func (c *graphqlLimitChecker) conditionType(parent *graphql.Object, condition *ast.Named) *graphql.Object {
+	if condition == nil {
+		return parent
+	}
+	object, _ := c.schema.Type(condition.Name.Value).(*graphql.Object)
+	return object
+}
+
+// intArgument returns the value of an integer argument given as a literal or variable
+func (c *graphqlLimitChecker) intArgument(field *ast.Field, name string) (int, bool) {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != name {
+			continue
+		}
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			n, err := strconv.Atoi(value.Value)
+			return n, err == nil
+		case *ast.Variable:
+			switch n := c.variables[value.Name.Value].(type) {
+			case float64:
+				return int(n), true
+			case int:
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func hasArgument(definition *graphql.FieldDefinition, name string) bool {
+	for _, arg := range definition.Args {
+		if arg.Name() == name {
+			return true
+		}
+	}
+	return false
+}
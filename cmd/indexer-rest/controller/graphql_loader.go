@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"gno.land-block-indexer/cmd/indexer-rest/service"
+	"gno.land-block-indexer/model"
+)
+
+// loader batches the keys requested by the resolvers of a query. Resolvers return
+// the thunk of load, which graphql-go only calls once every field at the same depth
+// is resolved, so the first thunk fetches the keys of all its siblings at once.
+type loader[K comparable, V any] struct {
+	mu      sync.Mutex
+	fetch   func(ctx context.Context, keys []K) (map[K]V, error)
+	pending []K
+	results map[K]V
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:   fetch,
+		results: make(map[K]V),
+	}
+}
+
+// load queues the key and returns a thunk resolving to its value, the zero value if missing
+func (l *loader[K, V]) load(ctx context.Context, key K) func() (interface{}, error) {
+	l.mu.Lock()
+	if _, ok := l.results[key]; !ok {
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if value, ok := l.results[key]; ok {
+			return value, nil
+		}
+		if len(l.pending) > 0 {
+			keys := l.pending
+			l.pending = nil
+			values, err := l.fetch(ctx, keys)
+			if err != nil {
+				return nil, err
+			}
+			for _, k := range keys {
+				// Missing keys are cached too, so they are not fetched again
+				l.results[k] = values[k]
+			}
+		}
+		return l.results[key], nil
+	}
+}
+
+// graphqlLoaders holds the loaders of one GraphQL request
+type graphqlLoaders struct {
+	blocks       *loader[int, *model.Block]
+	blockTxs     *loader[int, []model.Transaction]
+	transactions *loader[string, *model.Transaction]
+	txTransfers  *loader[string, []model.Transfer]
+	tokens       *loader[string, *model.Token]
+}
+
+type graphqlLoadersKey struct{}
+
+func newGraphqlLoaders(svc service.Service) *graphqlLoaders {
+	return &graphqlLoaders{
+		blocks: newLoader(func(ctx context.Context, heights []int) (map[int]*model.Block, error) {
+			blocks, err := svc.GetBlocksByHeights(ctx, heights)
+			if err != nil {
+				return nil, err
+			}
+			values := make(map[int]*model.Block, len(blocks))
+			for i := range blocks {
+				values[blocks[i].Height] = &blocks[i]
+			}
+			return values, nil
+		}),
+		blockTxs: newLoader(func(ctx context.Context, heights []int) (map[int][]model.Transaction, error) {
+			txs, err := svc.GetTransactionsOfBlocks(ctx, heights)
+			if err != nil {
+				return nil, err
+			}
+			values := make(map[int][]model.Transaction, len(heights))
+			for _, tx := range txs {
+				values[tx.BlockHeight] = append(values[tx.BlockHeight], tx)
+			}
+			return values, nil
+		}),
+		transactions: newLoader(func(ctx context.Context, hashes []string) (map[string]*model.Transaction, error) {
+			txs, err := svc.GetTransactionsByHashes(ctx, hashes)
+			if err != nil {
+				return nil, err
+			}
+			values := make(map[string]*model.Transaction, len(txs))
+			for i := range txs {
+				values[txs[i].Hash] = &txs[i]
+			}
+			return values, nil
+		}),
+		txTransfers: newLoader(func(ctx context.Context, hashes []string) (map[string][]model.Transfer, error) {
+			transfers, err := svc.GetTransfersOfTransactions(ctx, hashes)
+			if err != nil {
+				return nil, err
+			}
+			values := make(map[string][]model.Transfer, len(hashes))
+			for _, transfer := range transfers {
+				values[transfer.Hash] = append(values[transfer.Hash], transfer)
+			}
+			return values, nil
+		}),
+		tokens: newLoader(func(ctx context.Context, paths []string) (map[string]*model.Token, error) {
+			tokens, err := svc.GetTokenMetadata(ctx, paths)
+			if err != nil {
+				return nil, err
+			}
+			values := make(map[string]*model.Token, len(tokens))
+			for path, token := range tokens {
+				values[path] = &token
+			}
+			return values, nil
+		}),
+	}
+}
+
+func loadersFromContext(ctx context.Context) *graphqlLoaders {
+	return ctx.Value(graphqlLoadersKey{}).(*graphqlLoaders)
+}
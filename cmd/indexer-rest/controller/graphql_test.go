@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"gno.land-block-indexer/cmd/indexer-rest/service"
+	"gno.land-block-indexer/model"
+)
+
+// graphqlTestService serves two blocks of two transactions, each with one transfer,
+// and counts the batch calls
+type graphqlTestService struct {
+	service.Service
+	calls map[string]int
+}
+
+func (s *graphqlTestService) GetBlocks(ctx context.Context, offset int, limit int) ([]model.Block, error) {
+	s.calls["GetBlocks"]++
+	return []model.Block{{Height: 2, NumTxs: 2}, {Height: 1, NumTxs: 2}}, nil
+}
+
+func (s *graphqlTestService) GetTransactionsOfBlocks(ctx context.Context, heights []int) ([]model.Transaction, error) {
+	s.calls["GetTransactionsOfBlocks"]++
+	var txs []model.Transaction
+	for _, height := range heights {
+		for i := 0; i < 2; i++ {
+			txs = append(txs, model.Transaction{Hash: strings.Repeat("h", height) + string(rune('a'+i)), BlockHeight: height, Index: i, Success: true})
+		}
+	}
+	return txs, nil
+}
+
+func (s *graphqlTestService) GetTransfersOfTransactions(ctx context.Context, hashes []string) ([]model.Transfer, error) {
+	s.calls["GetTransfersOfTransactions"]++
+	transfers := make([]model.Transfer, len(hashes))
+	for i, hash := range hashes {
+		transfers[i] = model.Transfer{Hash: hash, Token: "ugnot", Amount: 1500000}
+	}
+	return transfers, nil
+}
+
+func (s *graphqlTestService) GetTokenMetadata(ctx context.Context, paths []string) (map[string]model.Token, error) {
+	s.calls["GetTokenMetadata"]++
+	return map[string]model.Token{"ugnot": {Path: "ugnot", Decimals: 6}}, nil
+}
+
+func TestGraphqlBatchesNestedFields(t *testing.T) {
+	svc := &graphqlTestService{calls: make(map[string]int)}
+	c := &Controller{service: svc}
+	schema, err := newGraphqlSchema(c)
+	if err != nil {
+		t.Fatalf("newGraphqlSchema() error = %v", err)
+	}
+
+	query := `{ blocks { height transactions { hash transfers { amountFormatted } } } }`
+	if err := checkGraphqlLimits(schema, query, nil); err != nil {
+		t.Fatalf("checkGraphqlLimits() error = %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+		Context:       context.WithValue(context.Background(), graphqlLoadersKey{}, newGraphqlLoaders(svc)),
+	})
+	if result.HasErrors() {
+		t.Fatalf("graphql.Do() errors = %v", result.Errors)
+	}
+
+	blocks := result.Data.(map[string]interface{})["blocks"].([]interface{})
+	if len(blocks) != 2 {
+		t.Fatalf("got %d blocks, want 2", len(blocks))
+	}
+	txs := blocks[0].(map[string]interface{})["transactions"].([]interface{})
+	if len(txs) != 2 {
+		t.Fatalf("got %d transactions in block 2, want 2", len(txs))
+	}
+	transfers := txs[1].(map[string]interface{})["transfers"].([]interface{})
+	if len(transfers) != 1 || transfers[0].(map[string]interface{})["amountFormatted"] != "1.5" {
+		t.Errorf("got transfers %v, want one of 1.5", transfers)
+	}
+	for name, want := range map[string]int{"GetBlocks": 1, "GetTransactionsOfBlocks": 1, "GetTransfersOfTransactions": 1, "GetTokenMetadata": 1} {
+		if got := svc.calls[name]; got != want {
+			t.Errorf("%s called %d times, want %d", name, got, want)
+		}
+	}
+}
+
+func TestCheckGraphqlLimits(t *testing.T) {
+	schema, err := newGraphqlSchema(&Controller{})
+	if err != nil {
+		t.Fatalf("newGraphqlSchema() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		wantErr string
+	}{
+		{
+			name:  "address page",
+			query: `{ account(address: "g1") { balances { amountFormatted token { symbol } } transfers(limit: 50) { transfers { amount } nextCursor } } }`,
+		},
+		{
+			name:    "too deep",
+			query:   `{ transaction(hash: "h") { block { transactions { block { transactions { block { transactions { block { hash } } } } } } } } }`,
+			wantErr: "depth",
+		},
+		{
+			name:    "too deep through a fragment",
+			query:   `{ transaction(hash: "h") { ...tx } } fragment tx on Transaction { block { transactions { block { transactions { block { transactions { block { hash } } } } } } } }`,
+			wantErr: "depth",
+		},
+		{
+			name:    "too complex",
+			query:   `{ blocks(limit: 100) { transactions { transfers { transaction { events { attrs { key } } } } } } }`,
+			wantErr: "complexity",
+		},
+		{
+			name:    "too complex through variables",
+			query:   `query($n: Int) { tokens(limit: $n) { path } transactions(limit: $n) { events { attrs { key value } } messages { value } } }`,
+			wantErr: "complexity",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkGraphqlLimits(schema, tt.query, map[string]any{"n": float64(100)})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkGraphqlLimits() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkGraphqlLimits() error = %v, want %s error", err, tt.wantErr)
+			}
+		})
+	}
+}
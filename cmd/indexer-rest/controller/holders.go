@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gno.land-block-indexer/model"
+)
+
+type tokenHolderResponse struct {
+	Rank             int     `json:"rank"`
+	Address          string  `json:"address"`
+	Amount           int64   `json:"amount"`
+	AmountFormatted  string  `json:"amountFormatted"`
+	Percentage       float64 `json:"percentage"`
+	FirstSeenHeight  int     `json:"firstSeenHeight"`
+	LastActiveHeight int     `json:"lastActiveHeight"`
+	TxCount          int     `json:"txCount"`
+}
+
+type holderBucketResponse struct {
+	Min             int64   `json:"min"`
+	MinFormatted    string  `json:"minFormatted"`
+	Max             *int64  `json:"max"` // nil for the bucket of the largest int64 balances
+	MaxFormatted    string  `json:"maxFormatted,omitempty"`
+	Holders         int     `json:"holders"`
+	Amount          int64   `json:"amount"`
+	AmountFormatted string  `json:"amountFormatted"`
+	Percentage      float64 `json:"percentage"`
+}
+
+type tokenDistributionResponse struct {
+	TokenPath        string                 `json:"tokenPath"`
+	Holders          int                    `json:"holders"`
+	Supply           int64                  `json:"supply"`
+	SupplyFormatted  string                 `json:"supplyFormatted"`
+	Gini             float64                `json:"gini"`
+	Top10Percentage  float64                `json:"top10Percentage"`
+	Top100Percentage float64                `json:"top100Percentage"`
+	Buckets          []holderBucketResponse `json:"buckets"`
+}
+
+// supplyPercentage returns the share of the supply in an amount, 0 without supply
+func supplyPercentage(amount int64, supply int64) float64 {
+	if supply <= 0 {
+		return 0
+	}
+	return float64(amount) * 100 / float64(supply)
+}
+
+func newTokenDistributionResponse(distribution model.TokenDistribution, decimals int) tokenDistributionResponse {
+	buckets := make([]holderBucketResponse, len(distribution.Buckets))
+	for i, bucket := range distribution.Buckets {
+		buckets[i] = holderBucketResponse{
+			Min:             bucket.Min,
+			MinFormatted:    formatAmount(bucket.Min, decimals),
+			Holders:         bucket.Holders,
+			Amount:          bucket.Amount,
+			AmountFormatted: formatAmount(bucket.Amount, decimals),
+			Percentage:      supplyPercentage(bucket.Amount, distribution.Supply),
+		}
+		if bucket.Max > 0 {
+			bucketMax := bucket.Max
+			buckets[i].Max = &bucketMax
+			buckets[i].MaxFormatted = formatAmount(bucketMax, decimals)
+		}
+	}
+
+	return tokenDistributionResponse{
+		TokenPath:        distribution.Token,
+		Holders:          distribution.Holders,
+		Supply:           distribution.Supply,
+		SupplyFormatted:  formatAmount(distribution.Supply, decimals),
+		Gini:             distribution.Gini,
+		Top10Percentage:  supplyPercentage(distribution.Top10Amount, distribution.Supply),
+		Top100Percentage: supplyPercentage(distribution.Top100Amount, distribution.Supply),
+		Buckets:          buckets,
+	}
+}
+
+// GetTokenHolders lists the holders of a token by decreasing balance, with their
+// rank and percentage of the supply. min_balance is a raw amount, like the amounts.
+func (c *Controller) GetTokenHolders(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	tokenPath := gCtx.Param("tokenPath")
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	var minBalance float64
+	if value := gCtx.Query("min_balance"); value != "" {
+		if minBalance, err = strconv.ParseFloat(value, 64); err != nil || minBalance < 0 {
+			gCtx.JSON(400, gin.H{"error": "Invalid min_balance"})
+			return
+		}
+	}
+
+	token, err := c.service.GetToken(ctx, tokenPath)
+	if err != nil {
+		c.logger.Errorf("Failed to get token %s: %v", tokenPath, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get token holders"})
+		return
+	}
+	if token == nil {
+		gCtx.JSON(404, gin.H{"error": "token not found"})
+		return
+	}
+	holders, err := c.service.GetTokenHolders(ctx, tokenPath, minBalance, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get holders of token %s: %v", tokenPath, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get token holders"})
+		return
+	}
+
+	// The statistics count the holders and the supply, minted minus burned, the same
+	// supply the distribution shares are relative to
+	stats := token.Stats
+	if stats == nil {
+		stats = &model.TokenStat{Token: tokenPath}
+	}
+	response := struct {
+		TokenPath       string                `json:"tokenPath"`
+		HolderCount     int                   `json:"holderCount"`
+		Supply          int64                 `json:"supply"`
+		SupplyFormatted string                `json:"supplyFormatted"`
+		Holders         []tokenHolderResponse `json:"holders"`
+	}{
+		TokenPath:       tokenPath,
+		HolderCount:     stats.Holders,
+		Supply:          stats.Supply,
+		SupplyFormatted: formatAmount(stats.Supply, token.Decimals),
+		Holders:         make([]tokenHolderResponse, len(holders)),
+	}
+	for i, holder := range holders {
+		response.Holders[i] = tokenHolderResponse{
+			Rank:             holder.Rank,
+			Address:          holder.Address,
+			Amount:           int64(holder.Amount),
+			AmountFormatted:  formatAmount(int64(holder.Amount), token.Decimals),
+			Percentage:       supplyPercentage(int64(holder.Amount), stats.Supply),
+			FirstSeenHeight:  holder.FirstSeenHeight,
+			LastActiveHeight: holder.LastActiveHeight,
+			TxCount:          holder.TxCount,
+		}
+	}
+
+	gCtx.JSON(200, response)
+}
+
+// GetTokenDistribution summarizes how a token is spread across its holders: the
+// Gini coefficient of the balances, the share of the 10 and 100 largest holders
+// and the holders by order of magnitude of their balance
+func (c *Controller) GetTokenDistribution(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	tokenPath := gCtx.Param("tokenPath")
+	token, err := c.service.GetToken(ctx, tokenPath)
+	if err != nil {
+		c.logger.Errorf("Failed to get token %s: %v", tokenPath, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get token distribution"})
+		return
+	}
+	if token == nil {
+		gCtx.JSON(404, gin.H{"error": "token not found"})
+		return
+	}
+	distribution, err := c.service.GetTokenDistribution(ctx, tokenPath)
+	if err != nil {
+		c.logger.Errorf("Failed to get distribution of token %s: %v", tokenPath, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get token distribution"})
+		return
+	}
+
+	gCtx.JSON(200, newTokenDistributionResponse(*distribution, token.Decimals))
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestNewTokenDistributionResponse(t *testing.T) {
+	response := newTokenDistributionResponse(model.TokenDistribution{
+		Token:        "ugnot",
+		Holders:      3,
+		Supply:       4_000_000,
+		Gini:         0.5,
+		Top10Amount:  4_000_000,
+		Top100Amount: 4_000_000,
+		Buckets: []model.HolderBucket{
+			{Min: 100_000, Max: 1_000_000, Holders: 1, Amount: 500_000},
+			{Min: 1_000_000, Max: 10_000_000, Holders: 1, Amount: 3_500_000},
+			{Min: 1_000_000_000_000_000_000, Holders: 0},
+		},
+	}, 6)
+
+	if response.SupplyFormatted != "4" || response.Top10Percentage != 100 {
+		t.Errorf("supplyFormatted = %q, top10Percentage = %v, want \"4\", 100", response.SupplyFormatted, response.Top10Percentage)
+	}
+	tests := []struct {
+		minFormatted string
+		max          int64 // 0 for no maximum
+		maxFormatted string
+		percentage   float64
+	}{
+		{"0.1", 1_000_000, "1", 12.5},
+		{"1", 10_000_000, "10", 87.5},
+		{"1000000000000", 0, "", 0},
+	}
+	for i, tt := range tests {
+		bucket := response.Buckets[i]
+		if bucket.MinFormatted != tt.minFormatted || bucket.MaxFormatted != tt.maxFormatted || bucket.Percentage != tt.percentage {
+			t.Errorf("bucket %d = %+v, want min %s, max %s, percentage %v", i, bucket, tt.minFormatted, tt.maxFormatted, tt.percentage)
+		}
+		if (bucket.Max == nil) != (tt.max == 0) || (bucket.Max != nil && *bucket.Max != tt.max) {
+			t.Errorf("bucket %d max = %v, want %d", i, bucket.Max, tt.max)
+		}
+	}
+}
+
+func TestSupplyPercentageWithoutSupply(t *testing.T) {
+	if got := supplyPercentage(10, 0); got != 0 {
+		t.Errorf("supplyPercentage(10, 0) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,426 @@
+package controller
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// openapiJSON is the OpenAPI document of the API, from which the routes are registered
+//
+//go:embed openapi.json
+var openapiJSON []byte
+
+// openapiDocument is the part of an OpenAPI 3 document used for routing and validation
+type openapiDocument struct {
+	Paths      map[string]map[string]*openapiOperation `json:"paths"`
+	Components struct {
+		Parameters map[string]*openapiParameter `json:"parameters"`
+		Schemas    map[string]*openapiSchema    `json:"schemas"`
+	} `json:"components"`
+
+	routes []*openapiRoute
+}
+
+type openapiOperation struct {
+	OperationID string              `json:"operationId"`
+	Parameters  []*openapiParameter `json:"parameters"`
+}
+
+type openapiParameter struct {
+	Ref         string         `json:"$ref"`
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Required    bool           `json:"required"`
+	Description string         `json:"description"`
+	Slashes     bool           `json:"x-slashes"` // Path parameter spanning several segments, like a token path
+	Example     string         `json:"example"`
+	Schema      *openapiSchema `json:"schema"`
+}
+
+type openapiSchema struct {
+	Ref       string         `json:"$ref"`
+	Type      string         `json:"type"`
+	Format    string         `json:"format"`
+	Pattern   string         `json:"pattern"`
+	Enum      []string       `json:"enum"`
+	Minimum   *float64       `json:"minimum"`
+	Maximum   *float64       `json:"maximum"`
+	MaxLength *int           `json:"maxLength"`
+	Items     *openapiSchema `json:"items"`
+
+	pattern *regexp.Regexp
+}
+
+// openapiRoute is an operation of the document with the pattern of its path
+type openapiRoute struct {
+	Method    string
+	Template  string
+	Operation *openapiOperation
+
+	pattern *regexp.Regexp // Matches the request paths, capturing the path parameters
+	params  []string       // Names of the captured path parameters
+	literal int            // Length of the template without its parameters
+}
+
+var openapiTemplateParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// loadOpenapiDocument parses a document, resolving the references of its parameters
+// and compiling the patterns of its schemas
+func loadOpenapiDocument(data []byte) (*openapiDocument, error) {
+	var doc openapiDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	for name, schema := range doc.Components.Schemas {
+		if _, err := doc.resolveSchema(schema); err != nil {
+			return nil, fmt.Errorf("schema %s: %v", name, err)
+		}
+	}
+	for template, operations := range doc.Paths {
+		for method, operation := range operations {
+			if operation.OperationID == "" {
+				return nil, fmt.Errorf("%s %s has no operationId", strings.ToUpper(method), template)
+			}
+			for i, param := range operation.Parameters {
+				resolved, err := doc.resolveParameter(param)
+				if err != nil {
+					return nil, fmt.Errorf("operation %s: %v", operation.OperationID, err)
+				}
+				operation.Parameters[i] = resolved
+			}
+			route, err := newOpenapiRoute(strings.ToUpper(method), template, operation)
+			if err != nil {
+				return nil, err
+			}
+			doc.routes = append(doc.routes, route)
+		}
+	}
+
+	// The most specific templates are matched first, /tokens/{tokenPath}/balances before /tokens/{tokenPath}
+	sort.Slice(doc.routes, func(i, j int) bool {
+		if doc.routes[i].literal != doc.routes[j].literal {
+			return doc.routes[i].literal > doc.routes[j].literal
+		}
+		return doc.routes[i].Template < doc.routes[j].Template
+	})
+	return &doc, nil
+}
+
+func (d *openapiDocument) resolveParameter(param *openapiParameter) (*openapiParameter, error) {
+	if param.Ref != "" {
+		name, ok := strings.CutPrefix(param.Ref, "#/components/parameters/")
+		if !ok || d.Components.Parameters[name] == nil {
+			return nil, fmt.Errorf("unknown parameter %s", param.Ref)
+		}
+		param = d.Components.Parameters[name]
+	}
+	if param.Schema == nil {
+		return nil, fmt.Errorf("parameter %s has no schema", param.Name)
+	}
+	schema, err := d.resolveSchema(param.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("parameter %s: %v", param.Name, err)
+	}
+	param.Schema = schema
+	return param, nil
+}
+
+func (d *openapiDocument) resolveSchema(schema *openapiSchema) (*openapiSchema, error) {
+	if schema.Ref != "" {
+		name, ok := strings.CutPrefix(schema.Ref, "#/components/schemas/")
+		if !ok || d.Components.Schemas[name] == nil {
+			return nil, fmt.Errorf("unknown schema %s", schema.Ref)
+		}
+		schema = d.Components.Schemas[name]
+	}
+	if schema.Pattern != "" && schema.pattern == nil {
+		pattern, err := regexp.Compile(schema.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", schema.Pattern, err)
+		}
+		schema.pattern = pattern
+	}
+	if schema.Items != nil {
+		items, err := d.resolveSchema(schema.Items)
+		if err != nil {
+			return nil, err
+		}
+		schema.Items = items
+	}
+	return schema, nil
+}
+
+func newOpenapiRoute(method string, template string, operation *openapiOperation) (*openapiRoute, error) {
+	route := &openapiRoute{Method: method, Template: template, Operation: operation}
+
+	pattern := "^"
+	last := 0
+	for _, match := range openapiTemplateParam.FindAllStringSubmatchIndex(template, -1) {
+		name := template[match[2]:match[3]]
+		param := operation.pathParameter(name)
+		if param == nil {
+			return nil, fmt.Errorf("operation %s does not declare path parameter %s", operation.OperationID, name)
+		}
+		pattern += regexp.QuoteMeta(template[last:match[0]])
+		if param.Slashes {
+			pattern += "(.+)"
+		} else {
+			pattern += "([^/]+)"
+		}
+		route.literal += match[0] - last
+		route.params = append(route.params, name)
+		last = match[1]
+	}
+	pattern += regexp.QuoteMeta(template[last:]) + "$"
+	route.literal += len(template) - last
+	route.pattern = regexp.MustCompile(pattern)
+	return route, nil
+}
+
+func (o *openapiOperation) pathParameter(name string) *openapiParameter {
+	for _, param := range o.Parameters {
+		if param.In == "path" && param.Name == name {
+			return param
+		}
+	}
+	return nil
+}
+
+// hasSlashes tells whether a path parameter of the route may contain slashes
+func (r *openapiRoute) hasSlashes() bool {
+	for _, name := range r.params {
+		if r.Operation.pathParameter(name).Slashes {
+			return true
+		}
+	}
+	return false
+}
+
+// ginPath returns the gin path of a route whose parameters are single segments
+func (r *openapiRoute) ginPath() string {
+	return openapiTemplateParam.ReplaceAllString(r.Template, ":$1")
+}
+
+// firstSegment returns the first segment of the template, "tokens" for /tokens/{tokenPath}
+func (r *openapiRoute) firstSegment() string {
+	segment, _, _ := strings.Cut(strings.TrimPrefix(r.Template, "/"), "/")
+	return segment
+}
+
+// registerRoutes registers the operations of the document with their handlers,
+// keyed by operation ID, validating the requests first. Gin parameters cannot
+// hold slashes, so the paths under a segment having such parameters, like
+// /tokens/{tokenPath}, are served by a wildcard matching them in the document.
+func registerRoutes(engine *gin.Engine, doc *openapiDocument, handlers map[string]gin.HandlerFunc) error {
+	dispatched := make(map[string]bool)
+	for _, route := range doc.routes {
+		if route.hasSlashes() {
+			dispatched[route.firstSegment()] = true
+		}
+	}
+
+	type routeHandler struct {
+		route   *openapiRoute
+		handler gin.HandlerFunc
+	}
+	wildcards := make(map[string][]routeHandler) // Routes served by the wildcard of a method and segment
+	var wildcardKeys []string
+	for _, route := range doc.routes {
+		handler, ok := handlers[route.Operation.OperationID]
+		if !ok {
+			return fmt.Errorf("no handler for operation %s", route.Operation.OperationID)
+		}
+		handler = validateRequest(route.Operation, handler)
+
+		segment := route.firstSegment()
+		if !dispatched[segment] {
+			engine.Handle(route.Method, route.ginPath(), handler)
+			continue
+		}
+		if route.Template == "/"+segment {
+			// Also reached with a trailing slash, through the wildcard
+			engine.Handle(route.Method, route.Template, handler)
+		}
+		key := route.Method + " /" + segment
+		if _, ok := wildcards[key]; !ok {
+			wildcardKeys = append(wildcardKeys, key)
+		}
+		wildcards[key] = append(wildcards[key], routeHandler{route: route, handler: handler})
+	}
+
+	for _, key := range wildcardKeys {
+		routes := wildcards[key]
+		method, prefix, _ := strings.Cut(key, " ")
+		engine.Handle(method, prefix+"/*any", func(gCtx *gin.Context) {
+			path := strings.TrimSuffix(gCtx.Request.URL.Path, "/")
+			for _, r := range routes {
+				match := r.route.pattern.FindStringSubmatch(path)
+				if match == nil {
+					continue
+				}
+				for i, name := range r.route.params {
+					gCtx.Params = append(gCtx.Params, gin.Param{Key: name, Value: match[i+1]})
+				}
+				r.handler(gCtx)
+				return
+			}
+			gCtx.JSON(404, gin.H{"error": "endpoint not found"})
+		})
+	}
+	return nil
+}
+
+// validateRequest wraps a handler to reject the requests whose parameters do not
+// match the operation with a 400. Empty values are absent, as for the handlers.
+func validateRequest(operation *openapiOperation, handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(gCtx *gin.Context) {
+		for _, param := range operation.Parameters {
+			if err := param.validate(gCtx); err != nil {
+				gCtx.JSON(400, gin.H{"error": err.Error()})
+				return
+			}
+		}
+		handler(gCtx)
+	}
+}
+
+func (p *openapiParameter) validate(gCtx *gin.Context) error {
+	var values []string
+	switch p.In {
+	case "path":
+		values = []string{gCtx.Param(p.Name)}
+	case "query":
+		values = gCtx.QueryArray(p.Name)
+	case "header":
+		values = gCtx.Request.Header.Values(p.Name)
+	}
+	values = slices.DeleteFunc(values, func(value string) bool { return value == "" })
+
+	if len(values) == 0 {
+		if p.Required {
+			return fmt.Errorf("%s parameter %s is required", p.In, p.Name)
+		}
+		return nil
+	}
+	if len(values) > 1 && p.Schema.Type != "array" {
+		return fmt.Errorf("invalid %s parameter %s: must be given once", p.In, p.Name)
+	}
+	for _, value := range values {
+		if err := p.Schema.validate(value); err != nil {
+			return fmt.Errorf("invalid %s parameter %s: %v", p.In, p.Name, err)
+		}
+	}
+	return nil
+}
+
+// validate checks a parameter value against the schema
+func (s *openapiSchema) validate(value string) error {
+	switch s.Type {
+	case "array":
+		return s.Items.validate(value)
+	case "integer":
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		return s.validateRange(float64(n))
+	case "number":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		return s.validateRange(n)
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not true or false", value)
+		}
+		return nil
+	}
+
+	if s.MaxLength != nil && len(value) > *s.MaxLength {
+		return fmt.Errorf("must be at most %d characters", *s.MaxLength)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(value) {
+		return fmt.Errorf("%q does not match %s", value, s.Pattern)
+	}
+	if len(s.Enum) > 0 && !slices.Contains(s.Enum, value) {
+		return fmt.Errorf("%q is not one of %s", value, strings.Join(s.Enum, ", "))
+	}
+	if s.Format == "date-time" {
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return fmt.Errorf("%q is not an RFC3339 timestamp", value)
+		}
+	}
+	return nil
+}
+
+func (s *openapiSchema) validateRange(n float64) error {
+	if s.Minimum != nil && n < *s.Minimum {
+		return fmt.Errorf("must be at least %v", *s.Minimum)
+	}
+	if s.Maximum != nil && n > *s.Maximum {
+		return fmt.Errorf("must be at most %v", *s.Maximum)
+	}
+	return nil
+}
+
+// GetOpenapi serves the OpenAPI document of the API
+func (c *Controller) GetOpenapi(gCtx *gin.Context) {
+	gCtx.Data(200, "application/json", openapiJSON)
+}
+
+// operationHandlers returns the handlers of the operations of the OpenAPI document
+func (c *Controller) operationHandlers() map[string]gin.HandlerFunc {
+	return map[string]gin.HandlerFunc{
+		"getOpenapi":                c.GetOpenapi,
+		"listTokens":                c.GetTokens,
+		"getTokenBalances":          c.GetTokenBalances,
+		"getTransferHistory":        c.GetTransferHistory,
+		"getToken":                  c.GetToken,
+		"getTokenAccountBalances":   c.GetTokenAccountBalances,
+		"getTokenHolders":           c.GetTokenHolders,
+		"getTokenDistribution":      c.GetTokenDistribution,
+		"getAccountBalances":        c.GetAccountBalances,
+		"getAccountNfts":            c.GetAccountNfts,
+		"getAccountCalls":           c.GetRealmCalls,
+		"getAccountTransactions":    c.GetAccountTransactions,
+		"getNftHistory":             c.GetNftHistory,
+		"listPackages":              c.GetPackages,
+		"getPackage":                c.GetPackage,
+		"getPackageFile":            c.GetPackageFile,
+		"listCalls":                 c.GetRealmCalls,
+		"listEvents":                c.GetEvents,
+		"listUndecodedEvents":       c.GetUndecodedEvents,
+		"listBlocks":                c.GetBlocks,
+		"getBlock":                  c.GetBlock,
+		"getBlockTransactions":      c.GetBlockTransactions,
+		"listTransactions":          c.GetTransactions,
+		"getTransaction":            c.GetTransaction,
+		"search":                    c.Search,
+		"queryGraphql":              c.GraphQL,
+		"postGraphql":               c.GraphQL,
+		"streamSse":                 c.StreamSSE,
+		"streamWebsocket":           c.StreamWebSocket,
+		"listWatchRules":            c.GetWatchRules,
+		"createWatchRule":           c.CreateWatchRule,
+		"getWatchRule":              c.GetWatchRule,
+		"deleteWatchRule":           c.DeleteWatchRule,
+		"getWebhookDeliveries":      c.GetWebhookDeliveries,
+		"replayWebhookDelivery":     c.ReplayWebhookDelivery,
+		"exportTransfers":           c.ExportTransfers,
+		"exportTransactions":        c.ExportTransactions,
+		"exportBalances":            c.ExportBalances,
+		"exportBalancesOfAddresses": c.ExportBalances,
+		"exportHolders":             c.ExportHolders,
+	}
+}
@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newOpenapiTestEngine registers the routes of the document with handlers writing
+// the operation ID and path parameters they were reached with
+func newOpenapiTestEngine(t *testing.T) (*gin.Engine, *openapiDocument) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	doc, err := loadOpenapiDocument(openapiJSON)
+	if err != nil {
+		t.Fatalf("loadOpenapiDocument() error = %v", err)
+	}
+
+	handlers := make(map[string]gin.HandlerFunc)
+	for _, route := range doc.routes {
+		operationID := route.Operation.OperationID
+		handlers[operationID] = func(gCtx *gin.Context) {
+			params := make(map[string]string)
+			for _, param := range route.Operation.Parameters {
+				if param.In == "path" {
+					params[param.Name] = gCtx.Param(param.Name)
+				}
+			}
+			gCtx.JSON(200, gin.H{"operationId": operationID, "params": params})
+		}
+	}
+	engine := gin.New()
+	if err := registerRoutes(engine, doc, handlers); err != nil {
+		t.Fatalf("registerRoutes() error = %v", err)
+	}
+	return engine, doc
+}
+
+func serveOpenapiTest(engine *gin.Engine, method string, target string) *httptest.ResponseRecorder {
+	return serveOpenapiTestWithHeaders(engine, method, target, nil)
+}
+
+func serveOpenapiTestWithHeaders(engine *gin.Engine, method string, target string, headers map[string]string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(method, target, nil)
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	engine.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestOpenapiOperationsHaveHandlers(t *testing.T) {
+	doc, err := loadOpenapiDocument(openapiJSON)
+	if err != nil {
+		t.Fatalf("loadOpenapiDocument() error = %v", err)
+	}
+
+	var operations []string
+	for _, route := range doc.routes {
+		operations = append(operations, route.Operation.OperationID)
+	}
+	var handlers []string
+	for operationID := range (&Controller{}).operationHandlers() {
+		handlers = append(handlers, operationID)
+	}
+	sort.Strings(operations)
+	sort.Strings(handlers)
+	if strings.Join(operations, ",") != strings.Join(handlers, ",") {
+		t.Errorf("operations = %v, handlers = %v", operations, handlers)
+	}
+}
+
+func TestOpenapiRoutesMatchSpec(t *testing.T) {
+	engine, doc := newOpenapiTestEngine(t)
+
+	for _, route := range doc.routes {
+		path := route.Template
+		want := make(map[string]string)
+		for _, name := range route.params {
+			example := route.Operation.pathParameter(name).Example
+			if example == "" {
+				t.Fatalf("%s %s: path parameter %s has no example", route.Method, route.Template, name)
+			}
+			path = strings.Replace(path, "{"+name+"}", example, 1)
+			want[name] = example
+		}
+		target := path
+		headers := make(map[string]string)
+		for _, param := range route.Operation.Parameters {
+			if (param.In != "query" && param.In != "header") || !param.Required {
+				continue
+			}
+			value := param.Example
+			if value == "" {
+				value = "x"
+			}
+			if param.In == "header" {
+				headers[param.Name] = value
+			} else {
+				target += "?" + param.Name + "=" + value
+			}
+		}
+
+		recorder := serveOpenapiTestWithHeaders(engine, route.Method, target, headers)
+		if recorder.Code != 200 {
+			t.Errorf("%s %s: status = %d, body = %s", route.Method, target, recorder.Code, recorder.Body)
+			continue
+		}
+		var response struct {
+			OperationID string            `json:"operationId"`
+			Params      map[string]string `json:"params"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			t.Fatalf("%s %s: invalid response: %v", route.Method, target, err)
+		}
+		if response.OperationID != route.Operation.OperationID {
+			t.Errorf("%s %s: reached %s, want %s", route.Method, target, response.OperationID, route.Operation.OperationID)
+		}
+		for name, value := range want {
+			if response.Params[name] != value {
+				t.Errorf("%s %s: parameter %s = %q, want %q", route.Method, target, name, response.Params[name], value)
+			}
+		}
+	}
+}
+
+func TestOpenapiRoutesEdgeCases(t *testing.T) {
+	engine, _ := newOpenapiTestEngine(t)
+
+	tests := []struct {
+		target        string
+		wantStatus    int
+		wantOperation string
+	}{
+		{"/tokens/", 200, "listTokens"},
+		{"/tokens/balances", 200, "getTokenBalances"},
+		{"/tokens/ugnot/balances", 200, "getTokenAccountBalances"},
+		{"/tokens/gno.land/r/demo/foo20/holders", 200, "getTokenHolders"},
+		{"/tokens/gno.land/r/demo/foo20/distribution/", 200, "getTokenDistribution"},
+		{"/tokens/gno.land/r/demo/foo20/", 200, "getToken"},
+		{"/packages/gno.land/p/demo/avl/files/tree.gno", 200, "getPackageFile"},
+		{"/transactions/", 200, "listTransactions"},
+		{"/nfts/gno.land/r/demo/nft", 404, ""},
+	}
+	for _, tt := range tests {
+		recorder := serveOpenapiTest(engine, "GET", tt.target)
+		if recorder.Code != tt.wantStatus {
+			t.Errorf("GET %s: status = %d, want %d", tt.target, recorder.Code, tt.wantStatus)
+			continue
+		}
+		if tt.wantOperation != "" && !strings.Contains(recorder.Body.String(), `"operationId":"`+tt.wantOperation+`"`) {
+			t.Errorf("GET %s: body = %s, want operation %s", tt.target, recorder.Body, tt.wantOperation)
+		}
+	}
+}
+
+func TestOpenapiRejectsInvalidParameters(t *testing.T) {
+	engine, _ := newOpenapiTestEngine(t)
+
+	tests := []struct {
+		method    string
+		target    string
+		wantError string
+	}{
+		{"GET", "/accounts/g1invalid/balances", "invalid path parameter address"},
+		{"GET", "/tokens/balances?address=cosmos1abc", "invalid query parameter address"},
+		{"GET", "/tokens/transfer-history?token=not%20a%20token", "invalid query parameter token"},
+		{"GET", "/tokens/gno.land/r/demo%20foo", "invalid path parameter tokenPath"},
+		{"GET", "/blocks?limit=0", "invalid query parameter limit: must be at least 1"},
+		{"GET", "/blocks?limit=101", "invalid query parameter limit: must be at most 100"},
+		{"GET", "/blocks?offset=-1", "invalid query parameter offset"},
+		{"GET", "/blocks?limit=ten", "invalid query parameter limit"},
+		{"GET", "/blocks?limit=1&limit=2", "invalid query parameter limit: must be given once"},
+		{"GET", "/blocks/abc", "invalid path parameter height"},
+		{"GET", "/transactions?success=maybe", "invalid query parameter success"},
+		{"GET", "/calls?from=yesterday", "invalid query parameter from"},
+		{"GET", "/stream/sse?types=blocks", "invalid query parameter types"},
+		{"GET", "/graphql", "query parameter query is required"},
+		{"GET", "/search?q=", "query parameter q is required"},
+		{"POST", "/webhooks/deliveries/0/replay", "invalid path parameter id"},
+		{"GET", "/tokens/ugnot/holders?min_balance=-1", "invalid query parameter min_balance"},
+		{"GET", "/exports/transfers?format=xml", "invalid query parameter format"},
+		{"GET", "/exports/balances?address=g1invalid", "invalid query parameter address"},
+		{"GET", "/exports/holders", "query parameter token is required"},
+	}
+	for _, tt := range tests {
+		recorder := serveOpenapiTest(engine, tt.method, tt.target)
+		if recorder.Code != 400 {
+			t.Errorf("%s %s: status = %d, want 400", tt.method, tt.target, recorder.Code)
+			continue
+		}
+		var response struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			t.Fatalf("%s %s: invalid response: %v", tt.method, tt.target, err)
+		}
+		if !strings.HasPrefix(response.Error, tt.wantError) {
+			t.Errorf("%s %s: error = %q, want prefix %q", tt.method, tt.target, response.Error, tt.wantError)
+		}
+	}
+}
+
+func TestOpenapiAcceptsValidParameters(t *testing.T) {
+	engine, _ := newOpenapiTestEngine(t)
+
+	for _, target := range []string{
+		"/blocks?offset=0&limit=100",
+		"/tokens/transfer-history?address=g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5&token=ugnot&min_amount=1.5",
+		"/tokens/balances?address=",
+		"/accounts/g17290cwvmrapvp869xfnhhawa8sm9edpufzat7d/transactions?role=signer,caller&role=sender",
+		"/calls?from=2024-01-01T00:00:00Z",
+		"/stream/sse?types=block,%20transfer",
+		"/exports/balances?address=g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5&address=g17290cwvmrapvp869xfnhhawa8sm9edpufzat7d&height=10",
+		"/exports/transactions?format=ndjson&success=true",
+	} {
+		if recorder := serveOpenapiTest(engine, http.MethodGet, target); recorder.Code != 200 {
+			t.Errorf("GET %s: status = %d, body = %s", target, recorder.Code, recorder.Body)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gno.land-block-indexer/cmd/indexer-rest/service"
+	"gno.land-block-indexer/model"
+)
+
+type searchResultResponse struct {
+	Type  string            `json:"type"`
+	ID    string            `json:"id"`
+	Label string            `json:"label"`
+	Links map[string]string `json:"links"` // Endpoints of the resource, the main one as self
+}
+
+// searchResultLinks returns the endpoints serving a search result
+func searchResultLinks(result model.SearchResult) map[string]string {
+	id := result.ID
+	switch result.Type {
+	case service.SearchResultBlock:
+		return map[string]string{
+			"self":         "/blocks/" + id,
+			"transactions": "/blocks/" + id + "/transactions",
+		}
+	case service.SearchResultTransaction:
+		// Base64 hashes may contain slashes, which the transaction route allows
+		return map[string]string{
+			"self": "/transactions/" + id,
+		}
+	case service.SearchResultAddress:
+		return map[string]string{
+			"self":         "/accounts/" + id + "/balances",
+			"transactions": "/accounts/" + id + "/transactions",
+			"nfts":         "/accounts/" + id + "/nfts",
+			"calls":        "/accounts/" + id + "/calls",
+			"transfers":    "/tokens/transfer-history?address=" + id,
+		}
+	case service.SearchResultPackage:
+		return map[string]string{
+			"self":  "/packages/" + id,
+			"calls": "/calls?pkg_path=" + url.QueryEscape(id),
+		}
+	case service.SearchResultToken:
+		return map[string]string{
+			"self":         "/tokens/" + id,
+			"holders":      "/tokens/" + id + "/holders",
+			"distribution": "/tokens/" + id + "/distribution",
+			"transfers":    "/tokens/transfer-history?token=" + url.QueryEscape(id),
+		}
+	}
+	return map[string]string{}
+}
+
+// Search resolves an identifier pasted by a user: a block height, a block or
+// transaction hash in hex or base64, an address, a package path, matched as a
+// prefix, or a token symbol. Every result links to the endpoints serving it.
+func (c *Controller) Search(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	query := strings.TrimSpace(gCtx.Query("q"))
+	if query == "" {
+		gCtx.JSON(400, gin.H{"error": "query parameter q is required"})
+		return
+	}
+	limit, err := parseLimit(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := c.service.Search(ctx, query, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to search %q: %v", query, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to search"})
+		return
+	}
+
+	response := struct {
+		Query   string                 `json:"query"`
+		Results []searchResultResponse `json:"results"`
+	}{
+		Query:   query,
+		Results: make([]searchResultResponse, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = searchResultResponse{
+			Type:  result.Type,
+			ID:    result.ID,
+			Label: result.Label,
+			Links: searchResultLinks(result),
+		}
+	}
+
+	gCtx.JSON(200, response)
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/cmd/indexer-rest/service"
+	"gno.land-block-indexer/model"
+)
+
+func TestSearchResultLinksAreRoutes(t *testing.T) {
+	engine, _ := newOpenapiTestEngine(t)
+
+	for _, result := range []model.SearchResult{
+		{Type: service.SearchResultBlock, ID: "42"},
+		{Type: service.SearchResultTransaction, ID: "c15NQRD8/MA+aTl71mmhI6j0AmyYDQKVFk4O3wMvUI0="},
+		{Type: service.SearchResultAddress, ID: "g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5"},
+		{Type: service.SearchResultPackage, ID: "gno.land/r/demo/boards"},
+		{Type: service.SearchResultToken, ID: "gno.land/r/demo/foo20"},
+		{Type: service.SearchResultToken, ID: "ugnot"},
+	} {
+		links := searchResultLinks(result)
+		if links["self"] == "" {
+			t.Errorf("%s %s: no self link", result.Type, result.ID)
+		}
+		for name, link := range links {
+			if recorder := serveOpenapiTest(engine, "GET", link); recorder.Code != 200 {
+				t.Errorf("%s %s: link %s = %s, status = %d, body = %s", result.Type, result.ID, name, link, recorder.Code, recorder.Body)
+			}
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"gno.land-block-indexer/cmd/indexer-rest/service"
+	"gno.land-block-indexer/model"
+)
+
+const (
+	streamKeepAliveInterval = 15 * time.Second // How often idle streams are pinged
+	streamWriteTimeout      = 10 * time.Second // How long a WebSocket write may block
+)
+
+var streamUpgrader = websocket.Upgrader{
+	// The API is public and read-only, so any origin may subscribe
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type streamTransferResponse struct {
+	TxHash      string `json:"txHash"`
+	BlockHeight int    `json:"blockHeight"`
+	Func        string `json:"func"`
+	FromAddress string `json:"fromAddress"`
+	ToAddress   string `json:"toAddress"`
+	Token       string `json:"token"`
+	Amount      int64  `json:"amount"`
+}
+
+type streamMessage struct {
+	Type   string `json:"type"`
+	Height int    `json:"height"`
+	Data   any    `json:"data,omitempty"`
+}
+
+// newStreamMessage formats an event as in the REST responses
+func newStreamMessage(event service.StreamEvent) streamMessage {
+	message := streamMessage{Type: event.Type, Height: event.Height}
+	switch {
+	case event.Block != nil:
+		message.Data = newBlockResponse(*event.Block)
+	case event.Transaction != nil:
+		message.Data = newTransactionResponse(*event.Transaction)
+	case event.Transfer != nil:
+		message.Data = newStreamTransferResponse(*event.Transfer)
+	}
+	return message
+}
+
+func newStreamTransferResponse(transfer model.Transfer) streamTransferResponse {
+	return streamTransferResponse{
+		TxHash:      transfer.Hash,
+		BlockHeight: transfer.BlockHeight,
+		Func:        transfer.Func,
+		FromAddress: transfer.FromAddress,
+		ToAddress:   transfer.ToAddress,
+		Token:       transfer.Token,
+		Amount:      int64(transfer.Amount),
+	}
+}
+
+// parseStreamRequest reads the filter and resume height of a stream, from the
+// from_height parameter or the Last-Event-ID header sent by reconnecting SSE clients
+func parseStreamRequest(gCtx *gin.Context) (service.StreamFilter, int, error) {
+	var request struct {
+		Types      string `form:"types"`
+		Address    string `form:"address"`
+		Token      string `form:"token"`
+		FromHeight int    `form:"from_height"`
+	}
+	if err := gCtx.ShouldBindQuery(&request); err != nil {
+		return service.StreamFilter{}, 0, fmt.Errorf("invalid request")
+	}
+
+	filter := service.StreamFilter{
+		Types:   make(map[string]bool),
+		Address: request.Address,
+		Token:   request.Token,
+	}
+	for _, eventType := range strings.Split(request.Types, ",") {
+		switch eventType = strings.TrimSpace(eventType); eventType {
+		case "":
+		case service.STREAM_EVENT_BLOCK, service.STREAM_EVENT_TRANSACTION, service.STREAM_EVENT_TRANSFER:
+			filter.Types[eventType] = true
+		default:
+			return service.StreamFilter{}, 0, fmt.Errorf("invalid type %q, must be block, transaction or transfer", eventType)
+		}
+	}
+
+	fromHeight := request.FromHeight
+	if lastEventID := gCtx.GetHeader("Last-Event-ID"); lastEventID != "" && fromHeight == 0 {
+		// Event IDs are the lowest height not fully delivered, so it is sent again
+		height, err := strconv.Atoi(lastEventID)
+		if err != nil {
+			return service.StreamFilter{}, 0, fmt.Errorf("invalid Last-Event-ID %q", lastEventID)
+		}
+		fromHeight = height
+	}
+	if fromHeight < 0 {
+		return service.StreamFilter{}, 0, fmt.Errorf("invalid from_height %d", fromHeight)
+	}
+	return filter, fromHeight, nil
+}
+
+// subscribeStream subscribes to the stream described by the request, and writes
+// the error response on failure
+func (c *Controller) subscribeStream(gCtx *gin.Context) (*service.StreamSubscription, bool) {
+	filter, fromHeight, err := parseStreamRequest(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return nil, false
+	}
+
+	sub, err := c.service.SubscribeStream(gCtx.Request.Context(), filter, fromHeight)
+	switch {
+	case errors.Is(err, service.ErrStreamUnavailable):
+		gCtx.JSON(503, gin.H{"error": "Live streams are unavailable"})
+		return nil, false
+	case errors.Is(err, service.ErrResumeTooFar):
+		gCtx.JSON(400, gin.H{"error": fmt.Sprintf("from_height is more than %d blocks behind, fetch the history from the REST API", service.STREAM_MAX_RESUME_BLOCKS)})
+		return nil, false
+	case err != nil:
+		c.logger.Errorf("Failed to subscribe to stream: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to subscribe to stream"})
+		return nil, false
+	}
+	return sub, true
+}
+
+// StreamSSE pushes new blocks, transactions and transfers as Server-Sent Events.
+// Event IDs are the lowest height not fully delivered yet, so reconnecting clients
+// resume without missing blocks committed out of order.
+func (c *Controller) StreamSSE(gCtx *gin.Context) {
+	sub, ok := c.subscribeStream(gCtx)
+	if !ok {
+		return
+	}
+	defer sub.Close()
+
+	gCtx.Header("Content-Type", "text/event-stream")
+	gCtx.Header("Cache-Control", "no-cache")
+	gCtx.Header("Connection", "keep-alive")
+	gCtx.Status(200)
+	gCtx.Writer.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-gCtx.Request.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(gCtx.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			gCtx.Writer.Flush()
+		case event, ok := <-sub.Events:
+			if !ok {
+				if sub.Overflowed() {
+					fmt.Fprintf(gCtx.Writer, "event: overflow\ndata: {\"resumeFromHeight\":%d}\n\n", sub.ResumeHeight())
+					gCtx.Writer.Flush()
+				}
+				return
+			}
+			data, err := json.Marshal(newStreamMessage(event).Data)
+			if err != nil {
+				c.logger.Errorf("Failed to marshal %s event at height %d: %v", event.Type, event.Height, err)
+				continue
+			}
+			if _, err := fmt.Fprintf(gCtx.Writer, "id: %d\nevent: %s\ndata: %s\n\n", sub.ResumeHeight(), event.Type, data); err != nil {
+				return
+			}
+			gCtx.Writer.Flush()
+		}
+	}
+}
+
+// StreamWebSocket pushes new blocks, transactions and transfers as JSON messages
+// of a WebSocket
+func (c *Controller) StreamWebSocket(gCtx *gin.Context) {
+	if !websocket.IsWebSocketUpgrade(gCtx.Request) {
+		gCtx.JSON(400, gin.H{"error": "WebSocket upgrade required"})
+		return
+	}
+	sub, ok := c.subscribeStream(gCtx)
+	if !ok {
+		return
+	}
+	defer sub.Close()
+
+	conn, err := streamUpgrader.Upgrade(gCtx.Writer, gCtx.Request, nil)
+	if err != nil {
+		c.logger.Warnf("Failed to upgrade stream to WebSocket: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	// Messages from the client are ignored, reading only detects the close
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case <-keepAlive.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)); err != nil {
+				return
+			}
+		case event, ok := <-sub.Events:
+			conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			if !ok {
+				if sub.Overflowed() {
+					conn.WriteJSON(streamMessage{Type: "overflow", Height: sub.ResumeHeight()})
+				}
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "stream ended"))
+				return
+			}
+			if err := conn.WriteJSON(newStreamMessage(event)); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,317 @@
+package controller
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gno.land-block-indexer/cmd/indexer-rest/service"
+	"gno.land-block-indexer/model"
+)
+
+// ownerTokenHeader carries the token of the owner of the watch rules, returned when a rule is created
+const ownerTokenHeader = "X-Owner-Token"
+
+// watchRuleEventTypes are the transfer functions a watch rule can select
+var watchRuleEventTypes = map[string]bool{
+	"transfer": true,
+	"mint":     true,
+	"burn":     true,
+	"fee":      true,
+	"genesis":  true,
+}
+
+type watchRuleResponse struct {
+	ID         int       `json:"id"`
+	URL        string    `json:"url"`
+	Secret     string    `json:"secret,omitempty"`     // Only returned when the rule is created
+	OwnerToken string    `json:"ownerToken,omitempty"` // Only returned when the rule is created
+	Address    string    `json:"address"`
+	Token      string    `json:"token"`
+	MinAmount  float64   `json:"minAmount"`
+	EventType  string    `json:"eventType"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func newWatchRuleResponse(rule *model.WatchRule) watchRuleResponse {
+	return watchRuleResponse{
+		ID:        rule.ID,
+		URL:       rule.URL,
+		Address:   rule.Address,
+		Token:     rule.Token,
+		MinAmount: rule.MinAmount,
+		EventType: rule.EventType,
+		Active:    rule.Active,
+		CreatedAt: rule.CreatedAt,
+	}
+}
+
+// parseID reads a positive integer path parameter
+func parseID(gCtx *gin.Context, name string) (int, bool) {
+	id, err := strconv.Atoi(gCtx.Param(name))
+	if err != nil || id <= 0 {
+		gCtx.JSON(400, gin.H{"error": "Invalid " + name})
+		return 0, false
+	}
+	return id, true
+}
+
+// requireOwnerToken reads the owner token of the request, writing the error response when missing
+func requireOwnerToken(gCtx *gin.Context) (string, bool) {
+	ownerToken := gCtx.GetHeader(ownerTokenHeader)
+	if ownerToken == "" {
+		gCtx.JSON(400, gin.H{"error": "header parameter " + ownerTokenHeader + " is required"})
+		return "", false
+	}
+	return ownerToken, true
+}
+
+// CreateWatchRule registers a watch rule, returning its secret and owner token once.
+// Rules created with the owner token of other rules belong to the same owner.
+func (c *Controller) CreateWatchRule(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	var request struct {
+		URL       string  `json:"url"`
+		Secret    string  `json:"secret"`
+		Address   string  `json:"address"`
+		Token     string  `json:"token"`
+		MinAmount float64 `json:"minAmount"`
+		EventType string  `json:"eventType"`
+	}
+	if err := gCtx.ShouldBindJSON(&request); err != nil {
+		c.logger.Errorf("Failed to bind request: %v", err)
+		gCtx.JSON(400, gin.H{"error": "Invalid request"})
+		return
+	}
+	target, err := url.Parse(request.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		gCtx.JSON(400, gin.H{"error": "url must be an http or https URL"})
+		return
+	}
+	request.EventType = strings.ToLower(request.EventType)
+	if request.EventType != "" && !watchRuleEventTypes[request.EventType] {
+		gCtx.JSON(400, gin.H{"error": "eventType must be one of transfer, mint, burn, fee or genesis"})
+		return
+	}
+	if request.MinAmount < 0 {
+		gCtx.JSON(400, gin.H{"error": "minAmount cannot be negative"})
+		return
+	}
+
+	rule, ownerToken, err := c.service.CreateWatchRule(ctx, &model.WatchRule{
+		URL:       request.URL,
+		Secret:    request.Secret,
+		Address:   request.Address,
+		Token:     request.Token,
+		MinAmount: request.MinAmount,
+		EventType: request.EventType,
+	}, gCtx.GetHeader(ownerTokenHeader))
+	if errors.Is(err, service.ErrWatchRuleURL) {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.logger.Errorf("Failed to create watch rule: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to create watch rule"})
+		return
+	}
+
+	response := newWatchRuleResponse(rule)
+	response.Secret = rule.Secret
+	response.OwnerToken = ownerToken
+	gCtx.JSON(201, response)
+}
+
+// GetWatchRules lists the watch rules of the owner
+func (c *Controller) GetWatchRules(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	ownerToken, ok := requireOwnerToken(gCtx)
+	if !ok {
+		return
+	}
+
+	rules, err := c.service.GetWatchRules(ctx, ownerToken)
+	if err != nil {
+		c.logger.Errorf("Failed to get watch rules: %v", err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get watch rules"})
+		return
+	}
+
+	var response struct {
+		Rules []watchRuleResponse `json:"rules"`
+	}
+	response.Rules = make([]watchRuleResponse, len(rules))
+	for i := range rules {
+		response.Rules[i] = newWatchRuleResponse(&rules[i])
+	}
+
+	gCtx.JSON(200, response)
+}
+
+func (c *Controller) GetWatchRule(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	id, ok := parseID(gCtx, "id")
+	if !ok {
+		return
+	}
+	ownerToken, ok := requireOwnerToken(gCtx)
+	if !ok {
+		return
+	}
+
+	rule, err := c.service.GetWatchRule(ctx, id, ownerToken)
+	if err != nil {
+		c.logger.Errorf("Failed to get watch rule %d: %v", id, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get watch rule"})
+		return
+	}
+	if rule == nil {
+		gCtx.JSON(404, gin.H{"error": "Watch rule not found"})
+		return
+	}
+
+	gCtx.JSON(200, newWatchRuleResponse(rule))
+}
+
+// DeleteWatchRule removes a watch rule with its delivery logs
+func (c *Controller) DeleteWatchRule(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	id, ok := parseID(gCtx, "id")
+	if !ok {
+		return
+	}
+	ownerToken, ok := requireOwnerToken(gCtx)
+	if !ok {
+		return
+	}
+
+	deleted, err := c.service.DeleteWatchRule(ctx, id, ownerToken)
+	if err != nil {
+		c.logger.Errorf("Failed to delete watch rule %d: %v", id, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to delete watch rule"})
+		return
+	}
+	if !deleted {
+		gCtx.JSON(404, gin.H{"error": "Watch rule not found"})
+		return
+	}
+
+	gCtx.Status(204)
+}
+
+type webhookDeliveryResponse struct {
+	ID             int        `json:"id"`
+	RuleID         int        `json:"ruleId"`
+	TxHash         string     `json:"txHash"`
+	EventIndex     int        `json:"eventIndex"`
+	Token          string     `json:"token"`
+	BlockHeight    int        `json:"blockHeight"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time `json:"lastAttemptAt"`
+	ResponseStatus int        `json:"responseStatus"`
+	LastError      string     `json:"lastError"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+func newWebhookDeliveryResponse(delivery *model.WebhookDelivery) webhookDeliveryResponse {
+	return webhookDeliveryResponse{
+		ID:             delivery.ID,
+		RuleID:         delivery.RuleID,
+		TxHash:         delivery.Hash,
+		EventIndex:     delivery.EventIndex,
+		Token:          delivery.Token,
+		BlockHeight:    delivery.BlockHeight,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+// GetWebhookDeliveries lists the delivery log of a watch rule, newest first
+func (c *Controller) GetWebhookDeliveries(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	id, ok := parseID(gCtx, "id")
+	if !ok {
+		return
+	}
+	status := gCtx.Query("status")
+	if status != "" && status != "pending" && status != "delivered" && status != "failed" {
+		gCtx.JSON(400, gin.H{"error": "status must be one of pending, delivered or failed"})
+		return
+	}
+	offset, limit, err := parsePagination(gCtx)
+	if err != nil {
+		gCtx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	ownerToken, ok := requireOwnerToken(gCtx)
+	if !ok {
+		return
+	}
+
+	rule, err := c.service.GetWatchRule(ctx, id, ownerToken)
+	if err != nil {
+		c.logger.Errorf("Failed to get watch rule %d: %v", id, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get watch rule"})
+		return
+	}
+	if rule == nil {
+		gCtx.JSON(404, gin.H{"error": "Watch rule not found"})
+		return
+	}
+
+	deliveries, err := c.service.GetWebhookDeliveries(ctx, id, status, offset, limit)
+	if err != nil {
+		c.logger.Errorf("Failed to get deliveries of watch rule %d: %v", id, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to get webhook deliveries"})
+		return
+	}
+
+	var response struct {
+		Deliveries []webhookDeliveryResponse `json:"deliveries"`
+	}
+	response.Deliveries = make([]webhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		response.Deliveries[i] = newWebhookDeliveryResponse(&deliveries[i])
+	}
+
+	gCtx.JSON(200, response)
+}
+
+// ReplayWebhookDelivery schedules a delivery to be sent again, whatever its status
+func (c *Controller) ReplayWebhookDelivery(gCtx *gin.Context) {
+	ctx := gCtx.Request.Context()
+	id, ok := parseID(gCtx, "id")
+	if !ok {
+		return
+	}
+	ownerToken, ok := requireOwnerToken(gCtx)
+	if !ok {
+		return
+	}
+
+	delivery, err := c.service.ReplayWebhookDelivery(ctx, id, ownerToken)
+	if err != nil {
+		c.logger.Errorf("Failed to replay webhook delivery %d: %v", id, err)
+		gCtx.JSON(500, gin.H{"error": "Failed to replay webhook delivery"})
+		return
+	}
+	if delivery == nil {
+		gCtx.JSON(404, gin.H{"error": "Webhook delivery not found"})
+		return
+	}
+
+	gCtx.JSON(202, newWebhookDeliveryResponse(delivery))
+}
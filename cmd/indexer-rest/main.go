@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"os"
+
 	"gno.land-block-indexer/cmd/indexer-rest/controller"
 	"gno.land-block-indexer/lib/log"
 )
@@ -9,6 +11,13 @@ import (
 func main() {
 	ctx := context.Background()
 	logger := log.NewLogger()
+	// indexer-rest export <dataset> writes an export instead of serving the API
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := controller.RunExport(ctx, logger, os.Args[2:]); err != nil {
+			logger.Fatalf("Failed to export: %v", err)
+		}
+		return
+	}
 	controller := controller.NewController(logger)
 	err := controller.Run(ctx)
 	if err != nil {
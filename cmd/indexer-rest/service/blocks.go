@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+
+	"gno.land-block-indexer/model"
+)
+
+// GetBlocks implements Service.
+func (s *service) GetBlocks(ctx context.Context, offset int, limit int) ([]model.Block, error) {
+	blocks, err := s.repo.GetBlocks(ctx, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get blocks: %v", err)
+	}
+
+	return blocks, nil
+}
+
+// GetBlock implements Service.
+func (s *service) GetBlock(ctx context.Context, height int) (*model.Block, error) {
+	block, err := s.repo.GetBlock(ctx, height)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get block %d: %v", height, err)
+	}
+
+	return block, nil
+}
+
+// GetBlockTransactions implements Service.
+func (s *service) GetBlockTransactions(ctx context.Context, height int, offset int, limit int) ([]model.Transaction, error) {
+	txs, err := s.repo.GetTransactions(ctx, height, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get transactions of block %d: %v", height, err)
+	}
+
+	return txs, nil
+}
+
+// GetTransaction implements Service.
+func (s *service) GetTransaction(ctx context.Context, hash string) (*model.Transaction, error) {
+	tx, err := s.repo.GetTransaction(ctx, hash)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get transaction %s: %v", hash, err)
+	}
+
+	return tx, nil
+}
+
+// GetTransactions implements Service.
+func (s *service) GetTransactions(ctx context.Context, filter model.TransactionFilter, offset int, limit int) ([]model.Transaction, error) {
+	txs, err := s.repo.GetTransactionsByFilter(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get transactions (message_type=%s): %v", filter.MessageType, err)
+	}
+
+	return txs, nil
+}
+
+// GetBlocksByHeights implements Service.
+func (s *service) GetBlocksByHeights(ctx context.Context, heights []int) ([]model.Block, error) {
+	blocks, err := s.repo.GetBlocksByHeights(ctx, heights)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get %d blocks: %v", len(heights), err)
+	}
+
+	return blocks, nil
+}
+
+// GetTransactionsOfBlocks implements Service.
+func (s *service) GetTransactionsOfBlocks(ctx context.Context, heights []int) ([]model.Transaction, error) {
+	txs, err := s.repo.GetTransactionsByHeights(ctx, heights)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get transactions of %d blocks: %v", len(heights), err)
+	}
+
+	return txs, nil
+}
+
+// GetTransactionsByHashes implements Service.
+func (s *service) GetTransactionsByHashes(ctx context.Context, hashes []string) ([]model.Transaction, error) {
+	txs, err := s.repo.GetTransactionsByHashes(ctx, hashes)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get %d transactions: %v", len(hashes), err)
+	}
+
+	return txs, nil
+}
+
+// GetTransfersOfTransactions implements Service.
+func (s *service) GetTransfersOfTransactions(ctx context.Context, hashes []string) ([]model.Transfer, error) {
+	transfers, err := s.repo.GetTransfersByHashes(ctx, hashes)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get transfers of %d transactions: %v", len(hashes), err)
+	}
+
+	return transfers, nil
+}
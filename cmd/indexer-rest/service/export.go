@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"gno.land-block-indexer/model"
+	"gno.land-block-indexer/repository"
+)
+
+// ExportSnapshot implements Service.
+//
+// The service passed to fn reads from a snapshot of the database, consistent up
+// to the block at the given height. Its exports must not run at the same time,
+// since they share one database connection.
+func (s *service) ExportSnapshot(ctx context.Context, fn func(snapshot Service, height int) error) error {
+	return s.repo.ExportSnapshot(ctx, func(repo repository.Repository, height int) error {
+		return fn(&service{logger: s.logger, repo: repo, streamHub: newStreamHub()}, height)
+	})
+}
+
+// ExportTransfers implements Service.
+//
+// Errors of fn are returned as is, the others are logged by the repository.
+func (s *service) ExportTransfers(ctx context.Context, filter model.TransferFilter, fn func(transfer model.Transfer) error) error {
+	return s.repo.ExportTransfers(ctx, filter, fn)
+}
+
+// ExportTransactions implements Service.
+func (s *service) ExportTransactions(ctx context.Context, filter model.TransactionFilter, fn func(tx model.Transaction) error) error {
+	return s.repo.ExportTransactions(ctx, filter, fn)
+}
+
+// ExportBalances implements Service.
+func (s *service) ExportBalances(ctx context.Context, filter model.BalanceFilter, fn func(account model.Account) error) error {
+	return s.repo.ExportBalances(ctx, filter, fn)
+}
+
+// ExportHolders implements Service.
+func (s *service) ExportHolders(ctx context.Context, token string, fn func(account model.Account) error) error {
+	return s.repo.ExportHolders(ctx, token, fn)
+}
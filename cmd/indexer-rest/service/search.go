@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gno.land-block-indexer/model"
+)
+
+const (
+	SearchResultBlock       = "block"
+	SearchResultTransaction = "transaction"
+	SearchResultAddress     = "address"
+	SearchResultPackage     = "package"
+	SearchResultToken       = "token"
+)
+
+var (
+	searchHeightPattern    = regexp.MustCompile(`^[0-9]{1,18}$`)
+	searchHexPattern       = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{64}$`)
+	searchAddressPattern   = regexp.MustCompile(`^g1[02-9ac-hj-np-z]{38}$`)
+	searchPathPattern      = regexp.MustCompile(`^gno\.land(/[A-Za-z0-9_.-]*)*$`)
+	searchSymbolPattern    = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]{0,15}$`)
+	searchShortPathPattern = regexp.MustCompile(`^/?[rp]/`)
+)
+
+// normalizeSearchPath returns the full path of a realm or package path given
+// without its domain, as r/demo/foo20 or /p/demo/avl, and the query otherwise
+func normalizeSearchPath(query string) string {
+	if searchShortPathPattern.MatchString(query) {
+		return "gno.land/" + strings.TrimPrefix(query, "/")
+	}
+	return query
+}
+
+// normalizeSearchHash returns the base64 form in which hashes are stored of a
+// hex or base64 (standard or URL) encoded 32 byte hash
+func normalizeSearchHash(query string) (string, bool) {
+	if searchHexPattern.MatchString(query) {
+		data, err := hex.DecodeString(strings.TrimPrefix(query, "0x"))
+		if err != nil {
+			return "", false
+		}
+		return base64.StdEncoding.EncodeToString(data), true
+	}
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if data, err := encoding.DecodeString(query); err == nil && len(data) == 32 {
+			return base64.StdEncoding.EncodeToString(data), true
+		}
+	}
+	return "", false
+}
+
+// Search implements Service.
+//
+// The query is classified by its form, and resolved as each kind of resource it
+// may be: a block height, a block or transaction hash, an address, a package path,
+// possibly without its gno.land domain, of which up to limit packages with the
+// path as prefix are returned, or a token symbol or path. Exact matches come first, in that order.
+func (s *service) Search(ctx context.Context, query string, limit int) ([]model.SearchResult, error) {
+	query = strings.TrimSpace(query)
+	results := make([]model.SearchResult, 0)
+
+	if searchHeightPattern.MatchString(query) {
+		height, _ := strconv.Atoi(query)
+		block, err := s.repo.GetBlock(ctx, height)
+		if err != nil {
+			return nil, s.logger.Errorf("Failed to search block %d: %v", height, err)
+		}
+		if block != nil {
+			results = append(results, newBlockSearchResult(*block))
+		}
+	}
+
+	if hash, ok := normalizeSearchHash(query); ok {
+		block, err := s.repo.GetBlockByHash(ctx, hash)
+		if err != nil {
+			return nil, s.logger.Errorf("Failed to search block %s: %v", hash, err)
+		}
+		if block != nil {
+			results = append(results, newBlockSearchResult(*block))
+		}
+		tx, err := s.repo.GetTransaction(ctx, hash)
+		if err != nil {
+			return nil, s.logger.Errorf("Failed to search transaction %s: %v", hash, err)
+		}
+		if tx != nil {
+			results = append(results, model.SearchResult{
+				Type:  SearchResultTransaction,
+				ID:    tx.Hash,
+				Label: fmt.Sprintf("Transaction %d of block %d", tx.Index, tx.BlockHeight),
+			})
+		}
+	}
+
+	// Addresses are valid resources even without activity, their lists are empty
+	if searchAddressPattern.MatchString(query) {
+		results = append(results, model.SearchResult{Type: SearchResultAddress, ID: query, Label: "Address " + query})
+	}
+
+	path := normalizeSearchPath(query)
+	if searchPathPattern.MatchString(path) {
+		packages, err := s.repo.GetPackagesByPrefix(ctx, path, limit)
+		if err != nil {
+			return nil, s.logger.Errorf("Failed to search packages with prefix %s: %v", path, err)
+		}
+		for _, pkg := range packages {
+			results = append(results, model.SearchResult{
+				Type:  SearchResultPackage,
+				ID:    pkg.Path,
+				Label: fmt.Sprintf("Package %s deployed at height %d", pkg.Name, pkg.BlockHeight),
+			})
+		}
+	}
+
+	// Tokens are found by symbol, or by path for the native coins and realms
+	if searchSymbolPattern.MatchString(query) || searchPathPattern.MatchString(path) {
+		var tokens []model.Token
+		if searchSymbolPattern.MatchString(query) {
+			bySymbol, err := s.repo.GetTokensBySymbol(ctx, query)
+			if err != nil {
+				return nil, s.logger.Errorf("Failed to search tokens with symbol %s: %v", query, err)
+			}
+			tokens = append(tokens, bySymbol...)
+		}
+		token, err := s.repo.GetToken(ctx, path)
+		if err != nil {
+			return nil, s.logger.Errorf("Failed to search token %s: %v", path, err)
+		}
+		if token != nil && !slices.ContainsFunc(tokens, func(t model.Token) bool { return t.Path == token.Path }) {
+			tokens = append(tokens, *token)
+		}
+		for _, token := range tokens {
+			label := "Token " + token.Path
+			if token.Symbol != "" {
+				label = fmt.Sprintf("Token %s (%s)", token.Name, token.Symbol)
+			}
+			results = append(results, model.SearchResult{Type: SearchResultToken, ID: token.Path, Label: label})
+		}
+	}
+
+	return results, nil
+}
+
+func newBlockSearchResult(block model.Block) model.SearchResult {
+	return model.SearchResult{
+		Type:  SearchResultBlock,
+		ID:    strconv.Itoa(block.Height),
+		Label: fmt.Sprintf("Block %d with %d transactions", block.Height, block.NumTxs),
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import "testing"
+
+func TestNormalizeSearchHash(t *testing.T) {
+	const want = "c15NQRD8/MA+aTl71mmhI6j0AmyYDQKVFk4O3wMvUI0="
+	tests := []struct {
+		query string
+		ok    bool
+	}{
+		{want, true},
+		{"c15NQRD8_MA-aTl71mmhI6j0AmyYDQKVFk4O3wMvUI0=", true},
+		{"c15NQRD8/MA+aTl71mmhI6j0AmyYDQKVFk4O3wMvUI0", true},
+		{"735e4d4110fcfcc03e69397bd669a123a8f4026c980d0295164e0edf032f508d", true},
+		{"0x735E4D4110FCFCC03E69397BD669A123A8F4026C980D0295164E0EDF032F508D", true},
+		{"735e4d4110fcfcc03e69397bd669a123", false},
+		{"aGVsbG8=", false},
+		{"gno.land/r/demo/foo20", false},
+	}
+	for _, tt := range tests {
+		got, ok := normalizeSearchHash(tt.query)
+		if ok != tt.ok || (ok && got != want) {
+			t.Errorf("normalizeSearchHash(%q) = %q, %v, want %q, %v", tt.query, got, ok, want, tt.ok)
+		}
+	}
+}
+
+func TestSearchPatterns(t *testing.T) {
+	tests := []struct {
+		query                         string
+		height, address, path, symbol bool
+	}{
+		{"12345", true, false, false, false},
+		{"g1jg8mtutu9khhfwc4nxmuhcpftf0pajdhfvsqf5", false, true, false, false},
+		{"gno.land/r/demo", false, false, true, false},
+		{"gno.land/r/demo/", false, false, true, false},
+		{"ugnot", false, false, false, true},
+		{"FOO20", false, false, false, true},
+		{"cosmos1abc/../x", false, false, false, false},
+	}
+	for _, tt := range tests {
+		height := searchHeightPattern.MatchString(tt.query)
+		address := searchAddressPattern.MatchString(tt.query)
+		path := searchPathPattern.MatchString(tt.query)
+		symbol := searchSymbolPattern.MatchString(tt.query)
+		if height != tt.height || address != tt.address || path != tt.path || symbol != tt.symbol {
+			t.Errorf("%q: height=%v address=%v path=%v symbol=%v, want %v %v %v %v",
+				tt.query, height, address, path, symbol, tt.height, tt.address, tt.path, tt.symbol)
+		}
+	}
+}
+
+func TestNormalizeSearchPath(t *testing.T) {
+	tests := []struct {
+		query, want string
+	}{
+		{"r/demo/foo20", "gno.land/r/demo/foo20"},
+		{"/r/demo/foo20", "gno.land/r/demo/foo20"},
+		{"p/demo/avl", "gno.land/p/demo/avl"},
+		{"/p/demo/", "gno.land/p/demo/"},
+		{"gno.land/r/demo", "gno.land/r/demo"},
+		{"ugnot", "ugnot"},
+		{"rabbit/x", "rabbit/x"},
+		{"/x/demo", "/x/demo"},
+	}
+	for _, tt := range tests {
+		got := normalizeSearchPath(tt.query)
+		if got != tt.want {
+			t.Errorf("normalizeSearchPath(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+		if tt.want != tt.query && !searchPathPattern.MatchString(got) {
+			t.Errorf("normalizeSearchPath(%q) = %q is not a searchable path", tt.query, got)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"gno.land-block-indexer/externals/msgbroker"
 	"gno.land-block-indexer/lib/log"
 	"gno.land-block-indexer/model"
 	"gno.land-block-indexer/repository"
@@ -10,19 +11,71 @@ import (
 
 type Service interface {
 	GetTokenBalances(ctx context.Context, address string) ([]model.TokenBalance, error)
+	GetBalancesAtHeight(ctx context.Context, address string, height int) ([]model.TokenBalance, error)
 	GetTokenAccountBalances(ctx context.Context, tokenPath string, address string) ([]model.Account, error)
-	GetTransferHistory(ctx context.Context, address string) ([]model.Transfer, error)
+	GetTokenHolders(ctx context.Context, tokenPath string, minBalance float64, offset int, limit int) ([]model.TokenHolder, error)
+	GetTokenDistribution(ctx context.Context, tokenPath string) (*model.TokenDistribution, error)
+	GetTransferHistory(ctx context.Context, filter model.TransferFilter, after *model.TransferCursor, limit int) ([]model.Transfer, *model.TransferCursor, error)
+	GetTokens(ctx context.Context, sort string, offset int, limit int) ([]model.Token, error)
+	GetToken(ctx context.Context, path string) (*model.Token, error)
+	GetTokenMetadata(ctx context.Context, paths []string) (map[string]model.Token, error)
+	GetAccountNfts(ctx context.Context, address string, collection string) ([]model.Nft, error)
+	GetNftHistory(ctx context.Context, collection string, tokenID string) ([]model.NftTransfer, error)
+	GetPackages(ctx context.Context, creator string, namespace string, offset int, limit int) ([]model.Package, error)
+	GetPackage(ctx context.Context, path string) (*model.Package, error)
+	GetPackageFile(ctx context.Context, path string, name string) (*model.PackageFile, error)
+	GetRealmCalls(ctx context.Context, filter model.RealmCallFilter, offset int, limit int) ([]model.RealmCall, error)
+	GetEvents(ctx context.Context, filter model.GnoEventFilter, offset int, limit int) ([]model.GnoEvent, error)
+	GetUndecodedEvents(ctx context.Context, fromHeight int, toHeight int, offset int, limit int) ([]model.UndecodedEvents, error)
+	GetAccountTransactions(ctx context.Context, address string, roles []string, offset int, limit int) ([]model.AddressTransaction, error)
+
+	// blocks and transactions
+	GetBlocks(ctx context.Context, offset int, limit int) ([]model.Block, error)
+	GetBlock(ctx context.Context, height int) (*model.Block, error)
+	GetBlockTransactions(ctx context.Context, height int, offset int, limit int) ([]model.Transaction, error)
+	GetTransaction(ctx context.Context, hash string) (*model.Transaction, error)
+	GetTransactions(ctx context.Context, filter model.TransactionFilter, offset int, limit int) ([]model.Transaction, error)
+	GetBlocksByHeights(ctx context.Context, heights []int) ([]model.Block, error)
+	GetTransactionsOfBlocks(ctx context.Context, heights []int) ([]model.Transaction, error)
+	GetTransactionsByHashes(ctx context.Context, hashes []string) ([]model.Transaction, error)
+	GetTransfersOfTransactions(ctx context.Context, hashes []string) ([]model.Transfer, error)
+
+	// search
+	Search(ctx context.Context, query string, limit int) ([]model.SearchResult, error)
+
+	// live streams
+	StartStream(ctx context.Context) error
+	SubscribeStream(ctx context.Context, filter StreamFilter, fromHeight int) (*StreamSubscription, error)
+
+	// exports, streaming the rows to fn one at a time
+	ExportSnapshot(ctx context.Context, fn func(snapshot Service, height int) error) error
+	ExportTransfers(ctx context.Context, filter model.TransferFilter, fn func(transfer model.Transfer) error) error
+	ExportTransactions(ctx context.Context, filter model.TransactionFilter, fn func(tx model.Transaction) error) error
+	ExportBalances(ctx context.Context, filter model.BalanceFilter, fn func(account model.Account) error) error
+	ExportHolders(ctx context.Context, token string, fn func(account model.Account) error) error
+
+	// webhooks
+	CreateWatchRule(ctx context.Context, rule *model.WatchRule, ownerToken string) (*model.WatchRule, string, error)
+	GetWatchRules(ctx context.Context, ownerToken string) ([]model.WatchRule, error)
+	GetWatchRule(ctx context.Context, id int, ownerToken string) (*model.WatchRule, error)
+	DeleteWatchRule(ctx context.Context, id int, ownerToken string) (bool, error)
+	GetWebhookDeliveries(ctx context.Context, ruleID int, status string, offset int, limit int) ([]model.WebhookDelivery, error)
+	ReplayWebhookDelivery(ctx context.Context, id int, ownerToken string) (*model.WebhookDelivery, error)
 }
 
 type service struct {
-	logger log.Logger
-	repo   repository.Repository
+	logger    log.Logger
+	repo      repository.Repository
+	msgBroker msgbroker.MsgBroker // nil when the broker is unreachable, disabling live streams
+	streamHub *streamHub
 }
 
-func NewService(logger log.Logger, repo repository.Repository) Service {
+func NewService(logger log.Logger, repo repository.Repository, msgBroker msgbroker.MsgBroker) Service {
 	return &service{
-		logger: logger,
-		repo:   repo,
+		logger:    logger,
+		repo:      repo,
+		msgBroker: msgBroker,
+		streamHub: newStreamHub(),
 	}
 }
 
@@ -35,6 +88,15 @@ func (s *service) GetTokenBalances(ctx context.Context, address string) ([]model
 	return tokenBalances, nil
 }
 
+// GetBalancesAtHeight implements Service.
+func (s *service) GetBalancesAtHeight(ctx context.Context, address string, height int) ([]model.TokenBalance, error) {
+	balances, err := s.repo.GetBalancesAtHeight(ctx, address, height)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get balances for address %s at height %d: %v", address, height, err)
+	}
+	return balances, nil
+}
+
 // GetTokenBalances implements Service.
 func (s *service) GetTokenAccountBalances(ctx context.Context, token, address string) ([]model.Account, error) {
 	accounts, err := s.repo.GetAccounts(ctx, address, token)
@@ -45,13 +107,164 @@ func (s *service) GetTokenAccountBalances(ctx context.Context, token, address st
 	return accounts, nil
 }
 
+// GetTokenHolders implements Service.
+func (s *service) GetTokenHolders(ctx context.Context, tokenPath string, minBalance float64, offset int, limit int) ([]model.TokenHolder, error) {
+	holders, err := s.repo.GetTokenHolders(ctx, tokenPath, minBalance, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get holders of token %s: %v", tokenPath, err)
+	}
+
+	return holders, nil
+}
+
+// GetTokenDistribution implements Service.
+func (s *service) GetTokenDistribution(ctx context.Context, tokenPath string) (*model.TokenDistribution, error) {
+	distribution, err := s.repo.GetTokenDistribution(ctx, tokenPath)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get distribution of token %s: %v", tokenPath, err)
+	}
+
+	return distribution, nil
+}
+
 // GetTransferHistory implements Service.
-func (s *service) GetTransferHistory(ctx context.Context, address string) ([]model.Transfer, error) {
-	transfers, err := s.repo.GetTransfers(ctx, address, address, "")
+//
+// The returned cursor points at the last transfer of the page, it is nil on the last page.
+func (s *service) GetTransferHistory(ctx context.Context, filter model.TransferFilter, after *model.TransferCursor, limit int) ([]model.Transfer, *model.TransferCursor, error) {
+	// One more transfer tells whether there is a next page
+	transfers, err := s.repo.GetTransfers(ctx, filter, after, limit+1)
+	if err != nil {
+		return nil, nil, s.logger.Errorf("Failed to get transfer history for address %s: %v", filter.Address, err)
+	}
+	if len(transfers) <= limit {
+		return transfers, nil, nil
+	}
+
+	transfers = transfers[:limit]
+	last := transfers[limit-1]
+	return transfers, &model.TransferCursor{BlockHeight: last.BlockHeight, ID: last.ID}, nil
+}
+
+// GetTokens implements Service.
+func (s *service) GetTokens(ctx context.Context, sort string, offset int, limit int) ([]model.Token, error) {
+	tokens, err := s.repo.GetTokens(ctx, sort, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get tokens: %v", err)
+	}
+
+	return tokens, nil
+}
+
+// GetToken implements Service.
+func (s *service) GetToken(ctx context.Context, path string) (*model.Token, error) {
+	token, err := s.repo.GetToken(ctx, path)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get token %s: %v", path, err)
+	}
+
+	return token, nil
+}
+
+// GetTokenMetadata implements Service.
+func (s *service) GetTokenMetadata(ctx context.Context, paths []string) (map[string]model.Token, error) {
+	tokens, err := s.repo.GetTokensByPaths(ctx, paths)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get metadata of tokens %v: %v", paths, err)
+	}
+
+	metadata := make(map[string]model.Token, len(tokens))
+	for _, token := range tokens {
+		metadata[token.Path] = token
+	}
+	return metadata, nil
+}
+
+// GetAccountNfts implements Service.
+func (s *service) GetAccountNfts(ctx context.Context, address string, collection string) ([]model.Nft, error) {
+	nfts, err := s.repo.GetNfts(ctx, address, collection)
 	if err != nil {
-		s.logger.Errorf("Failed to get transfer history for address %s: %v", address, err)
-		return nil, err
+		return nil, s.logger.Errorf("Failed to get nfts for address %s: %v", address, err)
+	}
+
+	return nfts, nil
+}
+
+// GetNftHistory implements Service.
+func (s *service) GetNftHistory(ctx context.Context, collection string, tokenID string) ([]model.NftTransfer, error) {
+	transfers, err := s.repo.GetNftTransfers(ctx, collection, tokenID)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get history of nft %s/%s: %v", collection, tokenID, err)
 	}
 
 	return transfers, nil
 }
+
+// GetPackages implements Service.
+func (s *service) GetPackages(ctx context.Context, creator string, namespace string, offset int, limit int) ([]model.Package, error) {
+	packages, err := s.repo.GetPackages(ctx, creator, namespace, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get packages (creator=%s, namespace=%s): %v", creator, namespace, err)
+	}
+
+	return packages, nil
+}
+
+// GetPackage implements Service.
+func (s *service) GetPackage(ctx context.Context, path string) (*model.Package, error) {
+	pkg, err := s.repo.GetPackage(ctx, path)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get package %s: %v", path, err)
+	}
+
+	return pkg, nil
+}
+
+// GetPackageFile implements Service.
+func (s *service) GetPackageFile(ctx context.Context, path string, name string) (*model.PackageFile, error) {
+	file, err := s.repo.GetPackageFile(ctx, path, name)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get file %s of package %s: %v", name, path, err)
+	}
+
+	return file, nil
+}
+
+// GetRealmCalls implements Service.
+func (s *service) GetRealmCalls(ctx context.Context, filter model.RealmCallFilter, offset int, limit int) ([]model.RealmCall, error) {
+	calls, err := s.repo.GetRealmCalls(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get realm calls (pkg_path=%s, func=%s, caller=%s): %v", filter.PkgPath, filter.Func, filter.Caller, err)
+	}
+
+	return calls, nil
+}
+
+// GetEvents implements Service.
+func (s *service) GetEvents(ctx context.Context, filter model.GnoEventFilter, offset int, limit int) ([]model.GnoEvent, error) {
+	events, err := s.repo.GetGnoEvents(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get events (pkg_path=%s, type=%s): %v", filter.PkgPath, filter.Type, err)
+	}
+
+	return events, nil
+}
+
+// GetUndecodedEvents implements Service.
+func (s *service) GetUndecodedEvents(ctx context.Context, fromHeight int, toHeight int, offset int, limit int) ([]model.UndecodedEvents, error) {
+	undecoded, err := s.repo.GetUndecodedEvents(ctx, fromHeight, toHeight, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get undecoded events: %v", err)
+	}
+
+	return undecoded, nil
+}
+
+// GetAccountTransactions implements Service.
+func (s *service) GetAccountTransactions(ctx context.Context, address string, roles []string, offset int, limit int) ([]model.AddressTransaction, error) {
+	txs, err := s.repo.GetAddressTransactions(ctx, address, roles, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get transactions of %s: %v", address, err)
+	}
+
+	return txs, nil
+}
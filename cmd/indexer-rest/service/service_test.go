@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"gno.land-block-indexer/lib/log"
+	"gno.land-block-indexer/model"
+	"gno.land-block-indexer/repository"
+)
+
+// transferRepository serves GetTransfers from a fixed list, recording the asked limit
+type transferRepository struct {
+	repository.Repository
+	transfers []model.Transfer
+	limit     int
+}
+
+func (r *transferRepository) GetTransfers(ctx context.Context, filter model.TransferFilter, after *model.TransferCursor, limit int) ([]model.Transfer, error) {
+	r.limit = limit
+	if len(r.transfers) > limit {
+		return r.transfers[:limit], nil
+	}
+	return r.transfers, nil
+}
+
+func TestGetTransferHistoryNextCursor(t *testing.T) {
+	transfers := []model.Transfer{
+		{ID: 1, BlockHeight: 10},
+		{ID: 2, BlockHeight: 10},
+		{ID: 3, BlockHeight: 11},
+	}
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+		next  *model.TransferCursor
+	}{
+		{"more than a page", 2, 2, &model.TransferCursor{BlockHeight: 10, ID: 2}},
+		{"exactly a page", 3, 3, nil},
+		{"less than a page", 5, 3, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &transferRepository{transfers: transfers}
+			s := &service{logger: log.NewLogger(), repo: repo}
+
+			got, next, err := s.GetTransferHistory(context.Background(), model.TransferFilter{}, nil, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if repo.limit != tt.limit+1 {
+				t.Errorf("repository asked for %d transfers, want %d", repo.limit, tt.limit+1)
+			}
+			if len(got) != tt.want {
+				t.Errorf("got %d transfers, want %d", len(got), tt.want)
+			}
+			if (next == nil) != (tt.next == nil) || (next != nil && *next != *tt.next) {
+				t.Errorf("next cursor = %+v, want %+v", next, tt.next)
+			}
+		})
+	}
+}
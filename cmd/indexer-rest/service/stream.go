@@ -0,0 +1,406 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"gno.land-block-indexer/externals/msgbroker"
+	"gno.land-block-indexer/model"
+)
+
+// indexedBlock is an indexed block loaded from the database with its transactions and transfers
+type indexedBlock struct {
+	Block        *model.Block
+	Transactions []model.Transaction
+	Transfers    []model.Transfer
+}
+
+const (
+	TOPIC_INDEXED_BLOCK = "indexed_block" // Blocks committed by the event processor
+
+	STREAM_EVENT_BLOCK       = "block"
+	STREAM_EVENT_TRANSACTION = "transaction"
+	STREAM_EVENT_TRANSFER    = "transfer"
+
+	STREAM_BUFFER_SIZE       = 1024 // Events buffered per subscriber before it is dropped as too slow
+	STREAM_MAX_RESUME_BLOCKS = 1000 // Most blocks replayed from the database when resuming
+)
+
+var (
+	// ErrStreamUnavailable is returned when the message broker could not be reached at startup
+	ErrStreamUnavailable = errors.New("live stream unavailable")
+	// ErrResumeTooFar is returned when resuming would replay more than STREAM_MAX_RESUME_BLOCKS blocks
+	ErrResumeTooFar = errors.New("resume height too far behind")
+)
+
+// StreamFilter selects the events of a subscriber, empty fields are not filtered on.
+// Address and token select transfers, and the transactions having such a transfer.
+type StreamFilter struct {
+	Types   map[string]bool // Event types to receive, all if empty
+	Address string          // Sender or receiver of the transfers
+	Token   string          // Token of the transfers
+}
+
+// StreamEvent is a block, transaction or transfer pushed to subscribers
+type StreamEvent struct {
+	Type        string
+	Height      int
+	Block       *model.Block
+	Transaction *model.Transaction
+	Transfer    *model.Transfer
+
+	blockEnd bool // Follows the events of the block at Height, marking it delivered
+}
+
+// StreamSubscription receives the events of indexed blocks until closed. Events
+// is closed when the subscription ends, Overflowed telling whether the subscriber
+// was dropped for not keeping up and ResumeHeight where to resume from.
+type StreamSubscription struct {
+	Events <-chan StreamEvent
+
+	hub        *streamHub
+	filter     StreamFilter
+	live       chan StreamEvent
+	overflowed bool
+	closeOnce  sync.Once
+	done       chan struct{}
+
+	// Blocks are committed out of order, so the delivered heights above the lowest
+	// undelivered one are kept until the heights below them are delivered too
+	mu            sync.Mutex
+	startHeight   int // Height of the first block, from which the delivered ones are tracked
+	resumeHeight  int // Lowest height not delivered yet, -1 until the first block
+	delivered     map[int]bool
+	dropped       bool
+	droppedHeight int // Height of the block which did not fit in the buffer
+}
+
+// Overflowed tells whether the subscription was dropped because its buffer was full
+func (s *StreamSubscription) Overflowed() bool {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	return s.overflowed
+}
+
+// ResumeHeight returns the lowest height whose events were not all delivered, from
+// which a new subscription misses nothing; it is -1 until the first block arrives.
+// Blocks above it may be delivered again when resuming.
+func (s *StreamSubscription) ResumeHeight() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dropped && (s.resumeHeight < 0 || s.droppedHeight < s.resumeHeight) {
+		return s.droppedHeight
+	}
+	return s.resumeHeight
+}
+
+// drop records the height of the block which overflowed the buffer
+func (s *StreamSubscription) drop(height int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dropped = true
+	s.droppedHeight = height
+}
+
+// startAt sets the resume height if no block arrived yet
+func (s *StreamSubscription) startAt(height int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.resumeHeight < 0 {
+		s.startHeight = height
+		s.resumeHeight = height
+	}
+}
+
+// wasDelivered tells whether all the events of the block at height were delivered,
+// e.g. when a block is announced again
+func (s *StreamSubscription) wasDelivered(height int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return (height >= s.startHeight && height < s.resumeHeight) || s.delivered[height]
+}
+
+// markDelivered records that all the events of the block at height were delivered
+func (s *StreamSubscription) markDelivered(height int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if height < s.resumeHeight {
+		return
+	}
+	if s.delivered == nil {
+		s.delivered = make(map[int]bool)
+	}
+	s.delivered[height] = true
+	for s.delivered[s.resumeHeight] {
+		delete(s.delivered, s.resumeHeight)
+		s.resumeHeight++
+	}
+}
+
+// Close ends the subscription
+func (s *StreamSubscription) Close() {
+	s.closeOnce.Do(func() {
+		s.hub.remove(s)
+		close(s.done)
+	})
+}
+
+// streamHub fans the indexed blocks out to the subscribers
+type streamHub struct {
+	mu          sync.Mutex
+	subscribers map[*StreamSubscription]struct{}
+}
+
+func newStreamHub() *streamHub {
+	return &streamHub{subscribers: make(map[*StreamSubscription]struct{})}
+}
+
+func (h *streamHub) add(sub *StreamSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscribers[sub] = struct{}{}
+}
+
+func (h *streamHub) remove(sub *StreamSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subscribers[sub]; ok {
+		delete(h.subscribers, sub)
+		close(sub.live)
+	}
+}
+
+// publish queues the events of a block for every subscriber. A block is queued
+// whole or not at all: subscribers without room for it are dropped so they can
+// resume from the database instead of slowing down the others.
+func (h *streamHub) publish(block indexedBlock) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subscribers {
+		events := streamEvents(sub.filter, block.Block, block.Transactions, block.Transfers)
+		events = append(events, StreamEvent{Height: block.Block.Height, blockEnd: true})
+		if cap(sub.live)-len(sub.live) < len(events) {
+			// The block is not delivered, resuming must not skip it
+			sub.drop(block.Block.Height)
+			sub.overflowed = true
+			delete(h.subscribers, sub)
+			close(sub.live)
+			continue
+		}
+		for _, event := range events {
+			sub.live <- event
+		}
+	}
+}
+
+// streamEvents returns the events of a block matching the filter, in chain order
+func streamEvents(filter StreamFilter, block *model.Block, txs []model.Transaction, transfers []model.Transfer) []StreamEvent {
+	wants := func(eventType string) bool {
+		return len(filter.Types) == 0 || filter.Types[eventType]
+	}
+	matchTransfer := func(transfer *model.Transfer) bool {
+		if filter.Address != "" && transfer.FromAddress != filter.Address && transfer.ToAddress != filter.Address {
+			return false
+		}
+		return filter.Token == "" || transfer.Token == filter.Token
+	}
+
+	txTransfers := make(map[string][]*model.Transfer)
+	for i := range transfers {
+		if matchTransfer(&transfers[i]) {
+			txTransfers[transfers[i].Hash] = append(txTransfers[transfers[i].Hash], &transfers[i])
+		}
+	}
+
+	var events []StreamEvent
+	if block != nil && wants(STREAM_EVENT_BLOCK) {
+		events = append(events, StreamEvent{Type: STREAM_EVENT_BLOCK, Height: block.Height, Block: block})
+	}
+	for i := range txs {
+		tx := &txs[i]
+		matched := txTransfers[tx.Hash]
+		if (filter.Address != "" || filter.Token != "") && len(matched) == 0 {
+			continue
+		}
+		if wants(STREAM_EVENT_TRANSACTION) {
+			events = append(events, StreamEvent{Type: STREAM_EVENT_TRANSACTION, Height: tx.BlockHeight, Transaction: tx})
+		}
+		if wants(STREAM_EVENT_TRANSFER) {
+			for _, transfer := range matched {
+				events = append(events, StreamEvent{Type: STREAM_EVENT_TRANSFER, Height: tx.BlockHeight, Transfer: transfer})
+			}
+		}
+	}
+	return events
+}
+
+// StartStream implements Service.
+func (s *service) StartStream(ctx context.Context) error {
+	if s.msgBroker == nil {
+		return ErrStreamUnavailable
+	}
+
+	// Announcements only name the blocks, which are loaded from the database
+	err := s.msgBroker.Subscribe(TOPIC_INDEXED_BLOCK, func(message []byte) error {
+		var announced msgbroker.IndexedBlock
+		if err := json.Unmarshal(message, &announced); err != nil {
+			return s.logger.Errorf("Failed to unmarshal indexed block: %v", err)
+		}
+		blocks, err := s.loadIndexedBlocks(ctx, announced.Height, announced.Height)
+		if err != nil {
+			return err
+		}
+		if len(blocks) == 0 {
+			return s.logger.Errorf("Indexed block %d not found", announced.Height)
+		}
+		s.streamHub.publish(blocks[0])
+		return nil
+	})
+	if err != nil {
+		return s.logger.Errorf("Failed to subscribe to topic %s: %v", TOPIC_INDEXED_BLOCK, err)
+	}
+
+	return nil
+}
+
+// SubscribeStream implements Service.
+//
+// With a positive fromHeight the blocks from that height to the highest indexed
+// one are replayed from the database before the live events, which skip the
+// replayed heights and the ones below fromHeight. Live blocks are compared with
+// the set of replayed heights rather than the highest one, since blocks below it
+// may be committed after the replay.
+func (s *service) SubscribeStream(ctx context.Context, filter StreamFilter, fromHeight int) (*StreamSubscription, error) {
+	if s.msgBroker == nil {
+		return nil, ErrStreamUnavailable
+	}
+
+	// Subscribe before reading the database so no block falls in between
+	sub := &StreamSubscription{
+		hub:          s.streamHub,
+		filter:       filter,
+		live:         make(chan StreamEvent, STREAM_BUFFER_SIZE),
+		done:         make(chan struct{}),
+		resumeHeight: -1,
+	}
+	s.streamHub.add(sub)
+
+	var replayed []StreamEvent
+	var replayedHeights map[int]bool
+	if fromHeight > 0 {
+		sub.startAt(fromHeight)
+		var err error
+		replayed, replayedHeights, err = s.replayStream(ctx, filter, fromHeight)
+		if err != nil {
+			sub.Close()
+			return nil, err
+		}
+	}
+
+	events := make(chan StreamEvent)
+	sub.Events = events
+	go func() {
+		defer close(events)
+		send := func(event StreamEvent) bool {
+			sub.startAt(event.Height)
+			if event.blockEnd {
+				sub.markDelivered(event.Height)
+				return true
+			}
+			select {
+			case events <- event:
+				return true
+			case <-sub.done:
+				return false
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, event := range replayed {
+			if !send(event) {
+				return
+			}
+		}
+		for event := range sub.live {
+			if replayedHeights[event.Height] || event.Height < fromHeight || sub.wasDelivered(event.Height) {
+				continue
+			}
+			if !send(event) {
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+// replayStream returns the events of the indexed blocks from the height on, and
+// the heights of the replayed blocks
+func (s *service) replayStream(ctx context.Context, filter StreamFilter, fromHeight int) ([]StreamEvent, map[int]bool, error) {
+	highest, err := s.repo.GetHighestBlock(ctx)
+	if err != nil {
+		return nil, nil, s.logger.Errorf("Failed to get highest block: %v", err)
+	}
+	if highest.Height < fromHeight {
+		return nil, nil, nil
+	}
+	if highest.Height-fromHeight >= STREAM_MAX_RESUME_BLOCKS {
+		return nil, nil, ErrResumeTooFar
+	}
+
+	blocks, err := s.loadIndexedBlocks(ctx, fromHeight, highest.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []StreamEvent
+	heights := make(map[int]bool, len(blocks))
+	for _, block := range blocks {
+		height := block.Block.Height
+		events = append(events, streamEvents(filter, block.Block, block.Transactions, block.Transfers)...)
+		events = append(events, StreamEvent{Height: height, blockEnd: true})
+		heights[height] = true
+	}
+	return events, heights, nil
+}
+
+// loadIndexedBlocks loads the indexed blocks of a height range with their transactions and transfers
+func (s *service) loadIndexedBlocks(ctx context.Context, fromHeight int, toHeight int) ([]indexedBlock, error) {
+	blocks, err := s.repo.GetBlocksInRange(ctx, fromHeight, toHeight)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get blocks %d to %d: %v", fromHeight, toHeight, err)
+	}
+	txs, err := s.repo.GetTransactionsInRange(ctx, fromHeight, toHeight)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get transactions of blocks %d to %d: %v", fromHeight, toHeight, err)
+	}
+	var transfers []model.Transfer
+	if len(txs) > 0 {
+		hashes := make([]string, len(txs))
+		for i, tx := range txs {
+			hashes[i] = tx.Hash
+		}
+		if transfers, err = s.repo.GetTransfersByHashes(ctx, hashes); err != nil {
+			return nil, s.logger.Errorf("Failed to get transfers of blocks %d to %d: %v", fromHeight, toHeight, err)
+		}
+	}
+
+	blockTxs := make(map[int][]model.Transaction)
+	for _, tx := range txs {
+		blockTxs[tx.BlockHeight] = append(blockTxs[tx.BlockHeight], tx)
+	}
+	blockTransfers := make(map[int][]model.Transfer)
+	for _, transfer := range transfers {
+		blockTransfers[transfer.BlockHeight] = append(blockTransfers[transfer.BlockHeight], transfer)
+	}
+
+	indexed := make([]indexedBlock, len(blocks))
+	for i := range blocks {
+		height := blocks[i].Height
+		indexed[i] = indexedBlock{Block: &blocks[i], Transactions: blockTxs[height], Transfers: blockTransfers[height]}
+	}
+	return indexed, nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func testIndexedBlock(height int) indexedBlock {
+	return indexedBlock{
+		Block: &model.Block{Height: height, NumTxs: 2},
+		Transactions: []model.Transaction{
+			{Hash: "tx1", BlockHeight: height, Index: 0},
+			{Hash: "tx2", BlockHeight: height, Index: 1},
+		},
+		Transfers: []model.Transfer{
+			{Hash: "tx1", BlockHeight: height, FromAddress: "g1alice", ToAddress: "g1bob", Token: "ugnot", Amount: 10},
+			{Hash: "tx2", BlockHeight: height, FromAddress: "g1carol", ToAddress: "g1dave", Token: "gno.land/r/demo/foo20", Amount: 20},
+		},
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	block := testIndexedBlock(7)
+	tests := []struct {
+		name   string
+		filter StreamFilter
+		want   []string // type:hash of the events, the block having no hash
+	}{
+		{
+			name:   "everything",
+			filter: StreamFilter{},
+			want:   []string{"block:", "transaction:tx1", "transfer:tx1", "transaction:tx2", "transfer:tx2"},
+		},
+		{
+			name:   "address",
+			filter: StreamFilter{Address: "g1bob"},
+			want:   []string{"block:", "transaction:tx1", "transfer:tx1"},
+		},
+		{
+			name:   "token transfers only",
+			filter: StreamFilter{Token: "gno.land/r/demo/foo20", Types: map[string]bool{STREAM_EVENT_TRANSFER: true}},
+			want:   []string{"transfer:tx2"},
+		},
+		{
+			name:   "no matching transfer",
+			filter: StreamFilter{Address: "g1nobody", Types: map[string]bool{STREAM_EVENT_TRANSACTION: true}},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := streamEvents(tt.filter, block.Block, block.Transactions, block.Transfers)
+			var got []string
+			for _, event := range events {
+				if event.Height != 7 {
+					t.Errorf("event %s has height %d, want 7", event.Type, event.Height)
+				}
+				hash := ""
+				if event.Transaction != nil {
+					hash = event.Transaction.Hash
+				} else if event.Transfer != nil {
+					hash = event.Transfer.Hash
+				}
+				got = append(got, event.Type+":"+hash)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("streamEvents() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("streamEvents() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestStreamHubDropsSlowSubscribers(t *testing.T) {
+	hub := newStreamHub()
+	newSub := func(size int) *StreamSubscription {
+		sub := &StreamSubscription{
+			hub:          hub,
+			live:         make(chan StreamEvent, size),
+			done:         make(chan struct{}),
+			resumeHeight: -1,
+		}
+		hub.add(sub)
+		return sub
+	}
+	// A block yields 5 events and its end marker: the slow subscriber only has room for one block
+	fast := newSub(100)
+	slow := newSub(8)
+
+	hub.publish(testIndexedBlock(1))
+	hub.publish(testIndexedBlock(2))
+
+	if fast.Overflowed() || len(fast.live) != 12 {
+		t.Errorf("fast subscriber overflowed = %v with %d events, want false with 12", fast.Overflowed(), len(fast.live))
+	}
+	if !slow.Overflowed() {
+		t.Fatalf("slow subscriber was not dropped")
+	}
+	var heights []int
+	for event := range slow.live {
+		heights = append(heights, event.Height)
+	}
+	if len(heights) != 6 || heights[5] != 1 {
+		t.Errorf("slow subscriber received heights %v, want the 6 events of block 1", heights)
+	}
+	if height := slow.ResumeHeight(); height != 2 {
+		t.Errorf("slow subscriber resumes from %d, want 2", height)
+	}
+
+	// Closing a dropped subscription must not close its channel twice
+	slow.Close()
+	fast.Close()
+	if _, ok := <-fast.live; !ok {
+		t.Errorf("fast subscriber lost its queued events on close")
+	}
+}
+
+func TestStreamSubscriptionResumeHeight(t *testing.T) {
+	sub := &StreamSubscription{resumeHeight: -1}
+	if height := sub.ResumeHeight(); height != -1 {
+		t.Errorf("ResumeHeight() = %d before any block, want -1", height)
+	}
+
+	// Blocks 10 and 12 are delivered while 11 is still being committed
+	sub.startAt(10)
+	sub.markDelivered(10)
+	sub.markDelivered(12)
+	if height := sub.ResumeHeight(); height != 11 {
+		t.Errorf("ResumeHeight() = %d with 11 missing, want 11", height)
+	}
+	sub.markDelivered(11)
+	if height := sub.ResumeHeight(); height != 13 {
+		t.Errorf("ResumeHeight() = %d after 11 arrived, want 13", height)
+	}
+
+	// Announced again, delivered blocks are skipped, unlike the ones before the first block
+	if !sub.wasDelivered(10) || !sub.wasDelivered(12) || sub.wasDelivered(13) || sub.wasDelivered(9) {
+		t.Errorf("wasDelivered(10, 12, 13, 9) = %v, %v, %v, %v, want true, true, false, false",
+			sub.wasDelivered(10), sub.wasDelivered(12), sub.wasDelivered(13), sub.wasDelivered(9))
+	}
+
+	// A block dropped below the delivered ones is resumed from
+	sub.markDelivered(14)
+	sub.drop(13)
+	if height := sub.ResumeHeight(); height != 13 {
+		t.Errorf("ResumeHeight() = %d after dropping 13, want 13", height)
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"gno.land-block-indexer/externals/webhook"
+	"gno.land-block-indexer/model"
+)
+
+// ErrWatchRuleURL is returned when the URL of a watch rule does not resolve to a public address
+var ErrWatchRuleURL = errors.New("url must resolve to a public address")
+
+// CreateWatchRule implements Service.
+//
+// Webhooks are posted from inside the indexer network, so URLs resolving to
+// loopback, private or link-local addresses are rejected. The rule belongs to the
+// owner token, generated if empty and returned, which is required to manage it.
+func (s *service) CreateWatchRule(ctx context.Context, rule *model.WatchRule, ownerToken string) (*model.WatchRule, string, error) {
+	target, err := url.Parse(rule.URL)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrWatchRuleURL, err)
+	}
+	if err := webhook.CheckHost(ctx, target.Hostname()); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrWatchRuleURL, err)
+	}
+
+	if rule.Secret == "" {
+		if rule.Secret, err = randomToken(); err != nil {
+			return nil, "", s.logger.Errorf("Failed to generate webhook secret: %v", err)
+		}
+	}
+	if ownerToken == "" {
+		if ownerToken, err = randomToken(); err != nil {
+			return nil, "", s.logger.Errorf("Failed to generate owner token: %v", err)
+		}
+	}
+	rule.OwnerHash = hashOwnerToken(ownerToken)
+
+	created, err := s.repo.AddWatchRule(ctx, rule)
+	if err != nil {
+		return nil, "", s.logger.Errorf("Failed to create watch rule for %s: %v", rule.URL, err)
+	}
+
+	return created, ownerToken, nil
+}
+
+// GetWatchRules implements Service.
+func (s *service) GetWatchRules(ctx context.Context, ownerToken string) ([]model.WatchRule, error) {
+	rules, err := s.repo.GetWatchRulesByOwner(ctx, hashOwnerToken(ownerToken))
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get watch rules: %v", err)
+	}
+
+	return rules, nil
+}
+
+// GetWatchRule implements Service.
+//
+// Rules of other owners are not found, so their existence is not disclosed.
+func (s *service) GetWatchRule(ctx context.Context, id int, ownerToken string) (*model.WatchRule, error) {
+	rule, err := s.repo.GetWatchRule(ctx, id)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get watch rule %d: %v", id, err)
+	}
+	if rule == nil || !ownsWatchRule(rule, ownerToken) {
+		return nil, nil
+	}
+
+	return rule, nil
+}
+
+// DeleteWatchRule implements Service.
+func (s *service) DeleteWatchRule(ctx context.Context, id int, ownerToken string) (bool, error) {
+	rule, err := s.GetWatchRule(ctx, id, ownerToken)
+	if err != nil || rule == nil {
+		return false, err
+	}
+
+	deleted, err := s.repo.DeleteWatchRule(ctx, id)
+	if err != nil {
+		return false, s.logger.Errorf("Failed to delete watch rule %d: %v", id, err)
+	}
+
+	return deleted, nil
+}
+
+// GetWebhookDeliveries implements Service.
+func (s *service) GetWebhookDeliveries(ctx context.Context, ruleID int, status string, offset int, limit int) ([]model.WebhookDelivery, error) {
+	deliveries, err := s.repo.GetWebhookDeliveries(ctx, ruleID, status, offset, limit)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get deliveries of watch rule %d: %v", ruleID, err)
+	}
+
+	return deliveries, nil
+}
+
+// ReplayWebhookDelivery implements Service.
+func (s *service) ReplayWebhookDelivery(ctx context.Context, id int, ownerToken string) (*model.WebhookDelivery, error) {
+	delivery, err := s.repo.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get webhook delivery %d: %v", id, err)
+	}
+	if delivery == nil {
+		return nil, nil
+	}
+	if rule, err := s.GetWatchRule(ctx, delivery.RuleID, ownerToken); err != nil || rule == nil {
+		return nil, err
+	}
+
+	replayed, err := s.repo.ReplayWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to replay webhook delivery %d: %v", id, err)
+	}
+	if !replayed {
+		return nil, nil
+	}
+
+	delivery, err = s.repo.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, s.logger.Errorf("Failed to get webhook delivery %d: %v", id, err)
+	}
+
+	return delivery, nil
+}
+
+// randomToken returns 32 random bytes, hex encoded
+func randomToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// hashOwnerToken returns the hash of an owner token, as stored with the rules
+func hashOwnerToken(ownerToken string) string {
+	sum := sha256.Sum256([]byte(ownerToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// ownsWatchRule tells whether the owner token manages the rule. Rules created
+// before owners were introduced have none and cannot be managed.
+func ownsWatchRule(rule *model.WatchRule, ownerToken string) bool {
+	if rule.OwnerHash == "" || ownerToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(rule.OwnerHash), []byte(hashOwnerToken(ownerToken))) == 1
+}
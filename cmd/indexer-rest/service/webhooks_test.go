@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+
+	"gno.land-block-indexer/model"
+)
+
+func TestOwnsWatchRule(t *testing.T) {
+	rule := &model.WatchRule{OwnerHash: hashOwnerToken("owner")}
+
+	tests := []struct {
+		name       string
+		rule       *model.WatchRule
+		ownerToken string
+		want       bool
+	}{
+		{"owner", rule, "owner", true},
+		{"other owner", rule, "other", false},
+		{"no token", rule, "", false},
+		{"rule without owner", &model.WatchRule{}, "", false},
+		{"rule without owner and a token", &model.WatchRule{}, "owner", false},
+	}
+	for _, tt := range tests {
+		if got := ownsWatchRule(tt.rule, tt.ownerToken); got != tt.want {
+			t.Errorf("%s: ownsWatchRule() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
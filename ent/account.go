@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -17,10 +18,10 @@ type Account struct {
 	// ID of the ent.
 	// Address of the account
 	ID string `json:"id,omitempty"`
-	// Token associated with the account
-	Token string `json:"token,omitempty"`
-	// Amount of the token in the account
-	Amount float64 `json:"amount,omitempty"`
+	// Height of the block in which the address was first seen
+	FirstSeenHeight int `json:"first_seen_height,omitempty"`
+	// Creation time of the account
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AccountQuery when eager-loading is set.
 	Edges        AccountEdges `json:"edges"`
@@ -33,9 +34,11 @@ type AccountEdges struct {
 	TransfersTo []*Transfer `json:"transfers_to,omitempty"`
 	// TransfersFrom holds the value of the transfers_from edge.
 	TransfersFrom []*Transfer `json:"transfers_from,omitempty"`
+	// Holdings holds the value of the holdings edge.
+	Holdings []*Holding `json:"holdings,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TransfersToOrErr returns the TransfersTo value or an error if the edge
@@ -56,15 +59,26 @@ func (e AccountEdges) TransfersFromOrErr() ([]*Transfer, error) {
 	return nil, &NotLoadedError{edge: "transfers_from"}
 }
 
+// HoldingsOrErr returns the Holdings value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) HoldingsOrErr() ([]*Holding, error) {
+	if e.loadedTypes[2] {
+		return e.Holdings, nil
+	}
+	return nil, &NotLoadedError{edge: "holdings"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Account) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case account.FieldAmount:
-			values[i] = new(sql.NullFloat64)
-		case account.FieldID, account.FieldToken:
+		case account.FieldFirstSeenHeight:
+			values[i] = new(sql.NullInt64)
+		case account.FieldID:
 			values[i] = new(sql.NullString)
+		case account.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -86,17 +100,17 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ID = value.String
 			}
-		case account.FieldToken:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field token", values[i])
+		case account.FieldFirstSeenHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen_height", values[i])
 			} else if value.Valid {
-				_m.Token = value.String
+				_m.FirstSeenHeight = int(value.Int64)
 			}
-		case account.FieldAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
-				return fmt.Errorf("unexpected type %T for field amount", values[i])
+		case account.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
-				_m.Amount = value.Float64
+				_m.CreatedAt = value.Time
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -121,6 +135,11 @@ func (_m *Account) QueryTransfersFrom() *TransferQuery {
 	return NewAccountClient(_m.config).QueryTransfersFrom(_m)
 }
 
+// QueryHoldings queries the "holdings" edge of the Account entity.
+func (_m *Account) QueryHoldings() *HoldingQuery {
+	return NewAccountClient(_m.config).QueryHoldings(_m)
+}
+
 // Update returns a builder for updating this Account.
 // Note that you need to call Account.Unwrap() before calling this method if this Account
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -144,11 +163,11 @@ func (_m *Account) String() string {
 	var builder strings.Builder
 	builder.WriteString("Account(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
-	builder.WriteString("token=")
-	builder.WriteString(_m.Token)
+	builder.WriteString("first_seen_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FirstSeenHeight))
 	builder.WriteString(", ")
-	builder.WriteString("amount=")
-	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
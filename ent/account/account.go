@@ -3,6 +3,8 @@
 package account
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,16 +14,20 @@ const (
 	Label = "account"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "address"
-	// FieldToken holds the string denoting the token field in the database.
-	FieldToken = "token"
-	// FieldAmount holds the string denoting the amount field in the database.
-	FieldAmount = "amount"
+	// FieldFirstSeenHeight holds the string denoting the first_seen_height field in the database.
+	FieldFirstSeenHeight = "first_seen_height"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// EdgeTransfersTo holds the string denoting the transfers_to edge name in mutations.
 	EdgeTransfersTo = "transfers_to"
 	// EdgeTransfersFrom holds the string denoting the transfers_from edge name in mutations.
 	EdgeTransfersFrom = "transfers_from"
+	// EdgeHoldings holds the string denoting the holdings edge name in mutations.
+	EdgeHoldings = "holdings"
 	// TransferFieldID holds the string denoting the ID field of the Transfer.
 	TransferFieldID = "id"
+	// HoldingFieldID holds the string denoting the ID field of the Holding.
+	HoldingFieldID = "id"
 	// Table holds the table name of the account in the database.
 	Table = "accounts"
 	// TransfersToTable is the table that holds the transfers_to relation/edge.
@@ -38,13 +44,20 @@ const (
 	TransfersFromInverseTable = "transfers"
 	// TransfersFromColumn is the table column denoting the transfers_from relation/edge.
 	TransfersFromColumn = "from_address"
+	// HoldingsTable is the table that holds the holdings relation/edge.
+	HoldingsTable = "holdings"
+	// HoldingsInverseTable is the table name for the Holding entity.
+	// It exists in this package in order to avoid circular dependency with the "holding" package.
+	HoldingsInverseTable = "holdings"
+	// HoldingsColumn is the table column denoting the holdings relation/edge.
+	HoldingsColumn = "address"
 )
 
 // Columns holds all SQL columns for account fields.
 var Columns = []string{
 	FieldID,
-	FieldToken,
-	FieldAmount,
+	FieldFirstSeenHeight,
+	FieldCreatedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -58,8 +71,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
-	TokenValidator func(string) error
+	// DefaultFirstSeenHeight holds the default value on creation for the "first_seen_height" field.
+	DefaultFirstSeenHeight int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -72,14 +87,14 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
-// ByToken orders the results by the token field.
-func ByToken(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldToken, opts...).ToFunc()
+// ByFirstSeenHeight orders the results by the first_seen_height field.
+func ByFirstSeenHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeenHeight, opts...).ToFunc()
 }
 
-// ByAmount orders the results by the amount field.
-func ByAmount(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
 // ByTransfersToCount orders the results by transfers_to count.
@@ -109,6 +124,20 @@ func ByTransfersFrom(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTransfersFromStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByHoldingsCount orders the results by holdings count.
+func ByHoldingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHoldingsStep(), opts...)
+	}
+}
+
+// ByHoldings orders the results by holdings terms.
+func ByHoldings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHoldingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTransfersToStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -123,3 +152,10 @@ func newTransfersFromStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TransfersFromTable, TransfersFromColumn),
 	)
 }
+func newHoldingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HoldingsInverseTable, HoldingFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HoldingsTable, HoldingsColumn),
+	)
+}
@@ -3,6 +3,8 @@
 package account
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"gno.land-block-indexer/ent/predicate"
@@ -63,119 +65,94 @@ func IDContainsFold(id string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldID, id))
 }
 
-// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
-func Token(v string) predicate.Account {
-	return predicate.Account(sql.FieldEQ(FieldToken, v))
-}
-
-// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v float64) predicate.Account {
-	return predicate.Account(sql.FieldEQ(FieldAmount, v))
-}
-
-// TokenEQ applies the EQ predicate on the "token" field.
-func TokenEQ(v string) predicate.Account {
-	return predicate.Account(sql.FieldEQ(FieldToken, v))
-}
-
-// TokenNEQ applies the NEQ predicate on the "token" field.
-func TokenNEQ(v string) predicate.Account {
-	return predicate.Account(sql.FieldNEQ(FieldToken, v))
+// FirstSeenHeight applies equality check predicate on the "first_seen_height" field. It's identical to FirstSeenHeightEQ.
+func FirstSeenHeight(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldFirstSeenHeight, v))
 }
 
-// TokenIn applies the In predicate on the "token" field.
-func TokenIn(vs ...string) predicate.Account {
-	return predicate.Account(sql.FieldIn(FieldToken, vs...))
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
 }
 
-// TokenNotIn applies the NotIn predicate on the "token" field.
-func TokenNotIn(vs ...string) predicate.Account {
-	return predicate.Account(sql.FieldNotIn(FieldToken, vs...))
+// FirstSeenHeightEQ applies the EQ predicate on the "first_seen_height" field.
+func FirstSeenHeightEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldFirstSeenHeight, v))
 }
 
-// TokenGT applies the GT predicate on the "token" field.
-func TokenGT(v string) predicate.Account {
-	return predicate.Account(sql.FieldGT(FieldToken, v))
+// FirstSeenHeightNEQ applies the NEQ predicate on the "first_seen_height" field.
+func FirstSeenHeightNEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldFirstSeenHeight, v))
 }
 
-// TokenGTE applies the GTE predicate on the "token" field.
-func TokenGTE(v string) predicate.Account {
-	return predicate.Account(sql.FieldGTE(FieldToken, v))
+// FirstSeenHeightIn applies the In predicate on the "first_seen_height" field.
+func FirstSeenHeightIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldFirstSeenHeight, vs...))
 }
 
-// TokenLT applies the LT predicate on the "token" field.
-func TokenLT(v string) predicate.Account {
-	return predicate.Account(sql.FieldLT(FieldToken, v))
+// FirstSeenHeightNotIn applies the NotIn predicate on the "first_seen_height" field.
+func FirstSeenHeightNotIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldFirstSeenHeight, vs...))
 }
 
-// TokenLTE applies the LTE predicate on the "token" field.
-func TokenLTE(v string) predicate.Account {
-	return predicate.Account(sql.FieldLTE(FieldToken, v))
+// FirstSeenHeightGT applies the GT predicate on the "first_seen_height" field.
+func FirstSeenHeightGT(v int) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldFirstSeenHeight, v))
 }
 
-// TokenContains applies the Contains predicate on the "token" field.
-func TokenContains(v string) predicate.Account {
-	return predicate.Account(sql.FieldContains(FieldToken, v))
+// FirstSeenHeightGTE applies the GTE predicate on the "first_seen_height" field.
+func FirstSeenHeightGTE(v int) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldFirstSeenHeight, v))
 }
 
-// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
-func TokenHasPrefix(v string) predicate.Account {
-	return predicate.Account(sql.FieldHasPrefix(FieldToken, v))
+// FirstSeenHeightLT applies the LT predicate on the "first_seen_height" field.
+func FirstSeenHeightLT(v int) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldFirstSeenHeight, v))
 }
 
-// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
-func TokenHasSuffix(v string) predicate.Account {
-	return predicate.Account(sql.FieldHasSuffix(FieldToken, v))
+// FirstSeenHeightLTE applies the LTE predicate on the "first_seen_height" field.
+func FirstSeenHeightLTE(v int) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldFirstSeenHeight, v))
 }
 
-// TokenEqualFold applies the EqualFold predicate on the "token" field.
-func TokenEqualFold(v string) predicate.Account {
-	return predicate.Account(sql.FieldEqualFold(FieldToken, v))
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
 }
 
-// TokenContainsFold applies the ContainsFold predicate on the "token" field.
-func TokenContainsFold(v string) predicate.Account {
-	return predicate.Account(sql.FieldContainsFold(FieldToken, v))
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldCreatedAt, v))
 }
 
-// AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v float64) predicate.Account {
-	return predicate.Account(sql.FieldEQ(FieldAmount, v))
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldCreatedAt, vs...))
 }
 
-// AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v float64) predicate.Account {
-	return predicate.Account(sql.FieldNEQ(FieldAmount, v))
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldCreatedAt, vs...))
 }
 
-// AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...float64) predicate.Account {
-	return predicate.Account(sql.FieldIn(FieldAmount, vs...))
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldCreatedAt, v))
 }
 
-// AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...float64) predicate.Account {
-	return predicate.Account(sql.FieldNotIn(FieldAmount, vs...))
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldCreatedAt, v))
 }
 
-// AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v float64) predicate.Account {
-	return predicate.Account(sql.FieldGT(FieldAmount, v))
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldCreatedAt, v))
 }
 
-// AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v float64) predicate.Account {
-	return predicate.Account(sql.FieldGTE(FieldAmount, v))
-}
-
-// AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v float64) predicate.Account {
-	return predicate.Account(sql.FieldLT(FieldAmount, v))
-}
-
-// AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v float64) predicate.Account {
-	return predicate.Account(sql.FieldLTE(FieldAmount, v))
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldCreatedAt, v))
 }
 
 // HasTransfersTo applies the HasEdge predicate on the "transfers_to" edge.
@@ -224,6 +201,29 @@ func HasTransfersFromWith(preds ...predicate.Transfer) predicate.Account {
 	})
 }
 
+// HasHoldings applies the HasEdge predicate on the "holdings" edge.
+func HasHoldings() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HoldingsTable, HoldingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHoldingsWith applies the HasEdge predicate on the "holdings" edge with a given conditions (other predicates).
+func HasHoldingsWith(preds ...predicate.Holding) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newHoldingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Account) predicate.Account {
 	return predicate.Account(sql.AndPredicates(predicates...))
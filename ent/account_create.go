@@ -6,12 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/holding"
 	"gno.land-block-indexer/ent/transfer"
 )
 
@@ -23,15 +25,31 @@ type AccountCreate struct {
 	conflict []sql.ConflictOption
 }
 
-// SetToken sets the "token" field.
-func (_c *AccountCreate) SetToken(v string) *AccountCreate {
-	_c.mutation.SetToken(v)
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_c *AccountCreate) SetFirstSeenHeight(v int) *AccountCreate {
+	_c.mutation.SetFirstSeenHeight(v)
 	return _c
 }
 
-// SetAmount sets the "amount" field.
-func (_c *AccountCreate) SetAmount(v float64) *AccountCreate {
-	_c.mutation.SetAmount(v)
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableFirstSeenHeight(v *int) *AccountCreate {
+	if v != nil {
+		_c.SetFirstSeenHeight(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountCreate) SetCreatedAt(v time.Time) *AccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableCreatedAt(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
 	return _c
 }
 
@@ -71,6 +89,21 @@ func (_c *AccountCreate) AddTransfersFrom(v ...*Transfer) *AccountCreate {
 	return _c.AddTransfersFromIDs(ids...)
 }
 
+// AddHoldingIDs adds the "holdings" edge to the Holding entity by IDs.
+func (_c *AccountCreate) AddHoldingIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddHoldingIDs(ids...)
+	return _c
+}
+
+// AddHoldings adds the "holdings" edges to the Holding entity.
+func (_c *AccountCreate) AddHoldings(v ...*Holding) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHoldingIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_c *AccountCreate) Mutation() *AccountMutation {
 	return _c.mutation
@@ -78,6 +111,7 @@ func (_c *AccountCreate) Mutation() *AccountMutation {
 
 // Save creates the Account in the database.
 func (_c *AccountCreate) Save(ctx context.Context) (*Account, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -103,18 +137,25 @@ func (_c *AccountCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *AccountCreate) defaults() {
+	if _, ok := _c.mutation.FirstSeenHeight(); !ok {
+		v := account.DefaultFirstSeenHeight
+		_c.mutation.SetFirstSeenHeight(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := account.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *AccountCreate) check() error {
-	if _, ok := _c.mutation.Token(); !ok {
-		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Account.token"`)}
-	}
-	if v, ok := _c.mutation.Token(); ok {
-		if err := account.TokenValidator(v); err != nil {
-			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Account.token": %w`, err)}
-		}
+	if _, ok := _c.mutation.FirstSeenHeight(); !ok {
+		return &ValidationError{Name: "first_seen_height", err: errors.New(`ent: missing required field "Account.first_seen_height"`)}
 	}
-	if _, ok := _c.mutation.Amount(); !ok {
-		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Account.amount"`)}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Account.created_at"`)}
 	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := account.IDValidator(v); err != nil {
@@ -157,13 +198,13 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
-	if value, ok := _c.mutation.Token(); ok {
-		_spec.SetField(account.FieldToken, field.TypeString, value)
-		_node.Token = value
+	if value, ok := _c.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(account.FieldFirstSeenHeight, field.TypeInt, value)
+		_node.FirstSeenHeight = value
 	}
-	if value, ok := _c.mutation.Amount(); ok {
-		_spec.SetField(account.FieldAmount, field.TypeFloat64, value)
-		_node.Amount = value
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
 	}
 	if nodes := _c.mutation.TransfersToIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -197,6 +238,22 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.HoldingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldingsTable,
+			Columns: []string{account.HoldingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -204,7 +261,7 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Account.Create().
-//		SetToken(v).
+//		SetFirstSeenHeight(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -213,7 +270,7 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.AccountUpsert) {
-//			SetToken(v+v).
+//			SetFirstSeenHeight(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *AccountCreate) OnConflict(opts ...sql.ConflictOption) *AccountUpsertOne {
@@ -249,33 +306,21 @@ type (
 	}
 )
 
-// SetToken sets the "token" field.
-func (u *AccountUpsert) SetToken(v string) *AccountUpsert {
-	u.Set(account.FieldToken, v)
-	return u
-}
-
-// UpdateToken sets the "token" field to the value that was provided on create.
-func (u *AccountUpsert) UpdateToken() *AccountUpsert {
-	u.SetExcluded(account.FieldToken)
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *AccountUpsert) SetFirstSeenHeight(v int) *AccountUpsert {
+	u.Set(account.FieldFirstSeenHeight, v)
 	return u
 }
 
-// SetAmount sets the "amount" field.
-func (u *AccountUpsert) SetAmount(v float64) *AccountUpsert {
-	u.Set(account.FieldAmount, v)
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateFirstSeenHeight() *AccountUpsert {
+	u.SetExcluded(account.FieldFirstSeenHeight)
 	return u
 }
 
-// UpdateAmount sets the "amount" field to the value that was provided on create.
-func (u *AccountUpsert) UpdateAmount() *AccountUpsert {
-	u.SetExcluded(account.FieldAmount)
-	return u
-}
-
-// AddAmount adds v to the "amount" field.
-func (u *AccountUpsert) AddAmount(v float64) *AccountUpsert {
-	u.Add(account.FieldAmount, v)
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *AccountUpsert) AddFirstSeenHeight(v int) *AccountUpsert {
+	u.Add(account.FieldFirstSeenHeight, v)
 	return u
 }
 
@@ -296,6 +341,9 @@ func (u *AccountUpsertOne) UpdateNewValues() *AccountUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(account.FieldID)
 		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(account.FieldCreatedAt)
+		}
 	}))
 	return u
 }
@@ -327,38 +375,24 @@ func (u *AccountUpsertOne) Update(set func(*AccountUpsert)) *AccountUpsertOne {
 	return u
 }
 
-// SetToken sets the "token" field.
-func (u *AccountUpsertOne) SetToken(v string) *AccountUpsertOne {
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *AccountUpsertOne) SetFirstSeenHeight(v int) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
-		s.SetToken(v)
+		s.SetFirstSeenHeight(v)
 	})
 }
 
-// UpdateToken sets the "token" field to the value that was provided on create.
-func (u *AccountUpsertOne) UpdateToken() *AccountUpsertOne {
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *AccountUpsertOne) AddFirstSeenHeight(v int) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
-		s.UpdateToken()
+		s.AddFirstSeenHeight(v)
 	})
 }
 
-// SetAmount sets the "amount" field.
-func (u *AccountUpsertOne) SetAmount(v float64) *AccountUpsertOne {
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateFirstSeenHeight() *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
-		s.SetAmount(v)
-	})
-}
-
-// AddAmount adds v to the "amount" field.
-func (u *AccountUpsertOne) AddAmount(v float64) *AccountUpsertOne {
-	return u.Update(func(s *AccountUpsert) {
-		s.AddAmount(v)
-	})
-}
-
-// UpdateAmount sets the "amount" field to the value that was provided on create.
-func (u *AccountUpsertOne) UpdateAmount() *AccountUpsertOne {
-	return u.Update(func(s *AccountUpsert) {
-		s.UpdateAmount()
+		s.UpdateFirstSeenHeight()
 	})
 }
 
@@ -419,6 +453,7 @@ func (_c *AccountCreateBulk) Save(ctx context.Context) ([]*Account, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*AccountMutation)
 				if !ok {
@@ -497,7 +532,7 @@ func (_c *AccountCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.AccountUpsert) {
-//			SetToken(v+v).
+//			SetFirstSeenHeight(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *AccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountUpsertBulk {
@@ -544,6 +579,9 @@ func (u *AccountUpsertBulk) UpdateNewValues() *AccountUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(account.FieldID)
 			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(account.FieldCreatedAt)
+			}
 		}
 	}))
 	return u
@@ -576,38 +614,24 @@ func (u *AccountUpsertBulk) Update(set func(*AccountUpsert)) *AccountUpsertBulk
 	return u
 }
 
-// SetToken sets the "token" field.
-func (u *AccountUpsertBulk) SetToken(v string) *AccountUpsertBulk {
-	return u.Update(func(s *AccountUpsert) {
-		s.SetToken(v)
-	})
-}
-
-// UpdateToken sets the "token" field to the value that was provided on create.
-func (u *AccountUpsertBulk) UpdateToken() *AccountUpsertBulk {
-	return u.Update(func(s *AccountUpsert) {
-		s.UpdateToken()
-	})
-}
-
-// SetAmount sets the "amount" field.
-func (u *AccountUpsertBulk) SetAmount(v float64) *AccountUpsertBulk {
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *AccountUpsertBulk) SetFirstSeenHeight(v int) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
-		s.SetAmount(v)
+		s.SetFirstSeenHeight(v)
 	})
 }
 
-// AddAmount adds v to the "amount" field.
-func (u *AccountUpsertBulk) AddAmount(v float64) *AccountUpsertBulk {
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *AccountUpsertBulk) AddFirstSeenHeight(v int) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
-		s.AddAmount(v)
+		s.AddFirstSeenHeight(v)
 	})
 }
 
-// UpdateAmount sets the "amount" field to the value that was provided on create.
-func (u *AccountUpsertBulk) UpdateAmount() *AccountUpsertBulk {
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateFirstSeenHeight() *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
-		s.UpdateAmount()
+		s.UpdateFirstSeenHeight()
 	})
 }
 
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/holding"
 	"gno.land-block-indexer/ent/predicate"
 	"gno.land-block-indexer/ent/transfer"
 )
@@ -26,6 +27,7 @@ type AccountQuery struct {
 	predicates        []predicate.Account
 	withTransfersTo   *TransferQuery
 	withTransfersFrom *TransferQuery
+	withHoldings      *HoldingQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +108,28 @@ func (_q *AccountQuery) QueryTransfersFrom() *TransferQuery {
 	return query
 }
 
+// QueryHoldings chains the current query on the "holdings" edge.
+func (_q *AccountQuery) QueryHoldings() *HoldingQuery {
+	query := (&HoldingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(holding.Table, holding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.HoldingsTable, account.HoldingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Account entity from the query.
 // Returns a *NotFoundError when no Account was found.
 func (_q *AccountQuery) First(ctx context.Context) (*Account, error) {
@@ -300,6 +324,7 @@ func (_q *AccountQuery) Clone() *AccountQuery {
 		predicates:        append([]predicate.Account{}, _q.predicates...),
 		withTransfersTo:   _q.withTransfersTo.Clone(),
 		withTransfersFrom: _q.withTransfersFrom.Clone(),
+		withHoldings:      _q.withHoldings.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -328,18 +353,29 @@ func (_q *AccountQuery) WithTransfersFrom(opts ...func(*TransferQuery)) *Account
 	return _q
 }
 
+// WithHoldings tells the query-builder to eager-load the nodes that are connected to
+// the "holdings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithHoldings(opts ...func(*HoldingQuery)) *AccountQuery {
+	query := (&HoldingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHoldings = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Token string `json:"token,omitempty"`
+//		FirstSeenHeight int `json:"first_seen_height,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Account.Query().
-//		GroupBy(account.FieldToken).
+//		GroupBy(account.FieldFirstSeenHeight).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *AccountQuery) GroupBy(field string, fields ...string) *AccountGroupBy {
@@ -357,11 +393,11 @@ func (_q *AccountQuery) GroupBy(field string, fields ...string) *AccountGroupBy
 // Example:
 //
 //	var v []struct {
-//		Token string `json:"token,omitempty"`
+//		FirstSeenHeight int `json:"first_seen_height,omitempty"`
 //	}
 //
 //	client.Account.Query().
-//		Select(account.FieldToken).
+//		Select(account.FieldFirstSeenHeight).
 //		Scan(ctx, &v)
 func (_q *AccountQuery) Select(fields ...string) *AccountSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -406,9 +442,10 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	var (
 		nodes       = []*Account{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withTransfersTo != nil,
 			_q.withTransfersFrom != nil,
+			_q.withHoldings != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,6 +480,13 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			return nil, err
 		}
 	}
+	if query := _q.withHoldings; query != nil {
+		if err := _q.loadHoldings(ctx, query, nodes,
+			func(n *Account) { n.Edges.Holdings = []*Holding{} },
+			func(n *Account, e *Holding) { n.Edges.Holdings = append(n.Edges.Holdings, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,6 +552,36 @@ func (_q *AccountQuery) loadTransfersFrom(ctx context.Context, query *TransferQu
 	}
 	return nil
 }
+func (_q *AccountQuery) loadHoldings(ctx context.Context, query *HoldingQuery, nodes []*Account, init func(*Account), assign func(*Account, *Holding)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(holding.FieldAddress)
+	}
+	query.Where(predicate.Holding(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.HoldingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.Address
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "address" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
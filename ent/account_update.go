@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/holding"
 	"gno.land-block-indexer/ent/predicate"
 	"gno.land-block-indexer/ent/transfer"
 )
@@ -28,38 +29,24 @@ func (_u *AccountUpdate) Where(ps ...predicate.Account) *AccountUpdate {
 	return _u
 }
 
-// SetToken sets the "token" field.
-func (_u *AccountUpdate) SetToken(v string) *AccountUpdate {
-	_u.mutation.SetToken(v)
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_u *AccountUpdate) SetFirstSeenHeight(v int) *AccountUpdate {
+	_u.mutation.ResetFirstSeenHeight()
+	_u.mutation.SetFirstSeenHeight(v)
 	return _u
 }
 
-// SetNillableToken sets the "token" field if the given value is not nil.
-func (_u *AccountUpdate) SetNillableToken(v *string) *AccountUpdate {
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableFirstSeenHeight(v *int) *AccountUpdate {
 	if v != nil {
-		_u.SetToken(*v)
+		_u.SetFirstSeenHeight(*v)
 	}
 	return _u
 }
 
-// SetAmount sets the "amount" field.
-func (_u *AccountUpdate) SetAmount(v float64) *AccountUpdate {
-	_u.mutation.ResetAmount()
-	_u.mutation.SetAmount(v)
-	return _u
-}
-
-// SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *AccountUpdate) SetNillableAmount(v *float64) *AccountUpdate {
-	if v != nil {
-		_u.SetAmount(*v)
-	}
-	return _u
-}
-
-// AddAmount adds value to the "amount" field.
-func (_u *AccountUpdate) AddAmount(v float64) *AccountUpdate {
-	_u.mutation.AddAmount(v)
+// AddFirstSeenHeight adds value to the "first_seen_height" field.
+func (_u *AccountUpdate) AddFirstSeenHeight(v int) *AccountUpdate {
+	_u.mutation.AddFirstSeenHeight(v)
 	return _u
 }
 
@@ -93,6 +80,21 @@ func (_u *AccountUpdate) AddTransfersFrom(v ...*Transfer) *AccountUpdate {
 	return _u.AddTransfersFromIDs(ids...)
 }
 
+// AddHoldingIDs adds the "holdings" edge to the Holding entity by IDs.
+func (_u *AccountUpdate) AddHoldingIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddHoldingIDs(ids...)
+	return _u
+}
+
+// AddHoldings adds the "holdings" edges to the Holding entity.
+func (_u *AccountUpdate) AddHoldings(v ...*Holding) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHoldingIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_u *AccountUpdate) Mutation() *AccountMutation {
 	return _u.mutation
@@ -140,6 +142,27 @@ func (_u *AccountUpdate) RemoveTransfersFrom(v ...*Transfer) *AccountUpdate {
 	return _u.RemoveTransfersFromIDs(ids...)
 }
 
+// ClearHoldings clears all "holdings" edges to the Holding entity.
+func (_u *AccountUpdate) ClearHoldings() *AccountUpdate {
+	_u.mutation.ClearHoldings()
+	return _u
+}
+
+// RemoveHoldingIDs removes the "holdings" edge to Holding entities by IDs.
+func (_u *AccountUpdate) RemoveHoldingIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveHoldingIDs(ids...)
+	return _u
+}
+
+// RemoveHoldings removes "holdings" edges to Holding entities.
+func (_u *AccountUpdate) RemoveHoldings(v ...*Holding) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHoldingIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -167,20 +190,7 @@ func (_u *AccountUpdate) ExecX(ctx context.Context) {
 	}
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *AccountUpdate) check() error {
-	if v, ok := _u.mutation.Token(); ok {
-		if err := account.TokenValidator(v); err != nil {
-			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Account.token": %w`, err)}
-		}
-	}
-	return nil
-}
-
 func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
 	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -189,14 +199,11 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.Token(); ok {
-		_spec.SetField(account.FieldToken, field.TypeString, value)
+	if value, ok := _u.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(account.FieldFirstSeenHeight, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(account.FieldAmount, field.TypeFloat64, value)
-	}
-	if value, ok := _u.mutation.AddedAmount(); ok {
-		_spec.AddField(account.FieldAmount, field.TypeFloat64, value)
+	if value, ok := _u.mutation.AddedFirstSeenHeight(); ok {
+		_spec.AddField(account.FieldFirstSeenHeight, field.TypeInt, value)
 	}
 	if _u.mutation.TransfersToCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -288,6 +295,51 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.HoldingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldingsTable,
+			Columns: []string{account.HoldingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHoldingsIDs(); len(nodes) > 0 && !_u.mutation.HoldingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldingsTable,
+			Columns: []string{account.HoldingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HoldingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldingsTable,
+			Columns: []string{account.HoldingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{account.Label}
@@ -308,38 +360,24 @@ type AccountUpdateOne struct {
 	mutation *AccountMutation
 }
 
-// SetToken sets the "token" field.
-func (_u *AccountUpdateOne) SetToken(v string) *AccountUpdateOne {
-	_u.mutation.SetToken(v)
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_u *AccountUpdateOne) SetFirstSeenHeight(v int) *AccountUpdateOne {
+	_u.mutation.ResetFirstSeenHeight()
+	_u.mutation.SetFirstSeenHeight(v)
 	return _u
 }
 
-// SetNillableToken sets the "token" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableToken(v *string) *AccountUpdateOne {
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableFirstSeenHeight(v *int) *AccountUpdateOne {
 	if v != nil {
-		_u.SetToken(*v)
+		_u.SetFirstSeenHeight(*v)
 	}
 	return _u
 }
 
-// SetAmount sets the "amount" field.
-func (_u *AccountUpdateOne) SetAmount(v float64) *AccountUpdateOne {
-	_u.mutation.ResetAmount()
-	_u.mutation.SetAmount(v)
-	return _u
-}
-
-// SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableAmount(v *float64) *AccountUpdateOne {
-	if v != nil {
-		_u.SetAmount(*v)
-	}
-	return _u
-}
-
-// AddAmount adds value to the "amount" field.
-func (_u *AccountUpdateOne) AddAmount(v float64) *AccountUpdateOne {
-	_u.mutation.AddAmount(v)
+// AddFirstSeenHeight adds value to the "first_seen_height" field.
+func (_u *AccountUpdateOne) AddFirstSeenHeight(v int) *AccountUpdateOne {
+	_u.mutation.AddFirstSeenHeight(v)
 	return _u
 }
 
@@ -373,6 +411,21 @@ func (_u *AccountUpdateOne) AddTransfersFrom(v ...*Transfer) *AccountUpdateOne {
 	return _u.AddTransfersFromIDs(ids...)
 }
 
+// AddHoldingIDs adds the "holdings" edge to the Holding entity by IDs.
+func (_u *AccountUpdateOne) AddHoldingIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddHoldingIDs(ids...)
+	return _u
+}
+
+// AddHoldings adds the "holdings" edges to the Holding entity.
+func (_u *AccountUpdateOne) AddHoldings(v ...*Holding) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHoldingIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_u *AccountUpdateOne) Mutation() *AccountMutation {
 	return _u.mutation
@@ -420,6 +473,27 @@ func (_u *AccountUpdateOne) RemoveTransfersFrom(v ...*Transfer) *AccountUpdateOn
 	return _u.RemoveTransfersFromIDs(ids...)
 }
 
+// ClearHoldings clears all "holdings" edges to the Holding entity.
+func (_u *AccountUpdateOne) ClearHoldings() *AccountUpdateOne {
+	_u.mutation.ClearHoldings()
+	return _u
+}
+
+// RemoveHoldingIDs removes the "holdings" edge to Holding entities by IDs.
+func (_u *AccountUpdateOne) RemoveHoldingIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveHoldingIDs(ids...)
+	return _u
+}
+
+// RemoveHoldings removes "holdings" edges to Holding entities.
+func (_u *AccountUpdateOne) RemoveHoldings(v ...*Holding) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHoldingIDs(ids...)
+}
+
 // Where appends a list predicates to the AccountUpdate builder.
 func (_u *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
 	_u.mutation.Where(ps...)
@@ -460,20 +534,7 @@ func (_u *AccountUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *AccountUpdateOne) check() error {
-	if v, ok := _u.mutation.Token(); ok {
-		if err := account.TokenValidator(v); err != nil {
-			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Account.token": %w`, err)}
-		}
-	}
-	return nil
-}
-
 func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
 	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -499,14 +560,11 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 			}
 		}
 	}
-	if value, ok := _u.mutation.Token(); ok {
-		_spec.SetField(account.FieldToken, field.TypeString, value)
+	if value, ok := _u.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(account.FieldFirstSeenHeight, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(account.FieldAmount, field.TypeFloat64, value)
-	}
-	if value, ok := _u.mutation.AddedAmount(); ok {
-		_spec.AddField(account.FieldAmount, field.TypeFloat64, value)
+	if value, ok := _u.mutation.AddedFirstSeenHeight(); ok {
+		_spec.AddField(account.FieldFirstSeenHeight, field.TypeInt, value)
 	}
 	if _u.mutation.TransfersToCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -598,6 +656,51 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.HoldingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldingsTable,
+			Columns: []string{account.HoldingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHoldingsIDs(); len(nodes) > 0 && !_u.mutation.HoldingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldingsTable,
+			Columns: []string{account.HoldingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HoldingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldingsTable,
+			Columns: []string{account.HoldingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Account{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/addresstransaction"
+)
+
+// AddressTransaction is the model entity for the AddressTransaction schema.
+type AddressTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Address taking part in the transaction
+	Address string `json:"address,omitempty"`
+	// Hash of the transaction
+	Hash string `json:"hash,omitempty"`
+	// Role of the address, e.g. signer, sender or receiver
+	Role string `json:"role,omitempty"`
+	// Height of the block containing the transaction
+	BlockHeight int `json:"block_height,omitempty"`
+	// Index of the transaction in the block
+	TxIndex int `json:"tx_index,omitempty"`
+	// Timestamp of the block containing the transaction
+	BlockTime time.Time `json:"block_time,omitempty"`
+	// Whether the transaction was successful
+	Success bool `json:"success,omitempty"`
+	// Creation time of the row
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AddressTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case addresstransaction.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case addresstransaction.FieldID, addresstransaction.FieldBlockHeight, addresstransaction.FieldTxIndex:
+			values[i] = new(sql.NullInt64)
+		case addresstransaction.FieldAddress, addresstransaction.FieldHash, addresstransaction.FieldRole:
+			values[i] = new(sql.NullString)
+		case addresstransaction.FieldBlockTime, addresstransaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AddressTransaction fields.
+func (_m *AddressTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case addresstransaction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case addresstransaction.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case addresstransaction.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case addresstransaction.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case addresstransaction.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = int(value.Int64)
+			}
+		case addresstransaction.FieldTxIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_index", values[i])
+			} else if value.Valid {
+				_m.TxIndex = int(value.Int64)
+			}
+		case addresstransaction.FieldBlockTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field block_time", values[i])
+			} else if value.Valid {
+				_m.BlockTime = value.Time
+			}
+		case addresstransaction.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				_m.Success = value.Bool
+			}
+		case addresstransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AddressTransaction.
+// This includes values selected through modifiers, order, etc.
+func (_m *AddressTransaction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AddressTransaction.
+// Note that you need to call AddressTransaction.Unwrap() before calling this method if this AddressTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AddressTransaction) Update() *AddressTransactionUpdateOne {
+	return NewAddressTransactionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AddressTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AddressTransaction) Unwrap() *AddressTransaction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AddressTransaction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AddressTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("AddressTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("tx_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TxIndex))
+	builder.WriteString(", ")
+	builder.WriteString("block_time=")
+	builder.WriteString(_m.BlockTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Success))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AddressTransactions is a parsable slice of AddressTransaction.
+type AddressTransactions []*AddressTransaction
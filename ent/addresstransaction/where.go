@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package addresstransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldAddress, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldHash, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldRole, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// TxIndex applies equality check predicate on the "tx_index" field. It's identical to TxIndexEQ.
+func TxIndex(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
+func BlockTime(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldSuccess, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldContainsFold(FieldHash, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldContainsFold(FieldRole, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// TxIndexEQ applies the EQ predicate on the "tx_index" field.
+func TxIndexEQ(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// TxIndexNEQ applies the NEQ predicate on the "tx_index" field.
+func TxIndexNEQ(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldTxIndex, v))
+}
+
+// TxIndexIn applies the In predicate on the "tx_index" field.
+func TxIndexIn(vs ...int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldIn(FieldTxIndex, vs...))
+}
+
+// TxIndexNotIn applies the NotIn predicate on the "tx_index" field.
+func TxIndexNotIn(vs ...int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNotIn(FieldTxIndex, vs...))
+}
+
+// TxIndexGT applies the GT predicate on the "tx_index" field.
+func TxIndexGT(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGT(FieldTxIndex, v))
+}
+
+// TxIndexGTE applies the GTE predicate on the "tx_index" field.
+func TxIndexGTE(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGTE(FieldTxIndex, v))
+}
+
+// TxIndexLT applies the LT predicate on the "tx_index" field.
+func TxIndexLT(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLT(FieldTxIndex, v))
+}
+
+// TxIndexLTE applies the LTE predicate on the "tx_index" field.
+func TxIndexLTE(v int) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLTE(FieldTxIndex, v))
+}
+
+// BlockTimeEQ applies the EQ predicate on the "block_time" field.
+func BlockTimeEQ(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// BlockTimeNEQ applies the NEQ predicate on the "block_time" field.
+func BlockTimeNEQ(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldBlockTime, v))
+}
+
+// BlockTimeIn applies the In predicate on the "block_time" field.
+func BlockTimeIn(vs ...time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeNotIn applies the NotIn predicate on the "block_time" field.
+func BlockTimeNotIn(vs ...time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNotIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeGT applies the GT predicate on the "block_time" field.
+func BlockTimeGT(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGT(FieldBlockTime, v))
+}
+
+// BlockTimeGTE applies the GTE predicate on the "block_time" field.
+func BlockTimeGTE(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGTE(FieldBlockTime, v))
+}
+
+// BlockTimeLT applies the LT predicate on the "block_time" field.
+func BlockTimeLT(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLT(FieldBlockTime, v))
+}
+
+// BlockTimeLTE applies the LTE predicate on the "block_time" field.
+func BlockTimeLTE(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLTE(FieldBlockTime, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AddressTransaction) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AddressTransaction) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AddressTransaction) predicate.AddressTransaction {
+	return predicate.AddressTransaction(sql.NotPredicates(p))
+}
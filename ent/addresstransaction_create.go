@@ -0,0 +1,883 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/addresstransaction"
+)
+
+// AddressTransactionCreate is the builder for creating a AddressTransaction entity.
+type AddressTransactionCreate struct {
+	config
+	mutation *AddressTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAddress sets the "address" field.
+func (_c *AddressTransactionCreate) SetAddress(v string) *AddressTransactionCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *AddressTransactionCreate) SetHash(v string) *AddressTransactionCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *AddressTransactionCreate) SetRole(v string) *AddressTransactionCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *AddressTransactionCreate) SetBlockHeight(v int) *AddressTransactionCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_c *AddressTransactionCreate) SetTxIndex(v int) *AddressTransactionCreate {
+	_c.mutation.SetTxIndex(v)
+	return _c
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_c *AddressTransactionCreate) SetBlockTime(v time.Time) *AddressTransactionCreate {
+	_c.mutation.SetBlockTime(v)
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *AddressTransactionCreate) SetSuccess(v bool) *AddressTransactionCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_c *AddressTransactionCreate) SetNillableSuccess(v *bool) *AddressTransactionCreate {
+	if v != nil {
+		_c.SetSuccess(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AddressTransactionCreate) SetCreatedAt(v time.Time) *AddressTransactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AddressTransactionCreate) SetNillableCreatedAt(v *time.Time) *AddressTransactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AddressTransactionMutation object of the builder.
+func (_c *AddressTransactionCreate) Mutation() *AddressTransactionMutation {
+	return _c.mutation
+}
+
+// Save creates the AddressTransaction in the database.
+func (_c *AddressTransactionCreate) Save(ctx context.Context) (*AddressTransaction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AddressTransactionCreate) SaveX(ctx context.Context) *AddressTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AddressTransactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AddressTransactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AddressTransactionCreate) defaults() {
+	if _, ok := _c.mutation.Success(); !ok {
+		v := addresstransaction.DefaultSuccess
+		_c.mutation.SetSuccess(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := addresstransaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AddressTransactionCreate) check() error {
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "AddressTransaction.address"`)}
+	}
+	if v, ok := _c.mutation.Address(); ok {
+		if err := addresstransaction.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "AddressTransaction.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := addresstransaction.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "AddressTransaction.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := addresstransaction.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "AddressTransaction.block_height"`)}
+	}
+	if _, ok := _c.mutation.TxIndex(); !ok {
+		return &ValidationError{Name: "tx_index", err: errors.New(`ent: missing required field "AddressTransaction.tx_index"`)}
+	}
+	if _, ok := _c.mutation.BlockTime(); !ok {
+		return &ValidationError{Name: "block_time", err: errors.New(`ent: missing required field "AddressTransaction.block_time"`)}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "AddressTransaction.success"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AddressTransaction.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AddressTransactionCreate) sqlSave(ctx context.Context) (*AddressTransaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AddressTransactionCreate) createSpec() (*AddressTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AddressTransaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(addresstransaction.Table, sqlgraph.NewFieldSpec(addresstransaction.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(addresstransaction.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(addresstransaction.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(addresstransaction.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(addresstransaction.FieldBlockHeight, field.TypeInt, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.TxIndex(); ok {
+		_spec.SetField(addresstransaction.FieldTxIndex, field.TypeInt, value)
+		_node.TxIndex = value
+	}
+	if value, ok := _c.mutation.BlockTime(); ok {
+		_spec.SetField(addresstransaction.FieldBlockTime, field.TypeTime, value)
+		_node.BlockTime = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(addresstransaction.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(addresstransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AddressTransaction.Create().
+//		SetAddress(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AddressTransactionUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AddressTransactionCreate) OnConflict(opts ...sql.ConflictOption) *AddressTransactionUpsertOne {
+	_c.conflict = opts
+	return &AddressTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AddressTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AddressTransactionCreate) OnConflictColumns(columns ...string) *AddressTransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AddressTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AddressTransactionUpsertOne is the builder for "upsert"-ing
+	//  one AddressTransaction node.
+	AddressTransactionUpsertOne struct {
+		create *AddressTransactionCreate
+	}
+
+	// AddressTransactionUpsert is the "OnConflict" setter.
+	AddressTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAddress sets the "address" field.
+func (u *AddressTransactionUpsert) SetAddress(v string) *AddressTransactionUpsert {
+	u.Set(addresstransaction.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *AddressTransactionUpsert) UpdateAddress() *AddressTransactionUpsert {
+	u.SetExcluded(addresstransaction.FieldAddress)
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *AddressTransactionUpsert) SetHash(v string) *AddressTransactionUpsert {
+	u.Set(addresstransaction.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AddressTransactionUpsert) UpdateHash() *AddressTransactionUpsert {
+	u.SetExcluded(addresstransaction.FieldHash)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *AddressTransactionUpsert) SetRole(v string) *AddressTransactionUpsert {
+	u.Set(addresstransaction.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *AddressTransactionUpsert) UpdateRole() *AddressTransactionUpsert {
+	u.SetExcluded(addresstransaction.FieldRole)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *AddressTransactionUpsert) SetBlockHeight(v int) *AddressTransactionUpsert {
+	u.Set(addresstransaction.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *AddressTransactionUpsert) UpdateBlockHeight() *AddressTransactionUpsert {
+	u.SetExcluded(addresstransaction.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *AddressTransactionUpsert) AddBlockHeight(v int) *AddressTransactionUpsert {
+	u.Add(addresstransaction.FieldBlockHeight, v)
+	return u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *AddressTransactionUpsert) SetTxIndex(v int) *AddressTransactionUpsert {
+	u.Set(addresstransaction.FieldTxIndex, v)
+	return u
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *AddressTransactionUpsert) UpdateTxIndex() *AddressTransactionUpsert {
+	u.SetExcluded(addresstransaction.FieldTxIndex)
+	return u
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *AddressTransactionUpsert) AddTxIndex(v int) *AddressTransactionUpsert {
+	u.Add(addresstransaction.FieldTxIndex, v)
+	return u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *AddressTransactionUpsert) SetBlockTime(v time.Time) *AddressTransactionUpsert {
+	u.Set(addresstransaction.FieldBlockTime, v)
+	return u
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *AddressTransactionUpsert) UpdateBlockTime() *AddressTransactionUpsert {
+	u.SetExcluded(addresstransaction.FieldBlockTime)
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *AddressTransactionUpsert) SetSuccess(v bool) *AddressTransactionUpsert {
+	u.Set(addresstransaction.FieldSuccess, v)
+	return u
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *AddressTransactionUpsert) UpdateSuccess() *AddressTransactionUpsert {
+	u.SetExcluded(addresstransaction.FieldSuccess)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AddressTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AddressTransactionUpsertOne) UpdateNewValues() *AddressTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(addresstransaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AddressTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AddressTransactionUpsertOne) Ignore() *AddressTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AddressTransactionUpsertOne) DoNothing() *AddressTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AddressTransactionCreate.OnConflict
+// documentation for more info.
+func (u *AddressTransactionUpsertOne) Update(set func(*AddressTransactionUpsert)) *AddressTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AddressTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *AddressTransactionUpsertOne) SetAddress(v string) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *AddressTransactionUpsertOne) UpdateAddress() *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *AddressTransactionUpsertOne) SetHash(v string) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AddressTransactionUpsertOne) UpdateHash() *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *AddressTransactionUpsertOne) SetRole(v string) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *AddressTransactionUpsertOne) UpdateRole() *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *AddressTransactionUpsertOne) SetBlockHeight(v int) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *AddressTransactionUpsertOne) AddBlockHeight(v int) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *AddressTransactionUpsertOne) UpdateBlockHeight() *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *AddressTransactionUpsertOne) SetTxIndex(v int) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *AddressTransactionUpsertOne) AddTxIndex(v int) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *AddressTransactionUpsertOne) UpdateTxIndex() *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *AddressTransactionUpsertOne) SetBlockTime(v time.Time) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *AddressTransactionUpsertOne) UpdateBlockTime() *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *AddressTransactionUpsertOne) SetSuccess(v bool) *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *AddressTransactionUpsertOne) UpdateSuccess() *AddressTransactionUpsertOne {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// Exec executes the query.
+func (u *AddressTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AddressTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AddressTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AddressTransactionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AddressTransactionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AddressTransactionCreateBulk is the builder for creating many AddressTransaction entities in bulk.
+type AddressTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*AddressTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AddressTransaction entities in the database.
+func (_c *AddressTransactionCreateBulk) Save(ctx context.Context) ([]*AddressTransaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AddressTransaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AddressTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AddressTransactionCreateBulk) SaveX(ctx context.Context) []*AddressTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AddressTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AddressTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AddressTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AddressTransactionUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AddressTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AddressTransactionUpsertBulk {
+	_c.conflict = opts
+	return &AddressTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AddressTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AddressTransactionCreateBulk) OnConflictColumns(columns ...string) *AddressTransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AddressTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// AddressTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AddressTransaction nodes.
+type AddressTransactionUpsertBulk struct {
+	create *AddressTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AddressTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AddressTransactionUpsertBulk) UpdateNewValues() *AddressTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(addresstransaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AddressTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AddressTransactionUpsertBulk) Ignore() *AddressTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AddressTransactionUpsertBulk) DoNothing() *AddressTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AddressTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AddressTransactionUpsertBulk) Update(set func(*AddressTransactionUpsert)) *AddressTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AddressTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *AddressTransactionUpsertBulk) SetAddress(v string) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *AddressTransactionUpsertBulk) UpdateAddress() *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *AddressTransactionUpsertBulk) SetHash(v string) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AddressTransactionUpsertBulk) UpdateHash() *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *AddressTransactionUpsertBulk) SetRole(v string) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *AddressTransactionUpsertBulk) UpdateRole() *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *AddressTransactionUpsertBulk) SetBlockHeight(v int) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *AddressTransactionUpsertBulk) AddBlockHeight(v int) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *AddressTransactionUpsertBulk) UpdateBlockHeight() *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *AddressTransactionUpsertBulk) SetTxIndex(v int) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *AddressTransactionUpsertBulk) AddTxIndex(v int) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *AddressTransactionUpsertBulk) UpdateTxIndex() *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *AddressTransactionUpsertBulk) SetBlockTime(v time.Time) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *AddressTransactionUpsertBulk) UpdateBlockTime() *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *AddressTransactionUpsertBulk) SetSuccess(v bool) *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *AddressTransactionUpsertBulk) UpdateSuccess() *AddressTransactionUpsertBulk {
+	return u.Update(func(s *AddressTransactionUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// Exec executes the query.
+func (u *AddressTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AddressTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AddressTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AddressTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
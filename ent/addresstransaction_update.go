@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/addresstransaction"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// AddressTransactionUpdate is the builder for updating AddressTransaction entities.
+type AddressTransactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AddressTransactionMutation
+}
+
+// Where appends a list predicates to the AddressTransactionUpdate builder.
+func (_u *AddressTransactionUpdate) Where(ps ...predicate.AddressTransaction) *AddressTransactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *AddressTransactionUpdate) SetAddress(v string) *AddressTransactionUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *AddressTransactionUpdate) SetNillableAddress(v *string) *AddressTransactionUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AddressTransactionUpdate) SetHash(v string) *AddressTransactionUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AddressTransactionUpdate) SetNillableHash(v *string) *AddressTransactionUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AddressTransactionUpdate) SetRole(v string) *AddressTransactionUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AddressTransactionUpdate) SetNillableRole(v *string) *AddressTransactionUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *AddressTransactionUpdate) SetBlockHeight(v int) *AddressTransactionUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *AddressTransactionUpdate) SetNillableBlockHeight(v *int) *AddressTransactionUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *AddressTransactionUpdate) AddBlockHeight(v int) *AddressTransactionUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *AddressTransactionUpdate) SetTxIndex(v int) *AddressTransactionUpdate {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *AddressTransactionUpdate) SetNillableTxIndex(v *int) *AddressTransactionUpdate {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *AddressTransactionUpdate) AddTxIndex(v int) *AddressTransactionUpdate {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *AddressTransactionUpdate) SetBlockTime(v time.Time) *AddressTransactionUpdate {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *AddressTransactionUpdate) SetNillableBlockTime(v *time.Time) *AddressTransactionUpdate {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *AddressTransactionUpdate) SetSuccess(v bool) *AddressTransactionUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *AddressTransactionUpdate) SetNillableSuccess(v *bool) *AddressTransactionUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AddressTransactionMutation object of the builder.
+func (_u *AddressTransactionUpdate) Mutation() *AddressTransactionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AddressTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AddressTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AddressTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AddressTransactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AddressTransactionUpdate) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := addresstransaction.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := addresstransaction.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := addresstransaction.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AddressTransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(addresstransaction.Table, addresstransaction.Columns, sqlgraph.NewFieldSpec(addresstransaction.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(addresstransaction.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(addresstransaction.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(addresstransaction.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(addresstransaction.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(addresstransaction.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(addresstransaction.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(addresstransaction.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(addresstransaction.FieldBlockTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(addresstransaction.FieldSuccess, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{addresstransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AddressTransactionUpdateOne is the builder for updating a single AddressTransaction entity.
+type AddressTransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AddressTransactionMutation
+}
+
+// SetAddress sets the "address" field.
+func (_u *AddressTransactionUpdateOne) SetAddress(v string) *AddressTransactionUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *AddressTransactionUpdateOne) SetNillableAddress(v *string) *AddressTransactionUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AddressTransactionUpdateOne) SetHash(v string) *AddressTransactionUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AddressTransactionUpdateOne) SetNillableHash(v *string) *AddressTransactionUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AddressTransactionUpdateOne) SetRole(v string) *AddressTransactionUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AddressTransactionUpdateOne) SetNillableRole(v *string) *AddressTransactionUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *AddressTransactionUpdateOne) SetBlockHeight(v int) *AddressTransactionUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *AddressTransactionUpdateOne) SetNillableBlockHeight(v *int) *AddressTransactionUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *AddressTransactionUpdateOne) AddBlockHeight(v int) *AddressTransactionUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *AddressTransactionUpdateOne) SetTxIndex(v int) *AddressTransactionUpdateOne {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *AddressTransactionUpdateOne) SetNillableTxIndex(v *int) *AddressTransactionUpdateOne {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *AddressTransactionUpdateOne) AddTxIndex(v int) *AddressTransactionUpdateOne {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *AddressTransactionUpdateOne) SetBlockTime(v time.Time) *AddressTransactionUpdateOne {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *AddressTransactionUpdateOne) SetNillableBlockTime(v *time.Time) *AddressTransactionUpdateOne {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *AddressTransactionUpdateOne) SetSuccess(v bool) *AddressTransactionUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *AddressTransactionUpdateOne) SetNillableSuccess(v *bool) *AddressTransactionUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AddressTransactionMutation object of the builder.
+func (_u *AddressTransactionUpdateOne) Mutation() *AddressTransactionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AddressTransactionUpdate builder.
+func (_u *AddressTransactionUpdateOne) Where(ps ...predicate.AddressTransaction) *AddressTransactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AddressTransactionUpdateOne) Select(field string, fields ...string) *AddressTransactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AddressTransaction entity.
+func (_u *AddressTransactionUpdateOne) Save(ctx context.Context) (*AddressTransaction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AddressTransactionUpdateOne) SaveX(ctx context.Context) *AddressTransaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AddressTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AddressTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AddressTransactionUpdateOne) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := addresstransaction.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := addresstransaction.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := addresstransaction.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "AddressTransaction.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AddressTransactionUpdateOne) sqlSave(ctx context.Context) (_node *AddressTransaction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(addresstransaction.Table, addresstransaction.Columns, sqlgraph.NewFieldSpec(addresstransaction.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AddressTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, addresstransaction.FieldID)
+		for _, f := range fields {
+			if !addresstransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != addresstransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(addresstransaction.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(addresstransaction.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(addresstransaction.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(addresstransaction.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(addresstransaction.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(addresstransaction.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(addresstransaction.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(addresstransaction.FieldBlockTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(addresstransaction.FieldSuccess, field.TypeBool, value)
+	}
+	_node = &AddressTransaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{addresstransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/balancechange"
+)
+
+// BalanceChange is the model entity for the BalanceChange schema.
+type BalanceChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Address of the account
+	Address string `json:"address,omitempty"`
+	// Token of the balance
+	Token string `json:"token,omitempty"`
+	// Height of the block in which the balance changed
+	BlockHeight int `json:"block_height,omitempty"`
+	// Signed change of the balance
+	Delta int64 `json:"delta,omitempty"`
+	// Reason of the change (fee, transfer, mint, burn, genesis, ...)
+	Reason string `json:"reason,omitempty"`
+	// Hash of the transaction
+	Hash string `json:"hash,omitempty"`
+	// Index of the event in the transaction, negative for changes derived from the fee (-1) and messages (-2 - message index)
+	EventIndex int `json:"event_index,omitempty"`
+	// Creation time of the change
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balancechange.FieldID, balancechange.FieldBlockHeight, balancechange.FieldDelta, balancechange.FieldEventIndex:
+			values[i] = new(sql.NullInt64)
+		case balancechange.FieldAddress, balancechange.FieldToken, balancechange.FieldReason, balancechange.FieldHash:
+			values[i] = new(sql.NullString)
+		case balancechange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceChange fields.
+func (_m *BalanceChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balancechange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case balancechange.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case balancechange.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case balancechange.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = int(value.Int64)
+			}
+		case balancechange.FieldDelta:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delta", values[i])
+			} else if value.Valid {
+				_m.Delta = value.Int64
+			}
+		case balancechange.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case balancechange.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case balancechange.FieldEventIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_index", values[i])
+			} else if value.Valid {
+				_m.EventIndex = int(value.Int64)
+			}
+		case balancechange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BalanceChange.
+// This includes values selected through modifiers, order, etc.
+func (_m *BalanceChange) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BalanceChange.
+// Note that you need to call BalanceChange.Unwrap() before calling this method if this BalanceChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BalanceChange) Update() *BalanceChangeUpdateOne {
+	return NewBalanceChangeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BalanceChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BalanceChange) Unwrap() *BalanceChange {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BalanceChange is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BalanceChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("delta=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Delta))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("event_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventIndex))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceChanges is a parsable slice of BalanceChange.
+type BalanceChanges []*BalanceChange
@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancechange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldID, id))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldAddress, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldToken, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// Delta applies equality check predicate on the "delta" field. It's identical to DeltaEQ.
+func Delta(v int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldDelta, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldReason, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldHash, v))
+}
+
+// EventIndex applies equality check predicate on the "event_index" field. It's identical to EventIndexEQ.
+func EventIndex(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldContainsFold(FieldToken, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// DeltaEQ applies the EQ predicate on the "delta" field.
+func DeltaEQ(v int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldDelta, v))
+}
+
+// DeltaNEQ applies the NEQ predicate on the "delta" field.
+func DeltaNEQ(v int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldDelta, v))
+}
+
+// DeltaIn applies the In predicate on the "delta" field.
+func DeltaIn(vs ...int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldDelta, vs...))
+}
+
+// DeltaNotIn applies the NotIn predicate on the "delta" field.
+func DeltaNotIn(vs ...int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldDelta, vs...))
+}
+
+// DeltaGT applies the GT predicate on the "delta" field.
+func DeltaGT(v int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldDelta, v))
+}
+
+// DeltaGTE applies the GTE predicate on the "delta" field.
+func DeltaGTE(v int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldDelta, v))
+}
+
+// DeltaLT applies the LT predicate on the "delta" field.
+func DeltaLT(v int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldDelta, v))
+}
+
+// DeltaLTE applies the LTE predicate on the "delta" field.
+func DeltaLTE(v int64) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldDelta, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldContainsFold(FieldReason, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldContainsFold(FieldHash, v))
+}
+
+// EventIndexEQ applies the EQ predicate on the "event_index" field.
+func EventIndexEQ(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// EventIndexNEQ applies the NEQ predicate on the "event_index" field.
+func EventIndexNEQ(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldEventIndex, v))
+}
+
+// EventIndexIn applies the In predicate on the "event_index" field.
+func EventIndexIn(vs ...int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldEventIndex, vs...))
+}
+
+// EventIndexNotIn applies the NotIn predicate on the "event_index" field.
+func EventIndexNotIn(vs ...int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldEventIndex, vs...))
+}
+
+// EventIndexGT applies the GT predicate on the "event_index" field.
+func EventIndexGT(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldEventIndex, v))
+}
+
+// EventIndexGTE applies the GTE predicate on the "event_index" field.
+func EventIndexGTE(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldEventIndex, v))
+}
+
+// EventIndexLT applies the LT predicate on the "event_index" field.
+func EventIndexLT(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldEventIndex, v))
+}
+
+// EventIndexLTE applies the LTE predicate on the "event_index" field.
+func EventIndexLTE(v int) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldEventIndex, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BalanceChange) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BalanceChange) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BalanceChange) predicate.BalanceChange {
+	return predicate.BalanceChange(sql.NotPredicates(p))
+}
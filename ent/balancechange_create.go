@@ -0,0 +1,896 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/balancechange"
+)
+
+// BalanceChangeCreate is the builder for creating a BalanceChange entity.
+type BalanceChangeCreate struct {
+	config
+	mutation *BalanceChangeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAddress sets the "address" field.
+func (_c *BalanceChangeCreate) SetAddress(v string) *BalanceChangeCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *BalanceChangeCreate) SetToken(v string) *BalanceChangeCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *BalanceChangeCreate) SetBlockHeight(v int) *BalanceChangeCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetDelta sets the "delta" field.
+func (_c *BalanceChangeCreate) SetDelta(v int64) *BalanceChangeCreate {
+	_c.mutation.SetDelta(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *BalanceChangeCreate) SetReason(v string) *BalanceChangeCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *BalanceChangeCreate) SetHash(v string) *BalanceChangeCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_c *BalanceChangeCreate) SetEventIndex(v int) *BalanceChangeCreate {
+	_c.mutation.SetEventIndex(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BalanceChangeCreate) SetCreatedAt(v time.Time) *BalanceChangeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BalanceChangeCreate) SetNillableCreatedAt(v *time.Time) *BalanceChangeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BalanceChangeMutation object of the builder.
+func (_c *BalanceChangeCreate) Mutation() *BalanceChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the BalanceChange in the database.
+func (_c *BalanceChangeCreate) Save(ctx context.Context) (*BalanceChange, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BalanceChangeCreate) SaveX(ctx context.Context) *BalanceChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BalanceChangeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := balancechange.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BalanceChangeCreate) check() error {
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "BalanceChange.address"`)}
+	}
+	if v, ok := _c.mutation.Address(); ok {
+		if err := balancechange.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "BalanceChange.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := balancechange.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "BalanceChange.block_height"`)}
+	}
+	if _, ok := _c.mutation.Delta(); !ok {
+		return &ValidationError{Name: "delta", err: errors.New(`ent: missing required field "BalanceChange.delta"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "BalanceChange.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := balancechange.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "BalanceChange.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := balancechange.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventIndex(); !ok {
+		return &ValidationError{Name: "event_index", err: errors.New(`ent: missing required field "BalanceChange.event_index"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BalanceChange.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BalanceChangeCreate) sqlSave(ctx context.Context) (*BalanceChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BalanceChangeCreate) createSpec() (*BalanceChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(balancechange.Table, sqlgraph.NewFieldSpec(balancechange.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(balancechange.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(balancechange.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(balancechange.FieldBlockHeight, field.TypeInt, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.Delta(); ok {
+		_spec.SetField(balancechange.FieldDelta, field.TypeInt64, value)
+		_node.Delta = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(balancechange.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(balancechange.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.EventIndex(); ok {
+		_spec.SetField(balancechange.FieldEventIndex, field.TypeInt, value)
+		_node.EventIndex = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(balancechange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceChange.Create().
+//		SetAddress(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceChangeUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceChangeCreate) OnConflict(opts ...sql.ConflictOption) *BalanceChangeUpsertOne {
+	_c.conflict = opts
+	return &BalanceChangeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceChangeCreate) OnConflictColumns(columns ...string) *BalanceChangeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceChangeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BalanceChangeUpsertOne is the builder for "upsert"-ing
+	//  one BalanceChange node.
+	BalanceChangeUpsertOne struct {
+		create *BalanceChangeCreate
+	}
+
+	// BalanceChangeUpsert is the "OnConflict" setter.
+	BalanceChangeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAddress sets the "address" field.
+func (u *BalanceChangeUpsert) SetAddress(v string) *BalanceChangeUpsert {
+	u.Set(balancechange.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BalanceChangeUpsert) UpdateAddress() *BalanceChangeUpsert {
+	u.SetExcluded(balancechange.FieldAddress)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *BalanceChangeUpsert) SetToken(v string) *BalanceChangeUpsert {
+	u.Set(balancechange.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BalanceChangeUpsert) UpdateToken() *BalanceChangeUpsert {
+	u.SetExcluded(balancechange.FieldToken)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *BalanceChangeUpsert) SetBlockHeight(v int) *BalanceChangeUpsert {
+	u.Set(balancechange.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *BalanceChangeUpsert) UpdateBlockHeight() *BalanceChangeUpsert {
+	u.SetExcluded(balancechange.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *BalanceChangeUpsert) AddBlockHeight(v int) *BalanceChangeUpsert {
+	u.Add(balancechange.FieldBlockHeight, v)
+	return u
+}
+
+// SetDelta sets the "delta" field.
+func (u *BalanceChangeUpsert) SetDelta(v int64) *BalanceChangeUpsert {
+	u.Set(balancechange.FieldDelta, v)
+	return u
+}
+
+// UpdateDelta sets the "delta" field to the value that was provided on create.
+func (u *BalanceChangeUpsert) UpdateDelta() *BalanceChangeUpsert {
+	u.SetExcluded(balancechange.FieldDelta)
+	return u
+}
+
+// AddDelta adds v to the "delta" field.
+func (u *BalanceChangeUpsert) AddDelta(v int64) *BalanceChangeUpsert {
+	u.Add(balancechange.FieldDelta, v)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *BalanceChangeUpsert) SetReason(v string) *BalanceChangeUpsert {
+	u.Set(balancechange.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BalanceChangeUpsert) UpdateReason() *BalanceChangeUpsert {
+	u.SetExcluded(balancechange.FieldReason)
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *BalanceChangeUpsert) SetHash(v string) *BalanceChangeUpsert {
+	u.Set(balancechange.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *BalanceChangeUpsert) UpdateHash() *BalanceChangeUpsert {
+	u.SetExcluded(balancechange.FieldHash)
+	return u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *BalanceChangeUpsert) SetEventIndex(v int) *BalanceChangeUpsert {
+	u.Set(balancechange.FieldEventIndex, v)
+	return u
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *BalanceChangeUpsert) UpdateEventIndex() *BalanceChangeUpsert {
+	u.SetExcluded(balancechange.FieldEventIndex)
+	return u
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *BalanceChangeUpsert) AddEventIndex(v int) *BalanceChangeUpsert {
+	u.Add(balancechange.FieldEventIndex, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BalanceChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BalanceChangeUpsertOne) UpdateNewValues() *BalanceChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(balancechange.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceChange.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BalanceChangeUpsertOne) Ignore() *BalanceChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceChangeUpsertOne) DoNothing() *BalanceChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceChangeCreate.OnConflict
+// documentation for more info.
+func (u *BalanceChangeUpsertOne) Update(set func(*BalanceChangeUpsert)) *BalanceChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *BalanceChangeUpsertOne) SetAddress(v string) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BalanceChangeUpsertOne) UpdateAddress() *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *BalanceChangeUpsertOne) SetToken(v string) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BalanceChangeUpsertOne) UpdateToken() *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *BalanceChangeUpsertOne) SetBlockHeight(v int) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *BalanceChangeUpsertOne) AddBlockHeight(v int) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *BalanceChangeUpsertOne) UpdateBlockHeight() *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetDelta sets the "delta" field.
+func (u *BalanceChangeUpsertOne) SetDelta(v int64) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetDelta(v)
+	})
+}
+
+// AddDelta adds v to the "delta" field.
+func (u *BalanceChangeUpsertOne) AddDelta(v int64) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.AddDelta(v)
+	})
+}
+
+// UpdateDelta sets the "delta" field to the value that was provided on create.
+func (u *BalanceChangeUpsertOne) UpdateDelta() *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateDelta()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *BalanceChangeUpsertOne) SetReason(v string) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BalanceChangeUpsertOne) UpdateReason() *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *BalanceChangeUpsertOne) SetHash(v string) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *BalanceChangeUpsertOne) UpdateHash() *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *BalanceChangeUpsertOne) SetEventIndex(v int) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *BalanceChangeUpsertOne) AddEventIndex(v int) *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *BalanceChangeUpsertOne) UpdateEventIndex() *BalanceChangeUpsertOne {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceChangeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceChangeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceChangeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BalanceChangeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BalanceChangeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BalanceChangeCreateBulk is the builder for creating many BalanceChange entities in bulk.
+type BalanceChangeCreateBulk struct {
+	config
+	err      error
+	builders []*BalanceChangeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BalanceChange entities in the database.
+func (_c *BalanceChangeCreateBulk) Save(ctx context.Context) ([]*BalanceChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BalanceChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BalanceChangeCreateBulk) SaveX(ctx context.Context) []*BalanceChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceChange.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceChangeUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceChangeCreateBulk) OnConflict(opts ...sql.ConflictOption) *BalanceChangeUpsertBulk {
+	_c.conflict = opts
+	return &BalanceChangeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceChangeCreateBulk) OnConflictColumns(columns ...string) *BalanceChangeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceChangeUpsertBulk{
+		create: _c,
+	}
+}
+
+// BalanceChangeUpsertBulk is the builder for "upsert"-ing
+// a bulk of BalanceChange nodes.
+type BalanceChangeUpsertBulk struct {
+	create *BalanceChangeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BalanceChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BalanceChangeUpsertBulk) UpdateNewValues() *BalanceChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(balancechange.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceChange.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BalanceChangeUpsertBulk) Ignore() *BalanceChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceChangeUpsertBulk) DoNothing() *BalanceChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceChangeCreateBulk.OnConflict
+// documentation for more info.
+func (u *BalanceChangeUpsertBulk) Update(set func(*BalanceChangeUpsert)) *BalanceChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *BalanceChangeUpsertBulk) SetAddress(v string) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BalanceChangeUpsertBulk) UpdateAddress() *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *BalanceChangeUpsertBulk) SetToken(v string) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BalanceChangeUpsertBulk) UpdateToken() *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *BalanceChangeUpsertBulk) SetBlockHeight(v int) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *BalanceChangeUpsertBulk) AddBlockHeight(v int) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *BalanceChangeUpsertBulk) UpdateBlockHeight() *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetDelta sets the "delta" field.
+func (u *BalanceChangeUpsertBulk) SetDelta(v int64) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetDelta(v)
+	})
+}
+
+// AddDelta adds v to the "delta" field.
+func (u *BalanceChangeUpsertBulk) AddDelta(v int64) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.AddDelta(v)
+	})
+}
+
+// UpdateDelta sets the "delta" field to the value that was provided on create.
+func (u *BalanceChangeUpsertBulk) UpdateDelta() *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateDelta()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *BalanceChangeUpsertBulk) SetReason(v string) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BalanceChangeUpsertBulk) UpdateReason() *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *BalanceChangeUpsertBulk) SetHash(v string) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *BalanceChangeUpsertBulk) UpdateHash() *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *BalanceChangeUpsertBulk) SetEventIndex(v int) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *BalanceChangeUpsertBulk) AddEventIndex(v int) *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *BalanceChangeUpsertBulk) UpdateEventIndex() *BalanceChangeUpsertBulk {
+	return u.Update(func(s *BalanceChangeUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceChangeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BalanceChangeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceChangeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceChangeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/balancechange"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// BalanceChangeUpdate is the builder for updating BalanceChange entities.
+type BalanceChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceChangeMutation
+}
+
+// Where appends a list predicates to the BalanceChangeUpdate builder.
+func (_u *BalanceChangeUpdate) Where(ps ...predicate.BalanceChange) *BalanceChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *BalanceChangeUpdate) SetAddress(v string) *BalanceChangeUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *BalanceChangeUpdate) SetNillableAddress(v *string) *BalanceChangeUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *BalanceChangeUpdate) SetToken(v string) *BalanceChangeUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *BalanceChangeUpdate) SetNillableToken(v *string) *BalanceChangeUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *BalanceChangeUpdate) SetBlockHeight(v int) *BalanceChangeUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *BalanceChangeUpdate) SetNillableBlockHeight(v *int) *BalanceChangeUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *BalanceChangeUpdate) AddBlockHeight(v int) *BalanceChangeUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetDelta sets the "delta" field.
+func (_u *BalanceChangeUpdate) SetDelta(v int64) *BalanceChangeUpdate {
+	_u.mutation.ResetDelta()
+	_u.mutation.SetDelta(v)
+	return _u
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (_u *BalanceChangeUpdate) SetNillableDelta(v *int64) *BalanceChangeUpdate {
+	if v != nil {
+		_u.SetDelta(*v)
+	}
+	return _u
+}
+
+// AddDelta adds value to the "delta" field.
+func (_u *BalanceChangeUpdate) AddDelta(v int64) *BalanceChangeUpdate {
+	_u.mutation.AddDelta(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *BalanceChangeUpdate) SetReason(v string) *BalanceChangeUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *BalanceChangeUpdate) SetNillableReason(v *string) *BalanceChangeUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *BalanceChangeUpdate) SetHash(v string) *BalanceChangeUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *BalanceChangeUpdate) SetNillableHash(v *string) *BalanceChangeUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *BalanceChangeUpdate) SetEventIndex(v int) *BalanceChangeUpdate {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *BalanceChangeUpdate) SetNillableEventIndex(v *int) *BalanceChangeUpdate {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *BalanceChangeUpdate) AddEventIndex(v int) *BalanceChangeUpdate {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// Mutation returns the BalanceChangeMutation object of the builder.
+func (_u *BalanceChangeUpdate) Mutation() *BalanceChangeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BalanceChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BalanceChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BalanceChangeUpdate) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := balancechange.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Token(); ok {
+		if err := balancechange.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := balancechange.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := balancechange.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BalanceChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancechange.Table, balancechange.Columns, sqlgraph.NewFieldSpec(balancechange.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(balancechange.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(balancechange.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(balancechange.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(balancechange.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Delta(); ok {
+		_spec.SetField(balancechange.FieldDelta, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDelta(); ok {
+		_spec.AddField(balancechange.FieldDelta, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(balancechange.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(balancechange.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(balancechange.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(balancechange.FieldEventIndex, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BalanceChangeUpdateOne is the builder for updating a single BalanceChange entity.
+type BalanceChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceChangeMutation
+}
+
+// SetAddress sets the "address" field.
+func (_u *BalanceChangeUpdateOne) SetAddress(v string) *BalanceChangeUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *BalanceChangeUpdateOne) SetNillableAddress(v *string) *BalanceChangeUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *BalanceChangeUpdateOne) SetToken(v string) *BalanceChangeUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *BalanceChangeUpdateOne) SetNillableToken(v *string) *BalanceChangeUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *BalanceChangeUpdateOne) SetBlockHeight(v int) *BalanceChangeUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *BalanceChangeUpdateOne) SetNillableBlockHeight(v *int) *BalanceChangeUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *BalanceChangeUpdateOne) AddBlockHeight(v int) *BalanceChangeUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetDelta sets the "delta" field.
+func (_u *BalanceChangeUpdateOne) SetDelta(v int64) *BalanceChangeUpdateOne {
+	_u.mutation.ResetDelta()
+	_u.mutation.SetDelta(v)
+	return _u
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (_u *BalanceChangeUpdateOne) SetNillableDelta(v *int64) *BalanceChangeUpdateOne {
+	if v != nil {
+		_u.SetDelta(*v)
+	}
+	return _u
+}
+
+// AddDelta adds value to the "delta" field.
+func (_u *BalanceChangeUpdateOne) AddDelta(v int64) *BalanceChangeUpdateOne {
+	_u.mutation.AddDelta(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *BalanceChangeUpdateOne) SetReason(v string) *BalanceChangeUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *BalanceChangeUpdateOne) SetNillableReason(v *string) *BalanceChangeUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *BalanceChangeUpdateOne) SetHash(v string) *BalanceChangeUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *BalanceChangeUpdateOne) SetNillableHash(v *string) *BalanceChangeUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *BalanceChangeUpdateOne) SetEventIndex(v int) *BalanceChangeUpdateOne {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *BalanceChangeUpdateOne) SetNillableEventIndex(v *int) *BalanceChangeUpdateOne {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *BalanceChangeUpdateOne) AddEventIndex(v int) *BalanceChangeUpdateOne {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// Mutation returns the BalanceChangeMutation object of the builder.
+func (_u *BalanceChangeUpdateOne) Mutation() *BalanceChangeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BalanceChangeUpdate builder.
+func (_u *BalanceChangeUpdateOne) Where(ps ...predicate.BalanceChange) *BalanceChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BalanceChangeUpdateOne) Select(field string, fields ...string) *BalanceChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BalanceChange entity.
+func (_u *BalanceChangeUpdateOne) Save(ctx context.Context) (*BalanceChange, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceChangeUpdateOne) SaveX(ctx context.Context) *BalanceChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BalanceChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BalanceChangeUpdateOne) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := balancechange.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Token(); ok {
+		if err := balancechange.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := balancechange.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := balancechange.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "BalanceChange.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BalanceChangeUpdateOne) sqlSave(ctx context.Context) (_node *BalanceChange, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancechange.Table, balancechange.Columns, sqlgraph.NewFieldSpec(balancechange.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancechange.FieldID)
+		for _, f := range fields {
+			if !balancechange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balancechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(balancechange.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(balancechange.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(balancechange.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(balancechange.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Delta(); ok {
+		_spec.SetField(balancechange.FieldDelta, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDelta(); ok {
+		_spec.AddField(balancechange.FieldDelta, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(balancechange.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(balancechange.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(balancechange.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(balancechange.FieldEventIndex, field.TypeInt, value)
+	}
+	_node = &BalanceChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
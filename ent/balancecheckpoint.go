@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/balancecheckpoint"
+)
+
+// BalanceCheckpoint is the model entity for the BalanceCheckpoint schema.
+type BalanceCheckpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Address of the account
+	Address string `json:"address,omitempty"`
+	// Token of the balance
+	Token string `json:"token,omitempty"`
+	// Height of the checkpoint
+	BlockHeight int `json:"block_height,omitempty"`
+	// Balance at the end of the checkpoint height
+	Amount int64 `json:"amount,omitempty"`
+	// Creation time of the checkpoint
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceCheckpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balancecheckpoint.FieldID, balancecheckpoint.FieldBlockHeight, balancecheckpoint.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case balancecheckpoint.FieldAddress, balancecheckpoint.FieldToken:
+			values[i] = new(sql.NullString)
+		case balancecheckpoint.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceCheckpoint fields.
+func (_m *BalanceCheckpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balancecheckpoint.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case balancecheckpoint.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case balancecheckpoint.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case balancecheckpoint.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = int(value.Int64)
+			}
+		case balancecheckpoint.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Int64
+			}
+		case balancecheckpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BalanceCheckpoint.
+// This includes values selected through modifiers, order, etc.
+func (_m *BalanceCheckpoint) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BalanceCheckpoint.
+// Note that you need to call BalanceCheckpoint.Unwrap() before calling this method if this BalanceCheckpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BalanceCheckpoint) Update() *BalanceCheckpointUpdateOne {
+	return NewBalanceCheckpointClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BalanceCheckpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BalanceCheckpoint) Unwrap() *BalanceCheckpoint {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BalanceCheckpoint is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BalanceCheckpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceCheckpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceCheckpoints is a parsable slice of BalanceCheckpoint.
+type BalanceCheckpoints []*BalanceCheckpoint
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancecheckpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLTE(FieldID, id))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldAddress, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldToken, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldContainsFold(FieldToken, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v int) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int64) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLTE(FieldAmount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BalanceCheckpoint) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BalanceCheckpoint) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BalanceCheckpoint) predicate.BalanceCheckpoint {
+	return predicate.BalanceCheckpoint(sql.NotPredicates(p))
+}
@@ -0,0 +1,707 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/balancecheckpoint"
+)
+
+// BalanceCheckpointCreate is the builder for creating a BalanceCheckpoint entity.
+type BalanceCheckpointCreate struct {
+	config
+	mutation *BalanceCheckpointMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAddress sets the "address" field.
+func (_c *BalanceCheckpointCreate) SetAddress(v string) *BalanceCheckpointCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *BalanceCheckpointCreate) SetToken(v string) *BalanceCheckpointCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *BalanceCheckpointCreate) SetBlockHeight(v int) *BalanceCheckpointCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BalanceCheckpointCreate) SetAmount(v int64) *BalanceCheckpointCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BalanceCheckpointCreate) SetCreatedAt(v time.Time) *BalanceCheckpointCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BalanceCheckpointCreate) SetNillableCreatedAt(v *time.Time) *BalanceCheckpointCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BalanceCheckpointMutation object of the builder.
+func (_c *BalanceCheckpointCreate) Mutation() *BalanceCheckpointMutation {
+	return _c.mutation
+}
+
+// Save creates the BalanceCheckpoint in the database.
+func (_c *BalanceCheckpointCreate) Save(ctx context.Context) (*BalanceCheckpoint, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BalanceCheckpointCreate) SaveX(ctx context.Context) *BalanceCheckpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceCheckpointCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceCheckpointCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BalanceCheckpointCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := balancecheckpoint.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BalanceCheckpointCreate) check() error {
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "BalanceCheckpoint.address"`)}
+	}
+	if v, ok := _c.mutation.Address(); ok {
+		if err := balancecheckpoint.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "BalanceCheckpoint.address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "BalanceCheckpoint.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := balancecheckpoint.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BalanceCheckpoint.token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "BalanceCheckpoint.block_height"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BalanceCheckpoint.amount"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BalanceCheckpoint.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BalanceCheckpointCreate) sqlSave(ctx context.Context) (*BalanceCheckpoint, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BalanceCheckpointCreate) createSpec() (*BalanceCheckpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceCheckpoint{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(balancecheckpoint.Table, sqlgraph.NewFieldSpec(balancecheckpoint.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(balancecheckpoint.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(balancecheckpoint.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(balancecheckpoint.FieldBlockHeight, field.TypeInt, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(balancecheckpoint.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(balancecheckpoint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceCheckpoint.Create().
+//		SetAddress(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceCheckpointUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceCheckpointCreate) OnConflict(opts ...sql.ConflictOption) *BalanceCheckpointUpsertOne {
+	_c.conflict = opts
+	return &BalanceCheckpointUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceCheckpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceCheckpointCreate) OnConflictColumns(columns ...string) *BalanceCheckpointUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceCheckpointUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BalanceCheckpointUpsertOne is the builder for "upsert"-ing
+	//  one BalanceCheckpoint node.
+	BalanceCheckpointUpsertOne struct {
+		create *BalanceCheckpointCreate
+	}
+
+	// BalanceCheckpointUpsert is the "OnConflict" setter.
+	BalanceCheckpointUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAddress sets the "address" field.
+func (u *BalanceCheckpointUpsert) SetAddress(v string) *BalanceCheckpointUpsert {
+	u.Set(balancecheckpoint.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsert) UpdateAddress() *BalanceCheckpointUpsert {
+	u.SetExcluded(balancecheckpoint.FieldAddress)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *BalanceCheckpointUpsert) SetToken(v string) *BalanceCheckpointUpsert {
+	u.Set(balancecheckpoint.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsert) UpdateToken() *BalanceCheckpointUpsert {
+	u.SetExcluded(balancecheckpoint.FieldToken)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *BalanceCheckpointUpsert) SetBlockHeight(v int) *BalanceCheckpointUpsert {
+	u.Set(balancecheckpoint.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsert) UpdateBlockHeight() *BalanceCheckpointUpsert {
+	u.SetExcluded(balancecheckpoint.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *BalanceCheckpointUpsert) AddBlockHeight(v int) *BalanceCheckpointUpsert {
+	u.Add(balancecheckpoint.FieldBlockHeight, v)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceCheckpointUpsert) SetAmount(v int64) *BalanceCheckpointUpsert {
+	u.Set(balancecheckpoint.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsert) UpdateAmount() *BalanceCheckpointUpsert {
+	u.SetExcluded(balancecheckpoint.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceCheckpointUpsert) AddAmount(v int64) *BalanceCheckpointUpsert {
+	u.Add(balancecheckpoint.FieldAmount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BalanceCheckpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BalanceCheckpointUpsertOne) UpdateNewValues() *BalanceCheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(balancecheckpoint.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceCheckpoint.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BalanceCheckpointUpsertOne) Ignore() *BalanceCheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceCheckpointUpsertOne) DoNothing() *BalanceCheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceCheckpointCreate.OnConflict
+// documentation for more info.
+func (u *BalanceCheckpointUpsertOne) Update(set func(*BalanceCheckpointUpsert)) *BalanceCheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceCheckpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *BalanceCheckpointUpsertOne) SetAddress(v string) *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsertOne) UpdateAddress() *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *BalanceCheckpointUpsertOne) SetToken(v string) *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsertOne) UpdateToken() *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *BalanceCheckpointUpsertOne) SetBlockHeight(v int) *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *BalanceCheckpointUpsertOne) AddBlockHeight(v int) *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsertOne) UpdateBlockHeight() *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceCheckpointUpsertOne) SetAmount(v int64) *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceCheckpointUpsertOne) AddAmount(v int64) *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsertOne) UpdateAmount() *BalanceCheckpointUpsertOne {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceCheckpointUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceCheckpointCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceCheckpointUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BalanceCheckpointUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BalanceCheckpointUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BalanceCheckpointCreateBulk is the builder for creating many BalanceCheckpoint entities in bulk.
+type BalanceCheckpointCreateBulk struct {
+	config
+	err      error
+	builders []*BalanceCheckpointCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BalanceCheckpoint entities in the database.
+func (_c *BalanceCheckpointCreateBulk) Save(ctx context.Context) ([]*BalanceCheckpoint, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BalanceCheckpoint, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceCheckpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BalanceCheckpointCreateBulk) SaveX(ctx context.Context) []*BalanceCheckpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceCheckpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceCheckpointCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceCheckpoint.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceCheckpointUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceCheckpointCreateBulk) OnConflict(opts ...sql.ConflictOption) *BalanceCheckpointUpsertBulk {
+	_c.conflict = opts
+	return &BalanceCheckpointUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceCheckpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceCheckpointCreateBulk) OnConflictColumns(columns ...string) *BalanceCheckpointUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceCheckpointUpsertBulk{
+		create: _c,
+	}
+}
+
+// BalanceCheckpointUpsertBulk is the builder for "upsert"-ing
+// a bulk of BalanceCheckpoint nodes.
+type BalanceCheckpointUpsertBulk struct {
+	create *BalanceCheckpointCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BalanceCheckpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BalanceCheckpointUpsertBulk) UpdateNewValues() *BalanceCheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(balancecheckpoint.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceCheckpoint.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BalanceCheckpointUpsertBulk) Ignore() *BalanceCheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceCheckpointUpsertBulk) DoNothing() *BalanceCheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceCheckpointCreateBulk.OnConflict
+// documentation for more info.
+func (u *BalanceCheckpointUpsertBulk) Update(set func(*BalanceCheckpointUpsert)) *BalanceCheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceCheckpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *BalanceCheckpointUpsertBulk) SetAddress(v string) *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsertBulk) UpdateAddress() *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *BalanceCheckpointUpsertBulk) SetToken(v string) *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsertBulk) UpdateToken() *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *BalanceCheckpointUpsertBulk) SetBlockHeight(v int) *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *BalanceCheckpointUpsertBulk) AddBlockHeight(v int) *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsertBulk) UpdateBlockHeight() *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceCheckpointUpsertBulk) SetAmount(v int64) *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceCheckpointUpsertBulk) AddAmount(v int64) *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceCheckpointUpsertBulk) UpdateAmount() *BalanceCheckpointUpsertBulk {
+	return u.Update(func(s *BalanceCheckpointUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceCheckpointUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BalanceCheckpointCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceCheckpointCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceCheckpointUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/balancecheckpoint"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// BalanceCheckpointDelete is the builder for deleting a BalanceCheckpoint entity.
+type BalanceCheckpointDelete struct {
+	config
+	hooks    []Hook
+	mutation *BalanceCheckpointMutation
+}
+
+// Where appends a list predicates to the BalanceCheckpointDelete builder.
+func (_d *BalanceCheckpointDelete) Where(ps ...predicate.BalanceCheckpoint) *BalanceCheckpointDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BalanceCheckpointDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BalanceCheckpointDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BalanceCheckpointDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(balancecheckpoint.Table, sqlgraph.NewFieldSpec(balancecheckpoint.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BalanceCheckpointDeleteOne is the builder for deleting a single BalanceCheckpoint entity.
+type BalanceCheckpointDeleteOne struct {
+	_d *BalanceCheckpointDelete
+}
+
+// Where appends a list predicates to the BalanceCheckpointDelete builder.
+func (_d *BalanceCheckpointDeleteOne) Where(ps ...predicate.BalanceCheckpoint) *BalanceCheckpointDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BalanceCheckpointDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{balancecheckpoint.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BalanceCheckpointDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/balancecheckpoint"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// BalanceCheckpointUpdate is the builder for updating BalanceCheckpoint entities.
+type BalanceCheckpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceCheckpointMutation
+}
+
+// Where appends a list predicates to the BalanceCheckpointUpdate builder.
+func (_u *BalanceCheckpointUpdate) Where(ps ...predicate.BalanceCheckpoint) *BalanceCheckpointUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *BalanceCheckpointUpdate) SetAddress(v string) *BalanceCheckpointUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *BalanceCheckpointUpdate) SetNillableAddress(v *string) *BalanceCheckpointUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *BalanceCheckpointUpdate) SetToken(v string) *BalanceCheckpointUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *BalanceCheckpointUpdate) SetNillableToken(v *string) *BalanceCheckpointUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *BalanceCheckpointUpdate) SetBlockHeight(v int) *BalanceCheckpointUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *BalanceCheckpointUpdate) SetNillableBlockHeight(v *int) *BalanceCheckpointUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *BalanceCheckpointUpdate) AddBlockHeight(v int) *BalanceCheckpointUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BalanceCheckpointUpdate) SetAmount(v int64) *BalanceCheckpointUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BalanceCheckpointUpdate) SetNillableAmount(v *int64) *BalanceCheckpointUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BalanceCheckpointUpdate) AddAmount(v int64) *BalanceCheckpointUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// Mutation returns the BalanceCheckpointMutation object of the builder.
+func (_u *BalanceCheckpointUpdate) Mutation() *BalanceCheckpointMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BalanceCheckpointUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceCheckpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BalanceCheckpointUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceCheckpointUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BalanceCheckpointUpdate) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := balancecheckpoint.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "BalanceCheckpoint.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Token(); ok {
+		if err := balancecheckpoint.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BalanceCheckpoint.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BalanceCheckpointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancecheckpoint.Table, balancecheckpoint.Columns, sqlgraph.NewFieldSpec(balancecheckpoint.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(balancecheckpoint.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(balancecheckpoint.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(balancecheckpoint.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(balancecheckpoint.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(balancecheckpoint.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(balancecheckpoint.FieldAmount, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancecheckpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BalanceCheckpointUpdateOne is the builder for updating a single BalanceCheckpoint entity.
+type BalanceCheckpointUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceCheckpointMutation
+}
+
+// SetAddress sets the "address" field.
+func (_u *BalanceCheckpointUpdateOne) SetAddress(v string) *BalanceCheckpointUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *BalanceCheckpointUpdateOne) SetNillableAddress(v *string) *BalanceCheckpointUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *BalanceCheckpointUpdateOne) SetToken(v string) *BalanceCheckpointUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *BalanceCheckpointUpdateOne) SetNillableToken(v *string) *BalanceCheckpointUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *BalanceCheckpointUpdateOne) SetBlockHeight(v int) *BalanceCheckpointUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *BalanceCheckpointUpdateOne) SetNillableBlockHeight(v *int) *BalanceCheckpointUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *BalanceCheckpointUpdateOne) AddBlockHeight(v int) *BalanceCheckpointUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BalanceCheckpointUpdateOne) SetAmount(v int64) *BalanceCheckpointUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BalanceCheckpointUpdateOne) SetNillableAmount(v *int64) *BalanceCheckpointUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BalanceCheckpointUpdateOne) AddAmount(v int64) *BalanceCheckpointUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// Mutation returns the BalanceCheckpointMutation object of the builder.
+func (_u *BalanceCheckpointUpdateOne) Mutation() *BalanceCheckpointMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BalanceCheckpointUpdate builder.
+func (_u *BalanceCheckpointUpdateOne) Where(ps ...predicate.BalanceCheckpoint) *BalanceCheckpointUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BalanceCheckpointUpdateOne) Select(field string, fields ...string) *BalanceCheckpointUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BalanceCheckpoint entity.
+func (_u *BalanceCheckpointUpdateOne) Save(ctx context.Context) (*BalanceCheckpoint, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceCheckpointUpdateOne) SaveX(ctx context.Context) *BalanceCheckpoint {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BalanceCheckpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceCheckpointUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BalanceCheckpointUpdateOne) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := balancecheckpoint.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "BalanceCheckpoint.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Token(); ok {
+		if err := balancecheckpoint.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BalanceCheckpoint.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BalanceCheckpointUpdateOne) sqlSave(ctx context.Context) (_node *BalanceCheckpoint, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancecheckpoint.Table, balancecheckpoint.Columns, sqlgraph.NewFieldSpec(balancecheckpoint.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceCheckpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancecheckpoint.FieldID)
+		for _, f := range fields {
+			if !balancecheckpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balancecheckpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(balancecheckpoint.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(balancecheckpoint.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(balancecheckpoint.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(balancecheckpoint.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(balancecheckpoint.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(balancecheckpoint.FieldAmount, field.TypeInt64, value)
+	}
+	_node = &BalanceCheckpoint{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancecheckpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -16,10 +16,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/addresstransaction"
+	"gno.land-block-indexer/ent/balancechange"
+	"gno.land-block-indexer/ent/balancecheckpoint"
 	"gno.land-block-indexer/ent/block"
+	"gno.land-block-indexer/ent/gnoevent"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+	"gno.land-block-indexer/ent/holding"
+	"gno.land-block-indexer/ent/nft"
+	"gno.land-block-indexer/ent/nfttransfer"
+	"gno.land-block-indexer/ent/realmcall"
+	"gno.land-block-indexer/ent/rebuildprogress"
 	"gno.land-block-indexer/ent/restorehistory"
+	"gno.land-block-indexer/ent/token"
+	"gno.land-block-indexer/ent/tokenstat"
 	"gno.land-block-indexer/ent/transaction"
 	"gno.land-block-indexer/ent/transfer"
+	"gno.land-block-indexer/ent/watchrule"
+	"gno.land-block-indexer/ent/webhookdelivery"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -29,14 +46,44 @@ type Client struct {
 	Schema *migrate.Schema
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// AddressTransaction is the client for interacting with the AddressTransaction builders.
+	AddressTransaction *AddressTransactionClient
+	// BalanceChange is the client for interacting with the BalanceChange builders.
+	BalanceChange *BalanceChangeClient
+	// BalanceCheckpoint is the client for interacting with the BalanceCheckpoint builders.
+	BalanceCheckpoint *BalanceCheckpointClient
 	// Block is the client for interacting with the Block builders.
 	Block *BlockClient
+	// GnoEvent is the client for interacting with the GnoEvent builders.
+	GnoEvent *GnoEventClient
+	// GnoPackage is the client for interacting with the GnoPackage builders.
+	GnoPackage *GnoPackageClient
+	// GnoPackageFile is the client for interacting with the GnoPackageFile builders.
+	GnoPackageFile *GnoPackageFileClient
+	// Holding is the client for interacting with the Holding builders.
+	Holding *HoldingClient
+	// Nft is the client for interacting with the Nft builders.
+	Nft *NftClient
+	// NftTransfer is the client for interacting with the NftTransfer builders.
+	NftTransfer *NftTransferClient
+	// RealmCall is the client for interacting with the RealmCall builders.
+	RealmCall *RealmCallClient
+	// RebuildProgress is the client for interacting with the RebuildProgress builders.
+	RebuildProgress *RebuildProgressClient
 	// RestoreHistory is the client for interacting with the RestoreHistory builders.
 	RestoreHistory *RestoreHistoryClient
+	// Token is the client for interacting with the Token builders.
+	Token *TokenClient
+	// TokenStat is the client for interacting with the TokenStat builders.
+	TokenStat *TokenStatClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// Transfer is the client for interacting with the Transfer builders.
 	Transfer *TransferClient
+	// WatchRule is the client for interacting with the WatchRule builders.
+	WatchRule *WatchRuleClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -49,10 +96,25 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Account = NewAccountClient(c.config)
+	c.AddressTransaction = NewAddressTransactionClient(c.config)
+	c.BalanceChange = NewBalanceChangeClient(c.config)
+	c.BalanceCheckpoint = NewBalanceCheckpointClient(c.config)
 	c.Block = NewBlockClient(c.config)
+	c.GnoEvent = NewGnoEventClient(c.config)
+	c.GnoPackage = NewGnoPackageClient(c.config)
+	c.GnoPackageFile = NewGnoPackageFileClient(c.config)
+	c.Holding = NewHoldingClient(c.config)
+	c.Nft = NewNftClient(c.config)
+	c.NftTransfer = NewNftTransferClient(c.config)
+	c.RealmCall = NewRealmCallClient(c.config)
+	c.RebuildProgress = NewRebuildProgressClient(c.config)
 	c.RestoreHistory = NewRestoreHistoryClient(c.config)
+	c.Token = NewTokenClient(c.config)
+	c.TokenStat = NewTokenStatClient(c.config)
 	c.Transaction = NewTransactionClient(c.config)
 	c.Transfer = NewTransferClient(c.config)
+	c.WatchRule = NewWatchRuleClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -143,13 +205,28 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Account:        NewAccountClient(cfg),
-		Block:          NewBlockClient(cfg),
-		RestoreHistory: NewRestoreHistoryClient(cfg),
-		Transaction:    NewTransactionClient(cfg),
-		Transfer:       NewTransferClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Account:            NewAccountClient(cfg),
+		AddressTransaction: NewAddressTransactionClient(cfg),
+		BalanceChange:      NewBalanceChangeClient(cfg),
+		BalanceCheckpoint:  NewBalanceCheckpointClient(cfg),
+		Block:              NewBlockClient(cfg),
+		GnoEvent:           NewGnoEventClient(cfg),
+		GnoPackage:         NewGnoPackageClient(cfg),
+		GnoPackageFile:     NewGnoPackageFileClient(cfg),
+		Holding:            NewHoldingClient(cfg),
+		Nft:                NewNftClient(cfg),
+		NftTransfer:        NewNftTransferClient(cfg),
+		RealmCall:          NewRealmCallClient(cfg),
+		RebuildProgress:    NewRebuildProgressClient(cfg),
+		RestoreHistory:     NewRestoreHistoryClient(cfg),
+		Token:              NewTokenClient(cfg),
+		TokenStat:          NewTokenStatClient(cfg),
+		Transaction:        NewTransactionClient(cfg),
+		Transfer:           NewTransferClient(cfg),
+		WatchRule:          NewWatchRuleClient(cfg),
+		WebhookDelivery:    NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -167,13 +244,28 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Account:        NewAccountClient(cfg),
-		Block:          NewBlockClient(cfg),
-		RestoreHistory: NewRestoreHistoryClient(cfg),
-		Transaction:    NewTransactionClient(cfg),
-		Transfer:       NewTransferClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Account:            NewAccountClient(cfg),
+		AddressTransaction: NewAddressTransactionClient(cfg),
+		BalanceChange:      NewBalanceChangeClient(cfg),
+		BalanceCheckpoint:  NewBalanceCheckpointClient(cfg),
+		Block:              NewBlockClient(cfg),
+		GnoEvent:           NewGnoEventClient(cfg),
+		GnoPackage:         NewGnoPackageClient(cfg),
+		GnoPackageFile:     NewGnoPackageFileClient(cfg),
+		Holding:            NewHoldingClient(cfg),
+		Nft:                NewNftClient(cfg),
+		NftTransfer:        NewNftTransferClient(cfg),
+		RealmCall:          NewRealmCallClient(cfg),
+		RebuildProgress:    NewRebuildProgressClient(cfg),
+		RestoreHistory:     NewRestoreHistoryClient(cfg),
+		Token:              NewTokenClient(cfg),
+		TokenStat:          NewTokenStatClient(cfg),
+		Transaction:        NewTransactionClient(cfg),
+		Transfer:           NewTransferClient(cfg),
+		WatchRule:          NewWatchRuleClient(cfg),
+		WebhookDelivery:    NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -202,21 +294,27 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Account.Use(hooks...)
-	c.Block.Use(hooks...)
-	c.RestoreHistory.Use(hooks...)
-	c.Transaction.Use(hooks...)
-	c.Transfer.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Account, c.AddressTransaction, c.BalanceChange, c.BalanceCheckpoint, c.Block,
+		c.GnoEvent, c.GnoPackage, c.GnoPackageFile, c.Holding, c.Nft, c.NftTransfer,
+		c.RealmCall, c.RebuildProgress, c.RestoreHistory, c.Token, c.TokenStat,
+		c.Transaction, c.Transfer, c.WatchRule, c.WebhookDelivery,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Account.Intercept(interceptors...)
-	c.Block.Intercept(interceptors...)
-	c.RestoreHistory.Intercept(interceptors...)
-	c.Transaction.Intercept(interceptors...)
-	c.Transfer.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Account, c.AddressTransaction, c.BalanceChange, c.BalanceCheckpoint, c.Block,
+		c.GnoEvent, c.GnoPackage, c.GnoPackageFile, c.Holding, c.Nft, c.NftTransfer,
+		c.RealmCall, c.RebuildProgress, c.RestoreHistory, c.Token, c.TokenStat,
+		c.Transaction, c.Transfer, c.WatchRule, c.WebhookDelivery,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -224,14 +322,44 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AccountMutation:
 		return c.Account.mutate(ctx, m)
+	case *AddressTransactionMutation:
+		return c.AddressTransaction.mutate(ctx, m)
+	case *BalanceChangeMutation:
+		return c.BalanceChange.mutate(ctx, m)
+	case *BalanceCheckpointMutation:
+		return c.BalanceCheckpoint.mutate(ctx, m)
 	case *BlockMutation:
 		return c.Block.mutate(ctx, m)
+	case *GnoEventMutation:
+		return c.GnoEvent.mutate(ctx, m)
+	case *GnoPackageMutation:
+		return c.GnoPackage.mutate(ctx, m)
+	case *GnoPackageFileMutation:
+		return c.GnoPackageFile.mutate(ctx, m)
+	case *HoldingMutation:
+		return c.Holding.mutate(ctx, m)
+	case *NftMutation:
+		return c.Nft.mutate(ctx, m)
+	case *NftTransferMutation:
+		return c.NftTransfer.mutate(ctx, m)
+	case *RealmCallMutation:
+		return c.RealmCall.mutate(ctx, m)
+	case *RebuildProgressMutation:
+		return c.RebuildProgress.mutate(ctx, m)
 	case *RestoreHistoryMutation:
 		return c.RestoreHistory.mutate(ctx, m)
+	case *TokenMutation:
+		return c.Token.mutate(ctx, m)
+	case *TokenStatMutation:
+		return c.TokenStat.mutate(ctx, m)
 	case *TransactionMutation:
 		return c.Transaction.mutate(ctx, m)
 	case *TransferMutation:
 		return c.Transfer.mutate(ctx, m)
+	case *WatchRuleMutation:
+		return c.WatchRule.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -377,6 +505,22 @@ func (c *AccountClient) QueryTransfersFrom(_m *Account) *TransferQuery {
 	return query
 }
 
+// QueryHoldings queries the holdings edge of a Account.
+func (c *AccountClient) QueryHoldings(_m *Account) *HoldingQuery {
+	query := (&HoldingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(holding.Table, holding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.HoldingsTable, account.HoldingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AccountClient) Hooks() []Hook {
 	return c.hooks.Account
@@ -402,107 +546,107 @@ func (c *AccountClient) mutate(ctx context.Context, m *AccountMutation) (Value,
 	}
 }
 
-// BlockClient is a client for the Block schema.
-type BlockClient struct {
+// AddressTransactionClient is a client for the AddressTransaction schema.
+type AddressTransactionClient struct {
 	config
 }
 
-// NewBlockClient returns a client for the Block from the given config.
-func NewBlockClient(c config) *BlockClient {
-	return &BlockClient{config: c}
+// NewAddressTransactionClient returns a client for the AddressTransaction from the given config.
+func NewAddressTransactionClient(c config) *AddressTransactionClient {
+	return &AddressTransactionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `block.Hooks(f(g(h())))`.
-func (c *BlockClient) Use(hooks ...Hook) {
-	c.hooks.Block = append(c.hooks.Block, hooks...)
+// A call to `Use(f, g, h)` equals to `addresstransaction.Hooks(f(g(h())))`.
+func (c *AddressTransactionClient) Use(hooks ...Hook) {
+	c.hooks.AddressTransaction = append(c.hooks.AddressTransaction, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `block.Intercept(f(g(h())))`.
-func (c *BlockClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Block = append(c.inters.Block, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `addresstransaction.Intercept(f(g(h())))`.
+func (c *AddressTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AddressTransaction = append(c.inters.AddressTransaction, interceptors...)
 }
 
-// Create returns a builder for creating a Block entity.
-func (c *BlockClient) Create() *BlockCreate {
-	mutation := newBlockMutation(c.config, OpCreate)
-	return &BlockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AddressTransaction entity.
+func (c *AddressTransactionClient) Create() *AddressTransactionCreate {
+	mutation := newAddressTransactionMutation(c.config, OpCreate)
+	return &AddressTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Block entities.
-func (c *BlockClient) CreateBulk(builders ...*BlockCreate) *BlockCreateBulk {
-	return &BlockCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AddressTransaction entities.
+func (c *AddressTransactionClient) CreateBulk(builders ...*AddressTransactionCreate) *AddressTransactionCreateBulk {
+	return &AddressTransactionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BlockClient) MapCreateBulk(slice any, setFunc func(*BlockCreate, int)) *BlockCreateBulk {
+func (c *AddressTransactionClient) MapCreateBulk(slice any, setFunc func(*AddressTransactionCreate, int)) *AddressTransactionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BlockCreateBulk{err: fmt.Errorf("calling to BlockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AddressTransactionCreateBulk{err: fmt.Errorf("calling to AddressTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BlockCreate, rv.Len())
+	builders := make([]*AddressTransactionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BlockCreateBulk{config: c.config, builders: builders}
+	return &AddressTransactionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Block.
-func (c *BlockClient) Update() *BlockUpdate {
-	mutation := newBlockMutation(c.config, OpUpdate)
-	return &BlockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AddressTransaction.
+func (c *AddressTransactionClient) Update() *AddressTransactionUpdate {
+	mutation := newAddressTransactionMutation(c.config, OpUpdate)
+	return &AddressTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BlockClient) UpdateOne(_m *Block) *BlockUpdateOne {
-	mutation := newBlockMutation(c.config, OpUpdateOne, withBlock(_m))
-	return &BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AddressTransactionClient) UpdateOne(_m *AddressTransaction) *AddressTransactionUpdateOne {
+	mutation := newAddressTransactionMutation(c.config, OpUpdateOne, withAddressTransaction(_m))
+	return &AddressTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BlockClient) UpdateOneID(id int) *BlockUpdateOne {
-	mutation := newBlockMutation(c.config, OpUpdateOne, withBlockID(id))
-	return &BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AddressTransactionClient) UpdateOneID(id int) *AddressTransactionUpdateOne {
+	mutation := newAddressTransactionMutation(c.config, OpUpdateOne, withAddressTransactionID(id))
+	return &AddressTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Block.
-func (c *BlockClient) Delete() *BlockDelete {
-	mutation := newBlockMutation(c.config, OpDelete)
-	return &BlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AddressTransaction.
+func (c *AddressTransactionClient) Delete() *AddressTransactionDelete {
+	mutation := newAddressTransactionMutation(c.config, OpDelete)
+	return &AddressTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BlockClient) DeleteOne(_m *Block) *BlockDeleteOne {
+func (c *AddressTransactionClient) DeleteOne(_m *AddressTransaction) *AddressTransactionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BlockClient) DeleteOneID(id int) *BlockDeleteOne {
-	builder := c.Delete().Where(block.ID(id))
+func (c *AddressTransactionClient) DeleteOneID(id int) *AddressTransactionDeleteOne {
+	builder := c.Delete().Where(addresstransaction.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BlockDeleteOne{builder}
+	return &AddressTransactionDeleteOne{builder}
 }
 
-// Query returns a query builder for Block.
-func (c *BlockClient) Query() *BlockQuery {
-	return &BlockQuery{
+// Query returns a query builder for AddressTransaction.
+func (c *AddressTransactionClient) Query() *AddressTransactionQuery {
+	return &AddressTransactionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBlock},
+		ctx:    &QueryContext{Type: TypeAddressTransaction},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Block entity by its id.
-func (c *BlockClient) Get(ctx context.Context, id int) (*Block, error) {
-	return c.Query().Where(block.ID(id)).Only(ctx)
+// Get returns a AddressTransaction entity by its id.
+func (c *AddressTransactionClient) Get(ctx context.Context, id int) (*AddressTransaction, error) {
+	return c.Query().Where(addresstransaction.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BlockClient) GetX(ctx context.Context, id int) *Block {
+func (c *AddressTransactionClient) GetX(ctx context.Context, id int) *AddressTransaction {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -510,148 +654,132 @@ func (c *BlockClient) GetX(ctx context.Context, id int) *Block {
 	return obj
 }
 
-// QueryTransactions queries the transactions edge of a Block.
-func (c *BlockClient) QueryTransactions(_m *Block) *TransactionQuery {
-	query := (&TransactionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(block.Table, block.FieldID, id),
-			sqlgraph.To(transaction.Table, transaction.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, block.TransactionsTable, block.TransactionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *BlockClient) Hooks() []Hook {
-	return c.hooks.Block
+func (c *AddressTransactionClient) Hooks() []Hook {
+	return c.hooks.AddressTransaction
 }
 
 // Interceptors returns the client interceptors.
-func (c *BlockClient) Interceptors() []Interceptor {
-	return c.inters.Block
+func (c *AddressTransactionClient) Interceptors() []Interceptor {
+	return c.inters.AddressTransaction
 }
 
-func (c *BlockClient) mutate(ctx context.Context, m *BlockMutation) (Value, error) {
+func (c *AddressTransactionClient) mutate(ctx context.Context, m *AddressTransactionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BlockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AddressTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BlockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AddressTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AddressTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BlockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AddressTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Block mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AddressTransaction mutation op: %q", m.Op())
 	}
 }
 
-// RestoreHistoryClient is a client for the RestoreHistory schema.
-type RestoreHistoryClient struct {
+// BalanceChangeClient is a client for the BalanceChange schema.
+type BalanceChangeClient struct {
 	config
 }
 
-// NewRestoreHistoryClient returns a client for the RestoreHistory from the given config.
-func NewRestoreHistoryClient(c config) *RestoreHistoryClient {
-	return &RestoreHistoryClient{config: c}
+// NewBalanceChangeClient returns a client for the BalanceChange from the given config.
+func NewBalanceChangeClient(c config) *BalanceChangeClient {
+	return &BalanceChangeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `restorehistory.Hooks(f(g(h())))`.
-func (c *RestoreHistoryClient) Use(hooks ...Hook) {
-	c.hooks.RestoreHistory = append(c.hooks.RestoreHistory, hooks...)
+// A call to `Use(f, g, h)` equals to `balancechange.Hooks(f(g(h())))`.
+func (c *BalanceChangeClient) Use(hooks ...Hook) {
+	c.hooks.BalanceChange = append(c.hooks.BalanceChange, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `restorehistory.Intercept(f(g(h())))`.
-func (c *RestoreHistoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RestoreHistory = append(c.inters.RestoreHistory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `balancechange.Intercept(f(g(h())))`.
+func (c *BalanceChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BalanceChange = append(c.inters.BalanceChange, interceptors...)
 }
 
-// Create returns a builder for creating a RestoreHistory entity.
-func (c *RestoreHistoryClient) Create() *RestoreHistoryCreate {
-	mutation := newRestoreHistoryMutation(c.config, OpCreate)
-	return &RestoreHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BalanceChange entity.
+func (c *BalanceChangeClient) Create() *BalanceChangeCreate {
+	mutation := newBalanceChangeMutation(c.config, OpCreate)
+	return &BalanceChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RestoreHistory entities.
-func (c *RestoreHistoryClient) CreateBulk(builders ...*RestoreHistoryCreate) *RestoreHistoryCreateBulk {
-	return &RestoreHistoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BalanceChange entities.
+func (c *BalanceChangeClient) CreateBulk(builders ...*BalanceChangeCreate) *BalanceChangeCreateBulk {
+	return &BalanceChangeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RestoreHistoryClient) MapCreateBulk(slice any, setFunc func(*RestoreHistoryCreate, int)) *RestoreHistoryCreateBulk {
+func (c *BalanceChangeClient) MapCreateBulk(slice any, setFunc func(*BalanceChangeCreate, int)) *BalanceChangeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RestoreHistoryCreateBulk{err: fmt.Errorf("calling to RestoreHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BalanceChangeCreateBulk{err: fmt.Errorf("calling to BalanceChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RestoreHistoryCreate, rv.Len())
+	builders := make([]*BalanceChangeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RestoreHistoryCreateBulk{config: c.config, builders: builders}
+	return &BalanceChangeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RestoreHistory.
-func (c *RestoreHistoryClient) Update() *RestoreHistoryUpdate {
-	mutation := newRestoreHistoryMutation(c.config, OpUpdate)
-	return &RestoreHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BalanceChange.
+func (c *BalanceChangeClient) Update() *BalanceChangeUpdate {
+	mutation := newBalanceChangeMutation(c.config, OpUpdate)
+	return &BalanceChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RestoreHistoryClient) UpdateOne(_m *RestoreHistory) *RestoreHistoryUpdateOne {
-	mutation := newRestoreHistoryMutation(c.config, OpUpdateOne, withRestoreHistory(_m))
-	return &RestoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BalanceChangeClient) UpdateOne(_m *BalanceChange) *BalanceChangeUpdateOne {
+	mutation := newBalanceChangeMutation(c.config, OpUpdateOne, withBalanceChange(_m))
+	return &BalanceChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RestoreHistoryClient) UpdateOneID(id int) *RestoreHistoryUpdateOne {
-	mutation := newRestoreHistoryMutation(c.config, OpUpdateOne, withRestoreHistoryID(id))
-	return &RestoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BalanceChangeClient) UpdateOneID(id int) *BalanceChangeUpdateOne {
+	mutation := newBalanceChangeMutation(c.config, OpUpdateOne, withBalanceChangeID(id))
+	return &BalanceChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RestoreHistory.
-func (c *RestoreHistoryClient) Delete() *RestoreHistoryDelete {
-	mutation := newRestoreHistoryMutation(c.config, OpDelete)
-	return &RestoreHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BalanceChange.
+func (c *BalanceChangeClient) Delete() *BalanceChangeDelete {
+	mutation := newBalanceChangeMutation(c.config, OpDelete)
+	return &BalanceChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RestoreHistoryClient) DeleteOne(_m *RestoreHistory) *RestoreHistoryDeleteOne {
+func (c *BalanceChangeClient) DeleteOne(_m *BalanceChange) *BalanceChangeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RestoreHistoryClient) DeleteOneID(id int) *RestoreHistoryDeleteOne {
-	builder := c.Delete().Where(restorehistory.ID(id))
+func (c *BalanceChangeClient) DeleteOneID(id int) *BalanceChangeDeleteOne {
+	builder := c.Delete().Where(balancechange.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RestoreHistoryDeleteOne{builder}
+	return &BalanceChangeDeleteOne{builder}
 }
 
-// Query returns a query builder for RestoreHistory.
-func (c *RestoreHistoryClient) Query() *RestoreHistoryQuery {
-	return &RestoreHistoryQuery{
+// Query returns a query builder for BalanceChange.
+func (c *BalanceChangeClient) Query() *BalanceChangeQuery {
+	return &BalanceChangeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRestoreHistory},
+		ctx:    &QueryContext{Type: TypeBalanceChange},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RestoreHistory entity by its id.
-func (c *RestoreHistoryClient) Get(ctx context.Context, id int) (*RestoreHistory, error) {
-	return c.Query().Where(restorehistory.ID(id)).Only(ctx)
+// Get returns a BalanceChange entity by its id.
+func (c *BalanceChangeClient) Get(ctx context.Context, id int) (*BalanceChange, error) {
+	return c.Query().Where(balancechange.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RestoreHistoryClient) GetX(ctx context.Context, id int) *RestoreHistory {
+func (c *BalanceChangeClient) GetX(ctx context.Context, id int) *BalanceChange {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -660,131 +788,131 @@ func (c *RestoreHistoryClient) GetX(ctx context.Context, id int) *RestoreHistory
 }
 
 // Hooks returns the client hooks.
-func (c *RestoreHistoryClient) Hooks() []Hook {
-	return c.hooks.RestoreHistory
+func (c *BalanceChangeClient) Hooks() []Hook {
+	return c.hooks.BalanceChange
 }
 
 // Interceptors returns the client interceptors.
-func (c *RestoreHistoryClient) Interceptors() []Interceptor {
-	return c.inters.RestoreHistory
+func (c *BalanceChangeClient) Interceptors() []Interceptor {
+	return c.inters.BalanceChange
 }
 
-func (c *RestoreHistoryClient) mutate(ctx context.Context, m *RestoreHistoryMutation) (Value, error) {
+func (c *BalanceChangeClient) mutate(ctx context.Context, m *BalanceChangeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RestoreHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BalanceChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RestoreHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BalanceChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RestoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BalanceChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RestoreHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BalanceChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RestoreHistory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BalanceChange mutation op: %q", m.Op())
 	}
 }
 
-// TransactionClient is a client for the Transaction schema.
-type TransactionClient struct {
+// BalanceCheckpointClient is a client for the BalanceCheckpoint schema.
+type BalanceCheckpointClient struct {
 	config
 }
 
-// NewTransactionClient returns a client for the Transaction from the given config.
-func NewTransactionClient(c config) *TransactionClient {
-	return &TransactionClient{config: c}
+// NewBalanceCheckpointClient returns a client for the BalanceCheckpoint from the given config.
+func NewBalanceCheckpointClient(c config) *BalanceCheckpointClient {
+	return &BalanceCheckpointClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `transaction.Hooks(f(g(h())))`.
-func (c *TransactionClient) Use(hooks ...Hook) {
-	c.hooks.Transaction = append(c.hooks.Transaction, hooks...)
+// A call to `Use(f, g, h)` equals to `balancecheckpoint.Hooks(f(g(h())))`.
+func (c *BalanceCheckpointClient) Use(hooks ...Hook) {
+	c.hooks.BalanceCheckpoint = append(c.hooks.BalanceCheckpoint, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `transaction.Intercept(f(g(h())))`.
-func (c *TransactionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Transaction = append(c.inters.Transaction, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `balancecheckpoint.Intercept(f(g(h())))`.
+func (c *BalanceCheckpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BalanceCheckpoint = append(c.inters.BalanceCheckpoint, interceptors...)
 }
 
-// Create returns a builder for creating a Transaction entity.
-func (c *TransactionClient) Create() *TransactionCreate {
-	mutation := newTransactionMutation(c.config, OpCreate)
-	return &TransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BalanceCheckpoint entity.
+func (c *BalanceCheckpointClient) Create() *BalanceCheckpointCreate {
+	mutation := newBalanceCheckpointMutation(c.config, OpCreate)
+	return &BalanceCheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Transaction entities.
-func (c *TransactionClient) CreateBulk(builders ...*TransactionCreate) *TransactionCreateBulk {
-	return &TransactionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BalanceCheckpoint entities.
+func (c *BalanceCheckpointClient) CreateBulk(builders ...*BalanceCheckpointCreate) *BalanceCheckpointCreateBulk {
+	return &BalanceCheckpointCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TransactionClient) MapCreateBulk(slice any, setFunc func(*TransactionCreate, int)) *TransactionCreateBulk {
+func (c *BalanceCheckpointClient) MapCreateBulk(slice any, setFunc func(*BalanceCheckpointCreate, int)) *BalanceCheckpointCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TransactionCreateBulk{err: fmt.Errorf("calling to TransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BalanceCheckpointCreateBulk{err: fmt.Errorf("calling to BalanceCheckpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TransactionCreate, rv.Len())
+	builders := make([]*BalanceCheckpointCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TransactionCreateBulk{config: c.config, builders: builders}
+	return &BalanceCheckpointCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Transaction.
-func (c *TransactionClient) Update() *TransactionUpdate {
-	mutation := newTransactionMutation(c.config, OpUpdate)
-	return &TransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BalanceCheckpoint.
+func (c *BalanceCheckpointClient) Update() *BalanceCheckpointUpdate {
+	mutation := newBalanceCheckpointMutation(c.config, OpUpdate)
+	return &BalanceCheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TransactionClient) UpdateOne(_m *Transaction) *TransactionUpdateOne {
-	mutation := newTransactionMutation(c.config, OpUpdateOne, withTransaction(_m))
-	return &TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BalanceCheckpointClient) UpdateOne(_m *BalanceCheckpoint) *BalanceCheckpointUpdateOne {
+	mutation := newBalanceCheckpointMutation(c.config, OpUpdateOne, withBalanceCheckpoint(_m))
+	return &BalanceCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TransactionClient) UpdateOneID(id int) *TransactionUpdateOne {
-	mutation := newTransactionMutation(c.config, OpUpdateOne, withTransactionID(id))
-	return &TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BalanceCheckpointClient) UpdateOneID(id int) *BalanceCheckpointUpdateOne {
+	mutation := newBalanceCheckpointMutation(c.config, OpUpdateOne, withBalanceCheckpointID(id))
+	return &BalanceCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Transaction.
-func (c *TransactionClient) Delete() *TransactionDelete {
-	mutation := newTransactionMutation(c.config, OpDelete)
-	return &TransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BalanceCheckpoint.
+func (c *BalanceCheckpointClient) Delete() *BalanceCheckpointDelete {
+	mutation := newBalanceCheckpointMutation(c.config, OpDelete)
+	return &BalanceCheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TransactionClient) DeleteOne(_m *Transaction) *TransactionDeleteOne {
+func (c *BalanceCheckpointClient) DeleteOne(_m *BalanceCheckpoint) *BalanceCheckpointDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TransactionClient) DeleteOneID(id int) *TransactionDeleteOne {
-	builder := c.Delete().Where(transaction.ID(id))
+func (c *BalanceCheckpointClient) DeleteOneID(id int) *BalanceCheckpointDeleteOne {
+	builder := c.Delete().Where(balancecheckpoint.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TransactionDeleteOne{builder}
+	return &BalanceCheckpointDeleteOne{builder}
 }
 
-// Query returns a query builder for Transaction.
-func (c *TransactionClient) Query() *TransactionQuery {
-	return &TransactionQuery{
+// Query returns a query builder for BalanceCheckpoint.
+func (c *BalanceCheckpointClient) Query() *BalanceCheckpointQuery {
+	return &BalanceCheckpointQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTransaction},
+		ctx:    &QueryContext{Type: TypeBalanceCheckpoint},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Transaction entity by its id.
-func (c *TransactionClient) Get(ctx context.Context, id int) (*Transaction, error) {
-	return c.Query().Where(transaction.ID(id)).Only(ctx)
+// Get returns a BalanceCheckpoint entity by its id.
+func (c *BalanceCheckpointClient) Get(ctx context.Context, id int) (*BalanceCheckpoint, error) {
+	return c.Query().Where(balancecheckpoint.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TransactionClient) GetX(ctx context.Context, id int) *Transaction {
+func (c *BalanceCheckpointClient) GetX(ctx context.Context, id int) *BalanceCheckpoint {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -792,78 +920,1871 @@ func (c *TransactionClient) GetX(ctx context.Context, id int) *Transaction {
 	return obj
 }
 
-// QueryBlock queries the block edge of a Transaction.
-func (c *TransactionClient) QueryBlock(_m *Transaction) *BlockQuery {
-	query := (&BlockClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(transaction.Table, transaction.FieldID, id),
-			sqlgraph.To(block.Table, block.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, transaction.BlockTable, transaction.BlockColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *TransactionClient) Hooks() []Hook {
-	return c.hooks.Transaction
+func (c *BalanceCheckpointClient) Hooks() []Hook {
+	return c.hooks.BalanceCheckpoint
 }
 
 // Interceptors returns the client interceptors.
-func (c *TransactionClient) Interceptors() []Interceptor {
-	return c.inters.Transaction
+func (c *BalanceCheckpointClient) Interceptors() []Interceptor {
+	return c.inters.BalanceCheckpoint
 }
 
-func (c *TransactionClient) mutate(ctx context.Context, m *TransactionMutation) (Value, error) {
+func (c *BalanceCheckpointClient) mutate(ctx context.Context, m *BalanceCheckpointMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BalanceCheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BalanceCheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BalanceCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BalanceCheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Transaction mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BalanceCheckpoint mutation op: %q", m.Op())
 	}
 }
 
-// TransferClient is a client for the Transfer schema.
-type TransferClient struct {
+// BlockClient is a client for the Block schema.
+type BlockClient struct {
 	config
 }
 
-// NewTransferClient returns a client for the Transfer from the given config.
-func NewTransferClient(c config) *TransferClient {
-	return &TransferClient{config: c}
+// NewBlockClient returns a client for the Block from the given config.
+func NewBlockClient(c config) *BlockClient {
+	return &BlockClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `transfer.Hooks(f(g(h())))`.
-func (c *TransferClient) Use(hooks ...Hook) {
-	c.hooks.Transfer = append(c.hooks.Transfer, hooks...)
+// A call to `Use(f, g, h)` equals to `block.Hooks(f(g(h())))`.
+func (c *BlockClient) Use(hooks ...Hook) {
+	c.hooks.Block = append(c.hooks.Block, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `transfer.Intercept(f(g(h())))`.
-func (c *TransferClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Transfer = append(c.inters.Transfer, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `block.Intercept(f(g(h())))`.
+func (c *BlockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Block = append(c.inters.Block, interceptors...)
 }
 
-// Create returns a builder for creating a Transfer entity.
-func (c *TransferClient) Create() *TransferCreate {
-	mutation := newTransferMutation(c.config, OpCreate)
-	return &TransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Block entity.
+func (c *BlockClient) Create() *BlockCreate {
+	mutation := newBlockMutation(c.config, OpCreate)
+	return &BlockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Transfer entities.
-func (c *TransferClient) CreateBulk(builders ...*TransferCreate) *TransferCreateBulk {
-	return &TransferCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Block entities.
+func (c *BlockClient) CreateBulk(builders ...*BlockCreate) *BlockCreateBulk {
+	return &BlockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlockClient) MapCreateBulk(slice any, setFunc func(*BlockCreate, int)) *BlockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlockCreateBulk{err: fmt.Errorf("calling to BlockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Block.
+func (c *BlockClient) Update() *BlockUpdate {
+	mutation := newBlockMutation(c.config, OpUpdate)
+	return &BlockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlockClient) UpdateOne(_m *Block) *BlockUpdateOne {
+	mutation := newBlockMutation(c.config, OpUpdateOne, withBlock(_m))
+	return &BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlockClient) UpdateOneID(id int) *BlockUpdateOne {
+	mutation := newBlockMutation(c.config, OpUpdateOne, withBlockID(id))
+	return &BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Block.
+func (c *BlockClient) Delete() *BlockDelete {
+	mutation := newBlockMutation(c.config, OpDelete)
+	return &BlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlockClient) DeleteOne(_m *Block) *BlockDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlockClient) DeleteOneID(id int) *BlockDeleteOne {
+	builder := c.Delete().Where(block.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlockDeleteOne{builder}
+}
+
+// Query returns a query builder for Block.
+func (c *BlockClient) Query() *BlockQuery {
+	return &BlockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Block entity by its id.
+func (c *BlockClient) Get(ctx context.Context, id int) (*Block, error) {
+	return c.Query().Where(block.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlockClient) GetX(ctx context.Context, id int) *Block {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTransactions queries the transactions edge of a Block.
+func (c *BlockClient) QueryTransactions(_m *Block) *TransactionQuery {
+	query := (&TransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(block.Table, block.FieldID, id),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, block.TransactionsTable, block.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BlockClient) Hooks() []Hook {
+	return c.hooks.Block
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlockClient) Interceptors() []Interceptor {
+	return c.inters.Block
+}
+
+func (c *BlockClient) mutate(ctx context.Context, m *BlockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Block mutation op: %q", m.Op())
+	}
+}
+
+// GnoEventClient is a client for the GnoEvent schema.
+type GnoEventClient struct {
+	config
+}
+
+// NewGnoEventClient returns a client for the GnoEvent from the given config.
+func NewGnoEventClient(c config) *GnoEventClient {
+	return &GnoEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gnoevent.Hooks(f(g(h())))`.
+func (c *GnoEventClient) Use(hooks ...Hook) {
+	c.hooks.GnoEvent = append(c.hooks.GnoEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gnoevent.Intercept(f(g(h())))`.
+func (c *GnoEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GnoEvent = append(c.inters.GnoEvent, interceptors...)
+}
+
+// Create returns a builder for creating a GnoEvent entity.
+func (c *GnoEventClient) Create() *GnoEventCreate {
+	mutation := newGnoEventMutation(c.config, OpCreate)
+	return &GnoEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GnoEvent entities.
+func (c *GnoEventClient) CreateBulk(builders ...*GnoEventCreate) *GnoEventCreateBulk {
+	return &GnoEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GnoEventClient) MapCreateBulk(slice any, setFunc func(*GnoEventCreate, int)) *GnoEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GnoEventCreateBulk{err: fmt.Errorf("calling to GnoEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GnoEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GnoEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GnoEvent.
+func (c *GnoEventClient) Update() *GnoEventUpdate {
+	mutation := newGnoEventMutation(c.config, OpUpdate)
+	return &GnoEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GnoEventClient) UpdateOne(_m *GnoEvent) *GnoEventUpdateOne {
+	mutation := newGnoEventMutation(c.config, OpUpdateOne, withGnoEvent(_m))
+	return &GnoEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GnoEventClient) UpdateOneID(id int) *GnoEventUpdateOne {
+	mutation := newGnoEventMutation(c.config, OpUpdateOne, withGnoEventID(id))
+	return &GnoEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GnoEvent.
+func (c *GnoEventClient) Delete() *GnoEventDelete {
+	mutation := newGnoEventMutation(c.config, OpDelete)
+	return &GnoEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GnoEventClient) DeleteOne(_m *GnoEvent) *GnoEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GnoEventClient) DeleteOneID(id int) *GnoEventDeleteOne {
+	builder := c.Delete().Where(gnoevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GnoEventDeleteOne{builder}
+}
+
+// Query returns a query builder for GnoEvent.
+func (c *GnoEventClient) Query() *GnoEventQuery {
+	return &GnoEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGnoEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GnoEvent entity by its id.
+func (c *GnoEventClient) Get(ctx context.Context, id int) (*GnoEvent, error) {
+	return c.Query().Where(gnoevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GnoEventClient) GetX(ctx context.Context, id int) *GnoEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GnoEventClient) Hooks() []Hook {
+	return c.hooks.GnoEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *GnoEventClient) Interceptors() []Interceptor {
+	return c.inters.GnoEvent
+}
+
+func (c *GnoEventClient) mutate(ctx context.Context, m *GnoEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GnoEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GnoEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GnoEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GnoEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GnoEvent mutation op: %q", m.Op())
+	}
+}
+
+// GnoPackageClient is a client for the GnoPackage schema.
+type GnoPackageClient struct {
+	config
+}
+
+// NewGnoPackageClient returns a client for the GnoPackage from the given config.
+func NewGnoPackageClient(c config) *GnoPackageClient {
+	return &GnoPackageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gnopackage.Hooks(f(g(h())))`.
+func (c *GnoPackageClient) Use(hooks ...Hook) {
+	c.hooks.GnoPackage = append(c.hooks.GnoPackage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gnopackage.Intercept(f(g(h())))`.
+func (c *GnoPackageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GnoPackage = append(c.inters.GnoPackage, interceptors...)
+}
+
+// Create returns a builder for creating a GnoPackage entity.
+func (c *GnoPackageClient) Create() *GnoPackageCreate {
+	mutation := newGnoPackageMutation(c.config, OpCreate)
+	return &GnoPackageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GnoPackage entities.
+func (c *GnoPackageClient) CreateBulk(builders ...*GnoPackageCreate) *GnoPackageCreateBulk {
+	return &GnoPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GnoPackageClient) MapCreateBulk(slice any, setFunc func(*GnoPackageCreate, int)) *GnoPackageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GnoPackageCreateBulk{err: fmt.Errorf("calling to GnoPackageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GnoPackageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GnoPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GnoPackage.
+func (c *GnoPackageClient) Update() *GnoPackageUpdate {
+	mutation := newGnoPackageMutation(c.config, OpUpdate)
+	return &GnoPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GnoPackageClient) UpdateOne(_m *GnoPackage) *GnoPackageUpdateOne {
+	mutation := newGnoPackageMutation(c.config, OpUpdateOne, withGnoPackage(_m))
+	return &GnoPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GnoPackageClient) UpdateOneID(id string) *GnoPackageUpdateOne {
+	mutation := newGnoPackageMutation(c.config, OpUpdateOne, withGnoPackageID(id))
+	return &GnoPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GnoPackage.
+func (c *GnoPackageClient) Delete() *GnoPackageDelete {
+	mutation := newGnoPackageMutation(c.config, OpDelete)
+	return &GnoPackageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GnoPackageClient) DeleteOne(_m *GnoPackage) *GnoPackageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GnoPackageClient) DeleteOneID(id string) *GnoPackageDeleteOne {
+	builder := c.Delete().Where(gnopackage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GnoPackageDeleteOne{builder}
+}
+
+// Query returns a query builder for GnoPackage.
+func (c *GnoPackageClient) Query() *GnoPackageQuery {
+	return &GnoPackageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGnoPackage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GnoPackage entity by its id.
+func (c *GnoPackageClient) Get(ctx context.Context, id string) (*GnoPackage, error) {
+	return c.Query().Where(gnopackage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GnoPackageClient) GetX(ctx context.Context, id string) *GnoPackage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFiles queries the files edge of a GnoPackage.
+func (c *GnoPackageClient) QueryFiles(_m *GnoPackage) *GnoPackageFileQuery {
+	query := (&GnoPackageFileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gnopackage.Table, gnopackage.FieldID, id),
+			sqlgraph.To(gnopackagefile.Table, gnopackagefile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, gnopackage.FilesTable, gnopackage.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GnoPackageClient) Hooks() []Hook {
+	return c.hooks.GnoPackage
+}
+
+// Interceptors returns the client interceptors.
+func (c *GnoPackageClient) Interceptors() []Interceptor {
+	return c.inters.GnoPackage
+}
+
+func (c *GnoPackageClient) mutate(ctx context.Context, m *GnoPackageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GnoPackageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GnoPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GnoPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GnoPackageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GnoPackage mutation op: %q", m.Op())
+	}
+}
+
+// GnoPackageFileClient is a client for the GnoPackageFile schema.
+type GnoPackageFileClient struct {
+	config
+}
+
+// NewGnoPackageFileClient returns a client for the GnoPackageFile from the given config.
+func NewGnoPackageFileClient(c config) *GnoPackageFileClient {
+	return &GnoPackageFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gnopackagefile.Hooks(f(g(h())))`.
+func (c *GnoPackageFileClient) Use(hooks ...Hook) {
+	c.hooks.GnoPackageFile = append(c.hooks.GnoPackageFile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gnopackagefile.Intercept(f(g(h())))`.
+func (c *GnoPackageFileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GnoPackageFile = append(c.inters.GnoPackageFile, interceptors...)
+}
+
+// Create returns a builder for creating a GnoPackageFile entity.
+func (c *GnoPackageFileClient) Create() *GnoPackageFileCreate {
+	mutation := newGnoPackageFileMutation(c.config, OpCreate)
+	return &GnoPackageFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GnoPackageFile entities.
+func (c *GnoPackageFileClient) CreateBulk(builders ...*GnoPackageFileCreate) *GnoPackageFileCreateBulk {
+	return &GnoPackageFileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GnoPackageFileClient) MapCreateBulk(slice any, setFunc func(*GnoPackageFileCreate, int)) *GnoPackageFileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GnoPackageFileCreateBulk{err: fmt.Errorf("calling to GnoPackageFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GnoPackageFileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GnoPackageFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GnoPackageFile.
+func (c *GnoPackageFileClient) Update() *GnoPackageFileUpdate {
+	mutation := newGnoPackageFileMutation(c.config, OpUpdate)
+	return &GnoPackageFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GnoPackageFileClient) UpdateOne(_m *GnoPackageFile) *GnoPackageFileUpdateOne {
+	mutation := newGnoPackageFileMutation(c.config, OpUpdateOne, withGnoPackageFile(_m))
+	return &GnoPackageFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GnoPackageFileClient) UpdateOneID(id int) *GnoPackageFileUpdateOne {
+	mutation := newGnoPackageFileMutation(c.config, OpUpdateOne, withGnoPackageFileID(id))
+	return &GnoPackageFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GnoPackageFile.
+func (c *GnoPackageFileClient) Delete() *GnoPackageFileDelete {
+	mutation := newGnoPackageFileMutation(c.config, OpDelete)
+	return &GnoPackageFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GnoPackageFileClient) DeleteOne(_m *GnoPackageFile) *GnoPackageFileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GnoPackageFileClient) DeleteOneID(id int) *GnoPackageFileDeleteOne {
+	builder := c.Delete().Where(gnopackagefile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GnoPackageFileDeleteOne{builder}
+}
+
+// Query returns a query builder for GnoPackageFile.
+func (c *GnoPackageFileClient) Query() *GnoPackageFileQuery {
+	return &GnoPackageFileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGnoPackageFile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GnoPackageFile entity by its id.
+func (c *GnoPackageFileClient) Get(ctx context.Context, id int) (*GnoPackageFile, error) {
+	return c.Query().Where(gnopackagefile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GnoPackageFileClient) GetX(ctx context.Context, id int) *GnoPackageFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackage queries the package edge of a GnoPackageFile.
+func (c *GnoPackageFileClient) QueryPackage(_m *GnoPackageFile) *GnoPackageQuery {
+	query := (&GnoPackageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gnopackagefile.Table, gnopackagefile.FieldID, id),
+			sqlgraph.To(gnopackage.Table, gnopackage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gnopackagefile.PackageTable, gnopackagefile.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GnoPackageFileClient) Hooks() []Hook {
+	return c.hooks.GnoPackageFile
+}
+
+// Interceptors returns the client interceptors.
+func (c *GnoPackageFileClient) Interceptors() []Interceptor {
+	return c.inters.GnoPackageFile
+}
+
+func (c *GnoPackageFileClient) mutate(ctx context.Context, m *GnoPackageFileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GnoPackageFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GnoPackageFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GnoPackageFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GnoPackageFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GnoPackageFile mutation op: %q", m.Op())
+	}
+}
+
+// HoldingClient is a client for the Holding schema.
+type HoldingClient struct {
+	config
+}
+
+// NewHoldingClient returns a client for the Holding from the given config.
+func NewHoldingClient(c config) *HoldingClient {
+	return &HoldingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `holding.Hooks(f(g(h())))`.
+func (c *HoldingClient) Use(hooks ...Hook) {
+	c.hooks.Holding = append(c.hooks.Holding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `holding.Intercept(f(g(h())))`.
+func (c *HoldingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Holding = append(c.inters.Holding, interceptors...)
+}
+
+// Create returns a builder for creating a Holding entity.
+func (c *HoldingClient) Create() *HoldingCreate {
+	mutation := newHoldingMutation(c.config, OpCreate)
+	return &HoldingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Holding entities.
+func (c *HoldingClient) CreateBulk(builders ...*HoldingCreate) *HoldingCreateBulk {
+	return &HoldingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HoldingClient) MapCreateBulk(slice any, setFunc func(*HoldingCreate, int)) *HoldingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HoldingCreateBulk{err: fmt.Errorf("calling to HoldingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HoldingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HoldingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Holding.
+func (c *HoldingClient) Update() *HoldingUpdate {
+	mutation := newHoldingMutation(c.config, OpUpdate)
+	return &HoldingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HoldingClient) UpdateOne(_m *Holding) *HoldingUpdateOne {
+	mutation := newHoldingMutation(c.config, OpUpdateOne, withHolding(_m))
+	return &HoldingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HoldingClient) UpdateOneID(id int) *HoldingUpdateOne {
+	mutation := newHoldingMutation(c.config, OpUpdateOne, withHoldingID(id))
+	return &HoldingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Holding.
+func (c *HoldingClient) Delete() *HoldingDelete {
+	mutation := newHoldingMutation(c.config, OpDelete)
+	return &HoldingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HoldingClient) DeleteOne(_m *Holding) *HoldingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HoldingClient) DeleteOneID(id int) *HoldingDeleteOne {
+	builder := c.Delete().Where(holding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HoldingDeleteOne{builder}
+}
+
+// Query returns a query builder for Holding.
+func (c *HoldingClient) Query() *HoldingQuery {
+	return &HoldingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHolding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Holding entity by its id.
+func (c *HoldingClient) Get(ctx context.Context, id int) (*Holding, error) {
+	return c.Query().Where(holding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HoldingClient) GetX(ctx context.Context, id int) *Holding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Holding.
+func (c *HoldingClient) QueryAccount(_m *Holding) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(holding.Table, holding.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, holding.AccountTable, holding.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HoldingClient) Hooks() []Hook {
+	return c.hooks.Holding
+}
+
+// Interceptors returns the client interceptors.
+func (c *HoldingClient) Interceptors() []Interceptor {
+	return c.inters.Holding
+}
+
+func (c *HoldingClient) mutate(ctx context.Context, m *HoldingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HoldingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HoldingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HoldingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HoldingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Holding mutation op: %q", m.Op())
+	}
+}
+
+// NftClient is a client for the Nft schema.
+type NftClient struct {
+	config
+}
+
+// NewNftClient returns a client for the Nft from the given config.
+func NewNftClient(c config) *NftClient {
+	return &NftClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nft.Hooks(f(g(h())))`.
+func (c *NftClient) Use(hooks ...Hook) {
+	c.hooks.Nft = append(c.hooks.Nft, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nft.Intercept(f(g(h())))`.
+func (c *NftClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Nft = append(c.inters.Nft, interceptors...)
+}
+
+// Create returns a builder for creating a Nft entity.
+func (c *NftClient) Create() *NftCreate {
+	mutation := newNftMutation(c.config, OpCreate)
+	return &NftCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Nft entities.
+func (c *NftClient) CreateBulk(builders ...*NftCreate) *NftCreateBulk {
+	return &NftCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NftClient) MapCreateBulk(slice any, setFunc func(*NftCreate, int)) *NftCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NftCreateBulk{err: fmt.Errorf("calling to NftClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NftCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NftCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Nft.
+func (c *NftClient) Update() *NftUpdate {
+	mutation := newNftMutation(c.config, OpUpdate)
+	return &NftUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NftClient) UpdateOne(_m *Nft) *NftUpdateOne {
+	mutation := newNftMutation(c.config, OpUpdateOne, withNft(_m))
+	return &NftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NftClient) UpdateOneID(id int) *NftUpdateOne {
+	mutation := newNftMutation(c.config, OpUpdateOne, withNftID(id))
+	return &NftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Nft.
+func (c *NftClient) Delete() *NftDelete {
+	mutation := newNftMutation(c.config, OpDelete)
+	return &NftDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NftClient) DeleteOne(_m *Nft) *NftDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NftClient) DeleteOneID(id int) *NftDeleteOne {
+	builder := c.Delete().Where(nft.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NftDeleteOne{builder}
+}
+
+// Query returns a query builder for Nft.
+func (c *NftClient) Query() *NftQuery {
+	return &NftQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNft},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Nft entity by its id.
+func (c *NftClient) Get(ctx context.Context, id int) (*Nft, error) {
+	return c.Query().Where(nft.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NftClient) GetX(ctx context.Context, id int) *Nft {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NftClient) Hooks() []Hook {
+	return c.hooks.Nft
+}
+
+// Interceptors returns the client interceptors.
+func (c *NftClient) Interceptors() []Interceptor {
+	return c.inters.Nft
+}
+
+func (c *NftClient) mutate(ctx context.Context, m *NftMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NftCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NftUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NftDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Nft mutation op: %q", m.Op())
+	}
+}
+
+// NftTransferClient is a client for the NftTransfer schema.
+type NftTransferClient struct {
+	config
+}
+
+// NewNftTransferClient returns a client for the NftTransfer from the given config.
+func NewNftTransferClient(c config) *NftTransferClient {
+	return &NftTransferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nfttransfer.Hooks(f(g(h())))`.
+func (c *NftTransferClient) Use(hooks ...Hook) {
+	c.hooks.NftTransfer = append(c.hooks.NftTransfer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nfttransfer.Intercept(f(g(h())))`.
+func (c *NftTransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NftTransfer = append(c.inters.NftTransfer, interceptors...)
+}
+
+// Create returns a builder for creating a NftTransfer entity.
+func (c *NftTransferClient) Create() *NftTransferCreate {
+	mutation := newNftTransferMutation(c.config, OpCreate)
+	return &NftTransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NftTransfer entities.
+func (c *NftTransferClient) CreateBulk(builders ...*NftTransferCreate) *NftTransferCreateBulk {
+	return &NftTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NftTransferClient) MapCreateBulk(slice any, setFunc func(*NftTransferCreate, int)) *NftTransferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NftTransferCreateBulk{err: fmt.Errorf("calling to NftTransferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NftTransferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NftTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NftTransfer.
+func (c *NftTransferClient) Update() *NftTransferUpdate {
+	mutation := newNftTransferMutation(c.config, OpUpdate)
+	return &NftTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NftTransferClient) UpdateOne(_m *NftTransfer) *NftTransferUpdateOne {
+	mutation := newNftTransferMutation(c.config, OpUpdateOne, withNftTransfer(_m))
+	return &NftTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NftTransferClient) UpdateOneID(id int) *NftTransferUpdateOne {
+	mutation := newNftTransferMutation(c.config, OpUpdateOne, withNftTransferID(id))
+	return &NftTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NftTransfer.
+func (c *NftTransferClient) Delete() *NftTransferDelete {
+	mutation := newNftTransferMutation(c.config, OpDelete)
+	return &NftTransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NftTransferClient) DeleteOne(_m *NftTransfer) *NftTransferDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NftTransferClient) DeleteOneID(id int) *NftTransferDeleteOne {
+	builder := c.Delete().Where(nfttransfer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NftTransferDeleteOne{builder}
+}
+
+// Query returns a query builder for NftTransfer.
+func (c *NftTransferClient) Query() *NftTransferQuery {
+	return &NftTransferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNftTransfer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NftTransfer entity by its id.
+func (c *NftTransferClient) Get(ctx context.Context, id int) (*NftTransfer, error) {
+	return c.Query().Where(nfttransfer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NftTransferClient) GetX(ctx context.Context, id int) *NftTransfer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NftTransferClient) Hooks() []Hook {
+	return c.hooks.NftTransfer
+}
+
+// Interceptors returns the client interceptors.
+func (c *NftTransferClient) Interceptors() []Interceptor {
+	return c.inters.NftTransfer
+}
+
+func (c *NftTransferClient) mutate(ctx context.Context, m *NftTransferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NftTransferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NftTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NftTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NftTransferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NftTransfer mutation op: %q", m.Op())
+	}
+}
+
+// RealmCallClient is a client for the RealmCall schema.
+type RealmCallClient struct {
+	config
+}
+
+// NewRealmCallClient returns a client for the RealmCall from the given config.
+func NewRealmCallClient(c config) *RealmCallClient {
+	return &RealmCallClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `realmcall.Hooks(f(g(h())))`.
+func (c *RealmCallClient) Use(hooks ...Hook) {
+	c.hooks.RealmCall = append(c.hooks.RealmCall, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `realmcall.Intercept(f(g(h())))`.
+func (c *RealmCallClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RealmCall = append(c.inters.RealmCall, interceptors...)
+}
+
+// Create returns a builder for creating a RealmCall entity.
+func (c *RealmCallClient) Create() *RealmCallCreate {
+	mutation := newRealmCallMutation(c.config, OpCreate)
+	return &RealmCallCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RealmCall entities.
+func (c *RealmCallClient) CreateBulk(builders ...*RealmCallCreate) *RealmCallCreateBulk {
+	return &RealmCallCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RealmCallClient) MapCreateBulk(slice any, setFunc func(*RealmCallCreate, int)) *RealmCallCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RealmCallCreateBulk{err: fmt.Errorf("calling to RealmCallClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RealmCallCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RealmCallCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RealmCall.
+func (c *RealmCallClient) Update() *RealmCallUpdate {
+	mutation := newRealmCallMutation(c.config, OpUpdate)
+	return &RealmCallUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RealmCallClient) UpdateOne(_m *RealmCall) *RealmCallUpdateOne {
+	mutation := newRealmCallMutation(c.config, OpUpdateOne, withRealmCall(_m))
+	return &RealmCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RealmCallClient) UpdateOneID(id int) *RealmCallUpdateOne {
+	mutation := newRealmCallMutation(c.config, OpUpdateOne, withRealmCallID(id))
+	return &RealmCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RealmCall.
+func (c *RealmCallClient) Delete() *RealmCallDelete {
+	mutation := newRealmCallMutation(c.config, OpDelete)
+	return &RealmCallDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RealmCallClient) DeleteOne(_m *RealmCall) *RealmCallDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RealmCallClient) DeleteOneID(id int) *RealmCallDeleteOne {
+	builder := c.Delete().Where(realmcall.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RealmCallDeleteOne{builder}
+}
+
+// Query returns a query builder for RealmCall.
+func (c *RealmCallClient) Query() *RealmCallQuery {
+	return &RealmCallQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRealmCall},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RealmCall entity by its id.
+func (c *RealmCallClient) Get(ctx context.Context, id int) (*RealmCall, error) {
+	return c.Query().Where(realmcall.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RealmCallClient) GetX(ctx context.Context, id int) *RealmCall {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RealmCallClient) Hooks() []Hook {
+	return c.hooks.RealmCall
+}
+
+// Interceptors returns the client interceptors.
+func (c *RealmCallClient) Interceptors() []Interceptor {
+	return c.inters.RealmCall
+}
+
+func (c *RealmCallClient) mutate(ctx context.Context, m *RealmCallMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RealmCallCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RealmCallUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RealmCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RealmCallDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RealmCall mutation op: %q", m.Op())
+	}
+}
+
+// RebuildProgressClient is a client for the RebuildProgress schema.
+type RebuildProgressClient struct {
+	config
+}
+
+// NewRebuildProgressClient returns a client for the RebuildProgress from the given config.
+func NewRebuildProgressClient(c config) *RebuildProgressClient {
+	return &RebuildProgressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rebuildprogress.Hooks(f(g(h())))`.
+func (c *RebuildProgressClient) Use(hooks ...Hook) {
+	c.hooks.RebuildProgress = append(c.hooks.RebuildProgress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rebuildprogress.Intercept(f(g(h())))`.
+func (c *RebuildProgressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RebuildProgress = append(c.inters.RebuildProgress, interceptors...)
+}
+
+// Create returns a builder for creating a RebuildProgress entity.
+func (c *RebuildProgressClient) Create() *RebuildProgressCreate {
+	mutation := newRebuildProgressMutation(c.config, OpCreate)
+	return &RebuildProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RebuildProgress entities.
+func (c *RebuildProgressClient) CreateBulk(builders ...*RebuildProgressCreate) *RebuildProgressCreateBulk {
+	return &RebuildProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RebuildProgressClient) MapCreateBulk(slice any, setFunc func(*RebuildProgressCreate, int)) *RebuildProgressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RebuildProgressCreateBulk{err: fmt.Errorf("calling to RebuildProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RebuildProgressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RebuildProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RebuildProgress.
+func (c *RebuildProgressClient) Update() *RebuildProgressUpdate {
+	mutation := newRebuildProgressMutation(c.config, OpUpdate)
+	return &RebuildProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RebuildProgressClient) UpdateOne(_m *RebuildProgress) *RebuildProgressUpdateOne {
+	mutation := newRebuildProgressMutation(c.config, OpUpdateOne, withRebuildProgress(_m))
+	return &RebuildProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RebuildProgressClient) UpdateOneID(id int) *RebuildProgressUpdateOne {
+	mutation := newRebuildProgressMutation(c.config, OpUpdateOne, withRebuildProgressID(id))
+	return &RebuildProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RebuildProgress.
+func (c *RebuildProgressClient) Delete() *RebuildProgressDelete {
+	mutation := newRebuildProgressMutation(c.config, OpDelete)
+	return &RebuildProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RebuildProgressClient) DeleteOne(_m *RebuildProgress) *RebuildProgressDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RebuildProgressClient) DeleteOneID(id int) *RebuildProgressDeleteOne {
+	builder := c.Delete().Where(rebuildprogress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RebuildProgressDeleteOne{builder}
+}
+
+// Query returns a query builder for RebuildProgress.
+func (c *RebuildProgressClient) Query() *RebuildProgressQuery {
+	return &RebuildProgressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRebuildProgress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RebuildProgress entity by its id.
+func (c *RebuildProgressClient) Get(ctx context.Context, id int) (*RebuildProgress, error) {
+	return c.Query().Where(rebuildprogress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RebuildProgressClient) GetX(ctx context.Context, id int) *RebuildProgress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RebuildProgressClient) Hooks() []Hook {
+	return c.hooks.RebuildProgress
+}
+
+// Interceptors returns the client interceptors.
+func (c *RebuildProgressClient) Interceptors() []Interceptor {
+	return c.inters.RebuildProgress
+}
+
+func (c *RebuildProgressClient) mutate(ctx context.Context, m *RebuildProgressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RebuildProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RebuildProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RebuildProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RebuildProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RebuildProgress mutation op: %q", m.Op())
+	}
+}
+
+// RestoreHistoryClient is a client for the RestoreHistory schema.
+type RestoreHistoryClient struct {
+	config
+}
+
+// NewRestoreHistoryClient returns a client for the RestoreHistory from the given config.
+func NewRestoreHistoryClient(c config) *RestoreHistoryClient {
+	return &RestoreHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `restorehistory.Hooks(f(g(h())))`.
+func (c *RestoreHistoryClient) Use(hooks ...Hook) {
+	c.hooks.RestoreHistory = append(c.hooks.RestoreHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `restorehistory.Intercept(f(g(h())))`.
+func (c *RestoreHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RestoreHistory = append(c.inters.RestoreHistory, interceptors...)
+}
+
+// Create returns a builder for creating a RestoreHistory entity.
+func (c *RestoreHistoryClient) Create() *RestoreHistoryCreate {
+	mutation := newRestoreHistoryMutation(c.config, OpCreate)
+	return &RestoreHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RestoreHistory entities.
+func (c *RestoreHistoryClient) CreateBulk(builders ...*RestoreHistoryCreate) *RestoreHistoryCreateBulk {
+	return &RestoreHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RestoreHistoryClient) MapCreateBulk(slice any, setFunc func(*RestoreHistoryCreate, int)) *RestoreHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RestoreHistoryCreateBulk{err: fmt.Errorf("calling to RestoreHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RestoreHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RestoreHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RestoreHistory.
+func (c *RestoreHistoryClient) Update() *RestoreHistoryUpdate {
+	mutation := newRestoreHistoryMutation(c.config, OpUpdate)
+	return &RestoreHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RestoreHistoryClient) UpdateOne(_m *RestoreHistory) *RestoreHistoryUpdateOne {
+	mutation := newRestoreHistoryMutation(c.config, OpUpdateOne, withRestoreHistory(_m))
+	return &RestoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RestoreHistoryClient) UpdateOneID(id int) *RestoreHistoryUpdateOne {
+	mutation := newRestoreHistoryMutation(c.config, OpUpdateOne, withRestoreHistoryID(id))
+	return &RestoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RestoreHistory.
+func (c *RestoreHistoryClient) Delete() *RestoreHistoryDelete {
+	mutation := newRestoreHistoryMutation(c.config, OpDelete)
+	return &RestoreHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RestoreHistoryClient) DeleteOne(_m *RestoreHistory) *RestoreHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RestoreHistoryClient) DeleteOneID(id int) *RestoreHistoryDeleteOne {
+	builder := c.Delete().Where(restorehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RestoreHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for RestoreHistory.
+func (c *RestoreHistoryClient) Query() *RestoreHistoryQuery {
+	return &RestoreHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRestoreHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RestoreHistory entity by its id.
+func (c *RestoreHistoryClient) Get(ctx context.Context, id int) (*RestoreHistory, error) {
+	return c.Query().Where(restorehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RestoreHistoryClient) GetX(ctx context.Context, id int) *RestoreHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RestoreHistoryClient) Hooks() []Hook {
+	return c.hooks.RestoreHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *RestoreHistoryClient) Interceptors() []Interceptor {
+	return c.inters.RestoreHistory
+}
+
+func (c *RestoreHistoryClient) mutate(ctx context.Context, m *RestoreHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RestoreHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RestoreHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RestoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RestoreHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RestoreHistory mutation op: %q", m.Op())
+	}
+}
+
+// TokenClient is a client for the Token schema.
+type TokenClient struct {
+	config
+}
+
+// NewTokenClient returns a client for the Token from the given config.
+func NewTokenClient(c config) *TokenClient {
+	return &TokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `token.Hooks(f(g(h())))`.
+func (c *TokenClient) Use(hooks ...Hook) {
+	c.hooks.Token = append(c.hooks.Token, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `token.Intercept(f(g(h())))`.
+func (c *TokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Token = append(c.inters.Token, interceptors...)
+}
+
+// Create returns a builder for creating a Token entity.
+func (c *TokenClient) Create() *TokenCreate {
+	mutation := newTokenMutation(c.config, OpCreate)
+	return &TokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Token entities.
+func (c *TokenClient) CreateBulk(builders ...*TokenCreate) *TokenCreateBulk {
+	return &TokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenClient) MapCreateBulk(slice any, setFunc func(*TokenCreate, int)) *TokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenCreateBulk{err: fmt.Errorf("calling to TokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Token.
+func (c *TokenClient) Update() *TokenUpdate {
+	mutation := newTokenMutation(c.config, OpUpdate)
+	return &TokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TokenClient) UpdateOne(_m *Token) *TokenUpdateOne {
+	mutation := newTokenMutation(c.config, OpUpdateOne, withToken(_m))
+	return &TokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TokenClient) UpdateOneID(id string) *TokenUpdateOne {
+	mutation := newTokenMutation(c.config, OpUpdateOne, withTokenID(id))
+	return &TokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Token.
+func (c *TokenClient) Delete() *TokenDelete {
+	mutation := newTokenMutation(c.config, OpDelete)
+	return &TokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TokenClient) DeleteOne(_m *Token) *TokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TokenClient) DeleteOneID(id string) *TokenDeleteOne {
+	builder := c.Delete().Where(token.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TokenDeleteOne{builder}
+}
+
+// Query returns a query builder for Token.
+func (c *TokenClient) Query() *TokenQuery {
+	return &TokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Token entity by its id.
+func (c *TokenClient) Get(ctx context.Context, id string) (*Token, error) {
+	return c.Query().Where(token.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TokenClient) GetX(ctx context.Context, id string) *Token {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TokenClient) Hooks() []Hook {
+	return c.hooks.Token
+}
+
+// Interceptors returns the client interceptors.
+func (c *TokenClient) Interceptors() []Interceptor {
+	return c.inters.Token
+}
+
+func (c *TokenClient) mutate(ctx context.Context, m *TokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Token mutation op: %q", m.Op())
+	}
+}
+
+// TokenStatClient is a client for the TokenStat schema.
+type TokenStatClient struct {
+	config
+}
+
+// NewTokenStatClient returns a client for the TokenStat from the given config.
+func NewTokenStatClient(c config) *TokenStatClient {
+	return &TokenStatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tokenstat.Hooks(f(g(h())))`.
+func (c *TokenStatClient) Use(hooks ...Hook) {
+	c.hooks.TokenStat = append(c.hooks.TokenStat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tokenstat.Intercept(f(g(h())))`.
+func (c *TokenStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TokenStat = append(c.inters.TokenStat, interceptors...)
+}
+
+// Create returns a builder for creating a TokenStat entity.
+func (c *TokenStatClient) Create() *TokenStatCreate {
+	mutation := newTokenStatMutation(c.config, OpCreate)
+	return &TokenStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TokenStat entities.
+func (c *TokenStatClient) CreateBulk(builders ...*TokenStatCreate) *TokenStatCreateBulk {
+	return &TokenStatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenStatClient) MapCreateBulk(slice any, setFunc func(*TokenStatCreate, int)) *TokenStatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenStatCreateBulk{err: fmt.Errorf("calling to TokenStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenStatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenStatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TokenStat.
+func (c *TokenStatClient) Update() *TokenStatUpdate {
+	mutation := newTokenStatMutation(c.config, OpUpdate)
+	return &TokenStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TokenStatClient) UpdateOne(_m *TokenStat) *TokenStatUpdateOne {
+	mutation := newTokenStatMutation(c.config, OpUpdateOne, withTokenStat(_m))
+	return &TokenStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TokenStatClient) UpdateOneID(id string) *TokenStatUpdateOne {
+	mutation := newTokenStatMutation(c.config, OpUpdateOne, withTokenStatID(id))
+	return &TokenStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TokenStat.
+func (c *TokenStatClient) Delete() *TokenStatDelete {
+	mutation := newTokenStatMutation(c.config, OpDelete)
+	return &TokenStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TokenStatClient) DeleteOne(_m *TokenStat) *TokenStatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TokenStatClient) DeleteOneID(id string) *TokenStatDeleteOne {
+	builder := c.Delete().Where(tokenstat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TokenStatDeleteOne{builder}
+}
+
+// Query returns a query builder for TokenStat.
+func (c *TokenStatClient) Query() *TokenStatQuery {
+	return &TokenStatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTokenStat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TokenStat entity by its id.
+func (c *TokenStatClient) Get(ctx context.Context, id string) (*TokenStat, error) {
+	return c.Query().Where(tokenstat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TokenStatClient) GetX(ctx context.Context, id string) *TokenStat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TokenStatClient) Hooks() []Hook {
+	return c.hooks.TokenStat
+}
+
+// Interceptors returns the client interceptors.
+func (c *TokenStatClient) Interceptors() []Interceptor {
+	return c.inters.TokenStat
+}
+
+func (c *TokenStatClient) mutate(ctx context.Context, m *TokenStatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TokenStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TokenStat mutation op: %q", m.Op())
+	}
+}
+
+// TransactionClient is a client for the Transaction schema.
+type TransactionClient struct {
+	config
+}
+
+// NewTransactionClient returns a client for the Transaction from the given config.
+func NewTransactionClient(c config) *TransactionClient {
+	return &TransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transaction.Hooks(f(g(h())))`.
+func (c *TransactionClient) Use(hooks ...Hook) {
+	c.hooks.Transaction = append(c.hooks.Transaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transaction.Intercept(f(g(h())))`.
+func (c *TransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Transaction = append(c.inters.Transaction, interceptors...)
+}
+
+// Create returns a builder for creating a Transaction entity.
+func (c *TransactionClient) Create() *TransactionCreate {
+	mutation := newTransactionMutation(c.config, OpCreate)
+	return &TransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Transaction entities.
+func (c *TransactionClient) CreateBulk(builders ...*TransactionCreate) *TransactionCreateBulk {
+	return &TransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TransactionClient) MapCreateBulk(slice any, setFunc func(*TransactionCreate, int)) *TransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TransactionCreateBulk{err: fmt.Errorf("calling to TransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Transaction.
+func (c *TransactionClient) Update() *TransactionUpdate {
+	mutation := newTransactionMutation(c.config, OpUpdate)
+	return &TransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TransactionClient) UpdateOne(_m *Transaction) *TransactionUpdateOne {
+	mutation := newTransactionMutation(c.config, OpUpdateOne, withTransaction(_m))
+	return &TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TransactionClient) UpdateOneID(id int) *TransactionUpdateOne {
+	mutation := newTransactionMutation(c.config, OpUpdateOne, withTransactionID(id))
+	return &TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Transaction.
+func (c *TransactionClient) Delete() *TransactionDelete {
+	mutation := newTransactionMutation(c.config, OpDelete)
+	return &TransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TransactionClient) DeleteOne(_m *Transaction) *TransactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TransactionClient) DeleteOneID(id int) *TransactionDeleteOne {
+	builder := c.Delete().Where(transaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for Transaction.
+func (c *TransactionClient) Query() *TransactionQuery {
+	return &TransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Transaction entity by its id.
+func (c *TransactionClient) Get(ctx context.Context, id int) (*Transaction, error) {
+	return c.Query().Where(transaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TransactionClient) GetX(ctx context.Context, id int) *Transaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBlock queries the block edge of a Transaction.
+func (c *TransactionClient) QueryBlock(_m *Transaction) *BlockQuery {
+	query := (&BlockClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, id),
+			sqlgraph.To(block.Table, block.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.BlockTable, transaction.BlockColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TransactionClient) Hooks() []Hook {
+	return c.hooks.Transaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *TransactionClient) Interceptors() []Interceptor {
+	return c.inters.Transaction
+}
+
+func (c *TransactionClient) mutate(ctx context.Context, m *TransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Transaction mutation op: %q", m.Op())
+	}
+}
+
+// TransferClient is a client for the Transfer schema.
+type TransferClient struct {
+	config
+}
+
+// NewTransferClient returns a client for the Transfer from the given config.
+func NewTransferClient(c config) *TransferClient {
+	return &TransferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transfer.Hooks(f(g(h())))`.
+func (c *TransferClient) Use(hooks ...Hook) {
+	c.hooks.Transfer = append(c.hooks.Transfer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transfer.Intercept(f(g(h())))`.
+func (c *TransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Transfer = append(c.inters.Transfer, interceptors...)
+}
+
+// Create returns a builder for creating a Transfer entity.
+func (c *TransferClient) Create() *TransferCreate {
+	mutation := newTransferMutation(c.config, OpCreate)
+	return &TransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Transfer entities.
+func (c *TransferClient) CreateBulk(builders ...*TransferCreate) *TransferCreateBulk {
+	return &TransferCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
@@ -966,12 +2887,340 @@ func (c *TransferClient) mutate(ctx context.Context, m *TransferMutation) (Value
 	}
 }
 
+// WatchRuleClient is a client for the WatchRule schema.
+type WatchRuleClient struct {
+	config
+}
+
+// NewWatchRuleClient returns a client for the WatchRule from the given config.
+func NewWatchRuleClient(c config) *WatchRuleClient {
+	return &WatchRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `watchrule.Hooks(f(g(h())))`.
+func (c *WatchRuleClient) Use(hooks ...Hook) {
+	c.hooks.WatchRule = append(c.hooks.WatchRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `watchrule.Intercept(f(g(h())))`.
+func (c *WatchRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WatchRule = append(c.inters.WatchRule, interceptors...)
+}
+
+// Create returns a builder for creating a WatchRule entity.
+func (c *WatchRuleClient) Create() *WatchRuleCreate {
+	mutation := newWatchRuleMutation(c.config, OpCreate)
+	return &WatchRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WatchRule entities.
+func (c *WatchRuleClient) CreateBulk(builders ...*WatchRuleCreate) *WatchRuleCreateBulk {
+	return &WatchRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WatchRuleClient) MapCreateBulk(slice any, setFunc func(*WatchRuleCreate, int)) *WatchRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WatchRuleCreateBulk{err: fmt.Errorf("calling to WatchRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WatchRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WatchRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WatchRule.
+func (c *WatchRuleClient) Update() *WatchRuleUpdate {
+	mutation := newWatchRuleMutation(c.config, OpUpdate)
+	return &WatchRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WatchRuleClient) UpdateOne(_m *WatchRule) *WatchRuleUpdateOne {
+	mutation := newWatchRuleMutation(c.config, OpUpdateOne, withWatchRule(_m))
+	return &WatchRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WatchRuleClient) UpdateOneID(id int) *WatchRuleUpdateOne {
+	mutation := newWatchRuleMutation(c.config, OpUpdateOne, withWatchRuleID(id))
+	return &WatchRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WatchRule.
+func (c *WatchRuleClient) Delete() *WatchRuleDelete {
+	mutation := newWatchRuleMutation(c.config, OpDelete)
+	return &WatchRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WatchRuleClient) DeleteOne(_m *WatchRule) *WatchRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WatchRuleClient) DeleteOneID(id int) *WatchRuleDeleteOne {
+	builder := c.Delete().Where(watchrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WatchRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for WatchRule.
+func (c *WatchRuleClient) Query() *WatchRuleQuery {
+	return &WatchRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWatchRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WatchRule entity by its id.
+func (c *WatchRuleClient) Get(ctx context.Context, id int) (*WatchRule, error) {
+	return c.Query().Where(watchrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WatchRuleClient) GetX(ctx context.Context, id int) *WatchRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeliveries queries the deliveries edge of a WatchRule.
+func (c *WatchRuleClient) QueryDeliveries(_m *WatchRule) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchrule.Table, watchrule.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, watchrule.DeliveriesTable, watchrule.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WatchRuleClient) Hooks() []Hook {
+	return c.hooks.WatchRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *WatchRuleClient) Interceptors() []Interceptor {
+	return c.inters.WatchRule
+}
+
+func (c *WatchRuleClient) mutate(ctx context.Context, m *WatchRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WatchRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WatchRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WatchRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WatchRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WatchRule mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRule queries the rule edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryRule(_m *WebhookDelivery) *WatchRuleQuery {
+	query := (&WatchRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(watchrule.Table, watchrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.RuleTable, webhookdelivery.RuleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Account, Block, RestoreHistory, Transaction, Transfer []ent.Hook
+		Account, AddressTransaction, BalanceChange, BalanceCheckpoint, Block, GnoEvent,
+		GnoPackage, GnoPackageFile, Holding, Nft, NftTransfer, RealmCall,
+		RebuildProgress, RestoreHistory, Token, TokenStat, Transaction, Transfer,
+		WatchRule, WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		Account, Block, RestoreHistory, Transaction, Transfer []ent.Interceptor
+		Account, AddressTransaction, BalanceChange, BalanceCheckpoint, Block, GnoEvent,
+		GnoPackage, GnoPackageFile, Holding, Nft, NftTransfer, RealmCall,
+		RebuildProgress, RestoreHistory, Token, TokenStat, Transaction, Transfer,
+		WatchRule, WebhookDelivery []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -13,10 +13,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/addresstransaction"
+	"gno.land-block-indexer/ent/balancechange"
+	"gno.land-block-indexer/ent/balancecheckpoint"
 	"gno.land-block-indexer/ent/block"
+	"gno.land-block-indexer/ent/gnoevent"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+	"gno.land-block-indexer/ent/holding"
+	"gno.land-block-indexer/ent/nft"
+	"gno.land-block-indexer/ent/nfttransfer"
+	"gno.land-block-indexer/ent/realmcall"
+	"gno.land-block-indexer/ent/rebuildprogress"
 	"gno.land-block-indexer/ent/restorehistory"
+	"gno.land-block-indexer/ent/token"
+	"gno.land-block-indexer/ent/tokenstat"
 	"gno.land-block-indexer/ent/transaction"
 	"gno.land-block-indexer/ent/transfer"
+	"gno.land-block-indexer/ent/watchrule"
+	"gno.land-block-indexer/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +92,26 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table:        account.ValidColumn,
-			block.Table:          block.ValidColumn,
-			restorehistory.Table: restorehistory.ValidColumn,
-			transaction.Table:    transaction.ValidColumn,
-			transfer.Table:       transfer.ValidColumn,
+			account.Table:            account.ValidColumn,
+			addresstransaction.Table: addresstransaction.ValidColumn,
+			balancechange.Table:      balancechange.ValidColumn,
+			balancecheckpoint.Table:  balancecheckpoint.ValidColumn,
+			block.Table:              block.ValidColumn,
+			gnoevent.Table:           gnoevent.ValidColumn,
+			gnopackage.Table:         gnopackage.ValidColumn,
+			gnopackagefile.Table:     gnopackagefile.ValidColumn,
+			holding.Table:            holding.ValidColumn,
+			nft.Table:                nft.ValidColumn,
+			nfttransfer.Table:        nfttransfer.ValidColumn,
+			realmcall.Table:          realmcall.ValidColumn,
+			rebuildprogress.Table:    rebuildprogress.ValidColumn,
+			restorehistory.Table:     restorehistory.ValidColumn,
+			token.Table:              token.ValidColumn,
+			tokenstat.Table:          tokenstat.ValidColumn,
+			transaction.Table:        transaction.ValidColumn,
+			transfer.Table:           transfer.ValidColumn,
+			watchrule.Table:          watchrule.ValidColumn,
+			webhookdelivery.Table:    webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/gnoevent"
+	"gno.land-block-indexer/ent/schema"
+)
+
+// GnoEvent is the model entity for the GnoEvent schema.
+type GnoEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Hash of the transaction
+	Hash string `json:"hash,omitempty"`
+	// Index of the event in the transaction
+	EventIndex int `json:"event_index,omitempty"`
+	// Height of the block containing the event
+	BlockHeight int `json:"block_height,omitempty"`
+	// Index of the transaction in the block
+	TxIndex int `json:"tx_index,omitempty"`
+	// Timestamp of the block containing the event
+	BlockTime time.Time `json:"block_time,omitempty"`
+	// Type of the event
+	Type string `json:"type,omitempty"`
+	// Function emitting the event
+	Func string `json:"func,omitempty"`
+	// Package path of the realm emitting the event
+	PkgPath string `json:"pkg_path,omitempty"`
+	// Attributes of the event
+	Attrs []schema.EventAttr `json:"attrs,omitempty"`
+	// Whether a decoder of the event-processor handled the event, null if unknown
+	Decoded *bool `json:"decoded,omitempty"`
+	// Creation time of the event
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GnoEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gnoevent.FieldAttrs:
+			values[i] = new([]byte)
+		case gnoevent.FieldDecoded:
+			values[i] = new(sql.NullBool)
+		case gnoevent.FieldID, gnoevent.FieldEventIndex, gnoevent.FieldBlockHeight, gnoevent.FieldTxIndex:
+			values[i] = new(sql.NullInt64)
+		case gnoevent.FieldHash, gnoevent.FieldType, gnoevent.FieldFunc, gnoevent.FieldPkgPath:
+			values[i] = new(sql.NullString)
+		case gnoevent.FieldBlockTime, gnoevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GnoEvent fields.
+func (_m *GnoEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gnoevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case gnoevent.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case gnoevent.FieldEventIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_index", values[i])
+			} else if value.Valid {
+				_m.EventIndex = int(value.Int64)
+			}
+		case gnoevent.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = int(value.Int64)
+			}
+		case gnoevent.FieldTxIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_index", values[i])
+			} else if value.Valid {
+				_m.TxIndex = int(value.Int64)
+			}
+		case gnoevent.FieldBlockTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field block_time", values[i])
+			} else if value.Valid {
+				_m.BlockTime = value.Time
+			}
+		case gnoevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case gnoevent.FieldFunc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field func", values[i])
+			} else if value.Valid {
+				_m.Func = value.String
+			}
+		case gnoevent.FieldPkgPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pkg_path", values[i])
+			} else if value.Valid {
+				_m.PkgPath = value.String
+			}
+		case gnoevent.FieldAttrs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attrs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Attrs); err != nil {
+					return fmt.Errorf("unmarshal field attrs: %w", err)
+				}
+			}
+		case gnoevent.FieldDecoded:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field decoded", values[i])
+			} else if value.Valid {
+				_m.Decoded = new(bool)
+				*_m.Decoded = value.Bool
+			}
+		case gnoevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GnoEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *GnoEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GnoEvent.
+// Note that you need to call GnoEvent.Unwrap() before calling this method if this GnoEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GnoEvent) Update() *GnoEventUpdateOne {
+	return NewGnoEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GnoEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GnoEvent) Unwrap() *GnoEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GnoEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GnoEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("GnoEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("event_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventIndex))
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("tx_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TxIndex))
+	builder.WriteString(", ")
+	builder.WriteString("block_time=")
+	builder.WriteString(_m.BlockTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("func=")
+	builder.WriteString(_m.Func)
+	builder.WriteString(", ")
+	builder.WriteString("pkg_path=")
+	builder.WriteString(_m.PkgPath)
+	builder.WriteString(", ")
+	builder.WriteString("attrs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attrs))
+	builder.WriteString(", ")
+	if v := _m.Decoded; v != nil {
+		builder.WriteString("decoded=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GnoEvents is a parsable slice of GnoEvent.
+type GnoEvents []*GnoEvent
@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gnoevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the gnoevent type in the database.
+	Label = "gno_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldEventIndex holds the string denoting the event_index field in the database.
+	FieldEventIndex = "event_index"
+	// FieldBlockHeight holds the string denoting the block_height field in the database.
+	FieldBlockHeight = "block_height"
+	// FieldTxIndex holds the string denoting the tx_index field in the database.
+	FieldTxIndex = "tx_index"
+	// FieldBlockTime holds the string denoting the block_time field in the database.
+	FieldBlockTime = "block_time"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldFunc holds the string denoting the func field in the database.
+	FieldFunc = "func"
+	// FieldPkgPath holds the string denoting the pkg_path field in the database.
+	FieldPkgPath = "pkg_path"
+	// FieldAttrs holds the string denoting the attrs field in the database.
+	FieldAttrs = "attrs"
+	// FieldDecoded holds the string denoting the decoded field in the database.
+	FieldDecoded = "decoded"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the gnoevent in the database.
+	Table = "gno_events"
+)
+
+// Columns holds all SQL columns for gnoevent fields.
+var Columns = []string{
+	FieldID,
+	FieldHash,
+	FieldEventIndex,
+	FieldBlockHeight,
+	FieldTxIndex,
+	FieldBlockTime,
+	FieldType,
+	FieldFunc,
+	FieldPkgPath,
+	FieldAttrs,
+	FieldDecoded,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	HashValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the GnoEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByEventIndex orders the results by the event_index field.
+func ByEventIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventIndex, opts...).ToFunc()
+}
+
+// ByBlockHeight orders the results by the block_height field.
+func ByBlockHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHeight, opts...).ToFunc()
+}
+
+// ByTxIndex orders the results by the tx_index field.
+func ByTxIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxIndex, opts...).ToFunc()
+}
+
+// ByBlockTime orders the results by the block_time field.
+func ByBlockTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockTime, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByFunc orders the results by the func field.
+func ByFunc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFunc, opts...).ToFunc()
+}
+
+// ByPkgPath orders the results by the pkg_path field.
+func ByPkgPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPkgPath, opts...).ToFunc()
+}
+
+// ByDecoded orders the results by the decoded field.
+func ByDecoded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecoded, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
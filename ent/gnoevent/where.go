@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gnoevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldID, id))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldHash, v))
+}
+
+// EventIndex applies equality check predicate on the "event_index" field. It's identical to EventIndexEQ.
+func EventIndex(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// TxIndex applies equality check predicate on the "tx_index" field. It's identical to TxIndexEQ.
+func TxIndex(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
+func BlockTime(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldType, v))
+}
+
+// Func applies equality check predicate on the "func" field. It's identical to FuncEQ.
+func Func(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldFunc, v))
+}
+
+// PkgPath applies equality check predicate on the "pkg_path" field. It's identical to PkgPathEQ.
+func PkgPath(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldPkgPath, v))
+}
+
+// Decoded applies equality check predicate on the "decoded" field. It's identical to DecodedEQ.
+func Decoded(v bool) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldDecoded, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldContainsFold(FieldHash, v))
+}
+
+// EventIndexEQ applies the EQ predicate on the "event_index" field.
+func EventIndexEQ(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// EventIndexNEQ applies the NEQ predicate on the "event_index" field.
+func EventIndexNEQ(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldEventIndex, v))
+}
+
+// EventIndexIn applies the In predicate on the "event_index" field.
+func EventIndexIn(vs ...int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldEventIndex, vs...))
+}
+
+// EventIndexNotIn applies the NotIn predicate on the "event_index" field.
+func EventIndexNotIn(vs ...int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldEventIndex, vs...))
+}
+
+// EventIndexGT applies the GT predicate on the "event_index" field.
+func EventIndexGT(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldEventIndex, v))
+}
+
+// EventIndexGTE applies the GTE predicate on the "event_index" field.
+func EventIndexGTE(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldEventIndex, v))
+}
+
+// EventIndexLT applies the LT predicate on the "event_index" field.
+func EventIndexLT(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldEventIndex, v))
+}
+
+// EventIndexLTE applies the LTE predicate on the "event_index" field.
+func EventIndexLTE(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldEventIndex, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// TxIndexEQ applies the EQ predicate on the "tx_index" field.
+func TxIndexEQ(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// TxIndexNEQ applies the NEQ predicate on the "tx_index" field.
+func TxIndexNEQ(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldTxIndex, v))
+}
+
+// TxIndexIn applies the In predicate on the "tx_index" field.
+func TxIndexIn(vs ...int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldTxIndex, vs...))
+}
+
+// TxIndexNotIn applies the NotIn predicate on the "tx_index" field.
+func TxIndexNotIn(vs ...int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldTxIndex, vs...))
+}
+
+// TxIndexGT applies the GT predicate on the "tx_index" field.
+func TxIndexGT(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldTxIndex, v))
+}
+
+// TxIndexGTE applies the GTE predicate on the "tx_index" field.
+func TxIndexGTE(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldTxIndex, v))
+}
+
+// TxIndexLT applies the LT predicate on the "tx_index" field.
+func TxIndexLT(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldTxIndex, v))
+}
+
+// TxIndexLTE applies the LTE predicate on the "tx_index" field.
+func TxIndexLTE(v int) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldTxIndex, v))
+}
+
+// BlockTimeEQ applies the EQ predicate on the "block_time" field.
+func BlockTimeEQ(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// BlockTimeNEQ applies the NEQ predicate on the "block_time" field.
+func BlockTimeNEQ(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldBlockTime, v))
+}
+
+// BlockTimeIn applies the In predicate on the "block_time" field.
+func BlockTimeIn(vs ...time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeNotIn applies the NotIn predicate on the "block_time" field.
+func BlockTimeNotIn(vs ...time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeGT applies the GT predicate on the "block_time" field.
+func BlockTimeGT(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldBlockTime, v))
+}
+
+// BlockTimeGTE applies the GTE predicate on the "block_time" field.
+func BlockTimeGTE(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldBlockTime, v))
+}
+
+// BlockTimeLT applies the LT predicate on the "block_time" field.
+func BlockTimeLT(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldBlockTime, v))
+}
+
+// BlockTimeLTE applies the LTE predicate on the "block_time" field.
+func BlockTimeLTE(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldBlockTime, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldContainsFold(FieldType, v))
+}
+
+// FuncEQ applies the EQ predicate on the "func" field.
+func FuncEQ(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldFunc, v))
+}
+
+// FuncNEQ applies the NEQ predicate on the "func" field.
+func FuncNEQ(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldFunc, v))
+}
+
+// FuncIn applies the In predicate on the "func" field.
+func FuncIn(vs ...string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldFunc, vs...))
+}
+
+// FuncNotIn applies the NotIn predicate on the "func" field.
+func FuncNotIn(vs ...string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldFunc, vs...))
+}
+
+// FuncGT applies the GT predicate on the "func" field.
+func FuncGT(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldFunc, v))
+}
+
+// FuncGTE applies the GTE predicate on the "func" field.
+func FuncGTE(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldFunc, v))
+}
+
+// FuncLT applies the LT predicate on the "func" field.
+func FuncLT(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldFunc, v))
+}
+
+// FuncLTE applies the LTE predicate on the "func" field.
+func FuncLTE(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldFunc, v))
+}
+
+// FuncContains applies the Contains predicate on the "func" field.
+func FuncContains(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldContains(FieldFunc, v))
+}
+
+// FuncHasPrefix applies the HasPrefix predicate on the "func" field.
+func FuncHasPrefix(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldHasPrefix(FieldFunc, v))
+}
+
+// FuncHasSuffix applies the HasSuffix predicate on the "func" field.
+func FuncHasSuffix(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldHasSuffix(FieldFunc, v))
+}
+
+// FuncIsNil applies the IsNil predicate on the "func" field.
+func FuncIsNil() predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIsNull(FieldFunc))
+}
+
+// FuncNotNil applies the NotNil predicate on the "func" field.
+func FuncNotNil() predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotNull(FieldFunc))
+}
+
+// FuncEqualFold applies the EqualFold predicate on the "func" field.
+func FuncEqualFold(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEqualFold(FieldFunc, v))
+}
+
+// FuncContainsFold applies the ContainsFold predicate on the "func" field.
+func FuncContainsFold(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldContainsFold(FieldFunc, v))
+}
+
+// PkgPathEQ applies the EQ predicate on the "pkg_path" field.
+func PkgPathEQ(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldPkgPath, v))
+}
+
+// PkgPathNEQ applies the NEQ predicate on the "pkg_path" field.
+func PkgPathNEQ(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldPkgPath, v))
+}
+
+// PkgPathIn applies the In predicate on the "pkg_path" field.
+func PkgPathIn(vs ...string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldPkgPath, vs...))
+}
+
+// PkgPathNotIn applies the NotIn predicate on the "pkg_path" field.
+func PkgPathNotIn(vs ...string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldPkgPath, vs...))
+}
+
+// PkgPathGT applies the GT predicate on the "pkg_path" field.
+func PkgPathGT(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldPkgPath, v))
+}
+
+// PkgPathGTE applies the GTE predicate on the "pkg_path" field.
+func PkgPathGTE(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldPkgPath, v))
+}
+
+// PkgPathLT applies the LT predicate on the "pkg_path" field.
+func PkgPathLT(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldPkgPath, v))
+}
+
+// PkgPathLTE applies the LTE predicate on the "pkg_path" field.
+func PkgPathLTE(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldPkgPath, v))
+}
+
+// PkgPathContains applies the Contains predicate on the "pkg_path" field.
+func PkgPathContains(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldContains(FieldPkgPath, v))
+}
+
+// PkgPathHasPrefix applies the HasPrefix predicate on the "pkg_path" field.
+func PkgPathHasPrefix(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldHasPrefix(FieldPkgPath, v))
+}
+
+// PkgPathHasSuffix applies the HasSuffix predicate on the "pkg_path" field.
+func PkgPathHasSuffix(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldHasSuffix(FieldPkgPath, v))
+}
+
+// PkgPathEqualFold applies the EqualFold predicate on the "pkg_path" field.
+func PkgPathEqualFold(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEqualFold(FieldPkgPath, v))
+}
+
+// PkgPathContainsFold applies the ContainsFold predicate on the "pkg_path" field.
+func PkgPathContainsFold(v string) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldContainsFold(FieldPkgPath, v))
+}
+
+// AttrsIsNil applies the IsNil predicate on the "attrs" field.
+func AttrsIsNil() predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIsNull(FieldAttrs))
+}
+
+// AttrsNotNil applies the NotNil predicate on the "attrs" field.
+func AttrsNotNil() predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotNull(FieldAttrs))
+}
+
+// DecodedEQ applies the EQ predicate on the "decoded" field.
+func DecodedEQ(v bool) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldDecoded, v))
+}
+
+// DecodedNEQ applies the NEQ predicate on the "decoded" field.
+func DecodedNEQ(v bool) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldDecoded, v))
+}
+
+// DecodedIsNil applies the IsNil predicate on the "decoded" field.
+func DecodedIsNil() predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIsNull(FieldDecoded))
+}
+
+// DecodedNotNil applies the NotNil predicate on the "decoded" field.
+func DecodedNotNil() predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotNull(FieldDecoded))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GnoEvent) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GnoEvent) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GnoEvent) predicate.GnoEvent {
+	return predicate.GnoEvent(sql.NotPredicates(p))
+}
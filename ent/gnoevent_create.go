@@ -0,0 +1,1108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/gnoevent"
+	"gno.land-block-indexer/ent/schema"
+)
+
+// GnoEventCreate is the builder for creating a GnoEvent entity.
+type GnoEventCreate struct {
+	config
+	mutation *GnoEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHash sets the "hash" field.
+func (_c *GnoEventCreate) SetHash(v string) *GnoEventCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_c *GnoEventCreate) SetEventIndex(v int) *GnoEventCreate {
+	_c.mutation.SetEventIndex(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *GnoEventCreate) SetBlockHeight(v int) *GnoEventCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_c *GnoEventCreate) SetTxIndex(v int) *GnoEventCreate {
+	_c.mutation.SetTxIndex(v)
+	return _c
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_c *GnoEventCreate) SetBlockTime(v time.Time) *GnoEventCreate {
+	_c.mutation.SetBlockTime(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *GnoEventCreate) SetType(v string) *GnoEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetFunc sets the "func" field.
+func (_c *GnoEventCreate) SetFunc(v string) *GnoEventCreate {
+	_c.mutation.SetFunc(v)
+	return _c
+}
+
+// SetNillableFunc sets the "func" field if the given value is not nil.
+func (_c *GnoEventCreate) SetNillableFunc(v *string) *GnoEventCreate {
+	if v != nil {
+		_c.SetFunc(*v)
+	}
+	return _c
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (_c *GnoEventCreate) SetPkgPath(v string) *GnoEventCreate {
+	_c.mutation.SetPkgPath(v)
+	return _c
+}
+
+// SetAttrs sets the "attrs" field.
+func (_c *GnoEventCreate) SetAttrs(v []schema.EventAttr) *GnoEventCreate {
+	_c.mutation.SetAttrs(v)
+	return _c
+}
+
+// SetDecoded sets the "decoded" field.
+func (_c *GnoEventCreate) SetDecoded(v bool) *GnoEventCreate {
+	_c.mutation.SetDecoded(v)
+	return _c
+}
+
+// SetNillableDecoded sets the "decoded" field if the given value is not nil.
+func (_c *GnoEventCreate) SetNillableDecoded(v *bool) *GnoEventCreate {
+	if v != nil {
+		_c.SetDecoded(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GnoEventCreate) SetCreatedAt(v time.Time) *GnoEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GnoEventCreate) SetNillableCreatedAt(v *time.Time) *GnoEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the GnoEventMutation object of the builder.
+func (_c *GnoEventCreate) Mutation() *GnoEventMutation {
+	return _c.mutation
+}
+
+// Save creates the GnoEvent in the database.
+func (_c *GnoEventCreate) Save(ctx context.Context) (*GnoEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GnoEventCreate) SaveX(ctx context.Context) *GnoEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GnoEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GnoEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GnoEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := gnoevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GnoEventCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "GnoEvent.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := gnoevent.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "GnoEvent.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventIndex(); !ok {
+		return &ValidationError{Name: "event_index", err: errors.New(`ent: missing required field "GnoEvent.event_index"`)}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "GnoEvent.block_height"`)}
+	}
+	if _, ok := _c.mutation.TxIndex(); !ok {
+		return &ValidationError{Name: "tx_index", err: errors.New(`ent: missing required field "GnoEvent.tx_index"`)}
+	}
+	if _, ok := _c.mutation.BlockTime(); !ok {
+		return &ValidationError{Name: "block_time", err: errors.New(`ent: missing required field "GnoEvent.block_time"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "GnoEvent.type"`)}
+	}
+	if _, ok := _c.mutation.PkgPath(); !ok {
+		return &ValidationError{Name: "pkg_path", err: errors.New(`ent: missing required field "GnoEvent.pkg_path"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GnoEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *GnoEventCreate) sqlSave(ctx context.Context) (*GnoEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GnoEventCreate) createSpec() (*GnoEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GnoEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gnoevent.Table, sqlgraph.NewFieldSpec(gnoevent.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(gnoevent.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.EventIndex(); ok {
+		_spec.SetField(gnoevent.FieldEventIndex, field.TypeInt, value)
+		_node.EventIndex = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(gnoevent.FieldBlockHeight, field.TypeInt, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.TxIndex(); ok {
+		_spec.SetField(gnoevent.FieldTxIndex, field.TypeInt, value)
+		_node.TxIndex = value
+	}
+	if value, ok := _c.mutation.BlockTime(); ok {
+		_spec.SetField(gnoevent.FieldBlockTime, field.TypeTime, value)
+		_node.BlockTime = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(gnoevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Func(); ok {
+		_spec.SetField(gnoevent.FieldFunc, field.TypeString, value)
+		_node.Func = value
+	}
+	if value, ok := _c.mutation.PkgPath(); ok {
+		_spec.SetField(gnoevent.FieldPkgPath, field.TypeString, value)
+		_node.PkgPath = value
+	}
+	if value, ok := _c.mutation.Attrs(); ok {
+		_spec.SetField(gnoevent.FieldAttrs, field.TypeJSON, value)
+		_node.Attrs = value
+	}
+	if value, ok := _c.mutation.Decoded(); ok {
+		_spec.SetField(gnoevent.FieldDecoded, field.TypeBool, value)
+		_node.Decoded = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(gnoevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GnoEvent.Create().
+//		SetHash(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GnoEventUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GnoEventCreate) OnConflict(opts ...sql.ConflictOption) *GnoEventUpsertOne {
+	_c.conflict = opts
+	return &GnoEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GnoEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GnoEventCreate) OnConflictColumns(columns ...string) *GnoEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GnoEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GnoEventUpsertOne is the builder for "upsert"-ing
+	//  one GnoEvent node.
+	GnoEventUpsertOne struct {
+		create *GnoEventCreate
+	}
+
+	// GnoEventUpsert is the "OnConflict" setter.
+	GnoEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHash sets the "hash" field.
+func (u *GnoEventUpsert) SetHash(v string) *GnoEventUpsert {
+	u.Set(gnoevent.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateHash() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldHash)
+	return u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *GnoEventUpsert) SetEventIndex(v int) *GnoEventUpsert {
+	u.Set(gnoevent.FieldEventIndex, v)
+	return u
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateEventIndex() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldEventIndex)
+	return u
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *GnoEventUpsert) AddEventIndex(v int) *GnoEventUpsert {
+	u.Add(gnoevent.FieldEventIndex, v)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *GnoEventUpsert) SetBlockHeight(v int) *GnoEventUpsert {
+	u.Set(gnoevent.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateBlockHeight() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *GnoEventUpsert) AddBlockHeight(v int) *GnoEventUpsert {
+	u.Add(gnoevent.FieldBlockHeight, v)
+	return u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *GnoEventUpsert) SetTxIndex(v int) *GnoEventUpsert {
+	u.Set(gnoevent.FieldTxIndex, v)
+	return u
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateTxIndex() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldTxIndex)
+	return u
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *GnoEventUpsert) AddTxIndex(v int) *GnoEventUpsert {
+	u.Add(gnoevent.FieldTxIndex, v)
+	return u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *GnoEventUpsert) SetBlockTime(v time.Time) *GnoEventUpsert {
+	u.Set(gnoevent.FieldBlockTime, v)
+	return u
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateBlockTime() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldBlockTime)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *GnoEventUpsert) SetType(v string) *GnoEventUpsert {
+	u.Set(gnoevent.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateType() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldType)
+	return u
+}
+
+// SetFunc sets the "func" field.
+func (u *GnoEventUpsert) SetFunc(v string) *GnoEventUpsert {
+	u.Set(gnoevent.FieldFunc, v)
+	return u
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateFunc() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldFunc)
+	return u
+}
+
+// ClearFunc clears the value of the "func" field.
+func (u *GnoEventUpsert) ClearFunc() *GnoEventUpsert {
+	u.SetNull(gnoevent.FieldFunc)
+	return u
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (u *GnoEventUpsert) SetPkgPath(v string) *GnoEventUpsert {
+	u.Set(gnoevent.FieldPkgPath, v)
+	return u
+}
+
+// UpdatePkgPath sets the "pkg_path" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdatePkgPath() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldPkgPath)
+	return u
+}
+
+// SetAttrs sets the "attrs" field.
+func (u *GnoEventUpsert) SetAttrs(v []schema.EventAttr) *GnoEventUpsert {
+	u.Set(gnoevent.FieldAttrs, v)
+	return u
+}
+
+// UpdateAttrs sets the "attrs" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateAttrs() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldAttrs)
+	return u
+}
+
+// ClearAttrs clears the value of the "attrs" field.
+func (u *GnoEventUpsert) ClearAttrs() *GnoEventUpsert {
+	u.SetNull(gnoevent.FieldAttrs)
+	return u
+}
+
+// SetDecoded sets the "decoded" field.
+func (u *GnoEventUpsert) SetDecoded(v bool) *GnoEventUpsert {
+	u.Set(gnoevent.FieldDecoded, v)
+	return u
+}
+
+// UpdateDecoded sets the "decoded" field to the value that was provided on create.
+func (u *GnoEventUpsert) UpdateDecoded() *GnoEventUpsert {
+	u.SetExcluded(gnoevent.FieldDecoded)
+	return u
+}
+
+// ClearDecoded clears the value of the "decoded" field.
+func (u *GnoEventUpsert) ClearDecoded() *GnoEventUpsert {
+	u.SetNull(gnoevent.FieldDecoded)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.GnoEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GnoEventUpsertOne) UpdateNewValues() *GnoEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(gnoevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GnoEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GnoEventUpsertOne) Ignore() *GnoEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GnoEventUpsertOne) DoNothing() *GnoEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GnoEventCreate.OnConflict
+// documentation for more info.
+func (u *GnoEventUpsertOne) Update(set func(*GnoEventUpsert)) *GnoEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GnoEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *GnoEventUpsertOne) SetHash(v string) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateHash() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *GnoEventUpsertOne) SetEventIndex(v int) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *GnoEventUpsertOne) AddEventIndex(v int) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateEventIndex() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *GnoEventUpsertOne) SetBlockHeight(v int) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *GnoEventUpsertOne) AddBlockHeight(v int) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateBlockHeight() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *GnoEventUpsertOne) SetTxIndex(v int) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *GnoEventUpsertOne) AddTxIndex(v int) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateTxIndex() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *GnoEventUpsertOne) SetBlockTime(v time.Time) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateBlockTime() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *GnoEventUpsertOne) SetType(v string) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateType() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetFunc sets the "func" field.
+func (u *GnoEventUpsertOne) SetFunc(v string) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetFunc(v)
+	})
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateFunc() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateFunc()
+	})
+}
+
+// ClearFunc clears the value of the "func" field.
+func (u *GnoEventUpsertOne) ClearFunc() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.ClearFunc()
+	})
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (u *GnoEventUpsertOne) SetPkgPath(v string) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetPkgPath(v)
+	})
+}
+
+// UpdatePkgPath sets the "pkg_path" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdatePkgPath() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdatePkgPath()
+	})
+}
+
+// SetAttrs sets the "attrs" field.
+func (u *GnoEventUpsertOne) SetAttrs(v []schema.EventAttr) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetAttrs(v)
+	})
+}
+
+// UpdateAttrs sets the "attrs" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateAttrs() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateAttrs()
+	})
+}
+
+// ClearAttrs clears the value of the "attrs" field.
+func (u *GnoEventUpsertOne) ClearAttrs() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.ClearAttrs()
+	})
+}
+
+// SetDecoded sets the "decoded" field.
+func (u *GnoEventUpsertOne) SetDecoded(v bool) *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetDecoded(v)
+	})
+}
+
+// UpdateDecoded sets the "decoded" field to the value that was provided on create.
+func (u *GnoEventUpsertOne) UpdateDecoded() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateDecoded()
+	})
+}
+
+// ClearDecoded clears the value of the "decoded" field.
+func (u *GnoEventUpsertOne) ClearDecoded() *GnoEventUpsertOne {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.ClearDecoded()
+	})
+}
+
+// Exec executes the query.
+func (u *GnoEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GnoEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GnoEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GnoEventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GnoEventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GnoEventCreateBulk is the builder for creating many GnoEvent entities in bulk.
+type GnoEventCreateBulk struct {
+	config
+	err      error
+	builders []*GnoEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GnoEvent entities in the database.
+func (_c *GnoEventCreateBulk) Save(ctx context.Context) ([]*GnoEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GnoEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GnoEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GnoEventCreateBulk) SaveX(ctx context.Context) []*GnoEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GnoEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GnoEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GnoEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GnoEventUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GnoEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *GnoEventUpsertBulk {
+	_c.conflict = opts
+	return &GnoEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GnoEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GnoEventCreateBulk) OnConflictColumns(columns ...string) *GnoEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GnoEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// GnoEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of GnoEvent nodes.
+type GnoEventUpsertBulk struct {
+	create *GnoEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GnoEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GnoEventUpsertBulk) UpdateNewValues() *GnoEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(gnoevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GnoEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GnoEventUpsertBulk) Ignore() *GnoEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GnoEventUpsertBulk) DoNothing() *GnoEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GnoEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *GnoEventUpsertBulk) Update(set func(*GnoEventUpsert)) *GnoEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GnoEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *GnoEventUpsertBulk) SetHash(v string) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateHash() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *GnoEventUpsertBulk) SetEventIndex(v int) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *GnoEventUpsertBulk) AddEventIndex(v int) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateEventIndex() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *GnoEventUpsertBulk) SetBlockHeight(v int) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *GnoEventUpsertBulk) AddBlockHeight(v int) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateBlockHeight() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *GnoEventUpsertBulk) SetTxIndex(v int) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *GnoEventUpsertBulk) AddTxIndex(v int) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateTxIndex() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *GnoEventUpsertBulk) SetBlockTime(v time.Time) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateBlockTime() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *GnoEventUpsertBulk) SetType(v string) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateType() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetFunc sets the "func" field.
+func (u *GnoEventUpsertBulk) SetFunc(v string) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetFunc(v)
+	})
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateFunc() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateFunc()
+	})
+}
+
+// ClearFunc clears the value of the "func" field.
+func (u *GnoEventUpsertBulk) ClearFunc() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.ClearFunc()
+	})
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (u *GnoEventUpsertBulk) SetPkgPath(v string) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetPkgPath(v)
+	})
+}
+
+// UpdatePkgPath sets the "pkg_path" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdatePkgPath() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdatePkgPath()
+	})
+}
+
+// SetAttrs sets the "attrs" field.
+func (u *GnoEventUpsertBulk) SetAttrs(v []schema.EventAttr) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetAttrs(v)
+	})
+}
+
+// UpdateAttrs sets the "attrs" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateAttrs() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateAttrs()
+	})
+}
+
+// ClearAttrs clears the value of the "attrs" field.
+func (u *GnoEventUpsertBulk) ClearAttrs() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.ClearAttrs()
+	})
+}
+
+// SetDecoded sets the "decoded" field.
+func (u *GnoEventUpsertBulk) SetDecoded(v bool) *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.SetDecoded(v)
+	})
+}
+
+// UpdateDecoded sets the "decoded" field to the value that was provided on create.
+func (u *GnoEventUpsertBulk) UpdateDecoded() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.UpdateDecoded()
+	})
+}
+
+// ClearDecoded clears the value of the "decoded" field.
+func (u *GnoEventUpsertBulk) ClearDecoded() *GnoEventUpsertBulk {
+	return u.Update(func(s *GnoEventUpsert) {
+		s.ClearDecoded()
+	})
+}
+
+// Exec executes the query.
+func (u *GnoEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GnoEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GnoEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GnoEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
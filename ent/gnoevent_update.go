@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/gnoevent"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/schema"
+)
+
+// GnoEventUpdate is the builder for updating GnoEvent entities.
+type GnoEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GnoEventMutation
+}
+
+// Where appends a list predicates to the GnoEventUpdate builder.
+func (_u *GnoEventUpdate) Where(ps ...predicate.GnoEvent) *GnoEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *GnoEventUpdate) SetHash(v string) *GnoEventUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillableHash(v *string) *GnoEventUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *GnoEventUpdate) SetEventIndex(v int) *GnoEventUpdate {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillableEventIndex(v *int) *GnoEventUpdate {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *GnoEventUpdate) AddEventIndex(v int) *GnoEventUpdate {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *GnoEventUpdate) SetBlockHeight(v int) *GnoEventUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillableBlockHeight(v *int) *GnoEventUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *GnoEventUpdate) AddBlockHeight(v int) *GnoEventUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *GnoEventUpdate) SetTxIndex(v int) *GnoEventUpdate {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillableTxIndex(v *int) *GnoEventUpdate {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *GnoEventUpdate) AddTxIndex(v int) *GnoEventUpdate {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *GnoEventUpdate) SetBlockTime(v time.Time) *GnoEventUpdate {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillableBlockTime(v *time.Time) *GnoEventUpdate {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *GnoEventUpdate) SetType(v string) *GnoEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillableType(v *string) *GnoEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetFunc sets the "func" field.
+func (_u *GnoEventUpdate) SetFunc(v string) *GnoEventUpdate {
+	_u.mutation.SetFunc(v)
+	return _u
+}
+
+// SetNillableFunc sets the "func" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillableFunc(v *string) *GnoEventUpdate {
+	if v != nil {
+		_u.SetFunc(*v)
+	}
+	return _u
+}
+
+// ClearFunc clears the value of the "func" field.
+func (_u *GnoEventUpdate) ClearFunc() *GnoEventUpdate {
+	_u.mutation.ClearFunc()
+	return _u
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (_u *GnoEventUpdate) SetPkgPath(v string) *GnoEventUpdate {
+	_u.mutation.SetPkgPath(v)
+	return _u
+}
+
+// SetNillablePkgPath sets the "pkg_path" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillablePkgPath(v *string) *GnoEventUpdate {
+	if v != nil {
+		_u.SetPkgPath(*v)
+	}
+	return _u
+}
+
+// SetAttrs sets the "attrs" field.
+func (_u *GnoEventUpdate) SetAttrs(v []schema.EventAttr) *GnoEventUpdate {
+	_u.mutation.SetAttrs(v)
+	return _u
+}
+
+// AppendAttrs appends value to the "attrs" field.
+func (_u *GnoEventUpdate) AppendAttrs(v []schema.EventAttr) *GnoEventUpdate {
+	_u.mutation.AppendAttrs(v)
+	return _u
+}
+
+// ClearAttrs clears the value of the "attrs" field.
+func (_u *GnoEventUpdate) ClearAttrs() *GnoEventUpdate {
+	_u.mutation.ClearAttrs()
+	return _u
+}
+
+// SetDecoded sets the "decoded" field.
+func (_u *GnoEventUpdate) SetDecoded(v bool) *GnoEventUpdate {
+	_u.mutation.SetDecoded(v)
+	return _u
+}
+
+// SetNillableDecoded sets the "decoded" field if the given value is not nil.
+func (_u *GnoEventUpdate) SetNillableDecoded(v *bool) *GnoEventUpdate {
+	if v != nil {
+		_u.SetDecoded(*v)
+	}
+	return _u
+}
+
+// ClearDecoded clears the value of the "decoded" field.
+func (_u *GnoEventUpdate) ClearDecoded() *GnoEventUpdate {
+	_u.mutation.ClearDecoded()
+	return _u
+}
+
+// Mutation returns the GnoEventMutation object of the builder.
+func (_u *GnoEventUpdate) Mutation() *GnoEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GnoEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GnoEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GnoEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GnoEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GnoEventUpdate) check() error {
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := gnoevent.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "GnoEvent.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GnoEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gnoevent.Table, gnoevent.Columns, sqlgraph.NewFieldSpec(gnoevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(gnoevent.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(gnoevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(gnoevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(gnoevent.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(gnoevent.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(gnoevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(gnoevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(gnoevent.FieldBlockTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(gnoevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Func(); ok {
+		_spec.SetField(gnoevent.FieldFunc, field.TypeString, value)
+	}
+	if _u.mutation.FuncCleared() {
+		_spec.ClearField(gnoevent.FieldFunc, field.TypeString)
+	}
+	if value, ok := _u.mutation.PkgPath(); ok {
+		_spec.SetField(gnoevent.FieldPkgPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attrs(); ok {
+		_spec.SetField(gnoevent.FieldAttrs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttrs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gnoevent.FieldAttrs, value)
+		})
+	}
+	if _u.mutation.AttrsCleared() {
+		_spec.ClearField(gnoevent.FieldAttrs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Decoded(); ok {
+		_spec.SetField(gnoevent.FieldDecoded, field.TypeBool, value)
+	}
+	if _u.mutation.DecodedCleared() {
+		_spec.ClearField(gnoevent.FieldDecoded, field.TypeBool)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gnoevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GnoEventUpdateOne is the builder for updating a single GnoEvent entity.
+type GnoEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GnoEventMutation
+}
+
+// SetHash sets the "hash" field.
+func (_u *GnoEventUpdateOne) SetHash(v string) *GnoEventUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillableHash(v *string) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *GnoEventUpdateOne) SetEventIndex(v int) *GnoEventUpdateOne {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillableEventIndex(v *int) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *GnoEventUpdateOne) AddEventIndex(v int) *GnoEventUpdateOne {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *GnoEventUpdateOne) SetBlockHeight(v int) *GnoEventUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillableBlockHeight(v *int) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *GnoEventUpdateOne) AddBlockHeight(v int) *GnoEventUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *GnoEventUpdateOne) SetTxIndex(v int) *GnoEventUpdateOne {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillableTxIndex(v *int) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *GnoEventUpdateOne) AddTxIndex(v int) *GnoEventUpdateOne {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *GnoEventUpdateOne) SetBlockTime(v time.Time) *GnoEventUpdateOne {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillableBlockTime(v *time.Time) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *GnoEventUpdateOne) SetType(v string) *GnoEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillableType(v *string) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetFunc sets the "func" field.
+func (_u *GnoEventUpdateOne) SetFunc(v string) *GnoEventUpdateOne {
+	_u.mutation.SetFunc(v)
+	return _u
+}
+
+// SetNillableFunc sets the "func" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillableFunc(v *string) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetFunc(*v)
+	}
+	return _u
+}
+
+// ClearFunc clears the value of the "func" field.
+func (_u *GnoEventUpdateOne) ClearFunc() *GnoEventUpdateOne {
+	_u.mutation.ClearFunc()
+	return _u
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (_u *GnoEventUpdateOne) SetPkgPath(v string) *GnoEventUpdateOne {
+	_u.mutation.SetPkgPath(v)
+	return _u
+}
+
+// SetNillablePkgPath sets the "pkg_path" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillablePkgPath(v *string) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetPkgPath(*v)
+	}
+	return _u
+}
+
+// SetAttrs sets the "attrs" field.
+func (_u *GnoEventUpdateOne) SetAttrs(v []schema.EventAttr) *GnoEventUpdateOne {
+	_u.mutation.SetAttrs(v)
+	return _u
+}
+
+// AppendAttrs appends value to the "attrs" field.
+func (_u *GnoEventUpdateOne) AppendAttrs(v []schema.EventAttr) *GnoEventUpdateOne {
+	_u.mutation.AppendAttrs(v)
+	return _u
+}
+
+// ClearAttrs clears the value of the "attrs" field.
+func (_u *GnoEventUpdateOne) ClearAttrs() *GnoEventUpdateOne {
+	_u.mutation.ClearAttrs()
+	return _u
+}
+
+// SetDecoded sets the "decoded" field.
+func (_u *GnoEventUpdateOne) SetDecoded(v bool) *GnoEventUpdateOne {
+	_u.mutation.SetDecoded(v)
+	return _u
+}
+
+// SetNillableDecoded sets the "decoded" field if the given value is not nil.
+func (_u *GnoEventUpdateOne) SetNillableDecoded(v *bool) *GnoEventUpdateOne {
+	if v != nil {
+		_u.SetDecoded(*v)
+	}
+	return _u
+}
+
+// ClearDecoded clears the value of the "decoded" field.
+func (_u *GnoEventUpdateOne) ClearDecoded() *GnoEventUpdateOne {
+	_u.mutation.ClearDecoded()
+	return _u
+}
+
+// Mutation returns the GnoEventMutation object of the builder.
+func (_u *GnoEventUpdateOne) Mutation() *GnoEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GnoEventUpdate builder.
+func (_u *GnoEventUpdateOne) Where(ps ...predicate.GnoEvent) *GnoEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GnoEventUpdateOne) Select(field string, fields ...string) *GnoEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GnoEvent entity.
+func (_u *GnoEventUpdateOne) Save(ctx context.Context) (*GnoEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GnoEventUpdateOne) SaveX(ctx context.Context) *GnoEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GnoEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GnoEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GnoEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := gnoevent.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "GnoEvent.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GnoEventUpdateOne) sqlSave(ctx context.Context) (_node *GnoEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gnoevent.Table, gnoevent.Columns, sqlgraph.NewFieldSpec(gnoevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GnoEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gnoevent.FieldID)
+		for _, f := range fields {
+			if !gnoevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gnoevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(gnoevent.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(gnoevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(gnoevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(gnoevent.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(gnoevent.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(gnoevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(gnoevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(gnoevent.FieldBlockTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(gnoevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Func(); ok {
+		_spec.SetField(gnoevent.FieldFunc, field.TypeString, value)
+	}
+	if _u.mutation.FuncCleared() {
+		_spec.ClearField(gnoevent.FieldFunc, field.TypeString)
+	}
+	if value, ok := _u.mutation.PkgPath(); ok {
+		_spec.SetField(gnoevent.FieldPkgPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attrs(); ok {
+		_spec.SetField(gnoevent.FieldAttrs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttrs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gnoevent.FieldAttrs, value)
+		})
+	}
+	if _u.mutation.AttrsCleared() {
+		_spec.ClearField(gnoevent.FieldAttrs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Decoded(); ok {
+		_spec.SetField(gnoevent.FieldDecoded, field.TypeBool, value)
+	}
+	if _u.mutation.DecodedCleared() {
+		_spec.ClearField(gnoevent.FieldDecoded, field.TypeBool)
+	}
+	_node = &GnoEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gnoevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
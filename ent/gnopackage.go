@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/gnopackage"
+)
+
+// GnoPackage is the model entity for the GnoPackage schema.
+type GnoPackage struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Path of the package used as primary key
+	ID string `json:"id,omitempty"`
+	// Name of the package
+	Name string `json:"name,omitempty"`
+	// Namespace of the package path (e.g. demo in gno.land/r/demo/foo)
+	Namespace string `json:"namespace,omitempty"`
+	// Address of the package creator
+	Creator string `json:"creator,omitempty"`
+	// Deposit paid for the deployment
+	Deposit string `json:"deposit,omitempty"`
+	// Height of the block in which the package was deployed
+	BlockHeight int `json:"block_height,omitempty"`
+	// Hash of the deploying transaction
+	Hash string `json:"hash,omitempty"`
+	// Creation time of the package
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GnoPackageQuery when eager-loading is set.
+	Edges        GnoPackageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GnoPackageEdges holds the relations/edges for other nodes in the graph.
+type GnoPackageEdges struct {
+	// Files holds the value of the files edge.
+	Files []*GnoPackageFile `json:"files,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e GnoPackageEdges) FilesOrErr() ([]*GnoPackageFile, error) {
+	if e.loadedTypes[0] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GnoPackage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gnopackage.FieldBlockHeight:
+			values[i] = new(sql.NullInt64)
+		case gnopackage.FieldID, gnopackage.FieldName, gnopackage.FieldNamespace, gnopackage.FieldCreator, gnopackage.FieldDeposit, gnopackage.FieldHash:
+			values[i] = new(sql.NullString)
+		case gnopackage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GnoPackage fields.
+func (_m *GnoPackage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gnopackage.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case gnopackage.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case gnopackage.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case gnopackage.FieldCreator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field creator", values[i])
+			} else if value.Valid {
+				_m.Creator = value.String
+			}
+		case gnopackage.FieldDeposit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit", values[i])
+			} else if value.Valid {
+				_m.Deposit = value.String
+			}
+		case gnopackage.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = int(value.Int64)
+			}
+		case gnopackage.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case gnopackage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GnoPackage.
+// This includes values selected through modifiers, order, etc.
+func (_m *GnoPackage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFiles queries the "files" edge of the GnoPackage entity.
+func (_m *GnoPackage) QueryFiles() *GnoPackageFileQuery {
+	return NewGnoPackageClient(_m.config).QueryFiles(_m)
+}
+
+// Update returns a builder for updating this GnoPackage.
+// Note that you need to call GnoPackage.Unwrap() before calling this method if this GnoPackage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GnoPackage) Update() *GnoPackageUpdateOne {
+	return NewGnoPackageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GnoPackage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GnoPackage) Unwrap() *GnoPackage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GnoPackage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GnoPackage) String() string {
+	var builder strings.Builder
+	builder.WriteString("GnoPackage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("creator=")
+	builder.WriteString(_m.Creator)
+	builder.WriteString(", ")
+	builder.WriteString("deposit=")
+	builder.WriteString(_m.Deposit)
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GnoPackages is a parsable slice of GnoPackage.
+type GnoPackages []*GnoPackage
@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gnopackage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldName, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Creator applies equality check predicate on the "creator" field. It's identical to CreatorEQ.
+func Creator(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldCreator, v))
+}
+
+// Deposit applies equality check predicate on the "deposit" field. It's identical to DepositEQ.
+func Deposit(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldDeposit, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldHash, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContainsFold(FieldName, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceIsNil applies the IsNil predicate on the "namespace" field.
+func NamespaceIsNil() predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIsNull(FieldNamespace))
+}
+
+// NamespaceNotNil applies the NotNil predicate on the "namespace" field.
+func NamespaceNotNil() predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotNull(FieldNamespace))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// CreatorEQ applies the EQ predicate on the "creator" field.
+func CreatorEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldCreator, v))
+}
+
+// CreatorNEQ applies the NEQ predicate on the "creator" field.
+func CreatorNEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNEQ(FieldCreator, v))
+}
+
+// CreatorIn applies the In predicate on the "creator" field.
+func CreatorIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIn(FieldCreator, vs...))
+}
+
+// CreatorNotIn applies the NotIn predicate on the "creator" field.
+func CreatorNotIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotIn(FieldCreator, vs...))
+}
+
+// CreatorGT applies the GT predicate on the "creator" field.
+func CreatorGT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGT(FieldCreator, v))
+}
+
+// CreatorGTE applies the GTE predicate on the "creator" field.
+func CreatorGTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGTE(FieldCreator, v))
+}
+
+// CreatorLT applies the LT predicate on the "creator" field.
+func CreatorLT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLT(FieldCreator, v))
+}
+
+// CreatorLTE applies the LTE predicate on the "creator" field.
+func CreatorLTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLTE(FieldCreator, v))
+}
+
+// CreatorContains applies the Contains predicate on the "creator" field.
+func CreatorContains(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContains(FieldCreator, v))
+}
+
+// CreatorHasPrefix applies the HasPrefix predicate on the "creator" field.
+func CreatorHasPrefix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasPrefix(FieldCreator, v))
+}
+
+// CreatorHasSuffix applies the HasSuffix predicate on the "creator" field.
+func CreatorHasSuffix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasSuffix(FieldCreator, v))
+}
+
+// CreatorIsNil applies the IsNil predicate on the "creator" field.
+func CreatorIsNil() predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIsNull(FieldCreator))
+}
+
+// CreatorNotNil applies the NotNil predicate on the "creator" field.
+func CreatorNotNil() predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotNull(FieldCreator))
+}
+
+// CreatorEqualFold applies the EqualFold predicate on the "creator" field.
+func CreatorEqualFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEqualFold(FieldCreator, v))
+}
+
+// CreatorContainsFold applies the ContainsFold predicate on the "creator" field.
+func CreatorContainsFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContainsFold(FieldCreator, v))
+}
+
+// DepositEQ applies the EQ predicate on the "deposit" field.
+func DepositEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldDeposit, v))
+}
+
+// DepositNEQ applies the NEQ predicate on the "deposit" field.
+func DepositNEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNEQ(FieldDeposit, v))
+}
+
+// DepositIn applies the In predicate on the "deposit" field.
+func DepositIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIn(FieldDeposit, vs...))
+}
+
+// DepositNotIn applies the NotIn predicate on the "deposit" field.
+func DepositNotIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotIn(FieldDeposit, vs...))
+}
+
+// DepositGT applies the GT predicate on the "deposit" field.
+func DepositGT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGT(FieldDeposit, v))
+}
+
+// DepositGTE applies the GTE predicate on the "deposit" field.
+func DepositGTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGTE(FieldDeposit, v))
+}
+
+// DepositLT applies the LT predicate on the "deposit" field.
+func DepositLT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLT(FieldDeposit, v))
+}
+
+// DepositLTE applies the LTE predicate on the "deposit" field.
+func DepositLTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLTE(FieldDeposit, v))
+}
+
+// DepositContains applies the Contains predicate on the "deposit" field.
+func DepositContains(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContains(FieldDeposit, v))
+}
+
+// DepositHasPrefix applies the HasPrefix predicate on the "deposit" field.
+func DepositHasPrefix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasPrefix(FieldDeposit, v))
+}
+
+// DepositHasSuffix applies the HasSuffix predicate on the "deposit" field.
+func DepositHasSuffix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasSuffix(FieldDeposit, v))
+}
+
+// DepositIsNil applies the IsNil predicate on the "deposit" field.
+func DepositIsNil() predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIsNull(FieldDeposit))
+}
+
+// DepositNotNil applies the NotNil predicate on the "deposit" field.
+func DepositNotNil() predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotNull(FieldDeposit))
+}
+
+// DepositEqualFold applies the EqualFold predicate on the "deposit" field.
+func DepositEqualFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEqualFold(FieldDeposit, v))
+}
+
+// DepositContainsFold applies the ContainsFold predicate on the "deposit" field.
+func DepositContainsFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContainsFold(FieldDeposit, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v int) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldContainsFold(FieldHash, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasFiles applies the HasEdge predicate on the "files" edge.
+func HasFiles() predicate.GnoPackage {
+	return predicate.GnoPackage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
+func HasFilesWith(preds ...predicate.GnoPackageFile) predicate.GnoPackage {
+	return predicate.GnoPackage(func(s *sql.Selector) {
+		step := newFilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GnoPackage) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GnoPackage) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GnoPackage) predicate.GnoPackage {
+	return predicate.GnoPackage(sql.NotPredicates(p))
+}
@@ -0,0 +1,934 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+)
+
+// GnoPackageCreate is the builder for creating a GnoPackage entity.
+type GnoPackageCreate struct {
+	config
+	mutation *GnoPackageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *GnoPackageCreate) SetName(v string) *GnoPackageCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *GnoPackageCreate) SetNamespace(v string) *GnoPackageCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_c *GnoPackageCreate) SetNillableNamespace(v *string) *GnoPackageCreate {
+	if v != nil {
+		_c.SetNamespace(*v)
+	}
+	return _c
+}
+
+// SetCreator sets the "creator" field.
+func (_c *GnoPackageCreate) SetCreator(v string) *GnoPackageCreate {
+	_c.mutation.SetCreator(v)
+	return _c
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (_c *GnoPackageCreate) SetNillableCreator(v *string) *GnoPackageCreate {
+	if v != nil {
+		_c.SetCreator(*v)
+	}
+	return _c
+}
+
+// SetDeposit sets the "deposit" field.
+func (_c *GnoPackageCreate) SetDeposit(v string) *GnoPackageCreate {
+	_c.mutation.SetDeposit(v)
+	return _c
+}
+
+// SetNillableDeposit sets the "deposit" field if the given value is not nil.
+func (_c *GnoPackageCreate) SetNillableDeposit(v *string) *GnoPackageCreate {
+	if v != nil {
+		_c.SetDeposit(*v)
+	}
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *GnoPackageCreate) SetBlockHeight(v int) *GnoPackageCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *GnoPackageCreate) SetHash(v string) *GnoPackageCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GnoPackageCreate) SetCreatedAt(v time.Time) *GnoPackageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GnoPackageCreate) SetNillableCreatedAt(v *time.Time) *GnoPackageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GnoPackageCreate) SetID(v string) *GnoPackageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddFileIDs adds the "files" edge to the GnoPackageFile entity by IDs.
+func (_c *GnoPackageCreate) AddFileIDs(ids ...int) *GnoPackageCreate {
+	_c.mutation.AddFileIDs(ids...)
+	return _c
+}
+
+// AddFiles adds the "files" edges to the GnoPackageFile entity.
+func (_c *GnoPackageCreate) AddFiles(v ...*GnoPackageFile) *GnoPackageCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFileIDs(ids...)
+}
+
+// Mutation returns the GnoPackageMutation object of the builder.
+func (_c *GnoPackageCreate) Mutation() *GnoPackageMutation {
+	return _c.mutation
+}
+
+// Save creates the GnoPackage in the database.
+func (_c *GnoPackageCreate) Save(ctx context.Context) (*GnoPackage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GnoPackageCreate) SaveX(ctx context.Context) *GnoPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GnoPackageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GnoPackageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GnoPackageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := gnopackage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GnoPackageCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "GnoPackage.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := gnopackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GnoPackage.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "GnoPackage.block_height"`)}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "GnoPackage.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := gnopackage.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "GnoPackage.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GnoPackage.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := gnopackage.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "GnoPackage.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GnoPackageCreate) sqlSave(ctx context.Context) (*GnoPackage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected GnoPackage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GnoPackageCreate) createSpec() (*GnoPackage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GnoPackage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gnopackage.Table, sqlgraph.NewFieldSpec(gnopackage.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(gnopackage.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(gnopackage.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Creator(); ok {
+		_spec.SetField(gnopackage.FieldCreator, field.TypeString, value)
+		_node.Creator = value
+	}
+	if value, ok := _c.mutation.Deposit(); ok {
+		_spec.SetField(gnopackage.FieldDeposit, field.TypeString, value)
+		_node.Deposit = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(gnopackage.FieldBlockHeight, field.TypeInt, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(gnopackage.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(gnopackage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gnopackage.FilesTable,
+			Columns: []string{gnopackage.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GnoPackage.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GnoPackageUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GnoPackageCreate) OnConflict(opts ...sql.ConflictOption) *GnoPackageUpsertOne {
+	_c.conflict = opts
+	return &GnoPackageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GnoPackage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GnoPackageCreate) OnConflictColumns(columns ...string) *GnoPackageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GnoPackageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GnoPackageUpsertOne is the builder for "upsert"-ing
+	//  one GnoPackage node.
+	GnoPackageUpsertOne struct {
+		create *GnoPackageCreate
+	}
+
+	// GnoPackageUpsert is the "OnConflict" setter.
+	GnoPackageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *GnoPackageUpsert) SetName(v string) *GnoPackageUpsert {
+	u.Set(gnopackage.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GnoPackageUpsert) UpdateName() *GnoPackageUpsert {
+	u.SetExcluded(gnopackage.FieldName)
+	return u
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *GnoPackageUpsert) SetNamespace(v string) *GnoPackageUpsert {
+	u.Set(gnopackage.FieldNamespace, v)
+	return u
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *GnoPackageUpsert) UpdateNamespace() *GnoPackageUpsert {
+	u.SetExcluded(gnopackage.FieldNamespace)
+	return u
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (u *GnoPackageUpsert) ClearNamespace() *GnoPackageUpsert {
+	u.SetNull(gnopackage.FieldNamespace)
+	return u
+}
+
+// SetCreator sets the "creator" field.
+func (u *GnoPackageUpsert) SetCreator(v string) *GnoPackageUpsert {
+	u.Set(gnopackage.FieldCreator, v)
+	return u
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *GnoPackageUpsert) UpdateCreator() *GnoPackageUpsert {
+	u.SetExcluded(gnopackage.FieldCreator)
+	return u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *GnoPackageUpsert) ClearCreator() *GnoPackageUpsert {
+	u.SetNull(gnopackage.FieldCreator)
+	return u
+}
+
+// SetDeposit sets the "deposit" field.
+func (u *GnoPackageUpsert) SetDeposit(v string) *GnoPackageUpsert {
+	u.Set(gnopackage.FieldDeposit, v)
+	return u
+}
+
+// UpdateDeposit sets the "deposit" field to the value that was provided on create.
+func (u *GnoPackageUpsert) UpdateDeposit() *GnoPackageUpsert {
+	u.SetExcluded(gnopackage.FieldDeposit)
+	return u
+}
+
+// ClearDeposit clears the value of the "deposit" field.
+func (u *GnoPackageUpsert) ClearDeposit() *GnoPackageUpsert {
+	u.SetNull(gnopackage.FieldDeposit)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *GnoPackageUpsert) SetBlockHeight(v int) *GnoPackageUpsert {
+	u.Set(gnopackage.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *GnoPackageUpsert) UpdateBlockHeight() *GnoPackageUpsert {
+	u.SetExcluded(gnopackage.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *GnoPackageUpsert) AddBlockHeight(v int) *GnoPackageUpsert {
+	u.Add(gnopackage.FieldBlockHeight, v)
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *GnoPackageUpsert) SetHash(v string) *GnoPackageUpsert {
+	u.Set(gnopackage.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *GnoPackageUpsert) UpdateHash() *GnoPackageUpsert {
+	u.SetExcluded(gnopackage.FieldHash)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GnoPackage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gnopackage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GnoPackageUpsertOne) UpdateNewValues() *GnoPackageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(gnopackage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(gnopackage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GnoPackage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GnoPackageUpsertOne) Ignore() *GnoPackageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GnoPackageUpsertOne) DoNothing() *GnoPackageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GnoPackageCreate.OnConflict
+// documentation for more info.
+func (u *GnoPackageUpsertOne) Update(set func(*GnoPackageUpsert)) *GnoPackageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GnoPackageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GnoPackageUpsertOne) SetName(v string) *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GnoPackageUpsertOne) UpdateName() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *GnoPackageUpsertOne) SetNamespace(v string) *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *GnoPackageUpsertOne) UpdateNamespace() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (u *GnoPackageUpsertOne) ClearNamespace() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.ClearNamespace()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *GnoPackageUpsertOne) SetCreator(v string) *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *GnoPackageUpsertOne) UpdateCreator() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *GnoPackageUpsertOne) ClearCreator() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetDeposit sets the "deposit" field.
+func (u *GnoPackageUpsertOne) SetDeposit(v string) *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetDeposit(v)
+	})
+}
+
+// UpdateDeposit sets the "deposit" field to the value that was provided on create.
+func (u *GnoPackageUpsertOne) UpdateDeposit() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateDeposit()
+	})
+}
+
+// ClearDeposit clears the value of the "deposit" field.
+func (u *GnoPackageUpsertOne) ClearDeposit() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.ClearDeposit()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *GnoPackageUpsertOne) SetBlockHeight(v int) *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *GnoPackageUpsertOne) AddBlockHeight(v int) *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *GnoPackageUpsertOne) UpdateBlockHeight() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *GnoPackageUpsertOne) SetHash(v string) *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *GnoPackageUpsertOne) UpdateHash() *GnoPackageUpsertOne {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// Exec executes the query.
+func (u *GnoPackageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GnoPackageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GnoPackageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GnoPackageUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GnoPackageUpsertOne.ID is not supported by MySQL driver. Use GnoPackageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GnoPackageUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GnoPackageCreateBulk is the builder for creating many GnoPackage entities in bulk.
+type GnoPackageCreateBulk struct {
+	config
+	err      error
+	builders []*GnoPackageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GnoPackage entities in the database.
+func (_c *GnoPackageCreateBulk) Save(ctx context.Context) ([]*GnoPackage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GnoPackage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GnoPackageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GnoPackageCreateBulk) SaveX(ctx context.Context) []*GnoPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GnoPackageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GnoPackageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GnoPackage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GnoPackageUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GnoPackageCreateBulk) OnConflict(opts ...sql.ConflictOption) *GnoPackageUpsertBulk {
+	_c.conflict = opts
+	return &GnoPackageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GnoPackage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GnoPackageCreateBulk) OnConflictColumns(columns ...string) *GnoPackageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GnoPackageUpsertBulk{
+		create: _c,
+	}
+}
+
+// GnoPackageUpsertBulk is the builder for "upsert"-ing
+// a bulk of GnoPackage nodes.
+type GnoPackageUpsertBulk struct {
+	create *GnoPackageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GnoPackage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gnopackage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GnoPackageUpsertBulk) UpdateNewValues() *GnoPackageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(gnopackage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(gnopackage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GnoPackage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GnoPackageUpsertBulk) Ignore() *GnoPackageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GnoPackageUpsertBulk) DoNothing() *GnoPackageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GnoPackageCreateBulk.OnConflict
+// documentation for more info.
+func (u *GnoPackageUpsertBulk) Update(set func(*GnoPackageUpsert)) *GnoPackageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GnoPackageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GnoPackageUpsertBulk) SetName(v string) *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GnoPackageUpsertBulk) UpdateName() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *GnoPackageUpsertBulk) SetNamespace(v string) *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *GnoPackageUpsertBulk) UpdateNamespace() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (u *GnoPackageUpsertBulk) ClearNamespace() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.ClearNamespace()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *GnoPackageUpsertBulk) SetCreator(v string) *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *GnoPackageUpsertBulk) UpdateCreator() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *GnoPackageUpsertBulk) ClearCreator() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetDeposit sets the "deposit" field.
+func (u *GnoPackageUpsertBulk) SetDeposit(v string) *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetDeposit(v)
+	})
+}
+
+// UpdateDeposit sets the "deposit" field to the value that was provided on create.
+func (u *GnoPackageUpsertBulk) UpdateDeposit() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateDeposit()
+	})
+}
+
+// ClearDeposit clears the value of the "deposit" field.
+func (u *GnoPackageUpsertBulk) ClearDeposit() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.ClearDeposit()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *GnoPackageUpsertBulk) SetBlockHeight(v int) *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *GnoPackageUpsertBulk) AddBlockHeight(v int) *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *GnoPackageUpsertBulk) UpdateBlockHeight() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *GnoPackageUpsertBulk) SetHash(v string) *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *GnoPackageUpsertBulk) UpdateHash() *GnoPackageUpsertBulk {
+	return u.Update(func(s *GnoPackageUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// Exec executes the query.
+func (u *GnoPackageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GnoPackageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GnoPackageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GnoPackageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// GnoPackageUpdate is the builder for updating GnoPackage entities.
+type GnoPackageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GnoPackageMutation
+}
+
+// Where appends a list predicates to the GnoPackageUpdate builder.
+func (_u *GnoPackageUpdate) Where(ps ...predicate.GnoPackage) *GnoPackageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GnoPackageUpdate) SetName(v string) *GnoPackageUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GnoPackageUpdate) SetNillableName(v *string) *GnoPackageUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *GnoPackageUpdate) SetNamespace(v string) *GnoPackageUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *GnoPackageUpdate) SetNillableNamespace(v *string) *GnoPackageUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (_u *GnoPackageUpdate) ClearNamespace() *GnoPackageUpdate {
+	_u.mutation.ClearNamespace()
+	return _u
+}
+
+// SetCreator sets the "creator" field.
+func (_u *GnoPackageUpdate) SetCreator(v string) *GnoPackageUpdate {
+	_u.mutation.SetCreator(v)
+	return _u
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (_u *GnoPackageUpdate) SetNillableCreator(v *string) *GnoPackageUpdate {
+	if v != nil {
+		_u.SetCreator(*v)
+	}
+	return _u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (_u *GnoPackageUpdate) ClearCreator() *GnoPackageUpdate {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// SetDeposit sets the "deposit" field.
+func (_u *GnoPackageUpdate) SetDeposit(v string) *GnoPackageUpdate {
+	_u.mutation.SetDeposit(v)
+	return _u
+}
+
+// SetNillableDeposit sets the "deposit" field if the given value is not nil.
+func (_u *GnoPackageUpdate) SetNillableDeposit(v *string) *GnoPackageUpdate {
+	if v != nil {
+		_u.SetDeposit(*v)
+	}
+	return _u
+}
+
+// ClearDeposit clears the value of the "deposit" field.
+func (_u *GnoPackageUpdate) ClearDeposit() *GnoPackageUpdate {
+	_u.mutation.ClearDeposit()
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *GnoPackageUpdate) SetBlockHeight(v int) *GnoPackageUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *GnoPackageUpdate) SetNillableBlockHeight(v *int) *GnoPackageUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *GnoPackageUpdate) AddBlockHeight(v int) *GnoPackageUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *GnoPackageUpdate) SetHash(v string) *GnoPackageUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *GnoPackageUpdate) SetNillableHash(v *string) *GnoPackageUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// AddFileIDs adds the "files" edge to the GnoPackageFile entity by IDs.
+func (_u *GnoPackageUpdate) AddFileIDs(ids ...int) *GnoPackageUpdate {
+	_u.mutation.AddFileIDs(ids...)
+	return _u
+}
+
+// AddFiles adds the "files" edges to the GnoPackageFile entity.
+func (_u *GnoPackageUpdate) AddFiles(v ...*GnoPackageFile) *GnoPackageUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFileIDs(ids...)
+}
+
+// Mutation returns the GnoPackageMutation object of the builder.
+func (_u *GnoPackageUpdate) Mutation() *GnoPackageMutation {
+	return _u.mutation
+}
+
+// ClearFiles clears all "files" edges to the GnoPackageFile entity.
+func (_u *GnoPackageUpdate) ClearFiles() *GnoPackageUpdate {
+	_u.mutation.ClearFiles()
+	return _u
+}
+
+// RemoveFileIDs removes the "files" edge to GnoPackageFile entities by IDs.
+func (_u *GnoPackageUpdate) RemoveFileIDs(ids ...int) *GnoPackageUpdate {
+	_u.mutation.RemoveFileIDs(ids...)
+	return _u
+}
+
+// RemoveFiles removes "files" edges to GnoPackageFile entities.
+func (_u *GnoPackageUpdate) RemoveFiles(v ...*GnoPackageFile) *GnoPackageUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFileIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GnoPackageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GnoPackageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GnoPackageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GnoPackageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GnoPackageUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := gnopackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GnoPackage.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := gnopackage.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "GnoPackage.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GnoPackageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gnopackage.Table, gnopackage.Columns, sqlgraph.NewFieldSpec(gnopackage.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(gnopackage.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(gnopackage.FieldNamespace, field.TypeString, value)
+	}
+	if _u.mutation.NamespaceCleared() {
+		_spec.ClearField(gnopackage.FieldNamespace, field.TypeString)
+	}
+	if value, ok := _u.mutation.Creator(); ok {
+		_spec.SetField(gnopackage.FieldCreator, field.TypeString, value)
+	}
+	if _u.mutation.CreatorCleared() {
+		_spec.ClearField(gnopackage.FieldCreator, field.TypeString)
+	}
+	if value, ok := _u.mutation.Deposit(); ok {
+		_spec.SetField(gnopackage.FieldDeposit, field.TypeString, value)
+	}
+	if _u.mutation.DepositCleared() {
+		_spec.ClearField(gnopackage.FieldDeposit, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(gnopackage.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(gnopackage.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(gnopackage.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gnopackage.FilesTable,
+			Columns: []string{gnopackage.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFilesIDs(); len(nodes) > 0 && !_u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gnopackage.FilesTable,
+			Columns: []string{gnopackage.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gnopackage.FilesTable,
+			Columns: []string{gnopackage.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gnopackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GnoPackageUpdateOne is the builder for updating a single GnoPackage entity.
+type GnoPackageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GnoPackageMutation
+}
+
+// SetName sets the "name" field.
+func (_u *GnoPackageUpdateOne) SetName(v string) *GnoPackageUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GnoPackageUpdateOne) SetNillableName(v *string) *GnoPackageUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *GnoPackageUpdateOne) SetNamespace(v string) *GnoPackageUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *GnoPackageUpdateOne) SetNillableNamespace(v *string) *GnoPackageUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (_u *GnoPackageUpdateOne) ClearNamespace() *GnoPackageUpdateOne {
+	_u.mutation.ClearNamespace()
+	return _u
+}
+
+// SetCreator sets the "creator" field.
+func (_u *GnoPackageUpdateOne) SetCreator(v string) *GnoPackageUpdateOne {
+	_u.mutation.SetCreator(v)
+	return _u
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (_u *GnoPackageUpdateOne) SetNillableCreator(v *string) *GnoPackageUpdateOne {
+	if v != nil {
+		_u.SetCreator(*v)
+	}
+	return _u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (_u *GnoPackageUpdateOne) ClearCreator() *GnoPackageUpdateOne {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// SetDeposit sets the "deposit" field.
+func (_u *GnoPackageUpdateOne) SetDeposit(v string) *GnoPackageUpdateOne {
+	_u.mutation.SetDeposit(v)
+	return _u
+}
+
+// SetNillableDeposit sets the "deposit" field if the given value is not nil.
+func (_u *GnoPackageUpdateOne) SetNillableDeposit(v *string) *GnoPackageUpdateOne {
+	if v != nil {
+		_u.SetDeposit(*v)
+	}
+	return _u
+}
+
+// ClearDeposit clears the value of the "deposit" field.
+func (_u *GnoPackageUpdateOne) ClearDeposit() *GnoPackageUpdateOne {
+	_u.mutation.ClearDeposit()
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *GnoPackageUpdateOne) SetBlockHeight(v int) *GnoPackageUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *GnoPackageUpdateOne) SetNillableBlockHeight(v *int) *GnoPackageUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *GnoPackageUpdateOne) AddBlockHeight(v int) *GnoPackageUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *GnoPackageUpdateOne) SetHash(v string) *GnoPackageUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *GnoPackageUpdateOne) SetNillableHash(v *string) *GnoPackageUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// AddFileIDs adds the "files" edge to the GnoPackageFile entity by IDs.
+func (_u *GnoPackageUpdateOne) AddFileIDs(ids ...int) *GnoPackageUpdateOne {
+	_u.mutation.AddFileIDs(ids...)
+	return _u
+}
+
+// AddFiles adds the "files" edges to the GnoPackageFile entity.
+func (_u *GnoPackageUpdateOne) AddFiles(v ...*GnoPackageFile) *GnoPackageUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFileIDs(ids...)
+}
+
+// Mutation returns the GnoPackageMutation object of the builder.
+func (_u *GnoPackageUpdateOne) Mutation() *GnoPackageMutation {
+	return _u.mutation
+}
+
+// ClearFiles clears all "files" edges to the GnoPackageFile entity.
+func (_u *GnoPackageUpdateOne) ClearFiles() *GnoPackageUpdateOne {
+	_u.mutation.ClearFiles()
+	return _u
+}
+
+// RemoveFileIDs removes the "files" edge to GnoPackageFile entities by IDs.
+func (_u *GnoPackageUpdateOne) RemoveFileIDs(ids ...int) *GnoPackageUpdateOne {
+	_u.mutation.RemoveFileIDs(ids...)
+	return _u
+}
+
+// RemoveFiles removes "files" edges to GnoPackageFile entities.
+func (_u *GnoPackageUpdateOne) RemoveFiles(v ...*GnoPackageFile) *GnoPackageUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFileIDs(ids...)
+}
+
+// Where appends a list predicates to the GnoPackageUpdate builder.
+func (_u *GnoPackageUpdateOne) Where(ps ...predicate.GnoPackage) *GnoPackageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GnoPackageUpdateOne) Select(field string, fields ...string) *GnoPackageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GnoPackage entity.
+func (_u *GnoPackageUpdateOne) Save(ctx context.Context) (*GnoPackage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GnoPackageUpdateOne) SaveX(ctx context.Context) *GnoPackage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GnoPackageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GnoPackageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GnoPackageUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := gnopackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GnoPackage.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := gnopackage.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "GnoPackage.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GnoPackageUpdateOne) sqlSave(ctx context.Context) (_node *GnoPackage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gnopackage.Table, gnopackage.Columns, sqlgraph.NewFieldSpec(gnopackage.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GnoPackage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gnopackage.FieldID)
+		for _, f := range fields {
+			if !gnopackage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gnopackage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(gnopackage.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(gnopackage.FieldNamespace, field.TypeString, value)
+	}
+	if _u.mutation.NamespaceCleared() {
+		_spec.ClearField(gnopackage.FieldNamespace, field.TypeString)
+	}
+	if value, ok := _u.mutation.Creator(); ok {
+		_spec.SetField(gnopackage.FieldCreator, field.TypeString, value)
+	}
+	if _u.mutation.CreatorCleared() {
+		_spec.ClearField(gnopackage.FieldCreator, field.TypeString)
+	}
+	if value, ok := _u.mutation.Deposit(); ok {
+		_spec.SetField(gnopackage.FieldDeposit, field.TypeString, value)
+	}
+	if _u.mutation.DepositCleared() {
+		_spec.ClearField(gnopackage.FieldDeposit, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(gnopackage.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(gnopackage.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(gnopackage.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gnopackage.FilesTable,
+			Columns: []string{gnopackage.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFilesIDs(); len(nodes) > 0 && !_u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gnopackage.FilesTable,
+			Columns: []string{gnopackage.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gnopackage.FilesTable,
+			Columns: []string{gnopackage.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GnoPackage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gnopackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
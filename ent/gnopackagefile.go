@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+)
+
+// GnoPackageFile is the model entity for the GnoPackageFile schema.
+type GnoPackageFile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name of the file
+	Name string `json:"name,omitempty"`
+	// Source of the file as deployed
+	Body string `json:"body,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GnoPackageFileQuery when eager-loading is set.
+	Edges        GnoPackageFileEdges `json:"edges"`
+	package_path *string
+	selectValues sql.SelectValues
+}
+
+// GnoPackageFileEdges holds the relations/edges for other nodes in the graph.
+type GnoPackageFileEdges struct {
+	// Package holds the value of the package edge.
+	Package *GnoPackage `json:"package,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PackageOrErr returns the Package value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GnoPackageFileEdges) PackageOrErr() (*GnoPackage, error) {
+	if e.Package != nil {
+		return e.Package, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: gnopackage.Label}
+	}
+	return nil, &NotLoadedError{edge: "package"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GnoPackageFile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gnopackagefile.FieldID:
+			values[i] = new(sql.NullInt64)
+		case gnopackagefile.FieldName, gnopackagefile.FieldBody:
+			values[i] = new(sql.NullString)
+		case gnopackagefile.ForeignKeys[0]: // package_path
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GnoPackageFile fields.
+func (_m *GnoPackageFile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gnopackagefile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case gnopackagefile.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case gnopackagefile.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case gnopackagefile.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package_path", values[i])
+			} else if value.Valid {
+				_m.package_path = new(string)
+				*_m.package_path = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GnoPackageFile.
+// This includes values selected through modifiers, order, etc.
+func (_m *GnoPackageFile) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPackage queries the "package" edge of the GnoPackageFile entity.
+func (_m *GnoPackageFile) QueryPackage() *GnoPackageQuery {
+	return NewGnoPackageFileClient(_m.config).QueryPackage(_m)
+}
+
+// Update returns a builder for updating this GnoPackageFile.
+// Note that you need to call GnoPackageFile.Unwrap() before calling this method if this GnoPackageFile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GnoPackageFile) Update() *GnoPackageFileUpdateOne {
+	return NewGnoPackageFileClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GnoPackageFile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GnoPackageFile) Unwrap() *GnoPackageFile {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GnoPackageFile is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GnoPackageFile) String() string {
+	var builder strings.Builder
+	builder.WriteString("GnoPackageFile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GnoPackageFiles is a parsable slice of GnoPackageFile.
+type GnoPackageFiles []*GnoPackageFile
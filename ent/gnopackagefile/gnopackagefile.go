@@ -0,0 +1,97 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gnopackagefile
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the gnopackagefile type in the database.
+	Label = "gno_package_file"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// EdgePackage holds the string denoting the package edge name in mutations.
+	EdgePackage = "package"
+	// GnoPackageFieldID holds the string denoting the ID field of the GnoPackage.
+	GnoPackageFieldID = "path"
+	// Table holds the table name of the gnopackagefile in the database.
+	Table = "package_files"
+	// PackageTable is the table that holds the package relation/edge.
+	PackageTable = "package_files"
+	// PackageInverseTable is the table name for the GnoPackage entity.
+	// It exists in this package in order to avoid circular dependency with the "gnopackage" package.
+	PackageInverseTable = "packages"
+	// PackageColumn is the table column denoting the package relation/edge.
+	PackageColumn = "package_path"
+)
+
+// Columns holds all SQL columns for gnopackagefile fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldBody,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "package_files"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"package_path",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the GnoPackageFile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByPackageField orders the results by package field.
+func ByPackageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPackageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPackageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PackageInverseTable, GnoPackageFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PackageTable, PackageColumn),
+	)
+}
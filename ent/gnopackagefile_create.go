@@ -0,0 +1,544 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+)
+
+// GnoPackageFileCreate is the builder for creating a GnoPackageFile entity.
+type GnoPackageFileCreate struct {
+	config
+	mutation *GnoPackageFileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *GnoPackageFileCreate) SetName(v string) *GnoPackageFileCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *GnoPackageFileCreate) SetBody(v string) *GnoPackageFileCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetPackageID sets the "package" edge to the GnoPackage entity by ID.
+func (_c *GnoPackageFileCreate) SetPackageID(id string) *GnoPackageFileCreate {
+	_c.mutation.SetPackageID(id)
+	return _c
+}
+
+// SetPackage sets the "package" edge to the GnoPackage entity.
+func (_c *GnoPackageFileCreate) SetPackage(v *GnoPackage) *GnoPackageFileCreate {
+	return _c.SetPackageID(v.ID)
+}
+
+// Mutation returns the GnoPackageFileMutation object of the builder.
+func (_c *GnoPackageFileCreate) Mutation() *GnoPackageFileMutation {
+	return _c.mutation
+}
+
+// Save creates the GnoPackageFile in the database.
+func (_c *GnoPackageFileCreate) Save(ctx context.Context) (*GnoPackageFile, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GnoPackageFileCreate) SaveX(ctx context.Context) *GnoPackageFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GnoPackageFileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GnoPackageFileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GnoPackageFileCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "GnoPackageFile.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := gnopackagefile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GnoPackageFile.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "GnoPackageFile.body"`)}
+	}
+	if len(_c.mutation.PackageIDs()) == 0 {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required edge "GnoPackageFile.package"`)}
+	}
+	return nil
+}
+
+func (_c *GnoPackageFileCreate) sqlSave(ctx context.Context) (*GnoPackageFile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GnoPackageFileCreate) createSpec() (*GnoPackageFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GnoPackageFile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gnopackagefile.Table, sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(gnopackagefile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(gnopackagefile.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if nodes := _c.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gnopackagefile.PackageTable,
+			Columns: []string{gnopackagefile.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.package_path = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GnoPackageFile.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GnoPackageFileUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GnoPackageFileCreate) OnConflict(opts ...sql.ConflictOption) *GnoPackageFileUpsertOne {
+	_c.conflict = opts
+	return &GnoPackageFileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GnoPackageFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GnoPackageFileCreate) OnConflictColumns(columns ...string) *GnoPackageFileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GnoPackageFileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GnoPackageFileUpsertOne is the builder for "upsert"-ing
+	//  one GnoPackageFile node.
+	GnoPackageFileUpsertOne struct {
+		create *GnoPackageFileCreate
+	}
+
+	// GnoPackageFileUpsert is the "OnConflict" setter.
+	GnoPackageFileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *GnoPackageFileUpsert) SetName(v string) *GnoPackageFileUpsert {
+	u.Set(gnopackagefile.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GnoPackageFileUpsert) UpdateName() *GnoPackageFileUpsert {
+	u.SetExcluded(gnopackagefile.FieldName)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *GnoPackageFileUpsert) SetBody(v string) *GnoPackageFileUpsert {
+	u.Set(gnopackagefile.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *GnoPackageFileUpsert) UpdateBody() *GnoPackageFileUpsert {
+	u.SetExcluded(gnopackagefile.FieldBody)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.GnoPackageFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GnoPackageFileUpsertOne) UpdateNewValues() *GnoPackageFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GnoPackageFile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GnoPackageFileUpsertOne) Ignore() *GnoPackageFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GnoPackageFileUpsertOne) DoNothing() *GnoPackageFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GnoPackageFileCreate.OnConflict
+// documentation for more info.
+func (u *GnoPackageFileUpsertOne) Update(set func(*GnoPackageFileUpsert)) *GnoPackageFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GnoPackageFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GnoPackageFileUpsertOne) SetName(v string) *GnoPackageFileUpsertOne {
+	return u.Update(func(s *GnoPackageFileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GnoPackageFileUpsertOne) UpdateName() *GnoPackageFileUpsertOne {
+	return u.Update(func(s *GnoPackageFileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *GnoPackageFileUpsertOne) SetBody(v string) *GnoPackageFileUpsertOne {
+	return u.Update(func(s *GnoPackageFileUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *GnoPackageFileUpsertOne) UpdateBody() *GnoPackageFileUpsertOne {
+	return u.Update(func(s *GnoPackageFileUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// Exec executes the query.
+func (u *GnoPackageFileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GnoPackageFileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GnoPackageFileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GnoPackageFileUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GnoPackageFileUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GnoPackageFileCreateBulk is the builder for creating many GnoPackageFile entities in bulk.
+type GnoPackageFileCreateBulk struct {
+	config
+	err      error
+	builders []*GnoPackageFileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GnoPackageFile entities in the database.
+func (_c *GnoPackageFileCreateBulk) Save(ctx context.Context) ([]*GnoPackageFile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GnoPackageFile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GnoPackageFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GnoPackageFileCreateBulk) SaveX(ctx context.Context) []*GnoPackageFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GnoPackageFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GnoPackageFileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GnoPackageFile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GnoPackageFileUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GnoPackageFileCreateBulk) OnConflict(opts ...sql.ConflictOption) *GnoPackageFileUpsertBulk {
+	_c.conflict = opts
+	return &GnoPackageFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GnoPackageFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GnoPackageFileCreateBulk) OnConflictColumns(columns ...string) *GnoPackageFileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GnoPackageFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// GnoPackageFileUpsertBulk is the builder for "upsert"-ing
+// a bulk of GnoPackageFile nodes.
+type GnoPackageFileUpsertBulk struct {
+	create *GnoPackageFileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GnoPackageFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GnoPackageFileUpsertBulk) UpdateNewValues() *GnoPackageFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GnoPackageFile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GnoPackageFileUpsertBulk) Ignore() *GnoPackageFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GnoPackageFileUpsertBulk) DoNothing() *GnoPackageFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GnoPackageFileCreateBulk.OnConflict
+// documentation for more info.
+func (u *GnoPackageFileUpsertBulk) Update(set func(*GnoPackageFileUpsert)) *GnoPackageFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GnoPackageFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GnoPackageFileUpsertBulk) SetName(v string) *GnoPackageFileUpsertBulk {
+	return u.Update(func(s *GnoPackageFileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GnoPackageFileUpsertBulk) UpdateName() *GnoPackageFileUpsertBulk {
+	return u.Update(func(s *GnoPackageFileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *GnoPackageFileUpsertBulk) SetBody(v string) *GnoPackageFileUpsertBulk {
+	return u.Update(func(s *GnoPackageFileUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *GnoPackageFileUpsertBulk) UpdateBody() *GnoPackageFileUpsertBulk {
+	return u.Update(func(s *GnoPackageFileUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// Exec executes the query.
+func (u *GnoPackageFileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GnoPackageFileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GnoPackageFileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GnoPackageFileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
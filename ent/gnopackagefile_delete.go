@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/gnopackagefile"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// GnoPackageFileDelete is the builder for deleting a GnoPackageFile entity.
+type GnoPackageFileDelete struct {
+	config
+	hooks    []Hook
+	mutation *GnoPackageFileMutation
+}
+
+// Where appends a list predicates to the GnoPackageFileDelete builder.
+func (_d *GnoPackageFileDelete) Where(ps ...predicate.GnoPackageFile) *GnoPackageFileDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GnoPackageFileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GnoPackageFileDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GnoPackageFileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gnopackagefile.Table, sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GnoPackageFileDeleteOne is the builder for deleting a single GnoPackageFile entity.
+type GnoPackageFileDeleteOne struct {
+	_d *GnoPackageFileDelete
+}
+
+// Where appends a list predicates to the GnoPackageFileDelete builder.
+func (_d *GnoPackageFileDeleteOne) Where(ps ...predicate.GnoPackageFile) *GnoPackageFileDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GnoPackageFileDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gnopackagefile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GnoPackageFileDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// GnoPackageFileUpdate is the builder for updating GnoPackageFile entities.
+type GnoPackageFileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GnoPackageFileMutation
+}
+
+// Where appends a list predicates to the GnoPackageFileUpdate builder.
+func (_u *GnoPackageFileUpdate) Where(ps ...predicate.GnoPackageFile) *GnoPackageFileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GnoPackageFileUpdate) SetName(v string) *GnoPackageFileUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GnoPackageFileUpdate) SetNillableName(v *string) *GnoPackageFileUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *GnoPackageFileUpdate) SetBody(v string) *GnoPackageFileUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *GnoPackageFileUpdate) SetNillableBody(v *string) *GnoPackageFileUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetPackageID sets the "package" edge to the GnoPackage entity by ID.
+func (_u *GnoPackageFileUpdate) SetPackageID(id string) *GnoPackageFileUpdate {
+	_u.mutation.SetPackageID(id)
+	return _u
+}
+
+// SetPackage sets the "package" edge to the GnoPackage entity.
+func (_u *GnoPackageFileUpdate) SetPackage(v *GnoPackage) *GnoPackageFileUpdate {
+	return _u.SetPackageID(v.ID)
+}
+
+// Mutation returns the GnoPackageFileMutation object of the builder.
+func (_u *GnoPackageFileUpdate) Mutation() *GnoPackageFileMutation {
+	return _u.mutation
+}
+
+// ClearPackage clears the "package" edge to the GnoPackage entity.
+func (_u *GnoPackageFileUpdate) ClearPackage() *GnoPackageFileUpdate {
+	_u.mutation.ClearPackage()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GnoPackageFileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GnoPackageFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GnoPackageFileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GnoPackageFileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GnoPackageFileUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := gnopackagefile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GnoPackageFile.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PackageCleared() && len(_u.mutation.PackageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GnoPackageFile.package"`)
+	}
+	return nil
+}
+
+func (_u *GnoPackageFileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gnopackagefile.Table, gnopackagefile.Columns, sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(gnopackagefile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(gnopackagefile.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gnopackagefile.PackageTable,
+			Columns: []string{gnopackagefile.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gnopackagefile.PackageTable,
+			Columns: []string{gnopackagefile.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gnopackagefile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GnoPackageFileUpdateOne is the builder for updating a single GnoPackageFile entity.
+type GnoPackageFileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GnoPackageFileMutation
+}
+
+// SetName sets the "name" field.
+func (_u *GnoPackageFileUpdateOne) SetName(v string) *GnoPackageFileUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GnoPackageFileUpdateOne) SetNillableName(v *string) *GnoPackageFileUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *GnoPackageFileUpdateOne) SetBody(v string) *GnoPackageFileUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *GnoPackageFileUpdateOne) SetNillableBody(v *string) *GnoPackageFileUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetPackageID sets the "package" edge to the GnoPackage entity by ID.
+func (_u *GnoPackageFileUpdateOne) SetPackageID(id string) *GnoPackageFileUpdateOne {
+	_u.mutation.SetPackageID(id)
+	return _u
+}
+
+// SetPackage sets the "package" edge to the GnoPackage entity.
+func (_u *GnoPackageFileUpdateOne) SetPackage(v *GnoPackage) *GnoPackageFileUpdateOne {
+	return _u.SetPackageID(v.ID)
+}
+
+// Mutation returns the GnoPackageFileMutation object of the builder.
+func (_u *GnoPackageFileUpdateOne) Mutation() *GnoPackageFileMutation {
+	return _u.mutation
+}
+
+// ClearPackage clears the "package" edge to the GnoPackage entity.
+func (_u *GnoPackageFileUpdateOne) ClearPackage() *GnoPackageFileUpdateOne {
+	_u.mutation.ClearPackage()
+	return _u
+}
+
+// Where appends a list predicates to the GnoPackageFileUpdate builder.
+func (_u *GnoPackageFileUpdateOne) Where(ps ...predicate.GnoPackageFile) *GnoPackageFileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GnoPackageFileUpdateOne) Select(field string, fields ...string) *GnoPackageFileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GnoPackageFile entity.
+func (_u *GnoPackageFileUpdateOne) Save(ctx context.Context) (*GnoPackageFile, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GnoPackageFileUpdateOne) SaveX(ctx context.Context) *GnoPackageFile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GnoPackageFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GnoPackageFileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GnoPackageFileUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := gnopackagefile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GnoPackageFile.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PackageCleared() && len(_u.mutation.PackageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GnoPackageFile.package"`)
+	}
+	return nil
+}
+
+func (_u *GnoPackageFileUpdateOne) sqlSave(ctx context.Context) (_node *GnoPackageFile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gnopackagefile.Table, gnopackagefile.Columns, sqlgraph.NewFieldSpec(gnopackagefile.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GnoPackageFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gnopackagefile.FieldID)
+		for _, f := range fields {
+			if !gnopackagefile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gnopackagefile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(gnopackagefile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(gnopackagefile.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gnopackagefile.PackageTable,
+			Columns: []string{gnopackagefile.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gnopackagefile.PackageTable,
+			Columns: []string{gnopackagefile.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gnopackage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GnoPackageFile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gnopackagefile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
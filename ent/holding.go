@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/holding"
+)
+
+// Holding is the model entity for the Holding schema.
+type Holding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Address of the holding account
+	Address string `json:"address,omitempty"`
+	// Token held
+	Token string `json:"token,omitempty"`
+	// Amount of the token held
+	Amount float64 `json:"amount,omitempty"`
+	// Height of the block of the first balance change
+	FirstSeenHeight int `json:"first_seen_height,omitempty"`
+	// Height of the block of the latest balance change
+	LastActiveHeight int `json:"last_active_height,omitempty"`
+	// Number of transactions which changed the balance
+	TxCount int `json:"tx_count,omitempty"`
+	// Hash of the last transaction which changed the balance, to count each transaction once
+	LastTxHash string `json:"last_tx_hash,omitempty"`
+	// Creation time of the holding
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HoldingQuery when eager-loading is set.
+	Edges        HoldingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HoldingEdges holds the relations/edges for other nodes in the graph.
+type HoldingEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HoldingEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Holding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case holding.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case holding.FieldID, holding.FieldFirstSeenHeight, holding.FieldLastActiveHeight, holding.FieldTxCount:
+			values[i] = new(sql.NullInt64)
+		case holding.FieldAddress, holding.FieldToken, holding.FieldLastTxHash:
+			values[i] = new(sql.NullString)
+		case holding.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Holding fields.
+func (_m *Holding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case holding.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case holding.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case holding.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case holding.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Float64
+			}
+		case holding.FieldFirstSeenHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen_height", values[i])
+			} else if value.Valid {
+				_m.FirstSeenHeight = int(value.Int64)
+			}
+		case holding.FieldLastActiveHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_active_height", values[i])
+			} else if value.Valid {
+				_m.LastActiveHeight = int(value.Int64)
+			}
+		case holding.FieldTxCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_count", values[i])
+			} else if value.Valid {
+				_m.TxCount = int(value.Int64)
+			}
+		case holding.FieldLastTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_tx_hash", values[i])
+			} else if value.Valid {
+				_m.LastTxHash = value.String
+			}
+		case holding.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Holding.
+// This includes values selected through modifiers, order, etc.
+func (_m *Holding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Holding entity.
+func (_m *Holding) QueryAccount() *AccountQuery {
+	return NewHoldingClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this Holding.
+// Note that you need to call Holding.Unwrap() before calling this method if this Holding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Holding) Update() *HoldingUpdateOne {
+	return NewHoldingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Holding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Holding) Unwrap() *Holding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Holding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Holding) String() string {
+	var builder strings.Builder
+	builder.WriteString("Holding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("first_seen_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FirstSeenHeight))
+	builder.WriteString(", ")
+	builder.WriteString("last_active_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastActiveHeight))
+	builder.WriteString(", ")
+	builder.WriteString("tx_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TxCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_tx_hash=")
+	builder.WriteString(_m.LastTxHash)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holdings is a parsable slice of Holding.
+type Holdings []*Holding
@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package holding
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the holding type in the database.
+	Label = "holding"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldFirstSeenHeight holds the string denoting the first_seen_height field in the database.
+	FieldFirstSeenHeight = "first_seen_height"
+	// FieldLastActiveHeight holds the string denoting the last_active_height field in the database.
+	FieldLastActiveHeight = "last_active_height"
+	// FieldTxCount holds the string denoting the tx_count field in the database.
+	FieldTxCount = "tx_count"
+	// FieldLastTxHash holds the string denoting the last_tx_hash field in the database.
+	FieldLastTxHash = "last_tx_hash"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// AccountFieldID holds the string denoting the ID field of the Account.
+	AccountFieldID = "address"
+	// Table holds the table name of the holding in the database.
+	Table = "holdings"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "holdings"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "address"
+)
+
+// Columns holds all SQL columns for holding fields.
+var Columns = []string{
+	FieldID,
+	FieldAddress,
+	FieldToken,
+	FieldAmount,
+	FieldFirstSeenHeight,
+	FieldLastActiveHeight,
+	FieldTxCount,
+	FieldLastTxHash,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	AddressValidator func(string) error
+	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	TokenValidator func(string) error
+	// DefaultAmount holds the default value on creation for the "amount" field.
+	DefaultAmount float64
+	// DefaultFirstSeenHeight holds the default value on creation for the "first_seen_height" field.
+	DefaultFirstSeenHeight int
+	// DefaultLastActiveHeight holds the default value on creation for the "last_active_height" field.
+	DefaultLastActiveHeight int
+	// DefaultTxCount holds the default value on creation for the "tx_count" field.
+	DefaultTxCount int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Holding queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByFirstSeenHeight orders the results by the first_seen_height field.
+func ByFirstSeenHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeenHeight, opts...).ToFunc()
+}
+
+// ByLastActiveHeight orders the results by the last_active_height field.
+func ByLastActiveHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastActiveHeight, opts...).ToFunc()
+}
+
+// ByTxCount orders the results by the tx_count field.
+func ByTxCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxCount, opts...).ToFunc()
+}
+
+// ByLastTxHash orders the results by the last_tx_hash field.
+func ByLastTxHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTxHash, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, AccountFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
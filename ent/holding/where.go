@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package holding
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldID, id))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldAddress, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldToken, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldAmount, v))
+}
+
+// FirstSeenHeight applies equality check predicate on the "first_seen_height" field. It's identical to FirstSeenHeightEQ.
+func FirstSeenHeight(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldFirstSeenHeight, v))
+}
+
+// LastActiveHeight applies equality check predicate on the "last_active_height" field. It's identical to LastActiveHeightEQ.
+func LastActiveHeight(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldLastActiveHeight, v))
+}
+
+// TxCount applies equality check predicate on the "tx_count" field. It's identical to TxCountEQ.
+func TxCount(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldTxCount, v))
+}
+
+// LastTxHash applies equality check predicate on the "last_tx_hash" field. It's identical to LastTxHashEQ.
+func LastTxHash(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldLastTxHash, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContainsFold(FieldToken, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldAmount, v))
+}
+
+// FirstSeenHeightEQ applies the EQ predicate on the "first_seen_height" field.
+func FirstSeenHeightEQ(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightNEQ applies the NEQ predicate on the "first_seen_height" field.
+func FirstSeenHeightNEQ(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightIn applies the In predicate on the "first_seen_height" field.
+func FirstSeenHeightIn(vs ...int) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldFirstSeenHeight, vs...))
+}
+
+// FirstSeenHeightNotIn applies the NotIn predicate on the "first_seen_height" field.
+func FirstSeenHeightNotIn(vs ...int) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldFirstSeenHeight, vs...))
+}
+
+// FirstSeenHeightGT applies the GT predicate on the "first_seen_height" field.
+func FirstSeenHeightGT(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightGTE applies the GTE predicate on the "first_seen_height" field.
+func FirstSeenHeightGTE(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightLT applies the LT predicate on the "first_seen_height" field.
+func FirstSeenHeightLT(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightLTE applies the LTE predicate on the "first_seen_height" field.
+func FirstSeenHeightLTE(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldFirstSeenHeight, v))
+}
+
+// LastActiveHeightEQ applies the EQ predicate on the "last_active_height" field.
+func LastActiveHeightEQ(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldLastActiveHeight, v))
+}
+
+// LastActiveHeightNEQ applies the NEQ predicate on the "last_active_height" field.
+func LastActiveHeightNEQ(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldLastActiveHeight, v))
+}
+
+// LastActiveHeightIn applies the In predicate on the "last_active_height" field.
+func LastActiveHeightIn(vs ...int) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldLastActiveHeight, vs...))
+}
+
+// LastActiveHeightNotIn applies the NotIn predicate on the "last_active_height" field.
+func LastActiveHeightNotIn(vs ...int) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldLastActiveHeight, vs...))
+}
+
+// LastActiveHeightGT applies the GT predicate on the "last_active_height" field.
+func LastActiveHeightGT(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldLastActiveHeight, v))
+}
+
+// LastActiveHeightGTE applies the GTE predicate on the "last_active_height" field.
+func LastActiveHeightGTE(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldLastActiveHeight, v))
+}
+
+// LastActiveHeightLT applies the LT predicate on the "last_active_height" field.
+func LastActiveHeightLT(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldLastActiveHeight, v))
+}
+
+// LastActiveHeightLTE applies the LTE predicate on the "last_active_height" field.
+func LastActiveHeightLTE(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldLastActiveHeight, v))
+}
+
+// TxCountEQ applies the EQ predicate on the "tx_count" field.
+func TxCountEQ(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldTxCount, v))
+}
+
+// TxCountNEQ applies the NEQ predicate on the "tx_count" field.
+func TxCountNEQ(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldTxCount, v))
+}
+
+// TxCountIn applies the In predicate on the "tx_count" field.
+func TxCountIn(vs ...int) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldTxCount, vs...))
+}
+
+// TxCountNotIn applies the NotIn predicate on the "tx_count" field.
+func TxCountNotIn(vs ...int) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldTxCount, vs...))
+}
+
+// TxCountGT applies the GT predicate on the "tx_count" field.
+func TxCountGT(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldTxCount, v))
+}
+
+// TxCountGTE applies the GTE predicate on the "tx_count" field.
+func TxCountGTE(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldTxCount, v))
+}
+
+// TxCountLT applies the LT predicate on the "tx_count" field.
+func TxCountLT(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldTxCount, v))
+}
+
+// TxCountLTE applies the LTE predicate on the "tx_count" field.
+func TxCountLTE(v int) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldTxCount, v))
+}
+
+// LastTxHashEQ applies the EQ predicate on the "last_tx_hash" field.
+func LastTxHashEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldLastTxHash, v))
+}
+
+// LastTxHashNEQ applies the NEQ predicate on the "last_tx_hash" field.
+func LastTxHashNEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldLastTxHash, v))
+}
+
+// LastTxHashIn applies the In predicate on the "last_tx_hash" field.
+func LastTxHashIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldLastTxHash, vs...))
+}
+
+// LastTxHashNotIn applies the NotIn predicate on the "last_tx_hash" field.
+func LastTxHashNotIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldLastTxHash, vs...))
+}
+
+// LastTxHashGT applies the GT predicate on the "last_tx_hash" field.
+func LastTxHashGT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldLastTxHash, v))
+}
+
+// LastTxHashGTE applies the GTE predicate on the "last_tx_hash" field.
+func LastTxHashGTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldLastTxHash, v))
+}
+
+// LastTxHashLT applies the LT predicate on the "last_tx_hash" field.
+func LastTxHashLT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldLastTxHash, v))
+}
+
+// LastTxHashLTE applies the LTE predicate on the "last_tx_hash" field.
+func LastTxHashLTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldLastTxHash, v))
+}
+
+// LastTxHashContains applies the Contains predicate on the "last_tx_hash" field.
+func LastTxHashContains(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContains(FieldLastTxHash, v))
+}
+
+// LastTxHashHasPrefix applies the HasPrefix predicate on the "last_tx_hash" field.
+func LastTxHashHasPrefix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasPrefix(FieldLastTxHash, v))
+}
+
+// LastTxHashHasSuffix applies the HasSuffix predicate on the "last_tx_hash" field.
+func LastTxHashHasSuffix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasSuffix(FieldLastTxHash, v))
+}
+
+// LastTxHashIsNil applies the IsNil predicate on the "last_tx_hash" field.
+func LastTxHashIsNil() predicate.Holding {
+	return predicate.Holding(sql.FieldIsNull(FieldLastTxHash))
+}
+
+// LastTxHashNotNil applies the NotNil predicate on the "last_tx_hash" field.
+func LastTxHashNotNil() predicate.Holding {
+	return predicate.Holding(sql.FieldNotNull(FieldLastTxHash))
+}
+
+// LastTxHashEqualFold applies the EqualFold predicate on the "last_tx_hash" field.
+func LastTxHashEqualFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEqualFold(FieldLastTxHash, v))
+}
+
+// LastTxHashContainsFold applies the ContainsFold predicate on the "last_tx_hash" field.
+func LastTxHashContainsFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContainsFold(FieldLastTxHash, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Holding) predicate.Holding {
+	return predicate.Holding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Holding) predicate.Holding {
+	return predicate.Holding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Holding) predicate.Holding {
+	return predicate.Holding(sql.NotPredicates(p))
+}
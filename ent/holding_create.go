@@ -0,0 +1,1007 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/holding"
+)
+
+// HoldingCreate is the builder for creating a Holding entity.
+type HoldingCreate struct {
+	config
+	mutation *HoldingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAddress sets the "address" field.
+func (_c *HoldingCreate) SetAddress(v string) *HoldingCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *HoldingCreate) SetToken(v string) *HoldingCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *HoldingCreate) SetAmount(v float64) *HoldingCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableAmount(v *float64) *HoldingCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_c *HoldingCreate) SetFirstSeenHeight(v int) *HoldingCreate {
+	_c.mutation.SetFirstSeenHeight(v)
+	return _c
+}
+
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableFirstSeenHeight(v *int) *HoldingCreate {
+	if v != nil {
+		_c.SetFirstSeenHeight(*v)
+	}
+	return _c
+}
+
+// SetLastActiveHeight sets the "last_active_height" field.
+func (_c *HoldingCreate) SetLastActiveHeight(v int) *HoldingCreate {
+	_c.mutation.SetLastActiveHeight(v)
+	return _c
+}
+
+// SetNillableLastActiveHeight sets the "last_active_height" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableLastActiveHeight(v *int) *HoldingCreate {
+	if v != nil {
+		_c.SetLastActiveHeight(*v)
+	}
+	return _c
+}
+
+// SetTxCount sets the "tx_count" field.
+func (_c *HoldingCreate) SetTxCount(v int) *HoldingCreate {
+	_c.mutation.SetTxCount(v)
+	return _c
+}
+
+// SetNillableTxCount sets the "tx_count" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableTxCount(v *int) *HoldingCreate {
+	if v != nil {
+		_c.SetTxCount(*v)
+	}
+	return _c
+}
+
+// SetLastTxHash sets the "last_tx_hash" field.
+func (_c *HoldingCreate) SetLastTxHash(v string) *HoldingCreate {
+	_c.mutation.SetLastTxHash(v)
+	return _c
+}
+
+// SetNillableLastTxHash sets the "last_tx_hash" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableLastTxHash(v *string) *HoldingCreate {
+	if v != nil {
+		_c.SetLastTxHash(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HoldingCreate) SetCreatedAt(v time.Time) *HoldingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableCreatedAt(v *time.Time) *HoldingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_c *HoldingCreate) SetAccountID(id string) *HoldingCreate {
+	_c.mutation.SetAccountID(id)
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *HoldingCreate) SetAccount(v *Account) *HoldingCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the HoldingMutation object of the builder.
+func (_c *HoldingCreate) Mutation() *HoldingMutation {
+	return _c.mutation
+}
+
+// Save creates the Holding in the database.
+func (_c *HoldingCreate) Save(ctx context.Context) (*Holding, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HoldingCreate) SaveX(ctx context.Context) *Holding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HoldingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HoldingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HoldingCreate) defaults() {
+	if _, ok := _c.mutation.Amount(); !ok {
+		v := holding.DefaultAmount
+		_c.mutation.SetAmount(v)
+	}
+	if _, ok := _c.mutation.FirstSeenHeight(); !ok {
+		v := holding.DefaultFirstSeenHeight
+		_c.mutation.SetFirstSeenHeight(v)
+	}
+	if _, ok := _c.mutation.LastActiveHeight(); !ok {
+		v := holding.DefaultLastActiveHeight
+		_c.mutation.SetLastActiveHeight(v)
+	}
+	if _, ok := _c.mutation.TxCount(); !ok {
+		v := holding.DefaultTxCount
+		_c.mutation.SetTxCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := holding.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HoldingCreate) check() error {
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "Holding.address"`)}
+	}
+	if v, ok := _c.mutation.Address(); ok {
+		if err := holding.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Holding.address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Holding.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := holding.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Holding.token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Holding.amount"`)}
+	}
+	if _, ok := _c.mutation.FirstSeenHeight(); !ok {
+		return &ValidationError{Name: "first_seen_height", err: errors.New(`ent: missing required field "Holding.first_seen_height"`)}
+	}
+	if _, ok := _c.mutation.LastActiveHeight(); !ok {
+		return &ValidationError{Name: "last_active_height", err: errors.New(`ent: missing required field "Holding.last_active_height"`)}
+	}
+	if _, ok := _c.mutation.TxCount(); !ok {
+		return &ValidationError{Name: "tx_count", err: errors.New(`ent: missing required field "Holding.tx_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Holding.created_at"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Holding.account"`)}
+	}
+	return nil
+}
+
+func (_c *HoldingCreate) sqlSave(ctx context.Context) (*Holding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HoldingCreate) createSpec() (*Holding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Holding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(holding.Table, sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(holding.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(holding.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(holding.FieldFirstSeenHeight, field.TypeInt, value)
+		_node.FirstSeenHeight = value
+	}
+	if value, ok := _c.mutation.LastActiveHeight(); ok {
+		_spec.SetField(holding.FieldLastActiveHeight, field.TypeInt, value)
+		_node.LastActiveHeight = value
+	}
+	if value, ok := _c.mutation.TxCount(); ok {
+		_spec.SetField(holding.FieldTxCount, field.TypeInt, value)
+		_node.TxCount = value
+	}
+	if value, ok := _c.mutation.LastTxHash(); ok {
+		_spec.SetField(holding.FieldLastTxHash, field.TypeString, value)
+		_node.LastTxHash = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(holding.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.Address = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Holding.Create().
+//		SetAddress(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HoldingUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HoldingCreate) OnConflict(opts ...sql.ConflictOption) *HoldingUpsertOne {
+	_c.conflict = opts
+	return &HoldingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HoldingCreate) OnConflictColumns(columns ...string) *HoldingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HoldingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// HoldingUpsertOne is the builder for "upsert"-ing
+	//  one Holding node.
+	HoldingUpsertOne struct {
+		create *HoldingCreate
+	}
+
+	// HoldingUpsert is the "OnConflict" setter.
+	HoldingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAddress sets the "address" field.
+func (u *HoldingUpsert) SetAddress(v string) *HoldingUpsert {
+	u.Set(holding.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateAddress() *HoldingUpsert {
+	u.SetExcluded(holding.FieldAddress)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *HoldingUpsert) SetToken(v string) *HoldingUpsert {
+	u.Set(holding.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateToken() *HoldingUpsert {
+	u.SetExcluded(holding.FieldToken)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *HoldingUpsert) SetAmount(v float64) *HoldingUpsert {
+	u.Set(holding.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateAmount() *HoldingUpsert {
+	u.SetExcluded(holding.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *HoldingUpsert) AddAmount(v float64) *HoldingUpsert {
+	u.Add(holding.FieldAmount, v)
+	return u
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *HoldingUpsert) SetFirstSeenHeight(v int) *HoldingUpsert {
+	u.Set(holding.FieldFirstSeenHeight, v)
+	return u
+}
+
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateFirstSeenHeight() *HoldingUpsert {
+	u.SetExcluded(holding.FieldFirstSeenHeight)
+	return u
+}
+
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *HoldingUpsert) AddFirstSeenHeight(v int) *HoldingUpsert {
+	u.Add(holding.FieldFirstSeenHeight, v)
+	return u
+}
+
+// SetLastActiveHeight sets the "last_active_height" field.
+func (u *HoldingUpsert) SetLastActiveHeight(v int) *HoldingUpsert {
+	u.Set(holding.FieldLastActiveHeight, v)
+	return u
+}
+
+// UpdateLastActiveHeight sets the "last_active_height" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateLastActiveHeight() *HoldingUpsert {
+	u.SetExcluded(holding.FieldLastActiveHeight)
+	return u
+}
+
+// AddLastActiveHeight adds v to the "last_active_height" field.
+func (u *HoldingUpsert) AddLastActiveHeight(v int) *HoldingUpsert {
+	u.Add(holding.FieldLastActiveHeight, v)
+	return u
+}
+
+// SetTxCount sets the "tx_count" field.
+func (u *HoldingUpsert) SetTxCount(v int) *HoldingUpsert {
+	u.Set(holding.FieldTxCount, v)
+	return u
+}
+
+// UpdateTxCount sets the "tx_count" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateTxCount() *HoldingUpsert {
+	u.SetExcluded(holding.FieldTxCount)
+	return u
+}
+
+// AddTxCount adds v to the "tx_count" field.
+func (u *HoldingUpsert) AddTxCount(v int) *HoldingUpsert {
+	u.Add(holding.FieldTxCount, v)
+	return u
+}
+
+// SetLastTxHash sets the "last_tx_hash" field.
+func (u *HoldingUpsert) SetLastTxHash(v string) *HoldingUpsert {
+	u.Set(holding.FieldLastTxHash, v)
+	return u
+}
+
+// UpdateLastTxHash sets the "last_tx_hash" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateLastTxHash() *HoldingUpsert {
+	u.SetExcluded(holding.FieldLastTxHash)
+	return u
+}
+
+// ClearLastTxHash clears the value of the "last_tx_hash" field.
+func (u *HoldingUpsert) ClearLastTxHash() *HoldingUpsert {
+	u.SetNull(holding.FieldLastTxHash)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *HoldingUpsertOne) UpdateNewValues() *HoldingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(holding.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HoldingUpsertOne) Ignore() *HoldingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HoldingUpsertOne) DoNothing() *HoldingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HoldingCreate.OnConflict
+// documentation for more info.
+func (u *HoldingUpsertOne) Update(set func(*HoldingUpsert)) *HoldingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HoldingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *HoldingUpsertOne) SetAddress(v string) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateAddress() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *HoldingUpsertOne) SetToken(v string) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateToken() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *HoldingUpsertOne) SetAmount(v float64) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *HoldingUpsertOne) AddAmount(v float64) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateAmount() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *HoldingUpsertOne) SetFirstSeenHeight(v int) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetFirstSeenHeight(v)
+	})
+}
+
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *HoldingUpsertOne) AddFirstSeenHeight(v int) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.AddFirstSeenHeight(v)
+	})
+}
+
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateFirstSeenHeight() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateFirstSeenHeight()
+	})
+}
+
+// SetLastActiveHeight sets the "last_active_height" field.
+func (u *HoldingUpsertOne) SetLastActiveHeight(v int) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetLastActiveHeight(v)
+	})
+}
+
+// AddLastActiveHeight adds v to the "last_active_height" field.
+func (u *HoldingUpsertOne) AddLastActiveHeight(v int) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.AddLastActiveHeight(v)
+	})
+}
+
+// UpdateLastActiveHeight sets the "last_active_height" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateLastActiveHeight() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateLastActiveHeight()
+	})
+}
+
+// SetTxCount sets the "tx_count" field.
+func (u *HoldingUpsertOne) SetTxCount(v int) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetTxCount(v)
+	})
+}
+
+// AddTxCount adds v to the "tx_count" field.
+func (u *HoldingUpsertOne) AddTxCount(v int) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.AddTxCount(v)
+	})
+}
+
+// UpdateTxCount sets the "tx_count" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateTxCount() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateTxCount()
+	})
+}
+
+// SetLastTxHash sets the "last_tx_hash" field.
+func (u *HoldingUpsertOne) SetLastTxHash(v string) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetLastTxHash(v)
+	})
+}
+
+// UpdateLastTxHash sets the "last_tx_hash" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateLastTxHash() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateLastTxHash()
+	})
+}
+
+// ClearLastTxHash clears the value of the "last_tx_hash" field.
+func (u *HoldingUpsertOne) ClearLastTxHash() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.ClearLastTxHash()
+	})
+}
+
+// Exec executes the query.
+func (u *HoldingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HoldingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HoldingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HoldingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HoldingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HoldingCreateBulk is the builder for creating many Holding entities in bulk.
+type HoldingCreateBulk struct {
+	config
+	err      error
+	builders []*HoldingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Holding entities in the database.
+func (_c *HoldingCreateBulk) Save(ctx context.Context) ([]*Holding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Holding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HoldingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HoldingCreateBulk) SaveX(ctx context.Context) []*Holding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HoldingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HoldingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Holding.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HoldingUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HoldingCreateBulk) OnConflict(opts ...sql.ConflictOption) *HoldingUpsertBulk {
+	_c.conflict = opts
+	return &HoldingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HoldingCreateBulk) OnConflictColumns(columns ...string) *HoldingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HoldingUpsertBulk{
+		create: _c,
+	}
+}
+
+// HoldingUpsertBulk is the builder for "upsert"-ing
+// a bulk of Holding nodes.
+type HoldingUpsertBulk struct {
+	create *HoldingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *HoldingUpsertBulk) UpdateNewValues() *HoldingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(holding.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HoldingUpsertBulk) Ignore() *HoldingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HoldingUpsertBulk) DoNothing() *HoldingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HoldingCreateBulk.OnConflict
+// documentation for more info.
+func (u *HoldingUpsertBulk) Update(set func(*HoldingUpsert)) *HoldingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HoldingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *HoldingUpsertBulk) SetAddress(v string) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateAddress() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *HoldingUpsertBulk) SetToken(v string) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateToken() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *HoldingUpsertBulk) SetAmount(v float64) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *HoldingUpsertBulk) AddAmount(v float64) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateAmount() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *HoldingUpsertBulk) SetFirstSeenHeight(v int) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetFirstSeenHeight(v)
+	})
+}
+
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *HoldingUpsertBulk) AddFirstSeenHeight(v int) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.AddFirstSeenHeight(v)
+	})
+}
+
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateFirstSeenHeight() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateFirstSeenHeight()
+	})
+}
+
+// SetLastActiveHeight sets the "last_active_height" field.
+func (u *HoldingUpsertBulk) SetLastActiveHeight(v int) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetLastActiveHeight(v)
+	})
+}
+
+// AddLastActiveHeight adds v to the "last_active_height" field.
+func (u *HoldingUpsertBulk) AddLastActiveHeight(v int) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.AddLastActiveHeight(v)
+	})
+}
+
+// UpdateLastActiveHeight sets the "last_active_height" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateLastActiveHeight() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateLastActiveHeight()
+	})
+}
+
+// SetTxCount sets the "tx_count" field.
+func (u *HoldingUpsertBulk) SetTxCount(v int) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetTxCount(v)
+	})
+}
+
+// AddTxCount adds v to the "tx_count" field.
+func (u *HoldingUpsertBulk) AddTxCount(v int) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.AddTxCount(v)
+	})
+}
+
+// UpdateTxCount sets the "tx_count" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateTxCount() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateTxCount()
+	})
+}
+
+// SetLastTxHash sets the "last_tx_hash" field.
+func (u *HoldingUpsertBulk) SetLastTxHash(v string) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetLastTxHash(v)
+	})
+}
+
+// UpdateLastTxHash sets the "last_tx_hash" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateLastTxHash() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateLastTxHash()
+	})
+}
+
+// ClearLastTxHash clears the value of the "last_tx_hash" field.
+func (u *HoldingUpsertBulk) ClearLastTxHash() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.ClearLastTxHash()
+	})
+}
+
+// Exec executes the query.
+func (u *HoldingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HoldingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HoldingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HoldingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/holding"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// HoldingUpdate is the builder for updating Holding entities.
+type HoldingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HoldingMutation
+}
+
+// Where appends a list predicates to the HoldingUpdate builder.
+func (_u *HoldingUpdate) Where(ps ...predicate.Holding) *HoldingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *HoldingUpdate) SetAddress(v string) *HoldingUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableAddress(v *string) *HoldingUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *HoldingUpdate) SetToken(v string) *HoldingUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableToken(v *string) *HoldingUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *HoldingUpdate) SetAmount(v float64) *HoldingUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableAmount(v *float64) *HoldingUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *HoldingUpdate) AddAmount(v float64) *HoldingUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_u *HoldingUpdate) SetFirstSeenHeight(v int) *HoldingUpdate {
+	_u.mutation.ResetFirstSeenHeight()
+	_u.mutation.SetFirstSeenHeight(v)
+	return _u
+}
+
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableFirstSeenHeight(v *int) *HoldingUpdate {
+	if v != nil {
+		_u.SetFirstSeenHeight(*v)
+	}
+	return _u
+}
+
+// AddFirstSeenHeight adds value to the "first_seen_height" field.
+func (_u *HoldingUpdate) AddFirstSeenHeight(v int) *HoldingUpdate {
+	_u.mutation.AddFirstSeenHeight(v)
+	return _u
+}
+
+// SetLastActiveHeight sets the "last_active_height" field.
+func (_u *HoldingUpdate) SetLastActiveHeight(v int) *HoldingUpdate {
+	_u.mutation.ResetLastActiveHeight()
+	_u.mutation.SetLastActiveHeight(v)
+	return _u
+}
+
+// SetNillableLastActiveHeight sets the "last_active_height" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableLastActiveHeight(v *int) *HoldingUpdate {
+	if v != nil {
+		_u.SetLastActiveHeight(*v)
+	}
+	return _u
+}
+
+// AddLastActiveHeight adds value to the "last_active_height" field.
+func (_u *HoldingUpdate) AddLastActiveHeight(v int) *HoldingUpdate {
+	_u.mutation.AddLastActiveHeight(v)
+	return _u
+}
+
+// SetTxCount sets the "tx_count" field.
+func (_u *HoldingUpdate) SetTxCount(v int) *HoldingUpdate {
+	_u.mutation.ResetTxCount()
+	_u.mutation.SetTxCount(v)
+	return _u
+}
+
+// SetNillableTxCount sets the "tx_count" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableTxCount(v *int) *HoldingUpdate {
+	if v != nil {
+		_u.SetTxCount(*v)
+	}
+	return _u
+}
+
+// AddTxCount adds value to the "tx_count" field.
+func (_u *HoldingUpdate) AddTxCount(v int) *HoldingUpdate {
+	_u.mutation.AddTxCount(v)
+	return _u
+}
+
+// SetLastTxHash sets the "last_tx_hash" field.
+func (_u *HoldingUpdate) SetLastTxHash(v string) *HoldingUpdate {
+	_u.mutation.SetLastTxHash(v)
+	return _u
+}
+
+// SetNillableLastTxHash sets the "last_tx_hash" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableLastTxHash(v *string) *HoldingUpdate {
+	if v != nil {
+		_u.SetLastTxHash(*v)
+	}
+	return _u
+}
+
+// ClearLastTxHash clears the value of the "last_tx_hash" field.
+func (_u *HoldingUpdate) ClearLastTxHash() *HoldingUpdate {
+	_u.mutation.ClearLastTxHash()
+	return _u
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *HoldingUpdate) SetAccountID(id string) *HoldingUpdate {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *HoldingUpdate) SetAccount(v *Account) *HoldingUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the HoldingMutation object of the builder.
+func (_u *HoldingUpdate) Mutation() *HoldingMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *HoldingUpdate) ClearAccount() *HoldingUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HoldingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HoldingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HoldingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HoldingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HoldingUpdate) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := holding.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Holding.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Token(); ok {
+		if err := holding.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Holding.token": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Holding.account"`)
+	}
+	return nil
+}
+
+func (_u *HoldingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holding.Table, holding.Columns, sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(holding.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(holding.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(holding.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(holding.FieldFirstSeenHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFirstSeenHeight(); ok {
+		_spec.AddField(holding.FieldFirstSeenHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastActiveHeight(); ok {
+		_spec.SetField(holding.FieldLastActiveHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastActiveHeight(); ok {
+		_spec.AddField(holding.FieldLastActiveHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxCount(); ok {
+		_spec.SetField(holding.FieldTxCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxCount(); ok {
+		_spec.AddField(holding.FieldTxCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastTxHash(); ok {
+		_spec.SetField(holding.FieldLastTxHash, field.TypeString, value)
+	}
+	if _u.mutation.LastTxHashCleared() {
+		_spec.ClearField(holding.FieldLastTxHash, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HoldingUpdateOne is the builder for updating a single Holding entity.
+type HoldingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HoldingMutation
+}
+
+// SetAddress sets the "address" field.
+func (_u *HoldingUpdateOne) SetAddress(v string) *HoldingUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableAddress(v *string) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *HoldingUpdateOne) SetToken(v string) *HoldingUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableToken(v *string) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *HoldingUpdateOne) SetAmount(v float64) *HoldingUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableAmount(v *float64) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *HoldingUpdateOne) AddAmount(v float64) *HoldingUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_u *HoldingUpdateOne) SetFirstSeenHeight(v int) *HoldingUpdateOne {
+	_u.mutation.ResetFirstSeenHeight()
+	_u.mutation.SetFirstSeenHeight(v)
+	return _u
+}
+
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableFirstSeenHeight(v *int) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetFirstSeenHeight(*v)
+	}
+	return _u
+}
+
+// AddFirstSeenHeight adds value to the "first_seen_height" field.
+func (_u *HoldingUpdateOne) AddFirstSeenHeight(v int) *HoldingUpdateOne {
+	_u.mutation.AddFirstSeenHeight(v)
+	return _u
+}
+
+// SetLastActiveHeight sets the "last_active_height" field.
+func (_u *HoldingUpdateOne) SetLastActiveHeight(v int) *HoldingUpdateOne {
+	_u.mutation.ResetLastActiveHeight()
+	_u.mutation.SetLastActiveHeight(v)
+	return _u
+}
+
+// SetNillableLastActiveHeight sets the "last_active_height" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableLastActiveHeight(v *int) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetLastActiveHeight(*v)
+	}
+	return _u
+}
+
+// AddLastActiveHeight adds value to the "last_active_height" field.
+func (_u *HoldingUpdateOne) AddLastActiveHeight(v int) *HoldingUpdateOne {
+	_u.mutation.AddLastActiveHeight(v)
+	return _u
+}
+
+// SetTxCount sets the "tx_count" field.
+func (_u *HoldingUpdateOne) SetTxCount(v int) *HoldingUpdateOne {
+	_u.mutation.ResetTxCount()
+	_u.mutation.SetTxCount(v)
+	return _u
+}
+
+// SetNillableTxCount sets the "tx_count" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableTxCount(v *int) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetTxCount(*v)
+	}
+	return _u
+}
+
+// AddTxCount adds value to the "tx_count" field.
+func (_u *HoldingUpdateOne) AddTxCount(v int) *HoldingUpdateOne {
+	_u.mutation.AddTxCount(v)
+	return _u
+}
+
+// SetLastTxHash sets the "last_tx_hash" field.
+func (_u *HoldingUpdateOne) SetLastTxHash(v string) *HoldingUpdateOne {
+	_u.mutation.SetLastTxHash(v)
+	return _u
+}
+
+// SetNillableLastTxHash sets the "last_tx_hash" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableLastTxHash(v *string) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetLastTxHash(*v)
+	}
+	return _u
+}
+
+// ClearLastTxHash clears the value of the "last_tx_hash" field.
+func (_u *HoldingUpdateOne) ClearLastTxHash() *HoldingUpdateOne {
+	_u.mutation.ClearLastTxHash()
+	return _u
+}
+
+// SetAccountID sets the "account" edge to the Account entity by ID.
+func (_u *HoldingUpdateOne) SetAccountID(id string) *HoldingUpdateOne {
+	_u.mutation.SetAccountID(id)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *HoldingUpdateOne) SetAccount(v *Account) *HoldingUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the HoldingMutation object of the builder.
+func (_u *HoldingUpdateOne) Mutation() *HoldingMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *HoldingUpdateOne) ClearAccount() *HoldingUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the HoldingUpdate builder.
+func (_u *HoldingUpdateOne) Where(ps ...predicate.Holding) *HoldingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HoldingUpdateOne) Select(field string, fields ...string) *HoldingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Holding entity.
+func (_u *HoldingUpdateOne) Save(ctx context.Context) (*Holding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HoldingUpdateOne) SaveX(ctx context.Context) *Holding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HoldingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HoldingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HoldingUpdateOne) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := holding.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Holding.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Token(); ok {
+		if err := holding.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Holding.token": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Holding.account"`)
+	}
+	return nil
+}
+
+func (_u *HoldingUpdateOne) sqlSave(ctx context.Context) (_node *Holding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holding.Table, holding.Columns, sqlgraph.NewFieldSpec(holding.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Holding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, holding.FieldID)
+		for _, f := range fields {
+			if !holding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != holding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(holding.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(holding.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(holding.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(holding.FieldFirstSeenHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFirstSeenHeight(); ok {
+		_spec.AddField(holding.FieldFirstSeenHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastActiveHeight(); ok {
+		_spec.SetField(holding.FieldLastActiveHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastActiveHeight(); ok {
+		_spec.AddField(holding.FieldLastActiveHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxCount(); ok {
+		_spec.SetField(holding.FieldTxCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxCount(); ok {
+		_spec.AddField(holding.FieldTxCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastTxHash(); ok {
+		_spec.SetField(holding.FieldLastTxHash, field.TypeString, value)
+	}
+	if _u.mutation.LastTxHashCleared() {
+		_spec.ClearField(holding.FieldLastTxHash, field.TypeString)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Holding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
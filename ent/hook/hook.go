@@ -21,6 +21,42 @@ func (f AccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountMutation", m)
 }
 
+// The AddressTransactionFunc type is an adapter to allow the use of ordinary
+// function as AddressTransaction mutator.
+type AddressTransactionFunc func(context.Context, *ent.AddressTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AddressTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AddressTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AddressTransactionMutation", m)
+}
+
+// The BalanceChangeFunc type is an adapter to allow the use of ordinary
+// function as BalanceChange mutator.
+type BalanceChangeFunc func(context.Context, *ent.BalanceChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BalanceChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BalanceChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BalanceChangeMutation", m)
+}
+
+// The BalanceCheckpointFunc type is an adapter to allow the use of ordinary
+// function as BalanceCheckpoint mutator.
+type BalanceCheckpointFunc func(context.Context, *ent.BalanceCheckpointMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BalanceCheckpointFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BalanceCheckpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BalanceCheckpointMutation", m)
+}
+
 // The BlockFunc type is an adapter to allow the use of ordinary
 // function as Block mutator.
 type BlockFunc func(context.Context, *ent.BlockMutation) (ent.Value, error)
@@ -33,6 +69,102 @@ func (f BlockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlockMutation", m)
 }
 
+// The GnoEventFunc type is an adapter to allow the use of ordinary
+// function as GnoEvent mutator.
+type GnoEventFunc func(context.Context, *ent.GnoEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GnoEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GnoEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GnoEventMutation", m)
+}
+
+// The GnoPackageFunc type is an adapter to allow the use of ordinary
+// function as GnoPackage mutator.
+type GnoPackageFunc func(context.Context, *ent.GnoPackageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GnoPackageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GnoPackageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GnoPackageMutation", m)
+}
+
+// The GnoPackageFileFunc type is an adapter to allow the use of ordinary
+// function as GnoPackageFile mutator.
+type GnoPackageFileFunc func(context.Context, *ent.GnoPackageFileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GnoPackageFileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GnoPackageFileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GnoPackageFileMutation", m)
+}
+
+// The HoldingFunc type is an adapter to allow the use of ordinary
+// function as Holding mutator.
+type HoldingFunc func(context.Context, *ent.HoldingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HoldingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HoldingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HoldingMutation", m)
+}
+
+// The NftFunc type is an adapter to allow the use of ordinary
+// function as Nft mutator.
+type NftFunc func(context.Context, *ent.NftMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NftFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NftMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NftMutation", m)
+}
+
+// The NftTransferFunc type is an adapter to allow the use of ordinary
+// function as NftTransfer mutator.
+type NftTransferFunc func(context.Context, *ent.NftTransferMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NftTransferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NftTransferMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NftTransferMutation", m)
+}
+
+// The RealmCallFunc type is an adapter to allow the use of ordinary
+// function as RealmCall mutator.
+type RealmCallFunc func(context.Context, *ent.RealmCallMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RealmCallFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RealmCallMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RealmCallMutation", m)
+}
+
+// The RebuildProgressFunc type is an adapter to allow the use of ordinary
+// function as RebuildProgress mutator.
+type RebuildProgressFunc func(context.Context, *ent.RebuildProgressMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RebuildProgressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RebuildProgressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RebuildProgressMutation", m)
+}
+
 // The RestoreHistoryFunc type is an adapter to allow the use of ordinary
 // function as RestoreHistory mutator.
 type RestoreHistoryFunc func(context.Context, *ent.RestoreHistoryMutation) (ent.Value, error)
@@ -45,6 +177,30 @@ func (f RestoreHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RestoreHistoryMutation", m)
 }
 
+// The TokenFunc type is an adapter to allow the use of ordinary
+// function as Token mutator.
+type TokenFunc func(context.Context, *ent.TokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenMutation", m)
+}
+
+// The TokenStatFunc type is an adapter to allow the use of ordinary
+// function as TokenStat mutator.
+type TokenStatFunc func(context.Context, *ent.TokenStatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TokenStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TokenStatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenStatMutation", m)
+}
+
 // The TransactionFunc type is an adapter to allow the use of ordinary
 // function as Transaction mutator.
 type TransactionFunc func(context.Context, *ent.TransactionMutation) (ent.Value, error)
@@ -69,6 +225,30 @@ func (f TransferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransferMutation", m)
 }
 
+// The WatchRuleFunc type is an adapter to allow the use of ordinary
+// function as WatchRule mutator.
+type WatchRuleFunc func(context.Context, *ent.WatchRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WatchRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WatchRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WatchRuleMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -11,19 +12,104 @@ var (
 	// AccountsColumns holds the columns for the "accounts" table.
 	AccountsColumns = []*schema.Column{
 		{Name: "address", Type: field.TypeString},
-		{Name: "token", Type: field.TypeString},
-		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "first_seen_height", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
 	}
 	// AccountsTable holds the schema information for the "accounts" table.
 	AccountsTable = &schema.Table{
 		Name:       "accounts",
 		Columns:    AccountsColumns,
 		PrimaryKey: []*schema.Column{AccountsColumns[0]},
+	}
+	// AddressTransactionsColumns holds the columns for the "address_transactions" table.
+	AddressTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "address", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "role", Type: field.TypeString},
+		{Name: "block_height", Type: field.TypeInt},
+		{Name: "tx_index", Type: field.TypeInt},
+		{Name: "block_time", Type: field.TypeTime},
+		{Name: "success", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AddressTransactionsTable holds the schema information for the "address_transactions" table.
+	AddressTransactionsTable = &schema.Table{
+		Name:       "address_transactions",
+		Columns:    AddressTransactionsColumns,
+		PrimaryKey: []*schema.Column{AddressTransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "addresstransaction_address_hash_role",
+				Unique:  true,
+				Columns: []*schema.Column{AddressTransactionsColumns[1], AddressTransactionsColumns[2], AddressTransactionsColumns[3]},
+			},
+			{
+				Name:    "addresstransaction_address_block_height_tx_index",
+				Unique:  false,
+				Columns: []*schema.Column{AddressTransactionsColumns[1], AddressTransactionsColumns[4], AddressTransactionsColumns[5]},
+			},
+			{
+				Name:    "addresstransaction_address_role_block_height",
+				Unique:  false,
+				Columns: []*schema.Column{AddressTransactionsColumns[1], AddressTransactionsColumns[3], AddressTransactionsColumns[4]},
+			},
+		},
+	}
+	// BalanceChangesColumns holds the columns for the "balance_changes" table.
+	BalanceChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "address", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString},
+		{Name: "block_height", Type: field.TypeInt},
+		{Name: "delta", Type: field.TypeInt64},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "event_index", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BalanceChangesTable holds the schema information for the "balance_changes" table.
+	BalanceChangesTable = &schema.Table{
+		Name:       "balance_changes",
+		Columns:    BalanceChangesColumns,
+		PrimaryKey: []*schema.Column{BalanceChangesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancechange_address_token_block_height",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceChangesColumns[1], BalanceChangesColumns[2], BalanceChangesColumns[3]},
+			},
+			{
+				Name:    "balancechange_hash",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceChangesColumns[6]},
+			},
+		},
+	}
+	// BalanceCheckpointsColumns holds the columns for the "balance_checkpoints" table.
+	BalanceCheckpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "address", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString},
+		{Name: "block_height", Type: field.TypeInt},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BalanceCheckpointsTable holds the schema information for the "balance_checkpoints" table.
+	BalanceCheckpointsTable = &schema.Table{
+		Name:       "balance_checkpoints",
+		Columns:    BalanceCheckpointsColumns,
+		PrimaryKey: []*schema.Column{BalanceCheckpointsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "account_address_token",
+				Name:    "balancecheckpoint_address_token_block_height",
 				Unique:  true,
-				Columns: []*schema.Column{AccountsColumns[0], AccountsColumns[1]},
+				Columns: []*schema.Column{BalanceCheckpointsColumns[1], BalanceCheckpointsColumns[2], BalanceCheckpointsColumns[3]},
+			},
+			{
+				Name:    "balancecheckpoint_block_height",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceCheckpointsColumns[3]},
 			},
 		},
 	}
@@ -41,6 +127,281 @@ var (
 		Name:       "blocks",
 		Columns:    BlocksColumns,
 		PrimaryKey: []*schema.Column{BlocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "block_hash",
+				Unique:  false,
+				Columns: []*schema.Column{BlocksColumns[1]},
+			},
+		},
+	}
+	// GnoEventsColumns holds the columns for the "gno_events" table.
+	GnoEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "event_index", Type: field.TypeInt},
+		{Name: "block_height", Type: field.TypeInt},
+		{Name: "tx_index", Type: field.TypeInt},
+		{Name: "block_time", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeString},
+		{Name: "func", Type: field.TypeString, Nullable: true},
+		{Name: "pkg_path", Type: field.TypeString},
+		{Name: "attrs", Type: field.TypeJSON, Nullable: true},
+		{Name: "decoded", Type: field.TypeBool, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GnoEventsTable holds the schema information for the "gno_events" table.
+	GnoEventsTable = &schema.Table{
+		Name:       "gno_events",
+		Columns:    GnoEventsColumns,
+		PrimaryKey: []*schema.Column{GnoEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gnoevent_hash_event_index",
+				Unique:  true,
+				Columns: []*schema.Column{GnoEventsColumns[1], GnoEventsColumns[2]},
+			},
+			{
+				Name:    "gnoevent_pkg_path_type_block_height",
+				Unique:  false,
+				Columns: []*schema.Column{GnoEventsColumns[8], GnoEventsColumns[6], GnoEventsColumns[3]},
+			},
+			{
+				Name:    "gnoevent_type_block_height",
+				Unique:  false,
+				Columns: []*schema.Column{GnoEventsColumns[6], GnoEventsColumns[3]},
+			},
+			{
+				Name:    "gnoevent_decoded_pkg_path_type",
+				Unique:  false,
+				Columns: []*schema.Column{GnoEventsColumns[10], GnoEventsColumns[8], GnoEventsColumns[6]},
+			},
+			{
+				Name:    "gnoevent_block_height_tx_index_event_index",
+				Unique:  false,
+				Columns: []*schema.Column{GnoEventsColumns[3], GnoEventsColumns[4], GnoEventsColumns[2]},
+			},
+			{
+				Name:    "gnoevent_attrs",
+				Unique:  false,
+				Columns: []*schema.Column{GnoEventsColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
+	}
+	// PackagesColumns holds the columns for the "packages" table.
+	PackagesColumns = []*schema.Column{
+		{Name: "path", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString, Nullable: true},
+		{Name: "creator", Type: field.TypeString, Nullable: true},
+		{Name: "deposit", Type: field.TypeString, Nullable: true},
+		{Name: "block_height", Type: field.TypeInt},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PackagesTable holds the schema information for the "packages" table.
+	PackagesTable = &schema.Table{
+		Name:       "packages",
+		Columns:    PackagesColumns,
+		PrimaryKey: []*schema.Column{PackagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gnopackage_creator",
+				Unique:  false,
+				Columns: []*schema.Column{PackagesColumns[3]},
+			},
+			{
+				Name:    "gnopackage_namespace",
+				Unique:  false,
+				Columns: []*schema.Column{PackagesColumns[2]},
+			},
+		},
+	}
+	// PackageFilesColumns holds the columns for the "package_files" table.
+	PackageFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "package_path", Type: field.TypeString},
+	}
+	// PackageFilesTable holds the schema information for the "package_files" table.
+	PackageFilesTable = &schema.Table{
+		Name:       "package_files",
+		Columns:    PackageFilesColumns,
+		PrimaryKey: []*schema.Column{PackageFilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "package_files_packages_files",
+				Columns:    []*schema.Column{PackageFilesColumns[3]},
+				RefColumns: []*schema.Column{PackagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gnopackagefile_name_package_path",
+				Unique:  true,
+				Columns: []*schema.Column{PackageFilesColumns[1], PackageFilesColumns[3]},
+			},
+		},
+	}
+	// HoldingsColumns holds the columns for the "holdings" table.
+	HoldingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "first_seen_height", Type: field.TypeInt, Default: 0},
+		{Name: "last_active_height", Type: field.TypeInt, Default: 0},
+		{Name: "tx_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_tx_hash", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "address", Type: field.TypeString},
+	}
+	// HoldingsTable holds the schema information for the "holdings" table.
+	HoldingsTable = &schema.Table{
+		Name:       "holdings",
+		Columns:    HoldingsColumns,
+		PrimaryKey: []*schema.Column{HoldingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "holdings_accounts_holdings",
+				Columns:    []*schema.Column{HoldingsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "holding_address_token",
+				Unique:  true,
+				Columns: []*schema.Column{HoldingsColumns[8], HoldingsColumns[1]},
+			},
+			{
+				Name:    "holding_token_amount",
+				Unique:  false,
+				Columns: []*schema.Column{HoldingsColumns[1], HoldingsColumns[2]},
+			},
+		},
+	}
+	// NftsColumns holds the columns for the "nfts" table.
+	NftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "collection", Type: field.TypeString},
+		{Name: "token_id", Type: field.TypeString},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "burned", Type: field.TypeBool, Default: false},
+		{Name: "minted_height", Type: field.TypeInt, Nullable: true},
+		{Name: "last_height", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// NftsTable holds the schema information for the "nfts" table.
+	NftsTable = &schema.Table{
+		Name:       "nfts",
+		Columns:    NftsColumns,
+		PrimaryKey: []*schema.Column{NftsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "nft_collection_token_id",
+				Unique:  true,
+				Columns: []*schema.Column{NftsColumns[1], NftsColumns[2]},
+			},
+			{
+				Name:    "nft_owner",
+				Unique:  false,
+				Columns: []*schema.Column{NftsColumns[3]},
+			},
+		},
+	}
+	// NftTransfersColumns holds the columns for the "nft_transfers" table.
+	NftTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "func", Type: field.TypeString},
+		{Name: "collection", Type: field.TypeString},
+		{Name: "token_id", Type: field.TypeString},
+		{Name: "from_address", Type: field.TypeString, Nullable: true},
+		{Name: "to_address", Type: field.TypeString, Nullable: true},
+		{Name: "block_height", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// NftTransfersTable holds the schema information for the "nft_transfers" table.
+	NftTransfersTable = &schema.Table{
+		Name:       "nft_transfers",
+		Columns:    NftTransfersColumns,
+		PrimaryKey: []*schema.Column{NftTransfersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "nfttransfer_collection_token_id_block_height",
+				Unique:  false,
+				Columns: []*schema.Column{NftTransfersColumns[3], NftTransfersColumns[4], NftTransfersColumns[7]},
+			},
+			{
+				Name:    "nfttransfer_from_address",
+				Unique:  false,
+				Columns: []*schema.Column{NftTransfersColumns[5]},
+			},
+			{
+				Name:    "nfttransfer_to_address",
+				Unique:  false,
+				Columns: []*schema.Column{NftTransfersColumns[6]},
+			},
+		},
+	}
+	// RealmCallsColumns holds the columns for the "realm_calls" table.
+	RealmCallsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "msg_index", Type: field.TypeInt},
+		{Name: "block_height", Type: field.TypeInt},
+		{Name: "block_time", Type: field.TypeTime},
+		{Name: "pkg_path", Type: field.TypeString},
+		{Name: "func", Type: field.TypeString},
+		{Name: "caller", Type: field.TypeString},
+		{Name: "args", Type: field.TypeJSON, Nullable: true},
+		{Name: "send", Type: field.TypeString, Nullable: true},
+		{Name: "success", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RealmCallsTable holds the schema information for the "realm_calls" table.
+	RealmCallsTable = &schema.Table{
+		Name:       "realm_calls",
+		Columns:    RealmCallsColumns,
+		PrimaryKey: []*schema.Column{RealmCallsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "realmcall_hash_msg_index",
+				Unique:  true,
+				Columns: []*schema.Column{RealmCallsColumns[1], RealmCallsColumns[2]},
+			},
+			{
+				Name:    "realmcall_pkg_path_func_block_time",
+				Unique:  false,
+				Columns: []*schema.Column{RealmCallsColumns[5], RealmCallsColumns[6], RealmCallsColumns[4]},
+			},
+			{
+				Name:    "realmcall_caller_block_time",
+				Unique:  false,
+				Columns: []*schema.Column{RealmCallsColumns[7], RealmCallsColumns[4]},
+			},
+		},
+	}
+	// RebuildProgressesColumns holds the columns for the "rebuild_progresses" table.
+	RebuildProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "last_height", Type: field.TypeInt},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RebuildProgressesTable holds the schema information for the "rebuild_progresses" table.
+	RebuildProgressesTable = &schema.Table{
+		Name:       "rebuild_progresses",
+		Columns:    RebuildProgressesColumns,
+		PrimaryKey: []*schema.Column{RebuildProgressesColumns[0]},
 	}
 	// RestoreHistoriesColumns holds the columns for the "restore_histories" table.
 	RestoreHistoriesColumns = []*schema.Column{
@@ -55,6 +416,58 @@ var (
 		Columns:    RestoreHistoriesColumns,
 		PrimaryKey: []*schema.Column{RestoreHistoriesColumns[0]},
 	}
+	// TokensColumns holds the columns for the "tokens" table.
+	TokensColumns = []*schema.Column{
+		{Name: "path", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "symbol", Type: field.TypeString, Nullable: true},
+		{Name: "decimals", Type: field.TypeInt, Default: 0},
+		{Name: "creator", Type: field.TypeString, Nullable: true},
+		{Name: "first_seen_height", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TokensTable holds the schema information for the "tokens" table.
+	TokensTable = &schema.Table{
+		Name:       "tokens",
+		Columns:    TokensColumns,
+		PrimaryKey: []*schema.Column{TokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "token_symbol",
+				Unique:  false,
+				Columns: []*schema.Column{TokensColumns[2]},
+			},
+		},
+	}
+	// TokenStatsColumns holds the columns for the "token_stats" table.
+	TokenStatsColumns = []*schema.Column{
+		{Name: "token", Type: field.TypeString},
+		{Name: "minted", Type: field.TypeInt64, Default: 0},
+		{Name: "burned", Type: field.TypeInt64, Default: 0},
+		{Name: "supply", Type: field.TypeInt64, Default: 0},
+		{Name: "holders", Type: field.TypeInt, Default: 0},
+		{Name: "transfer_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_height", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TokenStatsTable holds the schema information for the "token_stats" table.
+	TokenStatsTable = &schema.Table{
+		Name:       "token_stats",
+		Columns:    TokenStatsColumns,
+		PrimaryKey: []*schema.Column{TokenStatsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tokenstat_supply",
+				Unique:  false,
+				Columns: []*schema.Column{TokenStatsColumns[3]},
+			},
+			{
+				Name:    "tokenstat_holders",
+				Unique:  false,
+				Columns: []*schema.Column{TokenStatsColumns[4]},
+			},
+		},
+	}
 	// TransactionsColumns holds the columns for the "transactions" table.
 	TransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -84,6 +497,33 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transaction_hash",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[2]},
+			},
+			{
+				Name:    "transaction_block_height_index",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[4], TransactionsColumns[1]},
+			},
+			{
+				Name:    "transaction_success_block_height",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[3], TransactionsColumns[4]},
+			},
+			{
+				Name:    "transaction_messages",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
 	}
 	// TransfersColumns holds the columns for the "transfers" table.
 	TransfersColumns = []*schema.Column{
@@ -95,6 +535,11 @@ var (
 		{Name: "token", Type: field.TypeString},
 		{Name: "amount", Type: field.TypeFloat64},
 		{Name: "denom", Type: field.TypeString},
+		{Name: "block_height", Type: field.TypeInt, Default: 0},
+		{Name: "tx_index", Type: field.TypeInt, Default: 0},
+		{Name: "msg_index", Type: field.TypeInt, Default: -1},
+		{Name: "event_index", Type: field.TypeInt},
+		{Name: "block_time", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// TransfersTable holds the schema information for the "transfers" table.
@@ -127,20 +572,163 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{TransfersColumns[4], TransfersColumns[5]},
 			},
+			{
+				Name:    "transfer_hash_event_index_token",
+				Unique:  true,
+				Columns: []*schema.Column{TransfersColumns[1], TransfersColumns[11], TransfersColumns[5]},
+			},
+			{
+				Name:    "transfer_block_height_tx_index_event_index",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[8], TransfersColumns[9], TransfersColumns[11]},
+			},
+			{
+				Name:    "transfer_block_height_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[8], TransfersColumns[0]},
+			},
+			{
+				Name:    "transfer_from_address_block_height_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[3], TransfersColumns[8], TransfersColumns[0]},
+			},
+			{
+				Name:    "transfer_to_address_block_height_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[4], TransfersColumns[8], TransfersColumns[0]},
+			},
+			{
+				Name:    "transfer_token_block_height_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[5], TransfersColumns[8], TransfersColumns[0]},
+			},
+			{
+				Name:    "transfer_func_block_height_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[2], TransfersColumns[8], TransfersColumns[0]},
+			},
+			{
+				Name:    "transfer_block_time",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[12]},
+			},
+		},
+	}
+	// WatchRulesColumns holds the columns for the "watch_rules" table.
+	WatchRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "owner_hash", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true},
+		{Name: "token", Type: field.TypeString, Nullable: true},
+		{Name: "min_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "event_type", Type: field.TypeString, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WatchRulesTable holds the schema information for the "watch_rules" table.
+	WatchRulesTable = &schema.Table{
+		Name:       "watch_rules",
+		Columns:    WatchRulesColumns,
+		PrimaryKey: []*schema.Column{WatchRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "watchrule_active",
+				Unique:  false,
+				Columns: []*schema.Column{WatchRulesColumns[8]},
+			},
+			{
+				Name:    "watchrule_owner_hash",
+				Unique:  false,
+				Columns: []*schema.Column{WatchRulesColumns[3]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "event_index", Type: field.TypeInt},
+		{Name: "token", Type: field.TypeString},
+		{Name: "block_height", Type: field.TypeInt},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "delivered", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "rule_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_watch_rules_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[13]},
+				RefColumns: []*schema.Column{WatchRulesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_rule_id_hash_event_index_token",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[13], WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[2], WebhookDeliveriesColumns[3]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[6], WebhookDeliveriesColumns[8]},
+			},
+			{
+				Name:    "webhookdelivery_rule_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[13], WebhookDeliveriesColumns[12]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
+		AddressTransactionsTable,
+		BalanceChangesTable,
+		BalanceCheckpointsTable,
 		BlocksTable,
+		GnoEventsTable,
+		PackagesTable,
+		PackageFilesTable,
+		HoldingsTable,
+		NftsTable,
+		NftTransfersTable,
+		RealmCallsTable,
+		RebuildProgressesTable,
 		RestoreHistoriesTable,
+		TokensTable,
+		TokenStatsTable,
 		TransactionsTable,
 		TransfersTable,
+		WatchRulesTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
+	PackagesTable.Annotation = &entsql.Annotation{
+		Table: "packages",
+	}
+	PackageFilesTable.ForeignKeys[0].RefTable = PackagesTable
+	PackageFilesTable.Annotation = &entsql.Annotation{
+		Table: "package_files",
+	}
+	HoldingsTable.ForeignKeys[0].RefTable = AccountsTable
 	TransactionsTable.ForeignKeys[0].RefTable = BlocksTable
 	TransfersTable.ForeignKeys[0].RefTable = AccountsTable
 	TransfersTable.ForeignKeys[1].RefTable = AccountsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WatchRulesTable
 }
@@ -12,12 +12,27 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/addresstransaction"
+	"gno.land-block-indexer/ent/balancechange"
+	"gno.land-block-indexer/ent/balancecheckpoint"
 	"gno.land-block-indexer/ent/block"
+	"gno.land-block-indexer/ent/gnoevent"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+	"gno.land-block-indexer/ent/holding"
+	"gno.land-block-indexer/ent/nft"
+	"gno.land-block-indexer/ent/nfttransfer"
 	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/realmcall"
+	"gno.land-block-indexer/ent/rebuildprogress"
 	"gno.land-block-indexer/ent/restorehistory"
 	"gno.land-block-indexer/ent/schema"
+	"gno.land-block-indexer/ent/token"
+	"gno.land-block-indexer/ent/tokenstat"
 	"gno.land-block-indexer/ent/transaction"
 	"gno.land-block-indexer/ent/transfer"
+	"gno.land-block-indexer/ent/watchrule"
+	"gno.land-block-indexer/ent/webhookdelivery"
 )
 
 const (
@@ -29,11 +44,26 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccount        = "Account"
-	TypeBlock          = "Block"
-	TypeRestoreHistory = "RestoreHistory"
-	TypeTransaction    = "Transaction"
-	TypeTransfer       = "Transfer"
+	TypeAccount            = "Account"
+	TypeAddressTransaction = "AddressTransaction"
+	TypeBalanceChange      = "BalanceChange"
+	TypeBalanceCheckpoint  = "BalanceCheckpoint"
+	TypeBlock              = "Block"
+	TypeGnoEvent           = "GnoEvent"
+	TypeGnoPackage         = "GnoPackage"
+	TypeGnoPackageFile     = "GnoPackageFile"
+	TypeHolding            = "Holding"
+	TypeNft                = "Nft"
+	TypeNftTransfer        = "NftTransfer"
+	TypeRealmCall          = "RealmCall"
+	TypeRebuildProgress    = "RebuildProgress"
+	TypeRestoreHistory     = "RestoreHistory"
+	TypeToken              = "Token"
+	TypeTokenStat          = "TokenStat"
+	TypeTransaction        = "Transaction"
+	TypeTransfer           = "Transfer"
+	TypeWatchRule          = "WatchRule"
+	TypeWebhookDelivery    = "WebhookDelivery"
 )
 
 // AccountMutation represents an operation that mutates the Account nodes in the graph.
@@ -42,9 +72,9 @@ type AccountMutation struct {
 	op                    Op
 	typ                   string
 	id                    *string
-	token                 *string
-	amount                *float64
-	addamount             *float64
+	first_seen_height     *int
+	addfirst_seen_height  *int
+	created_at            *time.Time
 	clearedFields         map[string]struct{}
 	transfers_to          map[int]struct{}
 	removedtransfers_to   map[int]struct{}
@@ -52,6 +82,9 @@ type AccountMutation struct {
 	transfers_from        map[int]struct{}
 	removedtransfers_from map[int]struct{}
 	clearedtransfers_from bool
+	holdings              map[int]struct{}
+	removedholdings       map[int]struct{}
+	clearedholdings       bool
 	done                  bool
 	oldValue              func(context.Context) (*Account, error)
 	predicates            []predicate.Account
@@ -161,96 +194,96 @@ func (m *AccountMutation) IDs(ctx context.Context) ([]string, error) {
 	}
 }
 
-// SetToken sets the "token" field.
-func (m *AccountMutation) SetToken(s string) {
-	m.token = &s
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (m *AccountMutation) SetFirstSeenHeight(i int) {
+	m.first_seen_height = &i
+	m.addfirst_seen_height = nil
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *AccountMutation) Token() (r string, exists bool) {
-	v := m.token
+// FirstSeenHeight returns the value of the "first_seen_height" field in the mutation.
+func (m *AccountMutation) FirstSeenHeight() (r int, exists bool) {
+	v := m.first_seen_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Account entity.
+// OldFirstSeenHeight returns the old "first_seen_height" field's value of the Account entity.
 // If the Account object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AccountMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *AccountMutation) OldFirstSeenHeight(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldFirstSeenHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldFirstSeenHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldFirstSeenHeight: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.FirstSeenHeight, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *AccountMutation) ResetToken() {
-	m.token = nil
+// AddFirstSeenHeight adds i to the "first_seen_height" field.
+func (m *AccountMutation) AddFirstSeenHeight(i int) {
+	if m.addfirst_seen_height != nil {
+		*m.addfirst_seen_height += i
+	} else {
+		m.addfirst_seen_height = &i
+	}
 }
 
-// SetAmount sets the "amount" field.
-func (m *AccountMutation) SetAmount(f float64) {
-	m.amount = &f
-	m.addamount = nil
+// AddedFirstSeenHeight returns the value that was added to the "first_seen_height" field in this mutation.
+func (m *AccountMutation) AddedFirstSeenHeight() (r int, exists bool) {
+	v := m.addfirst_seen_height
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *AccountMutation) Amount() (r float64, exists bool) {
-	v := m.amount
+// ResetFirstSeenHeight resets all changes to the "first_seen_height" field.
+func (m *AccountMutation) ResetFirstSeenHeight() {
+	m.first_seen_height = nil
+	m.addfirst_seen_height = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AccountMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AccountMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the Account entity.
+// OldCreatedAt returns the old "created_at" field's value of the Account entity.
 // If the Account object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AccountMutation) OldAmount(ctx context.Context) (v float64, err error) {
+func (m *AccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
-	}
-	return oldValue.Amount, nil
-}
-
-// AddAmount adds f to the "amount" field.
-func (m *AccountMutation) AddAmount(f float64) {
-	if m.addamount != nil {
-		*m.addamount += f
-	} else {
-		m.addamount = &f
-	}
-}
-
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *AccountMutation) AddedAmount() (r float64, exists bool) {
-	v := m.addamount
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *AccountMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AccountMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // AddTransfersToIDs adds the "transfers_to" edge to the Transfer entity by ids.
@@ -361,6 +394,60 @@ func (m *AccountMutation) ResetTransfersFrom() {
 	m.removedtransfers_from = nil
 }
 
+// AddHoldingIDs adds the "holdings" edge to the Holding entity by ids.
+func (m *AccountMutation) AddHoldingIDs(ids ...int) {
+	if m.holdings == nil {
+		m.holdings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.holdings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHoldings clears the "holdings" edge to the Holding entity.
+func (m *AccountMutation) ClearHoldings() {
+	m.clearedholdings = true
+}
+
+// HoldingsCleared reports if the "holdings" edge to the Holding entity was cleared.
+func (m *AccountMutation) HoldingsCleared() bool {
+	return m.clearedholdings
+}
+
+// RemoveHoldingIDs removes the "holdings" edge to the Holding entity by IDs.
+func (m *AccountMutation) RemoveHoldingIDs(ids ...int) {
+	if m.removedholdings == nil {
+		m.removedholdings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.holdings, ids[i])
+		m.removedholdings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHoldings returns the removed IDs of the "holdings" edge to the Holding entity.
+func (m *AccountMutation) RemovedHoldingsIDs() (ids []int) {
+	for id := range m.removedholdings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HoldingsIDs returns the "holdings" edge IDs in the mutation.
+func (m *AccountMutation) HoldingsIDs() (ids []int) {
+	for id := range m.holdings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHoldings resets all changes to the "holdings" edge.
+func (m *AccountMutation) ResetHoldings() {
+	m.holdings = nil
+	m.clearedholdings = false
+	m.removedholdings = nil
+}
+
 // Where appends a list predicates to the AccountMutation builder.
 func (m *AccountMutation) Where(ps ...predicate.Account) {
 	m.predicates = append(m.predicates, ps...)
@@ -396,11 +483,11 @@ func (m *AccountMutation) Type() string {
 // AddedFields().
 func (m *AccountMutation) Fields() []string {
 	fields := make([]string, 0, 2)
-	if m.token != nil {
-		fields = append(fields, account.FieldToken)
+	if m.first_seen_height != nil {
+		fields = append(fields, account.FieldFirstSeenHeight)
 	}
-	if m.amount != nil {
-		fields = append(fields, account.FieldAmount)
+	if m.created_at != nil {
+		fields = append(fields, account.FieldCreatedAt)
 	}
 	return fields
 }
@@ -410,10 +497,10 @@ func (m *AccountMutation) Fields() []string {
 // schema.
 func (m *AccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case account.FieldToken:
-		return m.Token()
-	case account.FieldAmount:
-		return m.Amount()
+	case account.FieldFirstSeenHeight:
+		return m.FirstSeenHeight()
+	case account.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -423,10 +510,10 @@ func (m *AccountMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case account.FieldToken:
-		return m.OldToken(ctx)
-	case account.FieldAmount:
-		return m.OldAmount(ctx)
+	case account.FieldFirstSeenHeight:
+		return m.OldFirstSeenHeight(ctx)
+	case account.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Account field %s", name)
 }
@@ -436,19 +523,19 @@ func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value,
 // type.
 func (m *AccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case account.FieldToken:
-		v, ok := value.(string)
+	case account.FieldFirstSeenHeight:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetFirstSeenHeight(v)
 		return nil
-	case account.FieldAmount:
-		v, ok := value.(float64)
+	case account.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
 	return fmt.Errorf("unknown Account field %s", name)
@@ -458,8 +545,8 @@ func (m *AccountMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *AccountMutation) AddedFields() []string {
 	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, account.FieldAmount)
+	if m.addfirst_seen_height != nil {
+		fields = append(fields, account.FieldFirstSeenHeight)
 	}
 	return fields
 }
@@ -469,8 +556,8 @@ func (m *AccountMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *AccountMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case account.FieldAmount:
-		return m.AddedAmount()
+	case account.FieldFirstSeenHeight:
+		return m.AddedFirstSeenHeight()
 	}
 	return nil, false
 }
@@ -480,12 +567,12 @@ func (m *AccountMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *AccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case account.FieldAmount:
-		v, ok := value.(float64)
+	case account.FieldFirstSeenHeight:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAmount(v)
+		m.AddFirstSeenHeight(v)
 		return nil
 	}
 	return fmt.Errorf("unknown Account numeric field %s", name)
@@ -514,11 +601,11 @@ func (m *AccountMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *AccountMutation) ResetField(name string) error {
 	switch name {
-	case account.FieldToken:
-		m.ResetToken()
+	case account.FieldFirstSeenHeight:
+		m.ResetFirstSeenHeight()
 		return nil
-	case account.FieldAmount:
-		m.ResetAmount()
+	case account.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
 	return fmt.Errorf("unknown Account field %s", name)
@@ -526,13 +613,16 @@ func (m *AccountMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AccountMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.transfers_to != nil {
 		edges = append(edges, account.EdgeTransfersTo)
 	}
 	if m.transfers_from != nil {
 		edges = append(edges, account.EdgeTransfersFrom)
 	}
+	if m.holdings != nil {
+		edges = append(edges, account.EdgeHoldings)
+	}
 	return edges
 }
 
@@ -552,19 +642,28 @@ func (m *AccountMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case account.EdgeHoldings:
+		ids := make([]ent.Value, 0, len(m.holdings))
+		for id := range m.holdings {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AccountMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.removedtransfers_to != nil {
 		edges = append(edges, account.EdgeTransfersTo)
 	}
 	if m.removedtransfers_from != nil {
 		edges = append(edges, account.EdgeTransfersFrom)
 	}
+	if m.removedholdings != nil {
+		edges = append(edges, account.EdgeHoldings)
+	}
 	return edges
 }
 
@@ -584,19 +683,28 @@ func (m *AccountMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case account.EdgeHoldings:
+		ids := make([]ent.Value, 0, len(m.removedholdings))
+		for id := range m.removedholdings {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AccountMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedtransfers_to {
 		edges = append(edges, account.EdgeTransfersTo)
 	}
 	if m.clearedtransfers_from {
 		edges = append(edges, account.EdgeTransfersFrom)
 	}
+	if m.clearedholdings {
+		edges = append(edges, account.EdgeHoldings)
+	}
 	return edges
 }
 
@@ -608,6 +716,8 @@ func (m *AccountMutation) EdgeCleared(name string) bool {
 		return m.clearedtransfers_to
 	case account.EdgeTransfersFrom:
 		return m.clearedtransfers_from
+	case account.EdgeHoldings:
+		return m.clearedholdings
 	}
 	return false
 }
@@ -630,43 +740,46 @@ func (m *AccountMutation) ResetEdge(name string) error {
 	case account.EdgeTransfersFrom:
 		m.ResetTransfersFrom()
 		return nil
+	case account.EdgeHoldings:
+		m.ResetHoldings()
+		return nil
 	}
 	return fmt.Errorf("unknown Account edge %s", name)
 }
 
-// BlockMutation represents an operation that mutates the Block nodes in the graph.
-type BlockMutation struct {
+// AddressTransactionMutation represents an operation that mutates the AddressTransaction nodes in the graph.
+type AddressTransactionMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	hash                *string
-	time                *time.Time
-	total_txs           *int
-	addtotal_txs        *int
-	num_txs             *int
-	addnum_txs          *int
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	transactions        map[int]struct{}
-	removedtransactions map[int]struct{}
-	clearedtransactions bool
-	done                bool
-	oldValue            func(context.Context) (*Block, error)
-	predicates          []predicate.Block
+	op              Op
+	typ             string
+	id              *int
+	address         *string
+	hash            *string
+	role            *string
+	block_height    *int
+	addblock_height *int
+	tx_index        *int
+	addtx_index     *int
+	block_time      *time.Time
+	success         *bool
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*AddressTransaction, error)
+	predicates      []predicate.AddressTransaction
 }
 
-var _ ent.Mutation = (*BlockMutation)(nil)
+var _ ent.Mutation = (*AddressTransactionMutation)(nil)
 
-// blockOption allows management of the mutation configuration using functional options.
-type blockOption func(*BlockMutation)
+// addresstransactionOption allows management of the mutation configuration using functional options.
+type addresstransactionOption func(*AddressTransactionMutation)
 
-// newBlockMutation creates new mutation for the Block entity.
-func newBlockMutation(c config, op Op, opts ...blockOption) *BlockMutation {
-	m := &BlockMutation{
+// newAddressTransactionMutation creates new mutation for the AddressTransaction entity.
+func newAddressTransactionMutation(c config, op Op, opts ...addresstransactionOption) *AddressTransactionMutation {
+	m := &AddressTransactionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBlock,
+		typ:           TypeAddressTransaction,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -675,20 +788,20 @@ func newBlockMutation(c config, op Op, opts ...blockOption) *BlockMutation {
 	return m
 }
 
-// withBlockID sets the ID field of the mutation.
-func withBlockID(id int) blockOption {
-	return func(m *BlockMutation) {
+// withAddressTransactionID sets the ID field of the mutation.
+func withAddressTransactionID(id int) addresstransactionOption {
+	return func(m *AddressTransactionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Block
+			value *AddressTransaction
 		)
-		m.oldValue = func(ctx context.Context) (*Block, error) {
+		m.oldValue = func(ctx context.Context) (*AddressTransaction, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Block.Get(ctx, id)
+					value, err = m.Client().AddressTransaction.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -697,10 +810,10 @@ func withBlockID(id int) blockOption {
 	}
 }
 
-// withBlock sets the old Block of the mutation.
-func withBlock(node *Block) blockOption {
-	return func(m *BlockMutation) {
-		m.oldValue = func(context.Context) (*Block, error) {
+// withAddressTransaction sets the old AddressTransaction of the mutation.
+func withAddressTransaction(node *AddressTransaction) addresstransactionOption {
+	return func(m *AddressTransactionMutation) {
+		m.oldValue = func(context.Context) (*AddressTransaction, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -709,7 +822,7 @@ func withBlock(node *Block) blockOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BlockMutation) Client() *Client {
+func (m AddressTransactionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -717,7 +830,7 @@ func (m BlockMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BlockMutation) Tx() (*Tx, error) {
+func (m AddressTransactionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -726,15 +839,9 @@ func (m BlockMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Block entities.
-func (m *BlockMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BlockMutation) ID() (id int, exists bool) {
+func (m *AddressTransactionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -745,7 +852,7 @@ func (m *BlockMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BlockMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AddressTransactionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -754,19 +861,55 @@ func (m *BlockMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Block.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AddressTransaction.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetAddress sets the "address" field.
+func (m *AddressTransactionMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *AddressTransactionMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the AddressTransaction entity.
+// If the AddressTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressTransactionMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *AddressTransactionMutation) ResetAddress() {
+	m.address = nil
+}
+
 // SetHash sets the "hash" field.
-func (m *BlockMutation) SetHash(s string) {
+func (m *AddressTransactionMutation) SetHash(s string) {
 	m.hash = &s
 }
 
 // Hash returns the value of the "hash" field in the mutation.
-func (m *BlockMutation) Hash() (r string, exists bool) {
+func (m *AddressTransactionMutation) Hash() (r string, exists bool) {
 	v := m.hash
 	if v == nil {
 		return
@@ -774,10 +917,10 @@ func (m *BlockMutation) Hash() (r string, exists bool) {
 	return *v, true
 }
 
-// OldHash returns the old "hash" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the AddressTransaction entity.
+// If the AddressTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldHash(ctx context.Context) (v string, err error) {
+func (m *AddressTransactionMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
@@ -792,257 +935,275 @@ func (m *BlockMutation) OldHash(ctx context.Context) (v string, err error) {
 }
 
 // ResetHash resets all changes to the "hash" field.
-func (m *BlockMutation) ResetHash() {
+func (m *AddressTransactionMutation) ResetHash() {
 	m.hash = nil
 }
 
-// SetTime sets the "time" field.
-func (m *BlockMutation) SetTime(t time.Time) {
-	m.time = &t
+// SetRole sets the "role" field.
+func (m *AddressTransactionMutation) SetRole(s string) {
+	m.role = &s
 }
 
-// Time returns the value of the "time" field in the mutation.
-func (m *BlockMutation) Time() (r time.Time, exists bool) {
-	v := m.time
+// Role returns the value of the "role" field in the mutation.
+func (m *AddressTransactionMutation) Role() (r string, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTime returns the old "time" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the AddressTransaction entity.
+// If the AddressTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+func (m *AddressTransactionMutation) OldRole(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTime requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.Time, nil
+	return oldValue.Role, nil
 }
 
-// ResetTime resets all changes to the "time" field.
-func (m *BlockMutation) ResetTime() {
-	m.time = nil
+// ResetRole resets all changes to the "role" field.
+func (m *AddressTransactionMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetTotalTxs sets the "total_txs" field.
-func (m *BlockMutation) SetTotalTxs(i int) {
-	m.total_txs = &i
-	m.addtotal_txs = nil
+// SetBlockHeight sets the "block_height" field.
+func (m *AddressTransactionMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
 }
 
-// TotalTxs returns the value of the "total_txs" field in the mutation.
-func (m *BlockMutation) TotalTxs() (r int, exists bool) {
-	v := m.total_txs
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *AddressTransactionMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalTxs returns the old "total_txs" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockHeight returns the old "block_height" field's value of the AddressTransaction entity.
+// If the AddressTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldTotalTxs(ctx context.Context) (v int, err error) {
+func (m *AddressTransactionMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalTxs is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalTxs requires an ID field in the mutation")
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalTxs: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
 	}
-	return oldValue.TotalTxs, nil
+	return oldValue.BlockHeight, nil
 }
 
-// AddTotalTxs adds i to the "total_txs" field.
-func (m *BlockMutation) AddTotalTxs(i int) {
-	if m.addtotal_txs != nil {
-		*m.addtotal_txs += i
+// AddBlockHeight adds i to the "block_height" field.
+func (m *AddressTransactionMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
 	} else {
-		m.addtotal_txs = &i
+		m.addblock_height = &i
 	}
 }
 
-// AddedTotalTxs returns the value that was added to the "total_txs" field in this mutation.
-func (m *BlockMutation) AddedTotalTxs() (r int, exists bool) {
-	v := m.addtotal_txs
-	if v == nil {
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *AddressTransactionMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
+	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTotalTxs resets all changes to the "total_txs" field.
-func (m *BlockMutation) ResetTotalTxs() {
-	m.total_txs = nil
-	m.addtotal_txs = nil
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *AddressTransactionMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
 }
 
-// SetNumTxs sets the "num_txs" field.
-func (m *BlockMutation) SetNumTxs(i int) {
-	m.num_txs = &i
-	m.addnum_txs = nil
+// SetTxIndex sets the "tx_index" field.
+func (m *AddressTransactionMutation) SetTxIndex(i int) {
+	m.tx_index = &i
+	m.addtx_index = nil
 }
 
-// NumTxs returns the value of the "num_txs" field in the mutation.
-func (m *BlockMutation) NumTxs() (r int, exists bool) {
-	v := m.num_txs
+// TxIndex returns the value of the "tx_index" field in the mutation.
+func (m *AddressTransactionMutation) TxIndex() (r int, exists bool) {
+	v := m.tx_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumTxs returns the old "num_txs" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldTxIndex returns the old "tx_index" field's value of the AddressTransaction entity.
+// If the AddressTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldNumTxs(ctx context.Context) (v int, err error) {
+func (m *AddressTransactionMutation) OldTxIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumTxs is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumTxs requires an ID field in the mutation")
+		return v, errors.New("OldTxIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumTxs: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxIndex: %w", err)
 	}
-	return oldValue.NumTxs, nil
+	return oldValue.TxIndex, nil
 }
 
-// AddNumTxs adds i to the "num_txs" field.
-func (m *BlockMutation) AddNumTxs(i int) {
-	if m.addnum_txs != nil {
-		*m.addnum_txs += i
+// AddTxIndex adds i to the "tx_index" field.
+func (m *AddressTransactionMutation) AddTxIndex(i int) {
+	if m.addtx_index != nil {
+		*m.addtx_index += i
 	} else {
-		m.addnum_txs = &i
+		m.addtx_index = &i
 	}
 }
 
-// AddedNumTxs returns the value that was added to the "num_txs" field in this mutation.
-func (m *BlockMutation) AddedNumTxs() (r int, exists bool) {
-	v := m.addnum_txs
+// AddedTxIndex returns the value that was added to the "tx_index" field in this mutation.
+func (m *AddressTransactionMutation) AddedTxIndex() (r int, exists bool) {
+	v := m.addtx_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetNumTxs resets all changes to the "num_txs" field.
-func (m *BlockMutation) ResetNumTxs() {
-	m.num_txs = nil
-	m.addnum_txs = nil
+// ResetTxIndex resets all changes to the "tx_index" field.
+func (m *AddressTransactionMutation) ResetTxIndex() {
+	m.tx_index = nil
+	m.addtx_index = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BlockMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetBlockTime sets the "block_time" field.
+func (m *AddressTransactionMutation) SetBlockTime(t time.Time) {
+	m.block_time = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BlockMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// BlockTime returns the value of the "block_time" field in the mutation.
+func (m *AddressTransactionMutation) BlockTime() (r time.Time, exists bool) {
+	v := m.block_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockTime returns the old "block_time" field's value of the AddressTransaction entity.
+// If the AddressTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AddressTransactionMutation) OldBlockTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldBlockTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockTime: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.BlockTime, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BlockMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetBlockTime resets all changes to the "block_time" field.
+func (m *AddressTransactionMutation) ResetBlockTime() {
+	m.block_time = nil
 }
 
-// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
-func (m *BlockMutation) AddTransactionIDs(ids ...int) {
-	if m.transactions == nil {
-		m.transactions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.transactions[ids[i]] = struct{}{}
+// SetSuccess sets the "success" field.
+func (m *AddressTransactionMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *AddressTransactionMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearTransactions clears the "transactions" edge to the Transaction entity.
-func (m *BlockMutation) ClearTransactions() {
-	m.clearedtransactions = true
+// OldSuccess returns the old "success" field's value of the AddressTransaction entity.
+// If the AddressTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressTransactionMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
 }
 
-// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
-func (m *BlockMutation) TransactionsCleared() bool {
-	return m.clearedtransactions
+// ResetSuccess resets all changes to the "success" field.
+func (m *AddressTransactionMutation) ResetSuccess() {
+	m.success = nil
 }
 
-// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
-func (m *BlockMutation) RemoveTransactionIDs(ids ...int) {
-	if m.removedtransactions == nil {
-		m.removedtransactions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.transactions, ids[i])
-		m.removedtransactions[ids[i]] = struct{}{}
-	}
+// SetCreatedAt sets the "created_at" field.
+func (m *AddressTransactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
-func (m *BlockMutation) RemovedTransactionsIDs() (ids []int) {
-	for id := range m.removedtransactions {
-		ids = append(ids, id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AddressTransactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TransactionsIDs returns the "transactions" edge IDs in the mutation.
-func (m *BlockMutation) TransactionsIDs() (ids []int) {
-	for id := range m.transactions {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the AddressTransaction entity.
+// If the AddressTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressTransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTransactions resets all changes to the "transactions" edge.
-func (m *BlockMutation) ResetTransactions() {
-	m.transactions = nil
-	m.clearedtransactions = false
-	m.removedtransactions = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AddressTransactionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the BlockMutation builder.
-func (m *BlockMutation) Where(ps ...predicate.Block) {
+// Where appends a list predicates to the AddressTransactionMutation builder.
+func (m *AddressTransactionMutation) Where(ps ...predicate.AddressTransaction) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BlockMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AddressTransactionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BlockMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Block, len(ps))
+func (m *AddressTransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AddressTransaction, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1050,39 +1211,48 @@ func (m *BlockMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BlockMutation) Op() Op {
+func (m *AddressTransactionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BlockMutation) SetOp(op Op) {
+func (m *AddressTransactionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Block).
-func (m *BlockMutation) Type() string {
+// Type returns the node type of this mutation (AddressTransaction).
+func (m *AddressTransactionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BlockMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *AddressTransactionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.address != nil {
+		fields = append(fields, addresstransaction.FieldAddress)
+	}
 	if m.hash != nil {
-		fields = append(fields, block.FieldHash)
+		fields = append(fields, addresstransaction.FieldHash)
 	}
-	if m.time != nil {
-		fields = append(fields, block.FieldTime)
+	if m.role != nil {
+		fields = append(fields, addresstransaction.FieldRole)
 	}
-	if m.total_txs != nil {
-		fields = append(fields, block.FieldTotalTxs)
+	if m.block_height != nil {
+		fields = append(fields, addresstransaction.FieldBlockHeight)
 	}
-	if m.num_txs != nil {
-		fields = append(fields, block.FieldNumTxs)
+	if m.tx_index != nil {
+		fields = append(fields, addresstransaction.FieldTxIndex)
+	}
+	if m.block_time != nil {
+		fields = append(fields, addresstransaction.FieldBlockTime)
+	}
+	if m.success != nil {
+		fields = append(fields, addresstransaction.FieldSuccess)
 	}
 	if m.created_at != nil {
-		fields = append(fields, block.FieldCreatedAt)
+		fields = append(fields, addresstransaction.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1090,17 +1260,23 @@ func (m *BlockMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BlockMutation) Field(name string) (ent.Value, bool) {
+func (m *AddressTransactionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case block.FieldHash:
+	case addresstransaction.FieldAddress:
+		return m.Address()
+	case addresstransaction.FieldHash:
 		return m.Hash()
-	case block.FieldTime:
-		return m.Time()
-	case block.FieldTotalTxs:
-		return m.TotalTxs()
-	case block.FieldNumTxs:
-		return m.NumTxs()
-	case block.FieldCreatedAt:
+	case addresstransaction.FieldRole:
+		return m.Role()
+	case addresstransaction.FieldBlockHeight:
+		return m.BlockHeight()
+	case addresstransaction.FieldTxIndex:
+		return m.TxIndex()
+	case addresstransaction.FieldBlockTime:
+		return m.BlockTime()
+	case addresstransaction.FieldSuccess:
+		return m.Success()
+	case addresstransaction.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1109,56 +1285,83 @@ func (m *BlockMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BlockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AddressTransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case block.FieldHash:
+	case addresstransaction.FieldAddress:
+		return m.OldAddress(ctx)
+	case addresstransaction.FieldHash:
 		return m.OldHash(ctx)
-	case block.FieldTime:
-		return m.OldTime(ctx)
-	case block.FieldTotalTxs:
-		return m.OldTotalTxs(ctx)
-	case block.FieldNumTxs:
-		return m.OldNumTxs(ctx)
-	case block.FieldCreatedAt:
+	case addresstransaction.FieldRole:
+		return m.OldRole(ctx)
+	case addresstransaction.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case addresstransaction.FieldTxIndex:
+		return m.OldTxIndex(ctx)
+	case addresstransaction.FieldBlockTime:
+		return m.OldBlockTime(ctx)
+	case addresstransaction.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case addresstransaction.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Block field %s", name)
+	return nil, fmt.Errorf("unknown AddressTransaction field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlockMutation) SetField(name string, value ent.Value) error {
+func (m *AddressTransactionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case block.FieldHash:
+	case addresstransaction.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case addresstransaction.FieldHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetHash(v)
 		return nil
-	case block.FieldTime:
-		v, ok := value.(time.Time)
+	case addresstransaction.FieldRole:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTime(v)
+		m.SetRole(v)
 		return nil
-	case block.FieldTotalTxs:
+	case addresstransaction.FieldBlockHeight:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalTxs(v)
+		m.SetBlockHeight(v)
 		return nil
-	case block.FieldNumTxs:
+	case addresstransaction.FieldTxIndex:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumTxs(v)
+		m.SetTxIndex(v)
 		return nil
-	case block.FieldCreatedAt:
+	case addresstransaction.FieldBlockTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockTime(v)
+		return nil
+	case addresstransaction.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case addresstransaction.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1166,18 +1369,18 @@ func (m *BlockMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Block field %s", name)
+	return fmt.Errorf("unknown AddressTransaction field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BlockMutation) AddedFields() []string {
+func (m *AddressTransactionMutation) AddedFields() []string {
 	var fields []string
-	if m.addtotal_txs != nil {
-		fields = append(fields, block.FieldTotalTxs)
+	if m.addblock_height != nil {
+		fields = append(fields, addresstransaction.FieldBlockHeight)
 	}
-	if m.addnum_txs != nil {
-		fields = append(fields, block.FieldNumTxs)
+	if m.addtx_index != nil {
+		fields = append(fields, addresstransaction.FieldTxIndex)
 	}
 	return fields
 }
@@ -1185,12 +1388,12 @@ func (m *BlockMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BlockMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AddressTransactionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case block.FieldTotalTxs:
-		return m.AddedTotalTxs()
-	case block.FieldNumTxs:
-		return m.AddedNumTxs()
+	case addresstransaction.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case addresstransaction.FieldTxIndex:
+		return m.AddedTxIndex()
 	}
 	return nil, false
 }
@@ -1198,181 +1401,159 @@ func (m *BlockMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlockMutation) AddField(name string, value ent.Value) error {
+func (m *AddressTransactionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case block.FieldTotalTxs:
+	case addresstransaction.FieldBlockHeight:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalTxs(v)
+		m.AddBlockHeight(v)
 		return nil
-	case block.FieldNumTxs:
+	case addresstransaction.FieldTxIndex:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddNumTxs(v)
+		m.AddTxIndex(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Block numeric field %s", name)
+	return fmt.Errorf("unknown AddressTransaction numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BlockMutation) ClearedFields() []string {
+func (m *AddressTransactionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BlockMutation) FieldCleared(name string) bool {
+func (m *AddressTransactionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BlockMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Block nullable field %s", name)
+func (m *AddressTransactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AddressTransaction nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BlockMutation) ResetField(name string) error {
+func (m *AddressTransactionMutation) ResetField(name string) error {
 	switch name {
-	case block.FieldHash:
+	case addresstransaction.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case addresstransaction.FieldHash:
 		m.ResetHash()
 		return nil
-	case block.FieldTime:
-		m.ResetTime()
+	case addresstransaction.FieldRole:
+		m.ResetRole()
 		return nil
-	case block.FieldTotalTxs:
-		m.ResetTotalTxs()
+	case addresstransaction.FieldBlockHeight:
+		m.ResetBlockHeight()
 		return nil
-	case block.FieldNumTxs:
-		m.ResetNumTxs()
+	case addresstransaction.FieldTxIndex:
+		m.ResetTxIndex()
 		return nil
-	case block.FieldCreatedAt:
+	case addresstransaction.FieldBlockTime:
+		m.ResetBlockTime()
+		return nil
+	case addresstransaction.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case addresstransaction.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Block field %s", name)
+	return fmt.Errorf("unknown AddressTransaction field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BlockMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.transactions != nil {
-		edges = append(edges, block.EdgeTransactions)
-	}
+func (m *AddressTransactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BlockMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case block.EdgeTransactions:
-		ids := make([]ent.Value, 0, len(m.transactions))
-		for id := range m.transactions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AddressTransactionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BlockMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedtransactions != nil {
-		edges = append(edges, block.EdgeTransactions)
-	}
+func (m *AddressTransactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BlockMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case block.EdgeTransactions:
-		ids := make([]ent.Value, 0, len(m.removedtransactions))
-		for id := range m.removedtransactions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AddressTransactionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BlockMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtransactions {
-		edges = append(edges, block.EdgeTransactions)
-	}
+func (m *AddressTransactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BlockMutation) EdgeCleared(name string) bool {
-	switch name {
-	case block.EdgeTransactions:
-		return m.clearedtransactions
-	}
+func (m *AddressTransactionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BlockMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Block unique edge %s", name)
+func (m *AddressTransactionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AddressTransaction unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BlockMutation) ResetEdge(name string) error {
-	switch name {
-	case block.EdgeTransactions:
-		m.ResetTransactions()
-		return nil
-	}
-	return fmt.Errorf("unknown Block edge %s", name)
+func (m *AddressTransactionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AddressTransaction edge %s", name)
 }
 
-// RestoreHistoryMutation represents an operation that mutates the RestoreHistory nodes in the graph.
-type RestoreHistoryMutation struct {
+// BalanceChangeMutation represents an operation that mutates the BalanceChange nodes in the graph.
+type BalanceChangeMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *int
-	restore_range_start    *int
-	addrestore_range_start *int
-	restore_range_end      *int
-	addrestore_range_end   *int
-	being_block            *int
-	addbeing_block         *int
-	clearedFields          map[string]struct{}
-	done                   bool
-	oldValue               func(context.Context) (*RestoreHistory, error)
-	predicates             []predicate.RestoreHistory
+	op              Op
+	typ             string
+	id              *int
+	address         *string
+	token           *string
+	block_height    *int
+	addblock_height *int
+	delta           *int64
+	adddelta        *int64
+	reason          *string
+	hash            *string
+	event_index     *int
+	addevent_index  *int
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*BalanceChange, error)
+	predicates      []predicate.BalanceChange
 }
 
-var _ ent.Mutation = (*RestoreHistoryMutation)(nil)
+var _ ent.Mutation = (*BalanceChangeMutation)(nil)
 
-// restorehistoryOption allows management of the mutation configuration using functional options.
-type restorehistoryOption func(*RestoreHistoryMutation)
+// balancechangeOption allows management of the mutation configuration using functional options.
+type balancechangeOption func(*BalanceChangeMutation)
 
-// newRestoreHistoryMutation creates new mutation for the RestoreHistory entity.
-func newRestoreHistoryMutation(c config, op Op, opts ...restorehistoryOption) *RestoreHistoryMutation {
-	m := &RestoreHistoryMutation{
+// newBalanceChangeMutation creates new mutation for the BalanceChange entity.
+func newBalanceChangeMutation(c config, op Op, opts ...balancechangeOption) *BalanceChangeMutation {
+	m := &BalanceChangeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRestoreHistory,
+		typ:           TypeBalanceChange,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1381,20 +1562,20 @@ func newRestoreHistoryMutation(c config, op Op, opts ...restorehistoryOption) *R
 	return m
 }
 
-// withRestoreHistoryID sets the ID field of the mutation.
-func withRestoreHistoryID(id int) restorehistoryOption {
-	return func(m *RestoreHistoryMutation) {
+// withBalanceChangeID sets the ID field of the mutation.
+func withBalanceChangeID(id int) balancechangeOption {
+	return func(m *BalanceChangeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RestoreHistory
+			value *BalanceChange
 		)
-		m.oldValue = func(ctx context.Context) (*RestoreHistory, error) {
+		m.oldValue = func(ctx context.Context) (*BalanceChange, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RestoreHistory.Get(ctx, id)
+					value, err = m.Client().BalanceChange.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1403,10 +1584,10 @@ func withRestoreHistoryID(id int) restorehistoryOption {
 	}
 }
 
-// withRestoreHistory sets the old RestoreHistory of the mutation.
-func withRestoreHistory(node *RestoreHistory) restorehistoryOption {
-	return func(m *RestoreHistoryMutation) {
-		m.oldValue = func(context.Context) (*RestoreHistory, error) {
+// withBalanceChange sets the old BalanceChange of the mutation.
+func withBalanceChange(node *BalanceChange) balancechangeOption {
+	return func(m *BalanceChangeMutation) {
+		m.oldValue = func(context.Context) (*BalanceChange, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1415,7 +1596,7 @@ func withRestoreHistory(node *RestoreHistory) restorehistoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RestoreHistoryMutation) Client() *Client {
+func (m BalanceChangeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1423,7 +1604,7 @@ func (m RestoreHistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RestoreHistoryMutation) Tx() (*Tx, error) {
+func (m BalanceChangeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1432,15 +1613,9 @@ func (m RestoreHistoryMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RestoreHistory entities.
-func (m *RestoreHistoryMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RestoreHistoryMutation) ID() (id int, exists bool) {
+func (m *BalanceChangeMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1451,7 +1626,7 @@ func (m *RestoreHistoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RestoreHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BalanceChangeMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1460,223 +1635,418 @@ func (m *RestoreHistoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RestoreHistory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BalanceChange.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRestoreRangeStart sets the "restore_range_start" field.
-func (m *RestoreHistoryMutation) SetRestoreRangeStart(i int) {
-	m.restore_range_start = &i
-	m.addrestore_range_start = nil
+// SetAddress sets the "address" field.
+func (m *BalanceChangeMutation) SetAddress(s string) {
+	m.address = &s
 }
 
-// RestoreRangeStart returns the value of the "restore_range_start" field in the mutation.
-func (m *RestoreHistoryMutation) RestoreRangeStart() (r int, exists bool) {
-	v := m.restore_range_start
+// Address returns the value of the "address" field in the mutation.
+func (m *BalanceChangeMutation) Address() (r string, exists bool) {
+	v := m.address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRestoreRangeStart returns the old "restore_range_start" field's value of the RestoreHistory entity.
-// If the RestoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldAddress returns the old "address" field's value of the BalanceChange entity.
+// If the BalanceChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RestoreHistoryMutation) OldRestoreRangeStart(ctx context.Context) (v int, err error) {
+func (m *BalanceChangeMutation) OldAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRestoreRangeStart is only allowed on UpdateOne operations")
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRestoreRangeStart requires an ID field in the mutation")
+		return v, errors.New("OldAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRestoreRangeStart: %w", err)
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
 	}
-	return oldValue.RestoreRangeStart, nil
+	return oldValue.Address, nil
 }
 
-// AddRestoreRangeStart adds i to the "restore_range_start" field.
-func (m *RestoreHistoryMutation) AddRestoreRangeStart(i int) {
-	if m.addrestore_range_start != nil {
-		*m.addrestore_range_start += i
-	} else {
-		m.addrestore_range_start = &i
-	}
+// ResetAddress resets all changes to the "address" field.
+func (m *BalanceChangeMutation) ResetAddress() {
+	m.address = nil
 }
 
-// AddedRestoreRangeStart returns the value that was added to the "restore_range_start" field in this mutation.
-func (m *RestoreHistoryMutation) AddedRestoreRangeStart() (r int, exists bool) {
-	v := m.addrestore_range_start
+// SetToken sets the "token" field.
+func (m *BalanceChangeMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *BalanceChangeMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRestoreRangeStart resets all changes to the "restore_range_start" field.
-func (m *RestoreHistoryMutation) ResetRestoreRangeStart() {
-	m.restore_range_start = nil
-	m.addrestore_range_start = nil
+// OldToken returns the old "token" field's value of the BalanceChange entity.
+// If the BalanceChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceChangeMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
 }
 
-// SetRestoreRangeEnd sets the "restore_range_end" field.
-func (m *RestoreHistoryMutation) SetRestoreRangeEnd(i int) {
-	m.restore_range_end = &i
-	m.addrestore_range_end = nil
+// ResetToken resets all changes to the "token" field.
+func (m *BalanceChangeMutation) ResetToken() {
+	m.token = nil
 }
 
-// RestoreRangeEnd returns the value of the "restore_range_end" field in the mutation.
-func (m *RestoreHistoryMutation) RestoreRangeEnd() (r int, exists bool) {
-	v := m.restore_range_end
+// SetBlockHeight sets the "block_height" field.
+func (m *BalanceChangeMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *BalanceChangeMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRestoreRangeEnd returns the old "restore_range_end" field's value of the RestoreHistory entity.
-// If the RestoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockHeight returns the old "block_height" field's value of the BalanceChange entity.
+// If the BalanceChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RestoreHistoryMutation) OldRestoreRangeEnd(ctx context.Context) (v int, err error) {
+func (m *BalanceChangeMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRestoreRangeEnd is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRestoreRangeEnd requires an ID field in the mutation")
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRestoreRangeEnd: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
 	}
-	return oldValue.RestoreRangeEnd, nil
+	return oldValue.BlockHeight, nil
 }
 
-// AddRestoreRangeEnd adds i to the "restore_range_end" field.
-func (m *RestoreHistoryMutation) AddRestoreRangeEnd(i int) {
-	if m.addrestore_range_end != nil {
-		*m.addrestore_range_end += i
+// AddBlockHeight adds i to the "block_height" field.
+func (m *BalanceChangeMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
 	} else {
-		m.addrestore_range_end = &i
+		m.addblock_height = &i
 	}
 }
 
-// AddedRestoreRangeEnd returns the value that was added to the "restore_range_end" field in this mutation.
-func (m *RestoreHistoryMutation) AddedRestoreRangeEnd() (r int, exists bool) {
-	v := m.addrestore_range_end
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *BalanceChangeMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRestoreRangeEnd resets all changes to the "restore_range_end" field.
-func (m *RestoreHistoryMutation) ResetRestoreRangeEnd() {
-	m.restore_range_end = nil
-	m.addrestore_range_end = nil
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *BalanceChangeMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
 }
 
-// SetBeingBlock sets the "being_block" field.
-func (m *RestoreHistoryMutation) SetBeingBlock(i int) {
-	m.being_block = &i
-	m.addbeing_block = nil
+// SetDelta sets the "delta" field.
+func (m *BalanceChangeMutation) SetDelta(i int64) {
+	m.delta = &i
+	m.adddelta = nil
 }
 
-// BeingBlock returns the value of the "being_block" field in the mutation.
-func (m *RestoreHistoryMutation) BeingBlock() (r int, exists bool) {
-	v := m.being_block
+// Delta returns the value of the "delta" field in the mutation.
+func (m *BalanceChangeMutation) Delta() (r int64, exists bool) {
+	v := m.delta
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBeingBlock returns the old "being_block" field's value of the RestoreHistory entity.
-// If the RestoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldDelta returns the old "delta" field's value of the BalanceChange entity.
+// If the BalanceChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RestoreHistoryMutation) OldBeingBlock(ctx context.Context) (v int, err error) {
+func (m *BalanceChangeMutation) OldDelta(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBeingBlock is only allowed on UpdateOne operations")
+		return v, errors.New("OldDelta is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBeingBlock requires an ID field in the mutation")
+		return v, errors.New("OldDelta requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBeingBlock: %w", err)
+		return v, fmt.Errorf("querying old value for OldDelta: %w", err)
 	}
-	return oldValue.BeingBlock, nil
+	return oldValue.Delta, nil
 }
 
-// AddBeingBlock adds i to the "being_block" field.
-func (m *RestoreHistoryMutation) AddBeingBlock(i int) {
-	if m.addbeing_block != nil {
-		*m.addbeing_block += i
+// AddDelta adds i to the "delta" field.
+func (m *BalanceChangeMutation) AddDelta(i int64) {
+	if m.adddelta != nil {
+		*m.adddelta += i
 	} else {
-		m.addbeing_block = &i
+		m.adddelta = &i
 	}
 }
 
-// AddedBeingBlock returns the value that was added to the "being_block" field in this mutation.
-func (m *RestoreHistoryMutation) AddedBeingBlock() (r int, exists bool) {
-	v := m.addbeing_block
+// AddedDelta returns the value that was added to the "delta" field in this mutation.
+func (m *BalanceChangeMutation) AddedDelta() (r int64, exists bool) {
+	v := m.adddelta
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetBeingBlock resets all changes to the "being_block" field.
-func (m *RestoreHistoryMutation) ResetBeingBlock() {
-	m.being_block = nil
-	m.addbeing_block = nil
+// ResetDelta resets all changes to the "delta" field.
+func (m *BalanceChangeMutation) ResetDelta() {
+	m.delta = nil
+	m.adddelta = nil
 }
 
-// Where appends a list predicates to the RestoreHistoryMutation builder.
-func (m *RestoreHistoryMutation) Where(ps ...predicate.RestoreHistory) {
-	m.predicates = append(m.predicates, ps...)
+// SetReason sets the "reason" field.
+func (m *BalanceChangeMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// WhereP appends storage-level predicates to the RestoreHistoryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RestoreHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RestoreHistory, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Reason returns the value of the "reason" field in the mutation.
+func (m *BalanceChangeMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *RestoreHistoryMutation) Op() Op {
-	return m.op
+// OldReason returns the old "reason" field's value of the BalanceChange entity.
+// If the BalanceChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceChangeMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RestoreHistoryMutation) SetOp(op Op) {
-	m.op = op
+// ResetReason resets all changes to the "reason" field.
+func (m *BalanceChangeMutation) ResetReason() {
+	m.reason = nil
 }
 
-// Type returns the node type of this mutation (RestoreHistory).
-func (m *RestoreHistoryMutation) Type() string {
-	return m.typ
+// SetHash sets the "hash" field.
+func (m *BalanceChangeMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RestoreHistoryMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.restore_range_start != nil {
-		fields = append(fields, restorehistory.FieldRestoreRangeStart)
-	}
-	if m.restore_range_end != nil {
-		fields = append(fields, restorehistory.FieldRestoreRangeEnd)
+// Hash returns the value of the "hash" field in the mutation.
+func (m *BalanceChangeMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
 	}
-	if m.being_block != nil {
-		fields = append(fields, restorehistory.FieldBeingBlock)
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the BalanceChange entity.
+// If the BalanceChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceChangeMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *BalanceChangeMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetEventIndex sets the "event_index" field.
+func (m *BalanceChangeMutation) SetEventIndex(i int) {
+	m.event_index = &i
+	m.addevent_index = nil
+}
+
+// EventIndex returns the value of the "event_index" field in the mutation.
+func (m *BalanceChangeMutation) EventIndex() (r int, exists bool) {
+	v := m.event_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventIndex returns the old "event_index" field's value of the BalanceChange entity.
+// If the BalanceChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceChangeMutation) OldEventIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventIndex: %w", err)
+	}
+	return oldValue.EventIndex, nil
+}
+
+// AddEventIndex adds i to the "event_index" field.
+func (m *BalanceChangeMutation) AddEventIndex(i int) {
+	if m.addevent_index != nil {
+		*m.addevent_index += i
+	} else {
+		m.addevent_index = &i
+	}
+}
+
+// AddedEventIndex returns the value that was added to the "event_index" field in this mutation.
+func (m *BalanceChangeMutation) AddedEventIndex() (r int, exists bool) {
+	v := m.addevent_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventIndex resets all changes to the "event_index" field.
+func (m *BalanceChangeMutation) ResetEventIndex() {
+	m.event_index = nil
+	m.addevent_index = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BalanceChangeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BalanceChangeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BalanceChange entity.
+// If the BalanceChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceChangeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BalanceChangeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the BalanceChangeMutation builder.
+func (m *BalanceChangeMutation) Where(ps ...predicate.BalanceChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BalanceChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BalanceChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BalanceChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BalanceChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BalanceChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BalanceChange).
+func (m *BalanceChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BalanceChangeMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.address != nil {
+		fields = append(fields, balancechange.FieldAddress)
+	}
+	if m.token != nil {
+		fields = append(fields, balancechange.FieldToken)
+	}
+	if m.block_height != nil {
+		fields = append(fields, balancechange.FieldBlockHeight)
+	}
+	if m.delta != nil {
+		fields = append(fields, balancechange.FieldDelta)
+	}
+	if m.reason != nil {
+		fields = append(fields, balancechange.FieldReason)
+	}
+	if m.hash != nil {
+		fields = append(fields, balancechange.FieldHash)
+	}
+	if m.event_index != nil {
+		fields = append(fields, balancechange.FieldEventIndex)
+	}
+	if m.created_at != nil {
+		fields = append(fields, balancechange.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1684,14 +2054,24 @@ func (m *RestoreHistoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RestoreHistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *BalanceChangeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case restorehistory.FieldRestoreRangeStart:
-		return m.RestoreRangeStart()
-	case restorehistory.FieldRestoreRangeEnd:
-		return m.RestoreRangeEnd()
-	case restorehistory.FieldBeingBlock:
-		return m.BeingBlock()
+	case balancechange.FieldAddress:
+		return m.Address()
+	case balancechange.FieldToken:
+		return m.Token()
+	case balancechange.FieldBlockHeight:
+		return m.BlockHeight()
+	case balancechange.FieldDelta:
+		return m.Delta()
+	case balancechange.FieldReason:
+		return m.Reason()
+	case balancechange.FieldHash:
+		return m.Hash()
+	case balancechange.FieldEventIndex:
+		return m.EventIndex()
+	case balancechange.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1699,60 +2079,105 @@ func (m *RestoreHistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RestoreHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BalanceChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case restorehistory.FieldRestoreRangeStart:
-		return m.OldRestoreRangeStart(ctx)
-	case restorehistory.FieldRestoreRangeEnd:
-		return m.OldRestoreRangeEnd(ctx)
-	case restorehistory.FieldBeingBlock:
-		return m.OldBeingBlock(ctx)
+	case balancechange.FieldAddress:
+		return m.OldAddress(ctx)
+	case balancechange.FieldToken:
+		return m.OldToken(ctx)
+	case balancechange.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case balancechange.FieldDelta:
+		return m.OldDelta(ctx)
+	case balancechange.FieldReason:
+		return m.OldReason(ctx)
+	case balancechange.FieldHash:
+		return m.OldHash(ctx)
+	case balancechange.FieldEventIndex:
+		return m.OldEventIndex(ctx)
+	case balancechange.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RestoreHistory field %s", name)
+	return nil, fmt.Errorf("unknown BalanceChange field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RestoreHistoryMutation) SetField(name string, value ent.Value) error {
+func (m *BalanceChangeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case restorehistory.FieldRestoreRangeStart:
-		v, ok := value.(int)
+	case balancechange.FieldAddress:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRestoreRangeStart(v)
+		m.SetAddress(v)
 		return nil
-	case restorehistory.FieldRestoreRangeEnd:
+	case balancechange.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case balancechange.FieldBlockHeight:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRestoreRangeEnd(v)
+		m.SetBlockHeight(v)
 		return nil
-	case restorehistory.FieldBeingBlock:
+	case balancechange.FieldDelta:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDelta(v)
+		return nil
+	case balancechange.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case balancechange.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case balancechange.FieldEventIndex:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBeingBlock(v)
+		m.SetEventIndex(v)
+		return nil
+	case balancechange.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RestoreHistory field %s", name)
+	return fmt.Errorf("unknown BalanceChange field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RestoreHistoryMutation) AddedFields() []string {
+func (m *BalanceChangeMutation) AddedFields() []string {
 	var fields []string
-	if m.addrestore_range_start != nil {
-		fields = append(fields, restorehistory.FieldRestoreRangeStart)
+	if m.addblock_height != nil {
+		fields = append(fields, balancechange.FieldBlockHeight)
 	}
-	if m.addrestore_range_end != nil {
-		fields = append(fields, restorehistory.FieldRestoreRangeEnd)
+	if m.adddelta != nil {
+		fields = append(fields, balancechange.FieldDelta)
 	}
-	if m.addbeing_block != nil {
-		fields = append(fields, restorehistory.FieldBeingBlock)
+	if m.addevent_index != nil {
+		fields = append(fields, balancechange.FieldEventIndex)
 	}
 	return fields
 }
@@ -1760,14 +2185,14 @@ func (m *RestoreHistoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RestoreHistoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BalanceChangeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case restorehistory.FieldRestoreRangeStart:
-		return m.AddedRestoreRangeStart()
-	case restorehistory.FieldRestoreRangeEnd:
-		return m.AddedRestoreRangeEnd()
-	case restorehistory.FieldBeingBlock:
-		return m.AddedBeingBlock()
+	case balancechange.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case balancechange.FieldDelta:
+		return m.AddedDelta()
+	case balancechange.FieldEventIndex:
+		return m.AddedEventIndex()
 	}
 	return nil, false
 }
@@ -1775,158 +2200,162 @@ func (m *RestoreHistoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RestoreHistoryMutation) AddField(name string, value ent.Value) error {
+func (m *BalanceChangeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case restorehistory.FieldRestoreRangeStart:
+	case balancechange.FieldBlockHeight:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRestoreRangeStart(v)
+		m.AddBlockHeight(v)
 		return nil
-	case restorehistory.FieldRestoreRangeEnd:
-		v, ok := value.(int)
+	case balancechange.FieldDelta:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRestoreRangeEnd(v)
+		m.AddDelta(v)
 		return nil
-	case restorehistory.FieldBeingBlock:
+	case balancechange.FieldEventIndex:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBeingBlock(v)
+		m.AddEventIndex(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RestoreHistory numeric field %s", name)
+	return fmt.Errorf("unknown BalanceChange numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RestoreHistoryMutation) ClearedFields() []string {
+func (m *BalanceChangeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RestoreHistoryMutation) FieldCleared(name string) bool {
+func (m *BalanceChangeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RestoreHistoryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RestoreHistory nullable field %s", name)
+func (m *BalanceChangeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BalanceChange nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RestoreHistoryMutation) ResetField(name string) error {
+func (m *BalanceChangeMutation) ResetField(name string) error {
 	switch name {
-	case restorehistory.FieldRestoreRangeStart:
-		m.ResetRestoreRangeStart()
+	case balancechange.FieldAddress:
+		m.ResetAddress()
 		return nil
-	case restorehistory.FieldRestoreRangeEnd:
-		m.ResetRestoreRangeEnd()
+	case balancechange.FieldToken:
+		m.ResetToken()
 		return nil
-	case restorehistory.FieldBeingBlock:
-		m.ResetBeingBlock()
+	case balancechange.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case balancechange.FieldDelta:
+		m.ResetDelta()
+		return nil
+	case balancechange.FieldReason:
+		m.ResetReason()
+		return nil
+	case balancechange.FieldHash:
+		m.ResetHash()
+		return nil
+	case balancechange.FieldEventIndex:
+		m.ResetEventIndex()
+		return nil
+	case balancechange.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RestoreHistory field %s", name)
+	return fmt.Errorf("unknown BalanceChange field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RestoreHistoryMutation) AddedEdges() []string {
+func (m *BalanceChangeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RestoreHistoryMutation) AddedIDs(name string) []ent.Value {
+func (m *BalanceChangeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RestoreHistoryMutation) RemovedEdges() []string {
+func (m *BalanceChangeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RestoreHistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *BalanceChangeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RestoreHistoryMutation) ClearedEdges() []string {
+func (m *BalanceChangeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RestoreHistoryMutation) EdgeCleared(name string) bool {
+func (m *BalanceChangeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RestoreHistoryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown RestoreHistory unique edge %s", name)
+func (m *BalanceChangeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BalanceChange unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RestoreHistoryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown RestoreHistory edge %s", name)
+func (m *BalanceChangeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BalanceChange edge %s", name)
 }
 
-// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
-type TransactionMutation struct {
+// BalanceCheckpointMutation represents an operation that mutates the BalanceCheckpoint nodes in the graph.
+type BalanceCheckpointMutation struct {
 	config
 	op              Op
 	typ             string
 	id              *int
-	index           *int
-	addindex        *int
-	hash            *string
-	success         *bool
+	address         *string
+	token           *string
 	block_height    *int
 	addblock_height *int
-	gas_wanted      *float64
-	addgas_wanted   *float64
-	gas_used        *float64
-	addgas_used     *float64
-	memo            *string
-	gas_fee         *schema.GasFee
-	messages        *[]schema.Message
-	appendmessages  []schema.Message
-	response        *schema.Response
+	amount          *int64
+	addamount       *int64
 	created_at      *time.Time
 	clearedFields   map[string]struct{}
-	block           *int
-	clearedblock    bool
 	done            bool
-	oldValue        func(context.Context) (*Transaction, error)
-	predicates      []predicate.Transaction
+	oldValue        func(context.Context) (*BalanceCheckpoint, error)
+	predicates      []predicate.BalanceCheckpoint
 }
 
-var _ ent.Mutation = (*TransactionMutation)(nil)
+var _ ent.Mutation = (*BalanceCheckpointMutation)(nil)
 
-// transactionOption allows management of the mutation configuration using functional options.
-type transactionOption func(*TransactionMutation)
+// balancecheckpointOption allows management of the mutation configuration using functional options.
+type balancecheckpointOption func(*BalanceCheckpointMutation)
 
-// newTransactionMutation creates new mutation for the Transaction entity.
-func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
-	m := &TransactionMutation{
+// newBalanceCheckpointMutation creates new mutation for the BalanceCheckpoint entity.
+func newBalanceCheckpointMutation(c config, op Op, opts ...balancecheckpointOption) *BalanceCheckpointMutation {
+	m := &BalanceCheckpointMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTransaction,
+		typ:           TypeBalanceCheckpoint,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1935,20 +2364,20 @@ func newTransactionMutation(c config, op Op, opts ...transactionOption) *Transac
 	return m
 }
 
-// withTransactionID sets the ID field of the mutation.
-func withTransactionID(id int) transactionOption {
-	return func(m *TransactionMutation) {
+// withBalanceCheckpointID sets the ID field of the mutation.
+func withBalanceCheckpointID(id int) balancecheckpointOption {
+	return func(m *BalanceCheckpointMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Transaction
+			value *BalanceCheckpoint
 		)
-		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+		m.oldValue = func(ctx context.Context) (*BalanceCheckpoint, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Transaction.Get(ctx, id)
+					value, err = m.Client().BalanceCheckpoint.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1957,10 +2386,10 @@ func withTransactionID(id int) transactionOption {
 	}
 }
 
-// withTransaction sets the old Transaction of the mutation.
-func withTransaction(node *Transaction) transactionOption {
-	return func(m *TransactionMutation) {
-		m.oldValue = func(context.Context) (*Transaction, error) {
+// withBalanceCheckpoint sets the old BalanceCheckpoint of the mutation.
+func withBalanceCheckpoint(node *BalanceCheckpoint) balancecheckpointOption {
+	return func(m *BalanceCheckpointMutation) {
+		m.oldValue = func(context.Context) (*BalanceCheckpoint, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1969,7 +2398,7 @@ func withTransaction(node *Transaction) transactionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TransactionMutation) Client() *Client {
+func (m BalanceCheckpointMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1977,7 +2406,7 @@ func (m TransactionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TransactionMutation) Tx() (*Tx, error) {
+func (m BalanceCheckpointMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1988,7 +2417,7 @@ func (m TransactionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TransactionMutation) ID() (id int, exists bool) {
+func (m *BalanceCheckpointMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1999,7 +2428,7 @@ func (m *TransactionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BalanceCheckpointMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2008,148 +2437,92 @@ func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BalanceCheckpoint.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetIndex sets the "index" field.
-func (m *TransactionMutation) SetIndex(i int) {
-	m.index = &i
-	m.addindex = nil
-}
-
-// Index returns the value of the "index" field in the mutation.
-func (m *TransactionMutation) Index() (r int, exists bool) {
-	v := m.index
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIndex returns the old "index" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldIndex(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIndex requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
-	}
-	return oldValue.Index, nil
-}
-
-// AddIndex adds i to the "index" field.
-func (m *TransactionMutation) AddIndex(i int) {
-	if m.addindex != nil {
-		*m.addindex += i
-	} else {
-		m.addindex = &i
-	}
-}
-
-// AddedIndex returns the value that was added to the "index" field in this mutation.
-func (m *TransactionMutation) AddedIndex() (r int, exists bool) {
-	v := m.addindex
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetIndex resets all changes to the "index" field.
-func (m *TransactionMutation) ResetIndex() {
-	m.index = nil
-	m.addindex = nil
-}
-
-// SetHash sets the "hash" field.
-func (m *TransactionMutation) SetHash(s string) {
-	m.hash = &s
+// SetAddress sets the "address" field.
+func (m *BalanceCheckpointMutation) SetAddress(s string) {
+	m.address = &s
 }
 
-// Hash returns the value of the "hash" field in the mutation.
-func (m *TransactionMutation) Hash() (r string, exists bool) {
-	v := m.hash
+// Address returns the value of the "address" field in the mutation.
+func (m *BalanceCheckpointMutation) Address() (r string, exists bool) {
+	v := m.address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHash returns the old "hash" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldAddress returns the old "address" field's value of the BalanceCheckpoint entity.
+// If the BalanceCheckpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldHash(ctx context.Context) (v string, err error) {
+func (m *BalanceCheckpointMutation) OldAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHash requires an ID field in the mutation")
+		return v, errors.New("OldAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
 	}
-	return oldValue.Hash, nil
+	return oldValue.Address, nil
 }
 
-// ResetHash resets all changes to the "hash" field.
-func (m *TransactionMutation) ResetHash() {
-	m.hash = nil
+// ResetAddress resets all changes to the "address" field.
+func (m *BalanceCheckpointMutation) ResetAddress() {
+	m.address = nil
 }
 
-// SetSuccess sets the "success" field.
-func (m *TransactionMutation) SetSuccess(b bool) {
-	m.success = &b
+// SetToken sets the "token" field.
+func (m *BalanceCheckpointMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// Success returns the value of the "success" field in the mutation.
-func (m *TransactionMutation) Success() (r bool, exists bool) {
-	v := m.success
+// Token returns the value of the "token" field in the mutation.
+func (m *BalanceCheckpointMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuccess returns the old "success" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the BalanceCheckpoint entity.
+// If the BalanceCheckpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+func (m *BalanceCheckpointMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccess requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Success, nil
+	return oldValue.Token, nil
 }
 
-// ResetSuccess resets all changes to the "success" field.
-func (m *TransactionMutation) ResetSuccess() {
-	m.success = nil
+// ResetToken resets all changes to the "token" field.
+func (m *BalanceCheckpointMutation) ResetToken() {
+	m.token = nil
 }
 
 // SetBlockHeight sets the "block_height" field.
-func (m *TransactionMutation) SetBlockHeight(i int) {
+func (m *BalanceCheckpointMutation) SetBlockHeight(i int) {
 	m.block_height = &i
 	m.addblock_height = nil
 }
 
 // BlockHeight returns the value of the "block_height" field in the mutation.
-func (m *TransactionMutation) BlockHeight() (r int, exists bool) {
+func (m *BalanceCheckpointMutation) BlockHeight() (r int, exists bool) {
 	v := m.block_height
 	if v == nil {
 		return
@@ -2157,10 +2530,10 @@ func (m *TransactionMutation) BlockHeight() (r int, exists bool) {
 	return *v, true
 }
 
-// OldBlockHeight returns the old "block_height" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockHeight returns the old "block_height" field's value of the BalanceCheckpoint entity.
+// If the BalanceCheckpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
+func (m *BalanceCheckpointMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
 	}
@@ -2175,7 +2548,7 @@ func (m *TransactionMutation) OldBlockHeight(ctx context.Context) (v int, err er
 }
 
 // AddBlockHeight adds i to the "block_height" field.
-func (m *TransactionMutation) AddBlockHeight(i int) {
+func (m *BalanceCheckpointMutation) AddBlockHeight(i int) {
 	if m.addblock_height != nil {
 		*m.addblock_height += i
 	} else {
@@ -2184,7 +2557,7 @@ func (m *TransactionMutation) AddBlockHeight(i int) {
 }
 
 // AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
-func (m *TransactionMutation) AddedBlockHeight() (r int, exists bool) {
+func (m *BalanceCheckpointMutation) AddedBlockHeight() (r int, exists bool) {
 	v := m.addblock_height
 	if v == nil {
 		return
@@ -2193,342 +2566,12349 @@ func (m *TransactionMutation) AddedBlockHeight() (r int, exists bool) {
 }
 
 // ResetBlockHeight resets all changes to the "block_height" field.
-func (m *TransactionMutation) ResetBlockHeight() {
+func (m *BalanceCheckpointMutation) ResetBlockHeight() {
 	m.block_height = nil
 	m.addblock_height = nil
 }
 
-// SetGasWanted sets the "gas_wanted" field.
-func (m *TransactionMutation) SetGasWanted(f float64) {
-	m.gas_wanted = &f
-	m.addgas_wanted = nil
+// SetAmount sets the "amount" field.
+func (m *BalanceCheckpointMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
 }
 
-// GasWanted returns the value of the "gas_wanted" field in the mutation.
-func (m *TransactionMutation) GasWanted() (r float64, exists bool) {
-	v := m.gas_wanted
+// Amount returns the value of the "amount" field in the mutation.
+func (m *BalanceCheckpointMutation) Amount() (r int64, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGasWanted returns the old "gas_wanted" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the BalanceCheckpoint entity.
+// If the BalanceCheckpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldGasWanted(ctx context.Context) (v float64, err error) {
+func (m *BalanceCheckpointMutation) OldAmount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGasWanted is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGasWanted requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGasWanted: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.GasWanted, nil
+	return oldValue.Amount, nil
 }
 
-// AddGasWanted adds f to the "gas_wanted" field.
-func (m *TransactionMutation) AddGasWanted(f float64) {
-	if m.addgas_wanted != nil {
-		*m.addgas_wanted += f
+// AddAmount adds i to the "amount" field.
+func (m *BalanceCheckpointMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
 	} else {
-		m.addgas_wanted = &f
+		m.addamount = &i
 	}
 }
 
-// AddedGasWanted returns the value that was added to the "gas_wanted" field in this mutation.
-func (m *TransactionMutation) AddedGasWanted() (r float64, exists bool) {
-	v := m.addgas_wanted
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *BalanceCheckpointMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetGasWanted resets all changes to the "gas_wanted" field.
-func (m *TransactionMutation) ResetGasWanted() {
-	m.gas_wanted = nil
-	m.addgas_wanted = nil
+// ResetAmount resets all changes to the "amount" field.
+func (m *BalanceCheckpointMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
 }
 
-// SetGasUsed sets the "gas_used" field.
-func (m *TransactionMutation) SetGasUsed(f float64) {
-	m.gas_used = &f
-	m.addgas_used = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *BalanceCheckpointMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// GasUsed returns the value of the "gas_used" field in the mutation.
-func (m *TransactionMutation) GasUsed() (r float64, exists bool) {
-	v := m.gas_used
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BalanceCheckpointMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGasUsed returns the old "gas_used" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BalanceCheckpoint entity.
+// If the BalanceCheckpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldGasUsed(ctx context.Context) (v float64, err error) {
+func (m *BalanceCheckpointMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGasUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGasUsed requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGasUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.GasUsed, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddGasUsed adds f to the "gas_used" field.
-func (m *TransactionMutation) AddGasUsed(f float64) {
-	if m.addgas_used != nil {
-		*m.addgas_used += f
-	} else {
-		m.addgas_used = &f
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BalanceCheckpointMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedGasUsed returns the value that was added to the "gas_used" field in this mutation.
-func (m *TransactionMutation) AddedGasUsed() (r float64, exists bool) {
-	v := m.addgas_used
-	if v == nil {
-		return
+// Where appends a list predicates to the BalanceCheckpointMutation builder.
+func (m *BalanceCheckpointMutation) Where(ps ...predicate.BalanceCheckpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BalanceCheckpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BalanceCheckpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BalanceCheckpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetGasUsed resets all changes to the "gas_used" field.
-func (m *TransactionMutation) ResetGasUsed() {
-	m.gas_used = nil
-	m.addgas_used = nil
+// Op returns the operation name.
+func (m *BalanceCheckpointMutation) Op() Op {
+	return m.op
 }
 
-// SetMemo sets the "memo" field.
-func (m *TransactionMutation) SetMemo(s string) {
-	m.memo = &s
+// SetOp allows setting the mutation operation.
+func (m *BalanceCheckpointMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Memo returns the value of the "memo" field in the mutation.
-func (m *TransactionMutation) Memo() (r string, exists bool) {
-	v := m.memo
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (BalanceCheckpoint).
+func (m *BalanceCheckpointMutation) Type() string {
+	return m.typ
 }
 
-// OldMemo returns the old "memo" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldMemo(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BalanceCheckpointMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.address != nil {
+		fields = append(fields, balancecheckpoint.FieldAddress)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMemo requires an ID field in the mutation")
+	if m.token != nil {
+		fields = append(fields, balancecheckpoint.FieldToken)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	if m.block_height != nil {
+		fields = append(fields, balancecheckpoint.FieldBlockHeight)
 	}
-	return oldValue.Memo, nil
-}
-
+	if m.amount != nil {
+		fields = append(fields, balancecheckpoint.FieldAmount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, balancecheckpoint.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BalanceCheckpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case balancecheckpoint.FieldAddress:
+		return m.Address()
+	case balancecheckpoint.FieldToken:
+		return m.Token()
+	case balancecheckpoint.FieldBlockHeight:
+		return m.BlockHeight()
+	case balancecheckpoint.FieldAmount:
+		return m.Amount()
+	case balancecheckpoint.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BalanceCheckpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case balancecheckpoint.FieldAddress:
+		return m.OldAddress(ctx)
+	case balancecheckpoint.FieldToken:
+		return m.OldToken(ctx)
+	case balancecheckpoint.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case balancecheckpoint.FieldAmount:
+		return m.OldAmount(ctx)
+	case balancecheckpoint.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BalanceCheckpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BalanceCheckpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case balancecheckpoint.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case balancecheckpoint.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case balancecheckpoint.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case balancecheckpoint.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case balancecheckpoint.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceCheckpoint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BalanceCheckpointMutation) AddedFields() []string {
+	var fields []string
+	if m.addblock_height != nil {
+		fields = append(fields, balancecheckpoint.FieldBlockHeight)
+	}
+	if m.addamount != nil {
+		fields = append(fields, balancecheckpoint.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BalanceCheckpointMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case balancecheckpoint.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case balancecheckpoint.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BalanceCheckpointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case balancecheckpoint.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	case balancecheckpoint.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceCheckpoint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BalanceCheckpointMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BalanceCheckpointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BalanceCheckpointMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BalanceCheckpoint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BalanceCheckpointMutation) ResetField(name string) error {
+	switch name {
+	case balancecheckpoint.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case balancecheckpoint.FieldToken:
+		m.ResetToken()
+		return nil
+	case balancecheckpoint.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case balancecheckpoint.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case balancecheckpoint.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceCheckpoint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BalanceCheckpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BalanceCheckpointMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BalanceCheckpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BalanceCheckpointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BalanceCheckpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BalanceCheckpointMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BalanceCheckpointMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BalanceCheckpoint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BalanceCheckpointMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BalanceCheckpoint edge %s", name)
+}
+
+// BlockMutation represents an operation that mutates the Block nodes in the graph.
+type BlockMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	hash                *string
+	time                *time.Time
+	total_txs           *int
+	addtotal_txs        *int
+	num_txs             *int
+	addnum_txs          *int
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	transactions        map[int]struct{}
+	removedtransactions map[int]struct{}
+	clearedtransactions bool
+	done                bool
+	oldValue            func(context.Context) (*Block, error)
+	predicates          []predicate.Block
+}
+
+var _ ent.Mutation = (*BlockMutation)(nil)
+
+// blockOption allows management of the mutation configuration using functional options.
+type blockOption func(*BlockMutation)
+
+// newBlockMutation creates new mutation for the Block entity.
+func newBlockMutation(c config, op Op, opts ...blockOption) *BlockMutation {
+	m := &BlockMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBlock,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBlockID sets the ID field of the mutation.
+func withBlockID(id int) blockOption {
+	return func(m *BlockMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Block
+		)
+		m.oldValue = func(ctx context.Context) (*Block, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Block.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBlock sets the old Block of the mutation.
+func withBlock(node *Block) blockOption {
+	return func(m *BlockMutation) {
+		m.oldValue = func(context.Context) (*Block, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BlockMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BlockMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Block entities.
+func (m *BlockMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BlockMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BlockMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Block.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *BlockMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *BlockMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *BlockMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetTime sets the "time" field.
+func (m *BlockMutation) SetTime(t time.Time) {
+	m.time = &t
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *BlockMutation) Time() (r time.Time, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *BlockMutation) ResetTime() {
+	m.time = nil
+}
+
+// SetTotalTxs sets the "total_txs" field.
+func (m *BlockMutation) SetTotalTxs(i int) {
+	m.total_txs = &i
+	m.addtotal_txs = nil
+}
+
+// TotalTxs returns the value of the "total_txs" field in the mutation.
+func (m *BlockMutation) TotalTxs() (r int, exists bool) {
+	v := m.total_txs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTxs returns the old "total_txs" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldTotalTxs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTxs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTxs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTxs: %w", err)
+	}
+	return oldValue.TotalTxs, nil
+}
+
+// AddTotalTxs adds i to the "total_txs" field.
+func (m *BlockMutation) AddTotalTxs(i int) {
+	if m.addtotal_txs != nil {
+		*m.addtotal_txs += i
+	} else {
+		m.addtotal_txs = &i
+	}
+}
+
+// AddedTotalTxs returns the value that was added to the "total_txs" field in this mutation.
+func (m *BlockMutation) AddedTotalTxs() (r int, exists bool) {
+	v := m.addtotal_txs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalTxs resets all changes to the "total_txs" field.
+func (m *BlockMutation) ResetTotalTxs() {
+	m.total_txs = nil
+	m.addtotal_txs = nil
+}
+
+// SetNumTxs sets the "num_txs" field.
+func (m *BlockMutation) SetNumTxs(i int) {
+	m.num_txs = &i
+	m.addnum_txs = nil
+}
+
+// NumTxs returns the value of the "num_txs" field in the mutation.
+func (m *BlockMutation) NumTxs() (r int, exists bool) {
+	v := m.num_txs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumTxs returns the old "num_txs" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldNumTxs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumTxs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumTxs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumTxs: %w", err)
+	}
+	return oldValue.NumTxs, nil
+}
+
+// AddNumTxs adds i to the "num_txs" field.
+func (m *BlockMutation) AddNumTxs(i int) {
+	if m.addnum_txs != nil {
+		*m.addnum_txs += i
+	} else {
+		m.addnum_txs = &i
+	}
+}
+
+// AddedNumTxs returns the value that was added to the "num_txs" field in this mutation.
+func (m *BlockMutation) AddedNumTxs() (r int, exists bool) {
+	v := m.addnum_txs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumTxs resets all changes to the "num_txs" field.
+func (m *BlockMutation) ResetNumTxs() {
+	m.num_txs = nil
+	m.addnum_txs = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BlockMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BlockMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BlockMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *BlockMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *BlockMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *BlockMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *BlockMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *BlockMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *BlockMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *BlockMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// Where appends a list predicates to the BlockMutation builder.
+func (m *BlockMutation) Where(ps ...predicate.Block) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlockMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Block, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BlockMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BlockMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Block).
+func (m *BlockMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlockMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.hash != nil {
+		fields = append(fields, block.FieldHash)
+	}
+	if m.time != nil {
+		fields = append(fields, block.FieldTime)
+	}
+	if m.total_txs != nil {
+		fields = append(fields, block.FieldTotalTxs)
+	}
+	if m.num_txs != nil {
+		fields = append(fields, block.FieldNumTxs)
+	}
+	if m.created_at != nil {
+		fields = append(fields, block.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlockMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case block.FieldHash:
+		return m.Hash()
+	case block.FieldTime:
+		return m.Time()
+	case block.FieldTotalTxs:
+		return m.TotalTxs()
+	case block.FieldNumTxs:
+		return m.NumTxs()
+	case block.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case block.FieldHash:
+		return m.OldHash(ctx)
+	case block.FieldTime:
+		return m.OldTime(ctx)
+	case block.FieldTotalTxs:
+		return m.OldTotalTxs(ctx)
+	case block.FieldNumTxs:
+		return m.OldNumTxs(ctx)
+	case block.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Block field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case block.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case block.FieldTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case block.FieldTotalTxs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTxs(v)
+		return nil
+	case block.FieldNumTxs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumTxs(v)
+		return nil
+	case block.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Block field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlockMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_txs != nil {
+		fields = append(fields, block.FieldTotalTxs)
+	}
+	if m.addnum_txs != nil {
+		fields = append(fields, block.FieldNumTxs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlockMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case block.FieldTotalTxs:
+		return m.AddedTotalTxs()
+	case block.FieldNumTxs:
+		return m.AddedNumTxs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case block.FieldTotalTxs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTxs(v)
+		return nil
+	case block.FieldNumTxs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumTxs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Block numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlockMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlockMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlockMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Block nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlockMutation) ResetField(name string) error {
+	switch name {
+	case block.FieldHash:
+		m.ResetHash()
+		return nil
+	case block.FieldTime:
+		m.ResetTime()
+		return nil
+	case block.FieldTotalTxs:
+		m.ResetTotalTxs()
+		return nil
+	case block.FieldNumTxs:
+		m.ResetNumTxs()
+		return nil
+	case block.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Block field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.transactions != nil {
+		edges = append(edges, block.EdgeTransactions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlockMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case block.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedtransactions != nil {
+		edges = append(edges, block.EdgeTransactions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlockMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case block.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtransactions {
+		edges = append(edges, block.EdgeTransactions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlockMutation) EdgeCleared(name string) bool {
+	switch name {
+	case block.EdgeTransactions:
+		return m.clearedtransactions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlockMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Block unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlockMutation) ResetEdge(name string) error {
+	switch name {
+	case block.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	}
+	return fmt.Errorf("unknown Block edge %s", name)
+}
+
+// GnoEventMutation represents an operation that mutates the GnoEvent nodes in the graph.
+type GnoEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	hash            *string
+	event_index     *int
+	addevent_index  *int
+	block_height    *int
+	addblock_height *int
+	tx_index        *int
+	addtx_index     *int
+	block_time      *time.Time
+	_type           *string
+	_func           *string
+	pkg_path        *string
+	attrs           *[]schema.EventAttr
+	appendattrs     []schema.EventAttr
+	decoded         *bool
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*GnoEvent, error)
+	predicates      []predicate.GnoEvent
+}
+
+var _ ent.Mutation = (*GnoEventMutation)(nil)
+
+// gnoeventOption allows management of the mutation configuration using functional options.
+type gnoeventOption func(*GnoEventMutation)
+
+// newGnoEventMutation creates new mutation for the GnoEvent entity.
+func newGnoEventMutation(c config, op Op, opts ...gnoeventOption) *GnoEventMutation {
+	m := &GnoEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGnoEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGnoEventID sets the ID field of the mutation.
+func withGnoEventID(id int) gnoeventOption {
+	return func(m *GnoEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GnoEvent
+		)
+		m.oldValue = func(ctx context.Context) (*GnoEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GnoEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGnoEvent sets the old GnoEvent of the mutation.
+func withGnoEvent(node *GnoEvent) gnoeventOption {
+	return func(m *GnoEventMutation) {
+		m.oldValue = func(context.Context) (*GnoEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GnoEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GnoEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GnoEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GnoEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GnoEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *GnoEventMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *GnoEventMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *GnoEventMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetEventIndex sets the "event_index" field.
+func (m *GnoEventMutation) SetEventIndex(i int) {
+	m.event_index = &i
+	m.addevent_index = nil
+}
+
+// EventIndex returns the value of the "event_index" field in the mutation.
+func (m *GnoEventMutation) EventIndex() (r int, exists bool) {
+	v := m.event_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventIndex returns the old "event_index" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldEventIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventIndex: %w", err)
+	}
+	return oldValue.EventIndex, nil
+}
+
+// AddEventIndex adds i to the "event_index" field.
+func (m *GnoEventMutation) AddEventIndex(i int) {
+	if m.addevent_index != nil {
+		*m.addevent_index += i
+	} else {
+		m.addevent_index = &i
+	}
+}
+
+// AddedEventIndex returns the value that was added to the "event_index" field in this mutation.
+func (m *GnoEventMutation) AddedEventIndex() (r int, exists bool) {
+	v := m.addevent_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventIndex resets all changes to the "event_index" field.
+func (m *GnoEventMutation) ResetEventIndex() {
+	m.event_index = nil
+	m.addevent_index = nil
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (m *GnoEventMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *GnoEventMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHeight returns the old "block_height" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
+}
+
+// AddBlockHeight adds i to the "block_height" field.
+func (m *GnoEventMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
+	} else {
+		m.addblock_height = &i
+	}
+}
+
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *GnoEventMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *GnoEventMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (m *GnoEventMutation) SetTxIndex(i int) {
+	m.tx_index = &i
+	m.addtx_index = nil
+}
+
+// TxIndex returns the value of the "tx_index" field in the mutation.
+func (m *GnoEventMutation) TxIndex() (r int, exists bool) {
+	v := m.tx_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxIndex returns the old "tx_index" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldTxIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxIndex: %w", err)
+	}
+	return oldValue.TxIndex, nil
+}
+
+// AddTxIndex adds i to the "tx_index" field.
+func (m *GnoEventMutation) AddTxIndex(i int) {
+	if m.addtx_index != nil {
+		*m.addtx_index += i
+	} else {
+		m.addtx_index = &i
+	}
+}
+
+// AddedTxIndex returns the value that was added to the "tx_index" field in this mutation.
+func (m *GnoEventMutation) AddedTxIndex() (r int, exists bool) {
+	v := m.addtx_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTxIndex resets all changes to the "tx_index" field.
+func (m *GnoEventMutation) ResetTxIndex() {
+	m.tx_index = nil
+	m.addtx_index = nil
+}
+
+// SetBlockTime sets the "block_time" field.
+func (m *GnoEventMutation) SetBlockTime(t time.Time) {
+	m.block_time = &t
+}
+
+// BlockTime returns the value of the "block_time" field in the mutation.
+func (m *GnoEventMutation) BlockTime() (r time.Time, exists bool) {
+	v := m.block_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockTime returns the old "block_time" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldBlockTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockTime: %w", err)
+	}
+	return oldValue.BlockTime, nil
+}
+
+// ResetBlockTime resets all changes to the "block_time" field.
+func (m *GnoEventMutation) ResetBlockTime() {
+	m.block_time = nil
+}
+
+// SetType sets the "type" field.
+func (m *GnoEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *GnoEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *GnoEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetFunc sets the "func" field.
+func (m *GnoEventMutation) SetFunc(s string) {
+	m._func = &s
+}
+
+// Func returns the value of the "func" field in the mutation.
+func (m *GnoEventMutation) Func() (r string, exists bool) {
+	v := m._func
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFunc returns the old "func" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldFunc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFunc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFunc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFunc: %w", err)
+	}
+	return oldValue.Func, nil
+}
+
+// ClearFunc clears the value of the "func" field.
+func (m *GnoEventMutation) ClearFunc() {
+	m._func = nil
+	m.clearedFields[gnoevent.FieldFunc] = struct{}{}
+}
+
+// FuncCleared returns if the "func" field was cleared in this mutation.
+func (m *GnoEventMutation) FuncCleared() bool {
+	_, ok := m.clearedFields[gnoevent.FieldFunc]
+	return ok
+}
+
+// ResetFunc resets all changes to the "func" field.
+func (m *GnoEventMutation) ResetFunc() {
+	m._func = nil
+	delete(m.clearedFields, gnoevent.FieldFunc)
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (m *GnoEventMutation) SetPkgPath(s string) {
+	m.pkg_path = &s
+}
+
+// PkgPath returns the value of the "pkg_path" field in the mutation.
+func (m *GnoEventMutation) PkgPath() (r string, exists bool) {
+	v := m.pkg_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPkgPath returns the old "pkg_path" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldPkgPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPkgPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPkgPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPkgPath: %w", err)
+	}
+	return oldValue.PkgPath, nil
+}
+
+// ResetPkgPath resets all changes to the "pkg_path" field.
+func (m *GnoEventMutation) ResetPkgPath() {
+	m.pkg_path = nil
+}
+
+// SetAttrs sets the "attrs" field.
+func (m *GnoEventMutation) SetAttrs(sa []schema.EventAttr) {
+	m.attrs = &sa
+	m.appendattrs = nil
+}
+
+// Attrs returns the value of the "attrs" field in the mutation.
+func (m *GnoEventMutation) Attrs() (r []schema.EventAttr, exists bool) {
+	v := m.attrs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttrs returns the old "attrs" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldAttrs(ctx context.Context) (v []schema.EventAttr, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttrs: %w", err)
+	}
+	return oldValue.Attrs, nil
+}
+
+// AppendAttrs adds sa to the "attrs" field.
+func (m *GnoEventMutation) AppendAttrs(sa []schema.EventAttr) {
+	m.appendattrs = append(m.appendattrs, sa...)
+}
+
+// AppendedAttrs returns the list of values that were appended to the "attrs" field in this mutation.
+func (m *GnoEventMutation) AppendedAttrs() ([]schema.EventAttr, bool) {
+	if len(m.appendattrs) == 0 {
+		return nil, false
+	}
+	return m.appendattrs, true
+}
+
+// ClearAttrs clears the value of the "attrs" field.
+func (m *GnoEventMutation) ClearAttrs() {
+	m.attrs = nil
+	m.appendattrs = nil
+	m.clearedFields[gnoevent.FieldAttrs] = struct{}{}
+}
+
+// AttrsCleared returns if the "attrs" field was cleared in this mutation.
+func (m *GnoEventMutation) AttrsCleared() bool {
+	_, ok := m.clearedFields[gnoevent.FieldAttrs]
+	return ok
+}
+
+// ResetAttrs resets all changes to the "attrs" field.
+func (m *GnoEventMutation) ResetAttrs() {
+	m.attrs = nil
+	m.appendattrs = nil
+	delete(m.clearedFields, gnoevent.FieldAttrs)
+}
+
+// SetDecoded sets the "decoded" field.
+func (m *GnoEventMutation) SetDecoded(b bool) {
+	m.decoded = &b
+}
+
+// Decoded returns the value of the "decoded" field in the mutation.
+func (m *GnoEventMutation) Decoded() (r bool, exists bool) {
+	v := m.decoded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecoded returns the old "decoded" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldDecoded(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecoded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecoded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecoded: %w", err)
+	}
+	return oldValue.Decoded, nil
+}
+
+// ClearDecoded clears the value of the "decoded" field.
+func (m *GnoEventMutation) ClearDecoded() {
+	m.decoded = nil
+	m.clearedFields[gnoevent.FieldDecoded] = struct{}{}
+}
+
+// DecodedCleared returns if the "decoded" field was cleared in this mutation.
+func (m *GnoEventMutation) DecodedCleared() bool {
+	_, ok := m.clearedFields[gnoevent.FieldDecoded]
+	return ok
+}
+
+// ResetDecoded resets all changes to the "decoded" field.
+func (m *GnoEventMutation) ResetDecoded() {
+	m.decoded = nil
+	delete(m.clearedFields, gnoevent.FieldDecoded)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GnoEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GnoEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GnoEvent entity.
+// If the GnoEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GnoEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the GnoEventMutation builder.
+func (m *GnoEventMutation) Where(ps ...predicate.GnoEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GnoEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GnoEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GnoEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GnoEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GnoEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GnoEvent).
+func (m *GnoEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GnoEventMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.hash != nil {
+		fields = append(fields, gnoevent.FieldHash)
+	}
+	if m.event_index != nil {
+		fields = append(fields, gnoevent.FieldEventIndex)
+	}
+	if m.block_height != nil {
+		fields = append(fields, gnoevent.FieldBlockHeight)
+	}
+	if m.tx_index != nil {
+		fields = append(fields, gnoevent.FieldTxIndex)
+	}
+	if m.block_time != nil {
+		fields = append(fields, gnoevent.FieldBlockTime)
+	}
+	if m._type != nil {
+		fields = append(fields, gnoevent.FieldType)
+	}
+	if m._func != nil {
+		fields = append(fields, gnoevent.FieldFunc)
+	}
+	if m.pkg_path != nil {
+		fields = append(fields, gnoevent.FieldPkgPath)
+	}
+	if m.attrs != nil {
+		fields = append(fields, gnoevent.FieldAttrs)
+	}
+	if m.decoded != nil {
+		fields = append(fields, gnoevent.FieldDecoded)
+	}
+	if m.created_at != nil {
+		fields = append(fields, gnoevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GnoEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gnoevent.FieldHash:
+		return m.Hash()
+	case gnoevent.FieldEventIndex:
+		return m.EventIndex()
+	case gnoevent.FieldBlockHeight:
+		return m.BlockHeight()
+	case gnoevent.FieldTxIndex:
+		return m.TxIndex()
+	case gnoevent.FieldBlockTime:
+		return m.BlockTime()
+	case gnoevent.FieldType:
+		return m.GetType()
+	case gnoevent.FieldFunc:
+		return m.Func()
+	case gnoevent.FieldPkgPath:
+		return m.PkgPath()
+	case gnoevent.FieldAttrs:
+		return m.Attrs()
+	case gnoevent.FieldDecoded:
+		return m.Decoded()
+	case gnoevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GnoEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gnoevent.FieldHash:
+		return m.OldHash(ctx)
+	case gnoevent.FieldEventIndex:
+		return m.OldEventIndex(ctx)
+	case gnoevent.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case gnoevent.FieldTxIndex:
+		return m.OldTxIndex(ctx)
+	case gnoevent.FieldBlockTime:
+		return m.OldBlockTime(ctx)
+	case gnoevent.FieldType:
+		return m.OldType(ctx)
+	case gnoevent.FieldFunc:
+		return m.OldFunc(ctx)
+	case gnoevent.FieldPkgPath:
+		return m.OldPkgPath(ctx)
+	case gnoevent.FieldAttrs:
+		return m.OldAttrs(ctx)
+	case gnoevent.FieldDecoded:
+		return m.OldDecoded(ctx)
+	case gnoevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GnoEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GnoEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gnoevent.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case gnoevent.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventIndex(v)
+		return nil
+	case gnoevent.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case gnoevent.FieldTxIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxIndex(v)
+		return nil
+	case gnoevent.FieldBlockTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockTime(v)
+		return nil
+	case gnoevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case gnoevent.FieldFunc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFunc(v)
+		return nil
+	case gnoevent.FieldPkgPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPkgPath(v)
+		return nil
+	case gnoevent.FieldAttrs:
+		v, ok := value.([]schema.EventAttr)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttrs(v)
+		return nil
+	case gnoevent.FieldDecoded:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecoded(v)
+		return nil
+	case gnoevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GnoEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GnoEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addevent_index != nil {
+		fields = append(fields, gnoevent.FieldEventIndex)
+	}
+	if m.addblock_height != nil {
+		fields = append(fields, gnoevent.FieldBlockHeight)
+	}
+	if m.addtx_index != nil {
+		fields = append(fields, gnoevent.FieldTxIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GnoEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gnoevent.FieldEventIndex:
+		return m.AddedEventIndex()
+	case gnoevent.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case gnoevent.FieldTxIndex:
+		return m.AddedTxIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GnoEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gnoevent.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventIndex(v)
+		return nil
+	case gnoevent.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	case gnoevent.FieldTxIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTxIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GnoEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GnoEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gnoevent.FieldFunc) {
+		fields = append(fields, gnoevent.FieldFunc)
+	}
+	if m.FieldCleared(gnoevent.FieldAttrs) {
+		fields = append(fields, gnoevent.FieldAttrs)
+	}
+	if m.FieldCleared(gnoevent.FieldDecoded) {
+		fields = append(fields, gnoevent.FieldDecoded)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GnoEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GnoEventMutation) ClearField(name string) error {
+	switch name {
+	case gnoevent.FieldFunc:
+		m.ClearFunc()
+		return nil
+	case gnoevent.FieldAttrs:
+		m.ClearAttrs()
+		return nil
+	case gnoevent.FieldDecoded:
+		m.ClearDecoded()
+		return nil
+	}
+	return fmt.Errorf("unknown GnoEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GnoEventMutation) ResetField(name string) error {
+	switch name {
+	case gnoevent.FieldHash:
+		m.ResetHash()
+		return nil
+	case gnoevent.FieldEventIndex:
+		m.ResetEventIndex()
+		return nil
+	case gnoevent.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case gnoevent.FieldTxIndex:
+		m.ResetTxIndex()
+		return nil
+	case gnoevent.FieldBlockTime:
+		m.ResetBlockTime()
+		return nil
+	case gnoevent.FieldType:
+		m.ResetType()
+		return nil
+	case gnoevent.FieldFunc:
+		m.ResetFunc()
+		return nil
+	case gnoevent.FieldPkgPath:
+		m.ResetPkgPath()
+		return nil
+	case gnoevent.FieldAttrs:
+		m.ResetAttrs()
+		return nil
+	case gnoevent.FieldDecoded:
+		m.ResetDecoded()
+		return nil
+	case gnoevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GnoEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GnoEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GnoEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GnoEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GnoEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GnoEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GnoEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GnoEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GnoEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GnoEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GnoEvent edge %s", name)
+}
+
+// GnoPackageMutation represents an operation that mutates the GnoPackage nodes in the graph.
+type GnoPackageMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	name            *string
+	namespace       *string
+	creator         *string
+	deposit         *string
+	block_height    *int
+	addblock_height *int
+	hash            *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	files           map[int]struct{}
+	removedfiles    map[int]struct{}
+	clearedfiles    bool
+	done            bool
+	oldValue        func(context.Context) (*GnoPackage, error)
+	predicates      []predicate.GnoPackage
+}
+
+var _ ent.Mutation = (*GnoPackageMutation)(nil)
+
+// gnopackageOption allows management of the mutation configuration using functional options.
+type gnopackageOption func(*GnoPackageMutation)
+
+// newGnoPackageMutation creates new mutation for the GnoPackage entity.
+func newGnoPackageMutation(c config, op Op, opts ...gnopackageOption) *GnoPackageMutation {
+	m := &GnoPackageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGnoPackage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGnoPackageID sets the ID field of the mutation.
+func withGnoPackageID(id string) gnopackageOption {
+	return func(m *GnoPackageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GnoPackage
+		)
+		m.oldValue = func(ctx context.Context) (*GnoPackage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GnoPackage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGnoPackage sets the old GnoPackage of the mutation.
+func withGnoPackage(node *GnoPackage) gnopackageOption {
+	return func(m *GnoPackageMutation) {
+		m.oldValue = func(context.Context) (*GnoPackage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GnoPackageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GnoPackageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GnoPackage entities.
+func (m *GnoPackageMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GnoPackageMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GnoPackageMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GnoPackage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *GnoPackageMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GnoPackageMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the GnoPackage entity.
+// If the GnoPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GnoPackageMutation) ResetName() {
+	m.name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *GnoPackageMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *GnoPackageMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the GnoPackage entity.
+// If the GnoPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (m *GnoPackageMutation) ClearNamespace() {
+	m.namespace = nil
+	m.clearedFields[gnopackage.FieldNamespace] = struct{}{}
+}
+
+// NamespaceCleared returns if the "namespace" field was cleared in this mutation.
+func (m *GnoPackageMutation) NamespaceCleared() bool {
+	_, ok := m.clearedFields[gnopackage.FieldNamespace]
+	return ok
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *GnoPackageMutation) ResetNamespace() {
+	m.namespace = nil
+	delete(m.clearedFields, gnopackage.FieldNamespace)
+}
+
+// SetCreator sets the "creator" field.
+func (m *GnoPackageMutation) SetCreator(s string) {
+	m.creator = &s
+}
+
+// Creator returns the value of the "creator" field in the mutation.
+func (m *GnoPackageMutation) Creator() (r string, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreator returns the old "creator" field's value of the GnoPackage entity.
+// If the GnoPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageMutation) OldCreator(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreator is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreator requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreator: %w", err)
+	}
+	return oldValue.Creator, nil
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (m *GnoPackageMutation) ClearCreator() {
+	m.creator = nil
+	m.clearedFields[gnopackage.FieldCreator] = struct{}{}
+}
+
+// CreatorCleared returns if the "creator" field was cleared in this mutation.
+func (m *GnoPackageMutation) CreatorCleared() bool {
+	_, ok := m.clearedFields[gnopackage.FieldCreator]
+	return ok
+}
+
+// ResetCreator resets all changes to the "creator" field.
+func (m *GnoPackageMutation) ResetCreator() {
+	m.creator = nil
+	delete(m.clearedFields, gnopackage.FieldCreator)
+}
+
+// SetDeposit sets the "deposit" field.
+func (m *GnoPackageMutation) SetDeposit(s string) {
+	m.deposit = &s
+}
+
+// Deposit returns the value of the "deposit" field in the mutation.
+func (m *GnoPackageMutation) Deposit() (r string, exists bool) {
+	v := m.deposit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeposit returns the old "deposit" field's value of the GnoPackage entity.
+// If the GnoPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageMutation) OldDeposit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeposit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeposit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeposit: %w", err)
+	}
+	return oldValue.Deposit, nil
+}
+
+// ClearDeposit clears the value of the "deposit" field.
+func (m *GnoPackageMutation) ClearDeposit() {
+	m.deposit = nil
+	m.clearedFields[gnopackage.FieldDeposit] = struct{}{}
+}
+
+// DepositCleared returns if the "deposit" field was cleared in this mutation.
+func (m *GnoPackageMutation) DepositCleared() bool {
+	_, ok := m.clearedFields[gnopackage.FieldDeposit]
+	return ok
+}
+
+// ResetDeposit resets all changes to the "deposit" field.
+func (m *GnoPackageMutation) ResetDeposit() {
+	m.deposit = nil
+	delete(m.clearedFields, gnopackage.FieldDeposit)
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (m *GnoPackageMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *GnoPackageMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHeight returns the old "block_height" field's value of the GnoPackage entity.
+// If the GnoPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
+}
+
+// AddBlockHeight adds i to the "block_height" field.
+func (m *GnoPackageMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
+	} else {
+		m.addblock_height = &i
+	}
+}
+
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *GnoPackageMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *GnoPackageMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *GnoPackageMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *GnoPackageMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the GnoPackage entity.
+// If the GnoPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *GnoPackageMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GnoPackageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GnoPackageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GnoPackage entity.
+// If the GnoPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GnoPackageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddFileIDs adds the "files" edge to the GnoPackageFile entity by ids.
+func (m *GnoPackageMutation) AddFileIDs(ids ...int) {
+	if m.files == nil {
+		m.files = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the GnoPackageFile entity.
+func (m *GnoPackageMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the GnoPackageFile entity was cleared.
+func (m *GnoPackageMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the GnoPackageFile entity by IDs.
+func (m *GnoPackageMutation) RemoveFileIDs(ids ...int) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the GnoPackageFile entity.
+func (m *GnoPackageMutation) RemovedFilesIDs() (ids []int) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *GnoPackageMutation) FilesIDs() (ids []int) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *GnoPackageMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// Where appends a list predicates to the GnoPackageMutation builder.
+func (m *GnoPackageMutation) Where(ps ...predicate.GnoPackage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GnoPackageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GnoPackageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GnoPackage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GnoPackageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GnoPackageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GnoPackage).
+func (m *GnoPackageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GnoPackageMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, gnopackage.FieldName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, gnopackage.FieldNamespace)
+	}
+	if m.creator != nil {
+		fields = append(fields, gnopackage.FieldCreator)
+	}
+	if m.deposit != nil {
+		fields = append(fields, gnopackage.FieldDeposit)
+	}
+	if m.block_height != nil {
+		fields = append(fields, gnopackage.FieldBlockHeight)
+	}
+	if m.hash != nil {
+		fields = append(fields, gnopackage.FieldHash)
+	}
+	if m.created_at != nil {
+		fields = append(fields, gnopackage.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GnoPackageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gnopackage.FieldName:
+		return m.Name()
+	case gnopackage.FieldNamespace:
+		return m.Namespace()
+	case gnopackage.FieldCreator:
+		return m.Creator()
+	case gnopackage.FieldDeposit:
+		return m.Deposit()
+	case gnopackage.FieldBlockHeight:
+		return m.BlockHeight()
+	case gnopackage.FieldHash:
+		return m.Hash()
+	case gnopackage.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GnoPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gnopackage.FieldName:
+		return m.OldName(ctx)
+	case gnopackage.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case gnopackage.FieldCreator:
+		return m.OldCreator(ctx)
+	case gnopackage.FieldDeposit:
+		return m.OldDeposit(ctx)
+	case gnopackage.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case gnopackage.FieldHash:
+		return m.OldHash(ctx)
+	case gnopackage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GnoPackage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GnoPackageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gnopackage.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case gnopackage.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case gnopackage.FieldCreator:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreator(v)
+		return nil
+	case gnopackage.FieldDeposit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeposit(v)
+		return nil
+	case gnopackage.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case gnopackage.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case gnopackage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GnoPackageMutation) AddedFields() []string {
+	var fields []string
+	if m.addblock_height != nil {
+		fields = append(fields, gnopackage.FieldBlockHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GnoPackageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gnopackage.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GnoPackageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gnopackage.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GnoPackageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gnopackage.FieldNamespace) {
+		fields = append(fields, gnopackage.FieldNamespace)
+	}
+	if m.FieldCleared(gnopackage.FieldCreator) {
+		fields = append(fields, gnopackage.FieldCreator)
+	}
+	if m.FieldCleared(gnopackage.FieldDeposit) {
+		fields = append(fields, gnopackage.FieldDeposit)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GnoPackageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GnoPackageMutation) ClearField(name string) error {
+	switch name {
+	case gnopackage.FieldNamespace:
+		m.ClearNamespace()
+		return nil
+	case gnopackage.FieldCreator:
+		m.ClearCreator()
+		return nil
+	case gnopackage.FieldDeposit:
+		m.ClearDeposit()
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GnoPackageMutation) ResetField(name string) error {
+	switch name {
+	case gnopackage.FieldName:
+		m.ResetName()
+		return nil
+	case gnopackage.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case gnopackage.FieldCreator:
+		m.ResetCreator()
+		return nil
+	case gnopackage.FieldDeposit:
+		m.ResetDeposit()
+		return nil
+	case gnopackage.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case gnopackage.FieldHash:
+		m.ResetHash()
+		return nil
+	case gnopackage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GnoPackageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.files != nil {
+		edges = append(edges, gnopackage.EdgeFiles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GnoPackageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gnopackage.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GnoPackageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedfiles != nil {
+		edges = append(edges, gnopackage.EdgeFiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GnoPackageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case gnopackage.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GnoPackageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfiles {
+		edges = append(edges, gnopackage.EdgeFiles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GnoPackageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gnopackage.EdgeFiles:
+		return m.clearedfiles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GnoPackageMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GnoPackage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GnoPackageMutation) ResetEdge(name string) error {
+	switch name {
+	case gnopackage.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackage edge %s", name)
+}
+
+// GnoPackageFileMutation represents an operation that mutates the GnoPackageFile nodes in the graph.
+type GnoPackageFileMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	body            *string
+	clearedFields   map[string]struct{}
+	_package        *string
+	cleared_package bool
+	done            bool
+	oldValue        func(context.Context) (*GnoPackageFile, error)
+	predicates      []predicate.GnoPackageFile
+}
+
+var _ ent.Mutation = (*GnoPackageFileMutation)(nil)
+
+// gnopackagefileOption allows management of the mutation configuration using functional options.
+type gnopackagefileOption func(*GnoPackageFileMutation)
+
+// newGnoPackageFileMutation creates new mutation for the GnoPackageFile entity.
+func newGnoPackageFileMutation(c config, op Op, opts ...gnopackagefileOption) *GnoPackageFileMutation {
+	m := &GnoPackageFileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGnoPackageFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGnoPackageFileID sets the ID field of the mutation.
+func withGnoPackageFileID(id int) gnopackagefileOption {
+	return func(m *GnoPackageFileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GnoPackageFile
+		)
+		m.oldValue = func(ctx context.Context) (*GnoPackageFile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GnoPackageFile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGnoPackageFile sets the old GnoPackageFile of the mutation.
+func withGnoPackageFile(node *GnoPackageFile) gnopackagefileOption {
+	return func(m *GnoPackageFileMutation) {
+		m.oldValue = func(context.Context) (*GnoPackageFile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GnoPackageFileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GnoPackageFileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GnoPackageFileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GnoPackageFileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GnoPackageFile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *GnoPackageFileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GnoPackageFileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the GnoPackageFile entity.
+// If the GnoPackageFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageFileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GnoPackageFileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetBody sets the "body" field.
+func (m *GnoPackageFileMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *GnoPackageFileMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the GnoPackageFile entity.
+// If the GnoPackageFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GnoPackageFileMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *GnoPackageFileMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetPackageID sets the "package" edge to the GnoPackage entity by id.
+func (m *GnoPackageFileMutation) SetPackageID(id string) {
+	m._package = &id
+}
+
+// ClearPackage clears the "package" edge to the GnoPackage entity.
+func (m *GnoPackageFileMutation) ClearPackage() {
+	m.cleared_package = true
+}
+
+// PackageCleared reports if the "package" edge to the GnoPackage entity was cleared.
+func (m *GnoPackageFileMutation) PackageCleared() bool {
+	return m.cleared_package
+}
+
+// PackageID returns the "package" edge ID in the mutation.
+func (m *GnoPackageFileMutation) PackageID() (id string, exists bool) {
+	if m._package != nil {
+		return *m._package, true
+	}
+	return
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *GnoPackageFileMutation) PackageIDs() (ids []string) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *GnoPackageFileMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// Where appends a list predicates to the GnoPackageFileMutation builder.
+func (m *GnoPackageFileMutation) Where(ps ...predicate.GnoPackageFile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GnoPackageFileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GnoPackageFileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GnoPackageFile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GnoPackageFileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GnoPackageFileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GnoPackageFile).
+func (m *GnoPackageFileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GnoPackageFileMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, gnopackagefile.FieldName)
+	}
+	if m.body != nil {
+		fields = append(fields, gnopackagefile.FieldBody)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GnoPackageFileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gnopackagefile.FieldName:
+		return m.Name()
+	case gnopackagefile.FieldBody:
+		return m.Body()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GnoPackageFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gnopackagefile.FieldName:
+		return m.OldName(ctx)
+	case gnopackagefile.FieldBody:
+		return m.OldBody(ctx)
+	}
+	return nil, fmt.Errorf("unknown GnoPackageFile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GnoPackageFileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gnopackagefile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case gnopackagefile.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackageFile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GnoPackageFileMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GnoPackageFileMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GnoPackageFileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GnoPackageFile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GnoPackageFileMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GnoPackageFileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GnoPackageFileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GnoPackageFile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GnoPackageFileMutation) ResetField(name string) error {
+	switch name {
+	case gnopackagefile.FieldName:
+		m.ResetName()
+		return nil
+	case gnopackagefile.FieldBody:
+		m.ResetBody()
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackageFile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GnoPackageFileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._package != nil {
+		edges = append(edges, gnopackagefile.EdgePackage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GnoPackageFileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gnopackagefile.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GnoPackageFileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GnoPackageFileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GnoPackageFileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_package {
+		edges = append(edges, gnopackagefile.EdgePackage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GnoPackageFileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gnopackagefile.EdgePackage:
+		return m.cleared_package
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GnoPackageFileMutation) ClearEdge(name string) error {
+	switch name {
+	case gnopackagefile.EdgePackage:
+		m.ClearPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackageFile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GnoPackageFileMutation) ResetEdge(name string) error {
+	switch name {
+	case gnopackagefile.EdgePackage:
+		m.ResetPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown GnoPackageFile edge %s", name)
+}
+
+// HoldingMutation represents an operation that mutates the Holding nodes in the graph.
+type HoldingMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	token                 *string
+	amount                *float64
+	addamount             *float64
+	first_seen_height     *int
+	addfirst_seen_height  *int
+	last_active_height    *int
+	addlast_active_height *int
+	tx_count              *int
+	addtx_count           *int
+	last_tx_hash          *string
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	account               *string
+	clearedaccount        bool
+	done                  bool
+	oldValue              func(context.Context) (*Holding, error)
+	predicates            []predicate.Holding
+}
+
+var _ ent.Mutation = (*HoldingMutation)(nil)
+
+// holdingOption allows management of the mutation configuration using functional options.
+type holdingOption func(*HoldingMutation)
+
+// newHoldingMutation creates new mutation for the Holding entity.
+func newHoldingMutation(c config, op Op, opts ...holdingOption) *HoldingMutation {
+	m := &HoldingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHolding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHoldingID sets the ID field of the mutation.
+func withHoldingID(id int) holdingOption {
+	return func(m *HoldingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Holding
+		)
+		m.oldValue = func(ctx context.Context) (*Holding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Holding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHolding sets the old Holding of the mutation.
+func withHolding(node *Holding) holdingOption {
+	return func(m *HoldingMutation) {
+		m.oldValue = func(context.Context) (*Holding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HoldingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HoldingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HoldingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HoldingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Holding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAddress sets the "address" field.
+func (m *HoldingMutation) SetAddress(s string) {
+	m.account = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *HoldingMutation) Address() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the Holding entity.
+// If the Holding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldingMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *HoldingMutation) ResetAddress() {
+	m.account = nil
+}
+
+// SetToken sets the "token" field.
+func (m *HoldingMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *HoldingMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Holding entity.
+// If the Holding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldingMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *HoldingMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *HoldingMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *HoldingMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Holding entity.
+// If the Holding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldingMutation) OldAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *HoldingMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *HoldingMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *HoldingMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (m *HoldingMutation) SetFirstSeenHeight(i int) {
+	m.first_seen_height = &i
+	m.addfirst_seen_height = nil
+}
+
+// FirstSeenHeight returns the value of the "first_seen_height" field in the mutation.
+func (m *HoldingMutation) FirstSeenHeight() (r int, exists bool) {
+	v := m.first_seen_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstSeenHeight returns the old "first_seen_height" field's value of the Holding entity.
+// If the Holding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldingMutation) OldFirstSeenHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstSeenHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstSeenHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstSeenHeight: %w", err)
+	}
+	return oldValue.FirstSeenHeight, nil
+}
+
+// AddFirstSeenHeight adds i to the "first_seen_height" field.
+func (m *HoldingMutation) AddFirstSeenHeight(i int) {
+	if m.addfirst_seen_height != nil {
+		*m.addfirst_seen_height += i
+	} else {
+		m.addfirst_seen_height = &i
+	}
+}
+
+// AddedFirstSeenHeight returns the value that was added to the "first_seen_height" field in this mutation.
+func (m *HoldingMutation) AddedFirstSeenHeight() (r int, exists bool) {
+	v := m.addfirst_seen_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFirstSeenHeight resets all changes to the "first_seen_height" field.
+func (m *HoldingMutation) ResetFirstSeenHeight() {
+	m.first_seen_height = nil
+	m.addfirst_seen_height = nil
+}
+
+// SetLastActiveHeight sets the "last_active_height" field.
+func (m *HoldingMutation) SetLastActiveHeight(i int) {
+	m.last_active_height = &i
+	m.addlast_active_height = nil
+}
+
+// LastActiveHeight returns the value of the "last_active_height" field in the mutation.
+func (m *HoldingMutation) LastActiveHeight() (r int, exists bool) {
+	v := m.last_active_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastActiveHeight returns the old "last_active_height" field's value of the Holding entity.
+// If the Holding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldingMutation) OldLastActiveHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastActiveHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastActiveHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastActiveHeight: %w", err)
+	}
+	return oldValue.LastActiveHeight, nil
+}
+
+// AddLastActiveHeight adds i to the "last_active_height" field.
+func (m *HoldingMutation) AddLastActiveHeight(i int) {
+	if m.addlast_active_height != nil {
+		*m.addlast_active_height += i
+	} else {
+		m.addlast_active_height = &i
+	}
+}
+
+// AddedLastActiveHeight returns the value that was added to the "last_active_height" field in this mutation.
+func (m *HoldingMutation) AddedLastActiveHeight() (r int, exists bool) {
+	v := m.addlast_active_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastActiveHeight resets all changes to the "last_active_height" field.
+func (m *HoldingMutation) ResetLastActiveHeight() {
+	m.last_active_height = nil
+	m.addlast_active_height = nil
+}
+
+// SetTxCount sets the "tx_count" field.
+func (m *HoldingMutation) SetTxCount(i int) {
+	m.tx_count = &i
+	m.addtx_count = nil
+}
+
+// TxCount returns the value of the "tx_count" field in the mutation.
+func (m *HoldingMutation) TxCount() (r int, exists bool) {
+	v := m.tx_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxCount returns the old "tx_count" field's value of the Holding entity.
+// If the Holding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldingMutation) OldTxCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxCount: %w", err)
+	}
+	return oldValue.TxCount, nil
+}
+
+// AddTxCount adds i to the "tx_count" field.
+func (m *HoldingMutation) AddTxCount(i int) {
+	if m.addtx_count != nil {
+		*m.addtx_count += i
+	} else {
+		m.addtx_count = &i
+	}
+}
+
+// AddedTxCount returns the value that was added to the "tx_count" field in this mutation.
+func (m *HoldingMutation) AddedTxCount() (r int, exists bool) {
+	v := m.addtx_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTxCount resets all changes to the "tx_count" field.
+func (m *HoldingMutation) ResetTxCount() {
+	m.tx_count = nil
+	m.addtx_count = nil
+}
+
+// SetLastTxHash sets the "last_tx_hash" field.
+func (m *HoldingMutation) SetLastTxHash(s string) {
+	m.last_tx_hash = &s
+}
+
+// LastTxHash returns the value of the "last_tx_hash" field in the mutation.
+func (m *HoldingMutation) LastTxHash() (r string, exists bool) {
+	v := m.last_tx_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastTxHash returns the old "last_tx_hash" field's value of the Holding entity.
+// If the Holding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldingMutation) OldLastTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTxHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTxHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTxHash: %w", err)
+	}
+	return oldValue.LastTxHash, nil
+}
+
+// ClearLastTxHash clears the value of the "last_tx_hash" field.
+func (m *HoldingMutation) ClearLastTxHash() {
+	m.last_tx_hash = nil
+	m.clearedFields[holding.FieldLastTxHash] = struct{}{}
+}
+
+// LastTxHashCleared returns if the "last_tx_hash" field was cleared in this mutation.
+func (m *HoldingMutation) LastTxHashCleared() bool {
+	_, ok := m.clearedFields[holding.FieldLastTxHash]
+	return ok
+}
+
+// ResetLastTxHash resets all changes to the "last_tx_hash" field.
+func (m *HoldingMutation) ResetLastTxHash() {
+	m.last_tx_hash = nil
+	delete(m.clearedFields, holding.FieldLastTxHash)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HoldingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HoldingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Holding entity.
+// If the Holding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HoldingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetAccountID sets the "account" edge to the Account entity by id.
+func (m *HoldingMutation) SetAccountID(id string) {
+	m.account = &id
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *HoldingMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[holding.FieldAddress] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *HoldingMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountID returns the "account" edge ID in the mutation.
+func (m *HoldingMutation) AccountID() (id string, exists bool) {
+	if m.account != nil {
+		return *m.account, true
+	}
+	return
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *HoldingMutation) AccountIDs() (ids []string) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *HoldingMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// Where appends a list predicates to the HoldingMutation builder.
+func (m *HoldingMutation) Where(ps ...predicate.Holding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HoldingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HoldingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Holding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HoldingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HoldingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Holding).
+func (m *HoldingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HoldingMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.account != nil {
+		fields = append(fields, holding.FieldAddress)
+	}
+	if m.token != nil {
+		fields = append(fields, holding.FieldToken)
+	}
+	if m.amount != nil {
+		fields = append(fields, holding.FieldAmount)
+	}
+	if m.first_seen_height != nil {
+		fields = append(fields, holding.FieldFirstSeenHeight)
+	}
+	if m.last_active_height != nil {
+		fields = append(fields, holding.FieldLastActiveHeight)
+	}
+	if m.tx_count != nil {
+		fields = append(fields, holding.FieldTxCount)
+	}
+	if m.last_tx_hash != nil {
+		fields = append(fields, holding.FieldLastTxHash)
+	}
+	if m.created_at != nil {
+		fields = append(fields, holding.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HoldingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case holding.FieldAddress:
+		return m.Address()
+	case holding.FieldToken:
+		return m.Token()
+	case holding.FieldAmount:
+		return m.Amount()
+	case holding.FieldFirstSeenHeight:
+		return m.FirstSeenHeight()
+	case holding.FieldLastActiveHeight:
+		return m.LastActiveHeight()
+	case holding.FieldTxCount:
+		return m.TxCount()
+	case holding.FieldLastTxHash:
+		return m.LastTxHash()
+	case holding.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HoldingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case holding.FieldAddress:
+		return m.OldAddress(ctx)
+	case holding.FieldToken:
+		return m.OldToken(ctx)
+	case holding.FieldAmount:
+		return m.OldAmount(ctx)
+	case holding.FieldFirstSeenHeight:
+		return m.OldFirstSeenHeight(ctx)
+	case holding.FieldLastActiveHeight:
+		return m.OldLastActiveHeight(ctx)
+	case holding.FieldTxCount:
+		return m.OldTxCount(ctx)
+	case holding.FieldLastTxHash:
+		return m.OldLastTxHash(ctx)
+	case holding.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Holding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HoldingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case holding.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case holding.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case holding.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case holding.FieldFirstSeenHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstSeenHeight(v)
+		return nil
+	case holding.FieldLastActiveHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastActiveHeight(v)
+		return nil
+	case holding.FieldTxCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxCount(v)
+		return nil
+	case holding.FieldLastTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTxHash(v)
+		return nil
+	case holding.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Holding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HoldingMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, holding.FieldAmount)
+	}
+	if m.addfirst_seen_height != nil {
+		fields = append(fields, holding.FieldFirstSeenHeight)
+	}
+	if m.addlast_active_height != nil {
+		fields = append(fields, holding.FieldLastActiveHeight)
+	}
+	if m.addtx_count != nil {
+		fields = append(fields, holding.FieldTxCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HoldingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case holding.FieldAmount:
+		return m.AddedAmount()
+	case holding.FieldFirstSeenHeight:
+		return m.AddedFirstSeenHeight()
+	case holding.FieldLastActiveHeight:
+		return m.AddedLastActiveHeight()
+	case holding.FieldTxCount:
+		return m.AddedTxCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HoldingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case holding.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case holding.FieldFirstSeenHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstSeenHeight(v)
+		return nil
+	case holding.FieldLastActiveHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastActiveHeight(v)
+		return nil
+	case holding.FieldTxCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTxCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Holding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HoldingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(holding.FieldLastTxHash) {
+		fields = append(fields, holding.FieldLastTxHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HoldingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HoldingMutation) ClearField(name string) error {
+	switch name {
+	case holding.FieldLastTxHash:
+		m.ClearLastTxHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Holding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HoldingMutation) ResetField(name string) error {
+	switch name {
+	case holding.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case holding.FieldToken:
+		m.ResetToken()
+		return nil
+	case holding.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case holding.FieldFirstSeenHeight:
+		m.ResetFirstSeenHeight()
+		return nil
+	case holding.FieldLastActiveHeight:
+		m.ResetLastActiveHeight()
+		return nil
+	case holding.FieldTxCount:
+		m.ResetTxCount()
+		return nil
+	case holding.FieldLastTxHash:
+		m.ResetLastTxHash()
+		return nil
+	case holding.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Holding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HoldingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.account != nil {
+		edges = append(edges, holding.EdgeAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HoldingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case holding.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HoldingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HoldingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HoldingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedaccount {
+		edges = append(edges, holding.EdgeAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HoldingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case holding.EdgeAccount:
+		return m.clearedaccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HoldingMutation) ClearEdge(name string) error {
+	switch name {
+	case holding.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown Holding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HoldingMutation) ResetEdge(name string) error {
+	switch name {
+	case holding.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown Holding edge %s", name)
+}
+
+// NftMutation represents an operation that mutates the Nft nodes in the graph.
+type NftMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	collection       *string
+	token_id         *string
+	owner            *string
+	burned           *bool
+	minted_height    *int
+	addminted_height *int
+	last_height      *int
+	addlast_height   *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Nft, error)
+	predicates       []predicate.Nft
+}
+
+var _ ent.Mutation = (*NftMutation)(nil)
+
+// nftOption allows management of the mutation configuration using functional options.
+type nftOption func(*NftMutation)
+
+// newNftMutation creates new mutation for the Nft entity.
+func newNftMutation(c config, op Op, opts ...nftOption) *NftMutation {
+	m := &NftMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNft,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNftID sets the ID field of the mutation.
+func withNftID(id int) nftOption {
+	return func(m *NftMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Nft
+		)
+		m.oldValue = func(ctx context.Context) (*Nft, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Nft.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNft sets the old Nft of the mutation.
+func withNft(node *Nft) nftOption {
+	return func(m *NftMutation) {
+		m.oldValue = func(context.Context) (*Nft, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NftMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NftMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NftMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NftMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Nft.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollection sets the "collection" field.
+func (m *NftMutation) SetCollection(s string) {
+	m.collection = &s
+}
+
+// Collection returns the value of the "collection" field in the mutation.
+func (m *NftMutation) Collection() (r string, exists bool) {
+	v := m.collection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollection returns the old "collection" field's value of the Nft entity.
+// If the Nft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftMutation) OldCollection(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollection: %w", err)
+	}
+	return oldValue.Collection, nil
+}
+
+// ResetCollection resets all changes to the "collection" field.
+func (m *NftMutation) ResetCollection() {
+	m.collection = nil
+}
+
+// SetTokenID sets the "token_id" field.
+func (m *NftMutation) SetTokenID(s string) {
+	m.token_id = &s
+}
+
+// TokenID returns the value of the "token_id" field in the mutation.
+func (m *NftMutation) TokenID() (r string, exists bool) {
+	v := m.token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenID returns the old "token_id" field's value of the Nft entity.
+// If the Nft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftMutation) OldTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenID: %w", err)
+	}
+	return oldValue.TokenID, nil
+}
+
+// ResetTokenID resets all changes to the "token_id" field.
+func (m *NftMutation) ResetTokenID() {
+	m.token_id = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *NftMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *NftMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Nft entity.
+// If the Nft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *NftMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[nft.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *NftMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[nft.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *NftMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, nft.FieldOwner)
+}
+
+// SetBurned sets the "burned" field.
+func (m *NftMutation) SetBurned(b bool) {
+	m.burned = &b
+}
+
+// Burned returns the value of the "burned" field in the mutation.
+func (m *NftMutation) Burned() (r bool, exists bool) {
+	v := m.burned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurned returns the old "burned" field's value of the Nft entity.
+// If the Nft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftMutation) OldBurned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurned: %w", err)
+	}
+	return oldValue.Burned, nil
+}
+
+// ResetBurned resets all changes to the "burned" field.
+func (m *NftMutation) ResetBurned() {
+	m.burned = nil
+}
+
+// SetMintedHeight sets the "minted_height" field.
+func (m *NftMutation) SetMintedHeight(i int) {
+	m.minted_height = &i
+	m.addminted_height = nil
+}
+
+// MintedHeight returns the value of the "minted_height" field in the mutation.
+func (m *NftMutation) MintedHeight() (r int, exists bool) {
+	v := m.minted_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMintedHeight returns the old "minted_height" field's value of the Nft entity.
+// If the Nft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftMutation) OldMintedHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMintedHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMintedHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMintedHeight: %w", err)
+	}
+	return oldValue.MintedHeight, nil
+}
+
+// AddMintedHeight adds i to the "minted_height" field.
+func (m *NftMutation) AddMintedHeight(i int) {
+	if m.addminted_height != nil {
+		*m.addminted_height += i
+	} else {
+		m.addminted_height = &i
+	}
+}
+
+// AddedMintedHeight returns the value that was added to the "minted_height" field in this mutation.
+func (m *NftMutation) AddedMintedHeight() (r int, exists bool) {
+	v := m.addminted_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMintedHeight clears the value of the "minted_height" field.
+func (m *NftMutation) ClearMintedHeight() {
+	m.minted_height = nil
+	m.addminted_height = nil
+	m.clearedFields[nft.FieldMintedHeight] = struct{}{}
+}
+
+// MintedHeightCleared returns if the "minted_height" field was cleared in this mutation.
+func (m *NftMutation) MintedHeightCleared() bool {
+	_, ok := m.clearedFields[nft.FieldMintedHeight]
+	return ok
+}
+
+// ResetMintedHeight resets all changes to the "minted_height" field.
+func (m *NftMutation) ResetMintedHeight() {
+	m.minted_height = nil
+	m.addminted_height = nil
+	delete(m.clearedFields, nft.FieldMintedHeight)
+}
+
+// SetLastHeight sets the "last_height" field.
+func (m *NftMutation) SetLastHeight(i int) {
+	m.last_height = &i
+	m.addlast_height = nil
+}
+
+// LastHeight returns the value of the "last_height" field in the mutation.
+func (m *NftMutation) LastHeight() (r int, exists bool) {
+	v := m.last_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastHeight returns the old "last_height" field's value of the Nft entity.
+// If the Nft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftMutation) OldLastHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastHeight: %w", err)
+	}
+	return oldValue.LastHeight, nil
+}
+
+// AddLastHeight adds i to the "last_height" field.
+func (m *NftMutation) AddLastHeight(i int) {
+	if m.addlast_height != nil {
+		*m.addlast_height += i
+	} else {
+		m.addlast_height = &i
+	}
+}
+
+// AddedLastHeight returns the value that was added to the "last_height" field in this mutation.
+func (m *NftMutation) AddedLastHeight() (r int, exists bool) {
+	v := m.addlast_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastHeight resets all changes to the "last_height" field.
+func (m *NftMutation) ResetLastHeight() {
+	m.last_height = nil
+	m.addlast_height = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NftMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NftMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Nft entity.
+// If the Nft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NftMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the NftMutation builder.
+func (m *NftMutation) Where(ps ...predicate.Nft) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NftMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NftMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Nft, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NftMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NftMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Nft).
+func (m *NftMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NftMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.collection != nil {
+		fields = append(fields, nft.FieldCollection)
+	}
+	if m.token_id != nil {
+		fields = append(fields, nft.FieldTokenID)
+	}
+	if m.owner != nil {
+		fields = append(fields, nft.FieldOwner)
+	}
+	if m.burned != nil {
+		fields = append(fields, nft.FieldBurned)
+	}
+	if m.minted_height != nil {
+		fields = append(fields, nft.FieldMintedHeight)
+	}
+	if m.last_height != nil {
+		fields = append(fields, nft.FieldLastHeight)
+	}
+	if m.created_at != nil {
+		fields = append(fields, nft.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NftMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nft.FieldCollection:
+		return m.Collection()
+	case nft.FieldTokenID:
+		return m.TokenID()
+	case nft.FieldOwner:
+		return m.Owner()
+	case nft.FieldBurned:
+		return m.Burned()
+	case nft.FieldMintedHeight:
+		return m.MintedHeight()
+	case nft.FieldLastHeight:
+		return m.LastHeight()
+	case nft.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NftMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nft.FieldCollection:
+		return m.OldCollection(ctx)
+	case nft.FieldTokenID:
+		return m.OldTokenID(ctx)
+	case nft.FieldOwner:
+		return m.OldOwner(ctx)
+	case nft.FieldBurned:
+		return m.OldBurned(ctx)
+	case nft.FieldMintedHeight:
+		return m.OldMintedHeight(ctx)
+	case nft.FieldLastHeight:
+		return m.OldLastHeight(ctx)
+	case nft.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Nft field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NftMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nft.FieldCollection:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollection(v)
+		return nil
+	case nft.FieldTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenID(v)
+		return nil
+	case nft.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case nft.FieldBurned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurned(v)
+		return nil
+	case nft.FieldMintedHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMintedHeight(v)
+		return nil
+	case nft.FieldLastHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastHeight(v)
+		return nil
+	case nft.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Nft field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NftMutation) AddedFields() []string {
+	var fields []string
+	if m.addminted_height != nil {
+		fields = append(fields, nft.FieldMintedHeight)
+	}
+	if m.addlast_height != nil {
+		fields = append(fields, nft.FieldLastHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NftMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nft.FieldMintedHeight:
+		return m.AddedMintedHeight()
+	case nft.FieldLastHeight:
+		return m.AddedLastHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NftMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case nft.FieldMintedHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMintedHeight(v)
+		return nil
+	case nft.FieldLastHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Nft numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NftMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nft.FieldOwner) {
+		fields = append(fields, nft.FieldOwner)
+	}
+	if m.FieldCleared(nft.FieldMintedHeight) {
+		fields = append(fields, nft.FieldMintedHeight)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NftMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NftMutation) ClearField(name string) error {
+	switch name {
+	case nft.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case nft.FieldMintedHeight:
+		m.ClearMintedHeight()
+		return nil
+	}
+	return fmt.Errorf("unknown Nft nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NftMutation) ResetField(name string) error {
+	switch name {
+	case nft.FieldCollection:
+		m.ResetCollection()
+		return nil
+	case nft.FieldTokenID:
+		m.ResetTokenID()
+		return nil
+	case nft.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case nft.FieldBurned:
+		m.ResetBurned()
+		return nil
+	case nft.FieldMintedHeight:
+		m.ResetMintedHeight()
+		return nil
+	case nft.FieldLastHeight:
+		m.ResetLastHeight()
+		return nil
+	case nft.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Nft field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NftMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NftMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NftMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NftMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NftMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NftMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NftMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Nft unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NftMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Nft edge %s", name)
+}
+
+// NftTransferMutation represents an operation that mutates the NftTransfer nodes in the graph.
+type NftTransferMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	hash            *string
+	_func           *string
+	collection      *string
+	token_id        *string
+	from_address    *string
+	to_address      *string
+	block_height    *int
+	addblock_height *int
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*NftTransfer, error)
+	predicates      []predicate.NftTransfer
+}
+
+var _ ent.Mutation = (*NftTransferMutation)(nil)
+
+// nfttransferOption allows management of the mutation configuration using functional options.
+type nfttransferOption func(*NftTransferMutation)
+
+// newNftTransferMutation creates new mutation for the NftTransfer entity.
+func newNftTransferMutation(c config, op Op, opts ...nfttransferOption) *NftTransferMutation {
+	m := &NftTransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNftTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNftTransferID sets the ID field of the mutation.
+func withNftTransferID(id int) nfttransferOption {
+	return func(m *NftTransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NftTransfer
+		)
+		m.oldValue = func(ctx context.Context) (*NftTransfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NftTransfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNftTransfer sets the old NftTransfer of the mutation.
+func withNftTransfer(node *NftTransfer) nfttransferOption {
+	return func(m *NftTransferMutation) {
+		m.oldValue = func(context.Context) (*NftTransfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NftTransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NftTransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NftTransferMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NftTransferMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NftTransfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *NftTransferMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *NftTransferMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the NftTransfer entity.
+// If the NftTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftTransferMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *NftTransferMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetFunc sets the "func" field.
+func (m *NftTransferMutation) SetFunc(s string) {
+	m._func = &s
+}
+
+// Func returns the value of the "func" field in the mutation.
+func (m *NftTransferMutation) Func() (r string, exists bool) {
+	v := m._func
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFunc returns the old "func" field's value of the NftTransfer entity.
+// If the NftTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftTransferMutation) OldFunc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFunc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFunc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFunc: %w", err)
+	}
+	return oldValue.Func, nil
+}
+
+// ResetFunc resets all changes to the "func" field.
+func (m *NftTransferMutation) ResetFunc() {
+	m._func = nil
+}
+
+// SetCollection sets the "collection" field.
+func (m *NftTransferMutation) SetCollection(s string) {
+	m.collection = &s
+}
+
+// Collection returns the value of the "collection" field in the mutation.
+func (m *NftTransferMutation) Collection() (r string, exists bool) {
+	v := m.collection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollection returns the old "collection" field's value of the NftTransfer entity.
+// If the NftTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftTransferMutation) OldCollection(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollection: %w", err)
+	}
+	return oldValue.Collection, nil
+}
+
+// ResetCollection resets all changes to the "collection" field.
+func (m *NftTransferMutation) ResetCollection() {
+	m.collection = nil
+}
+
+// SetTokenID sets the "token_id" field.
+func (m *NftTransferMutation) SetTokenID(s string) {
+	m.token_id = &s
+}
+
+// TokenID returns the value of the "token_id" field in the mutation.
+func (m *NftTransferMutation) TokenID() (r string, exists bool) {
+	v := m.token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenID returns the old "token_id" field's value of the NftTransfer entity.
+// If the NftTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftTransferMutation) OldTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenID: %w", err)
+	}
+	return oldValue.TokenID, nil
+}
+
+// ResetTokenID resets all changes to the "token_id" field.
+func (m *NftTransferMutation) ResetTokenID() {
+	m.token_id = nil
+}
+
+// SetFromAddress sets the "from_address" field.
+func (m *NftTransferMutation) SetFromAddress(s string) {
+	m.from_address = &s
+}
+
+// FromAddress returns the value of the "from_address" field in the mutation.
+func (m *NftTransferMutation) FromAddress() (r string, exists bool) {
+	v := m.from_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromAddress returns the old "from_address" field's value of the NftTransfer entity.
+// If the NftTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftTransferMutation) OldFromAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromAddress: %w", err)
+	}
+	return oldValue.FromAddress, nil
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (m *NftTransferMutation) ClearFromAddress() {
+	m.from_address = nil
+	m.clearedFields[nfttransfer.FieldFromAddress] = struct{}{}
+}
+
+// FromAddressCleared returns if the "from_address" field was cleared in this mutation.
+func (m *NftTransferMutation) FromAddressCleared() bool {
+	_, ok := m.clearedFields[nfttransfer.FieldFromAddress]
+	return ok
+}
+
+// ResetFromAddress resets all changes to the "from_address" field.
+func (m *NftTransferMutation) ResetFromAddress() {
+	m.from_address = nil
+	delete(m.clearedFields, nfttransfer.FieldFromAddress)
+}
+
+// SetToAddress sets the "to_address" field.
+func (m *NftTransferMutation) SetToAddress(s string) {
+	m.to_address = &s
+}
+
+// ToAddress returns the value of the "to_address" field in the mutation.
+func (m *NftTransferMutation) ToAddress() (r string, exists bool) {
+	v := m.to_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToAddress returns the old "to_address" field's value of the NftTransfer entity.
+// If the NftTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftTransferMutation) OldToAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToAddress: %w", err)
+	}
+	return oldValue.ToAddress, nil
+}
+
+// ClearToAddress clears the value of the "to_address" field.
+func (m *NftTransferMutation) ClearToAddress() {
+	m.to_address = nil
+	m.clearedFields[nfttransfer.FieldToAddress] = struct{}{}
+}
+
+// ToAddressCleared returns if the "to_address" field was cleared in this mutation.
+func (m *NftTransferMutation) ToAddressCleared() bool {
+	_, ok := m.clearedFields[nfttransfer.FieldToAddress]
+	return ok
+}
+
+// ResetToAddress resets all changes to the "to_address" field.
+func (m *NftTransferMutation) ResetToAddress() {
+	m.to_address = nil
+	delete(m.clearedFields, nfttransfer.FieldToAddress)
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (m *NftTransferMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *NftTransferMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHeight returns the old "block_height" field's value of the NftTransfer entity.
+// If the NftTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftTransferMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
+}
+
+// AddBlockHeight adds i to the "block_height" field.
+func (m *NftTransferMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
+	} else {
+		m.addblock_height = &i
+	}
+}
+
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *NftTransferMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *NftTransferMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NftTransferMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NftTransferMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NftTransfer entity.
+// If the NftTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NftTransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NftTransferMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the NftTransferMutation builder.
+func (m *NftTransferMutation) Where(ps ...predicate.NftTransfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NftTransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NftTransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NftTransfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NftTransferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NftTransferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NftTransfer).
+func (m *NftTransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NftTransferMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.hash != nil {
+		fields = append(fields, nfttransfer.FieldHash)
+	}
+	if m._func != nil {
+		fields = append(fields, nfttransfer.FieldFunc)
+	}
+	if m.collection != nil {
+		fields = append(fields, nfttransfer.FieldCollection)
+	}
+	if m.token_id != nil {
+		fields = append(fields, nfttransfer.FieldTokenID)
+	}
+	if m.from_address != nil {
+		fields = append(fields, nfttransfer.FieldFromAddress)
+	}
+	if m.to_address != nil {
+		fields = append(fields, nfttransfer.FieldToAddress)
+	}
+	if m.block_height != nil {
+		fields = append(fields, nfttransfer.FieldBlockHeight)
+	}
+	if m.created_at != nil {
+		fields = append(fields, nfttransfer.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NftTransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nfttransfer.FieldHash:
+		return m.Hash()
+	case nfttransfer.FieldFunc:
+		return m.Func()
+	case nfttransfer.FieldCollection:
+		return m.Collection()
+	case nfttransfer.FieldTokenID:
+		return m.TokenID()
+	case nfttransfer.FieldFromAddress:
+		return m.FromAddress()
+	case nfttransfer.FieldToAddress:
+		return m.ToAddress()
+	case nfttransfer.FieldBlockHeight:
+		return m.BlockHeight()
+	case nfttransfer.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NftTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nfttransfer.FieldHash:
+		return m.OldHash(ctx)
+	case nfttransfer.FieldFunc:
+		return m.OldFunc(ctx)
+	case nfttransfer.FieldCollection:
+		return m.OldCollection(ctx)
+	case nfttransfer.FieldTokenID:
+		return m.OldTokenID(ctx)
+	case nfttransfer.FieldFromAddress:
+		return m.OldFromAddress(ctx)
+	case nfttransfer.FieldToAddress:
+		return m.OldToAddress(ctx)
+	case nfttransfer.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case nfttransfer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NftTransfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NftTransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nfttransfer.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case nfttransfer.FieldFunc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFunc(v)
+		return nil
+	case nfttransfer.FieldCollection:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollection(v)
+		return nil
+	case nfttransfer.FieldTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenID(v)
+		return nil
+	case nfttransfer.FieldFromAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromAddress(v)
+		return nil
+	case nfttransfer.FieldToAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToAddress(v)
+		return nil
+	case nfttransfer.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case nfttransfer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NftTransfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NftTransferMutation) AddedFields() []string {
+	var fields []string
+	if m.addblock_height != nil {
+		fields = append(fields, nfttransfer.FieldBlockHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NftTransferMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nfttransfer.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NftTransferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case nfttransfer.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NftTransfer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NftTransferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nfttransfer.FieldFromAddress) {
+		fields = append(fields, nfttransfer.FieldFromAddress)
+	}
+	if m.FieldCleared(nfttransfer.FieldToAddress) {
+		fields = append(fields, nfttransfer.FieldToAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NftTransferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NftTransferMutation) ClearField(name string) error {
+	switch name {
+	case nfttransfer.FieldFromAddress:
+		m.ClearFromAddress()
+		return nil
+	case nfttransfer.FieldToAddress:
+		m.ClearToAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown NftTransfer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NftTransferMutation) ResetField(name string) error {
+	switch name {
+	case nfttransfer.FieldHash:
+		m.ResetHash()
+		return nil
+	case nfttransfer.FieldFunc:
+		m.ResetFunc()
+		return nil
+	case nfttransfer.FieldCollection:
+		m.ResetCollection()
+		return nil
+	case nfttransfer.FieldTokenID:
+		m.ResetTokenID()
+		return nil
+	case nfttransfer.FieldFromAddress:
+		m.ResetFromAddress()
+		return nil
+	case nfttransfer.FieldToAddress:
+		m.ResetToAddress()
+		return nil
+	case nfttransfer.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case nfttransfer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NftTransfer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NftTransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NftTransferMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NftTransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NftTransferMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NftTransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NftTransferMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NftTransferMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NftTransfer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NftTransferMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NftTransfer edge %s", name)
+}
+
+// RealmCallMutation represents an operation that mutates the RealmCall nodes in the graph.
+type RealmCallMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	hash            *string
+	msg_index       *int
+	addmsg_index    *int
+	block_height    *int
+	addblock_height *int
+	block_time      *time.Time
+	pkg_path        *string
+	_func           *string
+	caller          *string
+	args            *[]string
+	appendargs      []string
+	send            *string
+	success         *bool
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*RealmCall, error)
+	predicates      []predicate.RealmCall
+}
+
+var _ ent.Mutation = (*RealmCallMutation)(nil)
+
+// realmcallOption allows management of the mutation configuration using functional options.
+type realmcallOption func(*RealmCallMutation)
+
+// newRealmCallMutation creates new mutation for the RealmCall entity.
+func newRealmCallMutation(c config, op Op, opts ...realmcallOption) *RealmCallMutation {
+	m := &RealmCallMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRealmCall,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRealmCallID sets the ID field of the mutation.
+func withRealmCallID(id int) realmcallOption {
+	return func(m *RealmCallMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RealmCall
+		)
+		m.oldValue = func(ctx context.Context) (*RealmCall, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RealmCall.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRealmCall sets the old RealmCall of the mutation.
+func withRealmCall(node *RealmCall) realmcallOption {
+	return func(m *RealmCallMutation) {
+		m.oldValue = func(context.Context) (*RealmCall, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RealmCallMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RealmCallMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RealmCallMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RealmCallMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RealmCall.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *RealmCallMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *RealmCallMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *RealmCallMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (m *RealmCallMutation) SetMsgIndex(i int) {
+	m.msg_index = &i
+	m.addmsg_index = nil
+}
+
+// MsgIndex returns the value of the "msg_index" field in the mutation.
+func (m *RealmCallMutation) MsgIndex() (r int, exists bool) {
+	v := m.msg_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgIndex returns the old "msg_index" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldMsgIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgIndex: %w", err)
+	}
+	return oldValue.MsgIndex, nil
+}
+
+// AddMsgIndex adds i to the "msg_index" field.
+func (m *RealmCallMutation) AddMsgIndex(i int) {
+	if m.addmsg_index != nil {
+		*m.addmsg_index += i
+	} else {
+		m.addmsg_index = &i
+	}
+}
+
+// AddedMsgIndex returns the value that was added to the "msg_index" field in this mutation.
+func (m *RealmCallMutation) AddedMsgIndex() (r int, exists bool) {
+	v := m.addmsg_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMsgIndex resets all changes to the "msg_index" field.
+func (m *RealmCallMutation) ResetMsgIndex() {
+	m.msg_index = nil
+	m.addmsg_index = nil
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (m *RealmCallMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *RealmCallMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHeight returns the old "block_height" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
+}
+
+// AddBlockHeight adds i to the "block_height" field.
+func (m *RealmCallMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
+	} else {
+		m.addblock_height = &i
+	}
+}
+
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *RealmCallMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *RealmCallMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
+}
+
+// SetBlockTime sets the "block_time" field.
+func (m *RealmCallMutation) SetBlockTime(t time.Time) {
+	m.block_time = &t
+}
+
+// BlockTime returns the value of the "block_time" field in the mutation.
+func (m *RealmCallMutation) BlockTime() (r time.Time, exists bool) {
+	v := m.block_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockTime returns the old "block_time" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldBlockTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockTime: %w", err)
+	}
+	return oldValue.BlockTime, nil
+}
+
+// ResetBlockTime resets all changes to the "block_time" field.
+func (m *RealmCallMutation) ResetBlockTime() {
+	m.block_time = nil
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (m *RealmCallMutation) SetPkgPath(s string) {
+	m.pkg_path = &s
+}
+
+// PkgPath returns the value of the "pkg_path" field in the mutation.
+func (m *RealmCallMutation) PkgPath() (r string, exists bool) {
+	v := m.pkg_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPkgPath returns the old "pkg_path" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldPkgPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPkgPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPkgPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPkgPath: %w", err)
+	}
+	return oldValue.PkgPath, nil
+}
+
+// ResetPkgPath resets all changes to the "pkg_path" field.
+func (m *RealmCallMutation) ResetPkgPath() {
+	m.pkg_path = nil
+}
+
+// SetFunc sets the "func" field.
+func (m *RealmCallMutation) SetFunc(s string) {
+	m._func = &s
+}
+
+// Func returns the value of the "func" field in the mutation.
+func (m *RealmCallMutation) Func() (r string, exists bool) {
+	v := m._func
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFunc returns the old "func" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldFunc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFunc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFunc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFunc: %w", err)
+	}
+	return oldValue.Func, nil
+}
+
+// ResetFunc resets all changes to the "func" field.
+func (m *RealmCallMutation) ResetFunc() {
+	m._func = nil
+}
+
+// SetCaller sets the "caller" field.
+func (m *RealmCallMutation) SetCaller(s string) {
+	m.caller = &s
+}
+
+// Caller returns the value of the "caller" field in the mutation.
+func (m *RealmCallMutation) Caller() (r string, exists bool) {
+	v := m.caller
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaller returns the old "caller" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldCaller(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaller is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaller requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaller: %w", err)
+	}
+	return oldValue.Caller, nil
+}
+
+// ResetCaller resets all changes to the "caller" field.
+func (m *RealmCallMutation) ResetCaller() {
+	m.caller = nil
+}
+
+// SetArgs sets the "args" field.
+func (m *RealmCallMutation) SetArgs(s []string) {
+	m.args = &s
+	m.appendargs = nil
+}
+
+// Args returns the value of the "args" field in the mutation.
+func (m *RealmCallMutation) Args() (r []string, exists bool) {
+	v := m.args
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArgs returns the old "args" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldArgs(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArgs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArgs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArgs: %w", err)
+	}
+	return oldValue.Args, nil
+}
+
+// AppendArgs adds s to the "args" field.
+func (m *RealmCallMutation) AppendArgs(s []string) {
+	m.appendargs = append(m.appendargs, s...)
+}
+
+// AppendedArgs returns the list of values that were appended to the "args" field in this mutation.
+func (m *RealmCallMutation) AppendedArgs() ([]string, bool) {
+	if len(m.appendargs) == 0 {
+		return nil, false
+	}
+	return m.appendargs, true
+}
+
+// ClearArgs clears the value of the "args" field.
+func (m *RealmCallMutation) ClearArgs() {
+	m.args = nil
+	m.appendargs = nil
+	m.clearedFields[realmcall.FieldArgs] = struct{}{}
+}
+
+// ArgsCleared returns if the "args" field was cleared in this mutation.
+func (m *RealmCallMutation) ArgsCleared() bool {
+	_, ok := m.clearedFields[realmcall.FieldArgs]
+	return ok
+}
+
+// ResetArgs resets all changes to the "args" field.
+func (m *RealmCallMutation) ResetArgs() {
+	m.args = nil
+	m.appendargs = nil
+	delete(m.clearedFields, realmcall.FieldArgs)
+}
+
+// SetSend sets the "send" field.
+func (m *RealmCallMutation) SetSend(s string) {
+	m.send = &s
+}
+
+// Send returns the value of the "send" field in the mutation.
+func (m *RealmCallMutation) Send() (r string, exists bool) {
+	v := m.send
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSend returns the old "send" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldSend(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSend is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSend requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSend: %w", err)
+	}
+	return oldValue.Send, nil
+}
+
+// ClearSend clears the value of the "send" field.
+func (m *RealmCallMutation) ClearSend() {
+	m.send = nil
+	m.clearedFields[realmcall.FieldSend] = struct{}{}
+}
+
+// SendCleared returns if the "send" field was cleared in this mutation.
+func (m *RealmCallMutation) SendCleared() bool {
+	_, ok := m.clearedFields[realmcall.FieldSend]
+	return ok
+}
+
+// ResetSend resets all changes to the "send" field.
+func (m *RealmCallMutation) ResetSend() {
+	m.send = nil
+	delete(m.clearedFields, realmcall.FieldSend)
+}
+
+// SetSuccess sets the "success" field.
+func (m *RealmCallMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *RealmCallMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *RealmCallMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RealmCallMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RealmCallMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RealmCall entity.
+// If the RealmCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealmCallMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RealmCallMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the RealmCallMutation builder.
+func (m *RealmCallMutation) Where(ps ...predicate.RealmCall) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RealmCallMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RealmCallMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RealmCall, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RealmCallMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RealmCallMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RealmCall).
+func (m *RealmCallMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RealmCallMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.hash != nil {
+		fields = append(fields, realmcall.FieldHash)
+	}
+	if m.msg_index != nil {
+		fields = append(fields, realmcall.FieldMsgIndex)
+	}
+	if m.block_height != nil {
+		fields = append(fields, realmcall.FieldBlockHeight)
+	}
+	if m.block_time != nil {
+		fields = append(fields, realmcall.FieldBlockTime)
+	}
+	if m.pkg_path != nil {
+		fields = append(fields, realmcall.FieldPkgPath)
+	}
+	if m._func != nil {
+		fields = append(fields, realmcall.FieldFunc)
+	}
+	if m.caller != nil {
+		fields = append(fields, realmcall.FieldCaller)
+	}
+	if m.args != nil {
+		fields = append(fields, realmcall.FieldArgs)
+	}
+	if m.send != nil {
+		fields = append(fields, realmcall.FieldSend)
+	}
+	if m.success != nil {
+		fields = append(fields, realmcall.FieldSuccess)
+	}
+	if m.created_at != nil {
+		fields = append(fields, realmcall.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RealmCallMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case realmcall.FieldHash:
+		return m.Hash()
+	case realmcall.FieldMsgIndex:
+		return m.MsgIndex()
+	case realmcall.FieldBlockHeight:
+		return m.BlockHeight()
+	case realmcall.FieldBlockTime:
+		return m.BlockTime()
+	case realmcall.FieldPkgPath:
+		return m.PkgPath()
+	case realmcall.FieldFunc:
+		return m.Func()
+	case realmcall.FieldCaller:
+		return m.Caller()
+	case realmcall.FieldArgs:
+		return m.Args()
+	case realmcall.FieldSend:
+		return m.Send()
+	case realmcall.FieldSuccess:
+		return m.Success()
+	case realmcall.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RealmCallMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case realmcall.FieldHash:
+		return m.OldHash(ctx)
+	case realmcall.FieldMsgIndex:
+		return m.OldMsgIndex(ctx)
+	case realmcall.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case realmcall.FieldBlockTime:
+		return m.OldBlockTime(ctx)
+	case realmcall.FieldPkgPath:
+		return m.OldPkgPath(ctx)
+	case realmcall.FieldFunc:
+		return m.OldFunc(ctx)
+	case realmcall.FieldCaller:
+		return m.OldCaller(ctx)
+	case realmcall.FieldArgs:
+		return m.OldArgs(ctx)
+	case realmcall.FieldSend:
+		return m.OldSend(ctx)
+	case realmcall.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case realmcall.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RealmCall field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RealmCallMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case realmcall.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case realmcall.FieldMsgIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgIndex(v)
+		return nil
+	case realmcall.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case realmcall.FieldBlockTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockTime(v)
+		return nil
+	case realmcall.FieldPkgPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPkgPath(v)
+		return nil
+	case realmcall.FieldFunc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFunc(v)
+		return nil
+	case realmcall.FieldCaller:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaller(v)
+		return nil
+	case realmcall.FieldArgs:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArgs(v)
+		return nil
+	case realmcall.FieldSend:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSend(v)
+		return nil
+	case realmcall.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case realmcall.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RealmCall field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RealmCallMutation) AddedFields() []string {
+	var fields []string
+	if m.addmsg_index != nil {
+		fields = append(fields, realmcall.FieldMsgIndex)
+	}
+	if m.addblock_height != nil {
+		fields = append(fields, realmcall.FieldBlockHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RealmCallMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case realmcall.FieldMsgIndex:
+		return m.AddedMsgIndex()
+	case realmcall.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RealmCallMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case realmcall.FieldMsgIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMsgIndex(v)
+		return nil
+	case realmcall.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RealmCall numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RealmCallMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(realmcall.FieldArgs) {
+		fields = append(fields, realmcall.FieldArgs)
+	}
+	if m.FieldCleared(realmcall.FieldSend) {
+		fields = append(fields, realmcall.FieldSend)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RealmCallMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RealmCallMutation) ClearField(name string) error {
+	switch name {
+	case realmcall.FieldArgs:
+		m.ClearArgs()
+		return nil
+	case realmcall.FieldSend:
+		m.ClearSend()
+		return nil
+	}
+	return fmt.Errorf("unknown RealmCall nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RealmCallMutation) ResetField(name string) error {
+	switch name {
+	case realmcall.FieldHash:
+		m.ResetHash()
+		return nil
+	case realmcall.FieldMsgIndex:
+		m.ResetMsgIndex()
+		return nil
+	case realmcall.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case realmcall.FieldBlockTime:
+		m.ResetBlockTime()
+		return nil
+	case realmcall.FieldPkgPath:
+		m.ResetPkgPath()
+		return nil
+	case realmcall.FieldFunc:
+		m.ResetFunc()
+		return nil
+	case realmcall.FieldCaller:
+		m.ResetCaller()
+		return nil
+	case realmcall.FieldArgs:
+		m.ResetArgs()
+		return nil
+	case realmcall.FieldSend:
+		m.ResetSend()
+		return nil
+	case realmcall.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case realmcall.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RealmCall field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RealmCallMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RealmCallMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RealmCallMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RealmCallMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RealmCallMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RealmCallMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RealmCallMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RealmCall unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RealmCallMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RealmCall edge %s", name)
+}
+
+// RebuildProgressMutation represents an operation that mutates the RebuildProgress nodes in the graph.
+type RebuildProgressMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	last_height    *int
+	addlast_height *int
+	started_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*RebuildProgress, error)
+	predicates     []predicate.RebuildProgress
+}
+
+var _ ent.Mutation = (*RebuildProgressMutation)(nil)
+
+// rebuildprogressOption allows management of the mutation configuration using functional options.
+type rebuildprogressOption func(*RebuildProgressMutation)
+
+// newRebuildProgressMutation creates new mutation for the RebuildProgress entity.
+func newRebuildProgressMutation(c config, op Op, opts ...rebuildprogressOption) *RebuildProgressMutation {
+	m := &RebuildProgressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRebuildProgress,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRebuildProgressID sets the ID field of the mutation.
+func withRebuildProgressID(id int) rebuildprogressOption {
+	return func(m *RebuildProgressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RebuildProgress
+		)
+		m.oldValue = func(ctx context.Context) (*RebuildProgress, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RebuildProgress.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRebuildProgress sets the old RebuildProgress of the mutation.
+func withRebuildProgress(node *RebuildProgress) rebuildprogressOption {
+	return func(m *RebuildProgressMutation) {
+		m.oldValue = func(context.Context) (*RebuildProgress, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RebuildProgressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RebuildProgressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RebuildProgressMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RebuildProgressMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RebuildProgress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLastHeight sets the "last_height" field.
+func (m *RebuildProgressMutation) SetLastHeight(i int) {
+	m.last_height = &i
+	m.addlast_height = nil
+}
+
+// LastHeight returns the value of the "last_height" field in the mutation.
+func (m *RebuildProgressMutation) LastHeight() (r int, exists bool) {
+	v := m.last_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastHeight returns the old "last_height" field's value of the RebuildProgress entity.
+// If the RebuildProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RebuildProgressMutation) OldLastHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastHeight: %w", err)
+	}
+	return oldValue.LastHeight, nil
+}
+
+// AddLastHeight adds i to the "last_height" field.
+func (m *RebuildProgressMutation) AddLastHeight(i int) {
+	if m.addlast_height != nil {
+		*m.addlast_height += i
+	} else {
+		m.addlast_height = &i
+	}
+}
+
+// AddedLastHeight returns the value that was added to the "last_height" field in this mutation.
+func (m *RebuildProgressMutation) AddedLastHeight() (r int, exists bool) {
+	v := m.addlast_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastHeight resets all changes to the "last_height" field.
+func (m *RebuildProgressMutation) ResetLastHeight() {
+	m.last_height = nil
+	m.addlast_height = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *RebuildProgressMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *RebuildProgressMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the RebuildProgress entity.
+// If the RebuildProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RebuildProgressMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *RebuildProgressMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RebuildProgressMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RebuildProgressMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RebuildProgress entity.
+// If the RebuildProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RebuildProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RebuildProgressMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RebuildProgressMutation builder.
+func (m *RebuildProgressMutation) Where(ps ...predicate.RebuildProgress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RebuildProgressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RebuildProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RebuildProgress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RebuildProgressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RebuildProgressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RebuildProgress).
+func (m *RebuildProgressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RebuildProgressMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.last_height != nil {
+		fields = append(fields, rebuildprogress.FieldLastHeight)
+	}
+	if m.started_at != nil {
+		fields = append(fields, rebuildprogress.FieldStartedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rebuildprogress.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RebuildProgressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rebuildprogress.FieldLastHeight:
+		return m.LastHeight()
+	case rebuildprogress.FieldStartedAt:
+		return m.StartedAt()
+	case rebuildprogress.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RebuildProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rebuildprogress.FieldLastHeight:
+		return m.OldLastHeight(ctx)
+	case rebuildprogress.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case rebuildprogress.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RebuildProgress field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RebuildProgressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rebuildprogress.FieldLastHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastHeight(v)
+		return nil
+	case rebuildprogress.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case rebuildprogress.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RebuildProgress field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RebuildProgressMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_height != nil {
+		fields = append(fields, rebuildprogress.FieldLastHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RebuildProgressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rebuildprogress.FieldLastHeight:
+		return m.AddedLastHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RebuildProgressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rebuildprogress.FieldLastHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RebuildProgress numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RebuildProgressMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RebuildProgressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RebuildProgressMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RebuildProgress nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RebuildProgressMutation) ResetField(name string) error {
+	switch name {
+	case rebuildprogress.FieldLastHeight:
+		m.ResetLastHeight()
+		return nil
+	case rebuildprogress.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case rebuildprogress.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RebuildProgress field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RebuildProgressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RebuildProgressMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RebuildProgressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RebuildProgressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RebuildProgressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RebuildProgressMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RebuildProgressMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RebuildProgress unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RebuildProgressMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RebuildProgress edge %s", name)
+}
+
+// RestoreHistoryMutation represents an operation that mutates the RestoreHistory nodes in the graph.
+type RestoreHistoryMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	restore_range_start    *int
+	addrestore_range_start *int
+	restore_range_end      *int
+	addrestore_range_end   *int
+	being_block            *int
+	addbeing_block         *int
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*RestoreHistory, error)
+	predicates             []predicate.RestoreHistory
+}
+
+var _ ent.Mutation = (*RestoreHistoryMutation)(nil)
+
+// restorehistoryOption allows management of the mutation configuration using functional options.
+type restorehistoryOption func(*RestoreHistoryMutation)
+
+// newRestoreHistoryMutation creates new mutation for the RestoreHistory entity.
+func newRestoreHistoryMutation(c config, op Op, opts ...restorehistoryOption) *RestoreHistoryMutation {
+	m := &RestoreHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRestoreHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRestoreHistoryID sets the ID field of the mutation.
+func withRestoreHistoryID(id int) restorehistoryOption {
+	return func(m *RestoreHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RestoreHistory
+		)
+		m.oldValue = func(ctx context.Context) (*RestoreHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RestoreHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRestoreHistory sets the old RestoreHistory of the mutation.
+func withRestoreHistory(node *RestoreHistory) restorehistoryOption {
+	return func(m *RestoreHistoryMutation) {
+		m.oldValue = func(context.Context) (*RestoreHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RestoreHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RestoreHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RestoreHistory entities.
+func (m *RestoreHistoryMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RestoreHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RestoreHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RestoreHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRestoreRangeStart sets the "restore_range_start" field.
+func (m *RestoreHistoryMutation) SetRestoreRangeStart(i int) {
+	m.restore_range_start = &i
+	m.addrestore_range_start = nil
+}
+
+// RestoreRangeStart returns the value of the "restore_range_start" field in the mutation.
+func (m *RestoreHistoryMutation) RestoreRangeStart() (r int, exists bool) {
+	v := m.restore_range_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestoreRangeStart returns the old "restore_range_start" field's value of the RestoreHistory entity.
+// If the RestoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestoreHistoryMutation) OldRestoreRangeStart(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestoreRangeStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestoreRangeStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestoreRangeStart: %w", err)
+	}
+	return oldValue.RestoreRangeStart, nil
+}
+
+// AddRestoreRangeStart adds i to the "restore_range_start" field.
+func (m *RestoreHistoryMutation) AddRestoreRangeStart(i int) {
+	if m.addrestore_range_start != nil {
+		*m.addrestore_range_start += i
+	} else {
+		m.addrestore_range_start = &i
+	}
+}
+
+// AddedRestoreRangeStart returns the value that was added to the "restore_range_start" field in this mutation.
+func (m *RestoreHistoryMutation) AddedRestoreRangeStart() (r int, exists bool) {
+	v := m.addrestore_range_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRestoreRangeStart resets all changes to the "restore_range_start" field.
+func (m *RestoreHistoryMutation) ResetRestoreRangeStart() {
+	m.restore_range_start = nil
+	m.addrestore_range_start = nil
+}
+
+// SetRestoreRangeEnd sets the "restore_range_end" field.
+func (m *RestoreHistoryMutation) SetRestoreRangeEnd(i int) {
+	m.restore_range_end = &i
+	m.addrestore_range_end = nil
+}
+
+// RestoreRangeEnd returns the value of the "restore_range_end" field in the mutation.
+func (m *RestoreHistoryMutation) RestoreRangeEnd() (r int, exists bool) {
+	v := m.restore_range_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestoreRangeEnd returns the old "restore_range_end" field's value of the RestoreHistory entity.
+// If the RestoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestoreHistoryMutation) OldRestoreRangeEnd(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestoreRangeEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestoreRangeEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestoreRangeEnd: %w", err)
+	}
+	return oldValue.RestoreRangeEnd, nil
+}
+
+// AddRestoreRangeEnd adds i to the "restore_range_end" field.
+func (m *RestoreHistoryMutation) AddRestoreRangeEnd(i int) {
+	if m.addrestore_range_end != nil {
+		*m.addrestore_range_end += i
+	} else {
+		m.addrestore_range_end = &i
+	}
+}
+
+// AddedRestoreRangeEnd returns the value that was added to the "restore_range_end" field in this mutation.
+func (m *RestoreHistoryMutation) AddedRestoreRangeEnd() (r int, exists bool) {
+	v := m.addrestore_range_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRestoreRangeEnd resets all changes to the "restore_range_end" field.
+func (m *RestoreHistoryMutation) ResetRestoreRangeEnd() {
+	m.restore_range_end = nil
+	m.addrestore_range_end = nil
+}
+
+// SetBeingBlock sets the "being_block" field.
+func (m *RestoreHistoryMutation) SetBeingBlock(i int) {
+	m.being_block = &i
+	m.addbeing_block = nil
+}
+
+// BeingBlock returns the value of the "being_block" field in the mutation.
+func (m *RestoreHistoryMutation) BeingBlock() (r int, exists bool) {
+	v := m.being_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBeingBlock returns the old "being_block" field's value of the RestoreHistory entity.
+// If the RestoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestoreHistoryMutation) OldBeingBlock(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBeingBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBeingBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBeingBlock: %w", err)
+	}
+	return oldValue.BeingBlock, nil
+}
+
+// AddBeingBlock adds i to the "being_block" field.
+func (m *RestoreHistoryMutation) AddBeingBlock(i int) {
+	if m.addbeing_block != nil {
+		*m.addbeing_block += i
+	} else {
+		m.addbeing_block = &i
+	}
+}
+
+// AddedBeingBlock returns the value that was added to the "being_block" field in this mutation.
+func (m *RestoreHistoryMutation) AddedBeingBlock() (r int, exists bool) {
+	v := m.addbeing_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBeingBlock resets all changes to the "being_block" field.
+func (m *RestoreHistoryMutation) ResetBeingBlock() {
+	m.being_block = nil
+	m.addbeing_block = nil
+}
+
+// Where appends a list predicates to the RestoreHistoryMutation builder.
+func (m *RestoreHistoryMutation) Where(ps ...predicate.RestoreHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RestoreHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RestoreHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RestoreHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RestoreHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RestoreHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RestoreHistory).
+func (m *RestoreHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RestoreHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.restore_range_start != nil {
+		fields = append(fields, restorehistory.FieldRestoreRangeStart)
+	}
+	if m.restore_range_end != nil {
+		fields = append(fields, restorehistory.FieldRestoreRangeEnd)
+	}
+	if m.being_block != nil {
+		fields = append(fields, restorehistory.FieldBeingBlock)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RestoreHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case restorehistory.FieldRestoreRangeStart:
+		return m.RestoreRangeStart()
+	case restorehistory.FieldRestoreRangeEnd:
+		return m.RestoreRangeEnd()
+	case restorehistory.FieldBeingBlock:
+		return m.BeingBlock()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RestoreHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case restorehistory.FieldRestoreRangeStart:
+		return m.OldRestoreRangeStart(ctx)
+	case restorehistory.FieldRestoreRangeEnd:
+		return m.OldRestoreRangeEnd(ctx)
+	case restorehistory.FieldBeingBlock:
+		return m.OldBeingBlock(ctx)
+	}
+	return nil, fmt.Errorf("unknown RestoreHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RestoreHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case restorehistory.FieldRestoreRangeStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestoreRangeStart(v)
+		return nil
+	case restorehistory.FieldRestoreRangeEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestoreRangeEnd(v)
+		return nil
+	case restorehistory.FieldBeingBlock:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBeingBlock(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RestoreHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RestoreHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addrestore_range_start != nil {
+		fields = append(fields, restorehistory.FieldRestoreRangeStart)
+	}
+	if m.addrestore_range_end != nil {
+		fields = append(fields, restorehistory.FieldRestoreRangeEnd)
+	}
+	if m.addbeing_block != nil {
+		fields = append(fields, restorehistory.FieldBeingBlock)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RestoreHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case restorehistory.FieldRestoreRangeStart:
+		return m.AddedRestoreRangeStart()
+	case restorehistory.FieldRestoreRangeEnd:
+		return m.AddedRestoreRangeEnd()
+	case restorehistory.FieldBeingBlock:
+		return m.AddedBeingBlock()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RestoreHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case restorehistory.FieldRestoreRangeStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRestoreRangeStart(v)
+		return nil
+	case restorehistory.FieldRestoreRangeEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRestoreRangeEnd(v)
+		return nil
+	case restorehistory.FieldBeingBlock:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBeingBlock(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RestoreHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RestoreHistoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RestoreHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RestoreHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RestoreHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RestoreHistoryMutation) ResetField(name string) error {
+	switch name {
+	case restorehistory.FieldRestoreRangeStart:
+		m.ResetRestoreRangeStart()
+		return nil
+	case restorehistory.FieldRestoreRangeEnd:
+		m.ResetRestoreRangeEnd()
+		return nil
+	case restorehistory.FieldBeingBlock:
+		m.ResetBeingBlock()
+		return nil
+	}
+	return fmt.Errorf("unknown RestoreHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RestoreHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RestoreHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RestoreHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RestoreHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RestoreHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RestoreHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RestoreHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RestoreHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RestoreHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RestoreHistory edge %s", name)
+}
+
+// TokenMutation represents an operation that mutates the Token nodes in the graph.
+type TokenMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	name                 *string
+	symbol               *string
+	decimals             *int
+	adddecimals          *int
+	creator              *string
+	first_seen_height    *int
+	addfirst_seen_height *int
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*Token, error)
+	predicates           []predicate.Token
+}
+
+var _ ent.Mutation = (*TokenMutation)(nil)
+
+// tokenOption allows management of the mutation configuration using functional options.
+type tokenOption func(*TokenMutation)
+
+// newTokenMutation creates new mutation for the Token entity.
+func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
+	m := &TokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenID sets the ID field of the mutation.
+func withTokenID(id string) tokenOption {
+	return func(m *TokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Token
+		)
+		m.oldValue = func(ctx context.Context) (*Token, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Token.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withToken sets the old Token of the mutation.
+func withToken(node *Token) tokenOption {
+	return func(m *TokenMutation) {
+		m.oldValue = func(context.Context) (*Token, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Token entities.
+func (m *TokenMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TokenMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *TokenMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[token.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *TokenMutation) NameCleared() bool {
+	_, ok := m.clearedFields[token.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TokenMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, token.FieldName)
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *TokenMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *TokenMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (m *TokenMutation) ClearSymbol() {
+	m.symbol = nil
+	m.clearedFields[token.FieldSymbol] = struct{}{}
+}
+
+// SymbolCleared returns if the "symbol" field was cleared in this mutation.
+func (m *TokenMutation) SymbolCleared() bool {
+	_, ok := m.clearedFields[token.FieldSymbol]
+	return ok
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *TokenMutation) ResetSymbol() {
+	m.symbol = nil
+	delete(m.clearedFields, token.FieldSymbol)
+}
+
+// SetDecimals sets the "decimals" field.
+func (m *TokenMutation) SetDecimals(i int) {
+	m.decimals = &i
+	m.adddecimals = nil
+}
+
+// Decimals returns the value of the "decimals" field in the mutation.
+func (m *TokenMutation) Decimals() (r int, exists bool) {
+	v := m.decimals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecimals returns the old "decimals" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldDecimals(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecimals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecimals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecimals: %w", err)
+	}
+	return oldValue.Decimals, nil
+}
+
+// AddDecimals adds i to the "decimals" field.
+func (m *TokenMutation) AddDecimals(i int) {
+	if m.adddecimals != nil {
+		*m.adddecimals += i
+	} else {
+		m.adddecimals = &i
+	}
+}
+
+// AddedDecimals returns the value that was added to the "decimals" field in this mutation.
+func (m *TokenMutation) AddedDecimals() (r int, exists bool) {
+	v := m.adddecimals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDecimals resets all changes to the "decimals" field.
+func (m *TokenMutation) ResetDecimals() {
+	m.decimals = nil
+	m.adddecimals = nil
+}
+
+// SetCreator sets the "creator" field.
+func (m *TokenMutation) SetCreator(s string) {
+	m.creator = &s
+}
+
+// Creator returns the value of the "creator" field in the mutation.
+func (m *TokenMutation) Creator() (r string, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreator returns the old "creator" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldCreator(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreator is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreator requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreator: %w", err)
+	}
+	return oldValue.Creator, nil
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (m *TokenMutation) ClearCreator() {
+	m.creator = nil
+	m.clearedFields[token.FieldCreator] = struct{}{}
+}
+
+// CreatorCleared returns if the "creator" field was cleared in this mutation.
+func (m *TokenMutation) CreatorCleared() bool {
+	_, ok := m.clearedFields[token.FieldCreator]
+	return ok
+}
+
+// ResetCreator resets all changes to the "creator" field.
+func (m *TokenMutation) ResetCreator() {
+	m.creator = nil
+	delete(m.clearedFields, token.FieldCreator)
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (m *TokenMutation) SetFirstSeenHeight(i int) {
+	m.first_seen_height = &i
+	m.addfirst_seen_height = nil
+}
+
+// FirstSeenHeight returns the value of the "first_seen_height" field in the mutation.
+func (m *TokenMutation) FirstSeenHeight() (r int, exists bool) {
+	v := m.first_seen_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstSeenHeight returns the old "first_seen_height" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldFirstSeenHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstSeenHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstSeenHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstSeenHeight: %w", err)
+	}
+	return oldValue.FirstSeenHeight, nil
+}
+
+// AddFirstSeenHeight adds i to the "first_seen_height" field.
+func (m *TokenMutation) AddFirstSeenHeight(i int) {
+	if m.addfirst_seen_height != nil {
+		*m.addfirst_seen_height += i
+	} else {
+		m.addfirst_seen_height = &i
+	}
+}
+
+// AddedFirstSeenHeight returns the value that was added to the "first_seen_height" field in this mutation.
+func (m *TokenMutation) AddedFirstSeenHeight() (r int, exists bool) {
+	v := m.addfirst_seen_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFirstSeenHeight resets all changes to the "first_seen_height" field.
+func (m *TokenMutation) ResetFirstSeenHeight() {
+	m.first_seen_height = nil
+	m.addfirst_seen_height = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TokenMutation builder.
+func (m *TokenMutation) Where(ps ...predicate.Token) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Token, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Token).
+func (m *TokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, token.FieldName)
+	}
+	if m.symbol != nil {
+		fields = append(fields, token.FieldSymbol)
+	}
+	if m.decimals != nil {
+		fields = append(fields, token.FieldDecimals)
+	}
+	if m.creator != nil {
+		fields = append(fields, token.FieldCreator)
+	}
+	if m.first_seen_height != nil {
+		fields = append(fields, token.FieldFirstSeenHeight)
+	}
+	if m.created_at != nil {
+		fields = append(fields, token.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldName:
+		return m.Name()
+	case token.FieldSymbol:
+		return m.Symbol()
+	case token.FieldDecimals:
+		return m.Decimals()
+	case token.FieldCreator:
+		return m.Creator()
+	case token.FieldFirstSeenHeight:
+		return m.FirstSeenHeight()
+	case token.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case token.FieldName:
+		return m.OldName(ctx)
+	case token.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case token.FieldDecimals:
+		return m.OldDecimals(ctx)
+	case token.FieldCreator:
+		return m.OldCreator(ctx)
+	case token.FieldFirstSeenHeight:
+		return m.OldFirstSeenHeight(ctx)
+	case token.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Token field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case token.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case token.FieldDecimals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecimals(v)
+		return nil
+	case token.FieldCreator:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreator(v)
+		return nil
+	case token.FieldFirstSeenHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstSeenHeight(v)
+		return nil
+	case token.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenMutation) AddedFields() []string {
+	var fields []string
+	if m.adddecimals != nil {
+		fields = append(fields, token.FieldDecimals)
+	}
+	if m.addfirst_seen_height != nil {
+		fields = append(fields, token.FieldFirstSeenHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldDecimals:
+		return m.AddedDecimals()
+	case token.FieldFirstSeenHeight:
+		return m.AddedFirstSeenHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldDecimals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDecimals(v)
+		return nil
+	case token.FieldFirstSeenHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstSeenHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Token numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(token.FieldName) {
+		fields = append(fields, token.FieldName)
+	}
+	if m.FieldCleared(token.FieldSymbol) {
+		fields = append(fields, token.FieldSymbol)
+	}
+	if m.FieldCleared(token.FieldCreator) {
+		fields = append(fields, token.FieldCreator)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenMutation) ClearField(name string) error {
+	switch name {
+	case token.FieldName:
+		m.ClearName()
+		return nil
+	case token.FieldSymbol:
+		m.ClearSymbol()
+		return nil
+	case token.FieldCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown Token nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenMutation) ResetField(name string) error {
+	switch name {
+	case token.FieldName:
+		m.ResetName()
+		return nil
+	case token.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case token.FieldDecimals:
+		m.ResetDecimals()
+		return nil
+	case token.FieldCreator:
+		m.ResetCreator()
+		return nil
+	case token.FieldFirstSeenHeight:
+		m.ResetFirstSeenHeight()
+		return nil
+	case token.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Token unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Token edge %s", name)
+}
+
+// TokenStatMutation represents an operation that mutates the TokenStat nodes in the graph.
+type TokenStatMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	minted            *int64
+	addminted         *int64
+	burned            *int64
+	addburned         *int64
+	supply            *int64
+	addsupply         *int64
+	holders           *int
+	addholders        *int
+	transfer_count    *int
+	addtransfer_count *int
+	last_height       *int
+	addlast_height    *int
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*TokenStat, error)
+	predicates        []predicate.TokenStat
+}
+
+var _ ent.Mutation = (*TokenStatMutation)(nil)
+
+// tokenstatOption allows management of the mutation configuration using functional options.
+type tokenstatOption func(*TokenStatMutation)
+
+// newTokenStatMutation creates new mutation for the TokenStat entity.
+func newTokenStatMutation(c config, op Op, opts ...tokenstatOption) *TokenStatMutation {
+	m := &TokenStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenStatID sets the ID field of the mutation.
+func withTokenStatID(id string) tokenstatOption {
+	return func(m *TokenStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TokenStat
+		)
+		m.oldValue = func(ctx context.Context) (*TokenStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TokenStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTokenStat sets the old TokenStat of the mutation.
+func withTokenStat(node *TokenStat) tokenstatOption {
+	return func(m *TokenStatMutation) {
+		m.oldValue = func(context.Context) (*TokenStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TokenStat entities.
+func (m *TokenStatMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenStatMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenStatMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TokenStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMinted sets the "minted" field.
+func (m *TokenStatMutation) SetMinted(i int64) {
+	m.minted = &i
+	m.addminted = nil
+}
+
+// Minted returns the value of the "minted" field in the mutation.
+func (m *TokenStatMutation) Minted() (r int64, exists bool) {
+	v := m.minted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinted returns the old "minted" field's value of the TokenStat entity.
+// If the TokenStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenStatMutation) OldMinted(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinted: %w", err)
+	}
+	return oldValue.Minted, nil
+}
+
+// AddMinted adds i to the "minted" field.
+func (m *TokenStatMutation) AddMinted(i int64) {
+	if m.addminted != nil {
+		*m.addminted += i
+	} else {
+		m.addminted = &i
+	}
+}
+
+// AddedMinted returns the value that was added to the "minted" field in this mutation.
+func (m *TokenStatMutation) AddedMinted() (r int64, exists bool) {
+	v := m.addminted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinted resets all changes to the "minted" field.
+func (m *TokenStatMutation) ResetMinted() {
+	m.minted = nil
+	m.addminted = nil
+}
+
+// SetBurned sets the "burned" field.
+func (m *TokenStatMutation) SetBurned(i int64) {
+	m.burned = &i
+	m.addburned = nil
+}
+
+// Burned returns the value of the "burned" field in the mutation.
+func (m *TokenStatMutation) Burned() (r int64, exists bool) {
+	v := m.burned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurned returns the old "burned" field's value of the TokenStat entity.
+// If the TokenStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenStatMutation) OldBurned(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurned: %w", err)
+	}
+	return oldValue.Burned, nil
+}
+
+// AddBurned adds i to the "burned" field.
+func (m *TokenStatMutation) AddBurned(i int64) {
+	if m.addburned != nil {
+		*m.addburned += i
+	} else {
+		m.addburned = &i
+	}
+}
+
+// AddedBurned returns the value that was added to the "burned" field in this mutation.
+func (m *TokenStatMutation) AddedBurned() (r int64, exists bool) {
+	v := m.addburned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBurned resets all changes to the "burned" field.
+func (m *TokenStatMutation) ResetBurned() {
+	m.burned = nil
+	m.addburned = nil
+}
+
+// SetSupply sets the "supply" field.
+func (m *TokenStatMutation) SetSupply(i int64) {
+	m.supply = &i
+	m.addsupply = nil
+}
+
+// Supply returns the value of the "supply" field in the mutation.
+func (m *TokenStatMutation) Supply() (r int64, exists bool) {
+	v := m.supply
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSupply returns the old "supply" field's value of the TokenStat entity.
+// If the TokenStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenStatMutation) OldSupply(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupply is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupply requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupply: %w", err)
+	}
+	return oldValue.Supply, nil
+}
+
+// AddSupply adds i to the "supply" field.
+func (m *TokenStatMutation) AddSupply(i int64) {
+	if m.addsupply != nil {
+		*m.addsupply += i
+	} else {
+		m.addsupply = &i
+	}
+}
+
+// AddedSupply returns the value that was added to the "supply" field in this mutation.
+func (m *TokenStatMutation) AddedSupply() (r int64, exists bool) {
+	v := m.addsupply
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSupply resets all changes to the "supply" field.
+func (m *TokenStatMutation) ResetSupply() {
+	m.supply = nil
+	m.addsupply = nil
+}
+
+// SetHolders sets the "holders" field.
+func (m *TokenStatMutation) SetHolders(i int) {
+	m.holders = &i
+	m.addholders = nil
+}
+
+// Holders returns the value of the "holders" field in the mutation.
+func (m *TokenStatMutation) Holders() (r int, exists bool) {
+	v := m.holders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHolders returns the old "holders" field's value of the TokenStat entity.
+// If the TokenStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenStatMutation) OldHolders(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHolders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHolders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHolders: %w", err)
+	}
+	return oldValue.Holders, nil
+}
+
+// AddHolders adds i to the "holders" field.
+func (m *TokenStatMutation) AddHolders(i int) {
+	if m.addholders != nil {
+		*m.addholders += i
+	} else {
+		m.addholders = &i
+	}
+}
+
+// AddedHolders returns the value that was added to the "holders" field in this mutation.
+func (m *TokenStatMutation) AddedHolders() (r int, exists bool) {
+	v := m.addholders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHolders resets all changes to the "holders" field.
+func (m *TokenStatMutation) ResetHolders() {
+	m.holders = nil
+	m.addholders = nil
+}
+
+// SetTransferCount sets the "transfer_count" field.
+func (m *TokenStatMutation) SetTransferCount(i int) {
+	m.transfer_count = &i
+	m.addtransfer_count = nil
+}
+
+// TransferCount returns the value of the "transfer_count" field in the mutation.
+func (m *TokenStatMutation) TransferCount() (r int, exists bool) {
+	v := m.transfer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransferCount returns the old "transfer_count" field's value of the TokenStat entity.
+// If the TokenStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenStatMutation) OldTransferCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransferCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransferCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransferCount: %w", err)
+	}
+	return oldValue.TransferCount, nil
+}
+
+// AddTransferCount adds i to the "transfer_count" field.
+func (m *TokenStatMutation) AddTransferCount(i int) {
+	if m.addtransfer_count != nil {
+		*m.addtransfer_count += i
+	} else {
+		m.addtransfer_count = &i
+	}
+}
+
+// AddedTransferCount returns the value that was added to the "transfer_count" field in this mutation.
+func (m *TokenStatMutation) AddedTransferCount() (r int, exists bool) {
+	v := m.addtransfer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTransferCount resets all changes to the "transfer_count" field.
+func (m *TokenStatMutation) ResetTransferCount() {
+	m.transfer_count = nil
+	m.addtransfer_count = nil
+}
+
+// SetLastHeight sets the "last_height" field.
+func (m *TokenStatMutation) SetLastHeight(i int) {
+	m.last_height = &i
+	m.addlast_height = nil
+}
+
+// LastHeight returns the value of the "last_height" field in the mutation.
+func (m *TokenStatMutation) LastHeight() (r int, exists bool) {
+	v := m.last_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastHeight returns the old "last_height" field's value of the TokenStat entity.
+// If the TokenStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenStatMutation) OldLastHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastHeight: %w", err)
+	}
+	return oldValue.LastHeight, nil
+}
+
+// AddLastHeight adds i to the "last_height" field.
+func (m *TokenStatMutation) AddLastHeight(i int) {
+	if m.addlast_height != nil {
+		*m.addlast_height += i
+	} else {
+		m.addlast_height = &i
+	}
+}
+
+// AddedLastHeight returns the value that was added to the "last_height" field in this mutation.
+func (m *TokenStatMutation) AddedLastHeight() (r int, exists bool) {
+	v := m.addlast_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastHeight resets all changes to the "last_height" field.
+func (m *TokenStatMutation) ResetLastHeight() {
+	m.last_height = nil
+	m.addlast_height = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TokenStatMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TokenStatMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TokenStat entity.
+// If the TokenStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenStatMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TokenStatMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the TokenStatMutation builder.
+func (m *TokenStatMutation) Where(ps ...predicate.TokenStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TokenStatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenStat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TokenStatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TokenStatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TokenStat).
+func (m *TokenStatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenStatMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.minted != nil {
+		fields = append(fields, tokenstat.FieldMinted)
+	}
+	if m.burned != nil {
+		fields = append(fields, tokenstat.FieldBurned)
+	}
+	if m.supply != nil {
+		fields = append(fields, tokenstat.FieldSupply)
+	}
+	if m.holders != nil {
+		fields = append(fields, tokenstat.FieldHolders)
+	}
+	if m.transfer_count != nil {
+		fields = append(fields, tokenstat.FieldTransferCount)
+	}
+	if m.last_height != nil {
+		fields = append(fields, tokenstat.FieldLastHeight)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tokenstat.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenStatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tokenstat.FieldMinted:
+		return m.Minted()
+	case tokenstat.FieldBurned:
+		return m.Burned()
+	case tokenstat.FieldSupply:
+		return m.Supply()
+	case tokenstat.FieldHolders:
+		return m.Holders()
+	case tokenstat.FieldTransferCount:
+		return m.TransferCount()
+	case tokenstat.FieldLastHeight:
+		return m.LastHeight()
+	case tokenstat.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tokenstat.FieldMinted:
+		return m.OldMinted(ctx)
+	case tokenstat.FieldBurned:
+		return m.OldBurned(ctx)
+	case tokenstat.FieldSupply:
+		return m.OldSupply(ctx)
+	case tokenstat.FieldHolders:
+		return m.OldHolders(ctx)
+	case tokenstat.FieldTransferCount:
+		return m.OldTransferCount(ctx)
+	case tokenstat.FieldLastHeight:
+		return m.OldLastHeight(ctx)
+	case tokenstat.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TokenStat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenStatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tokenstat.FieldMinted:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinted(v)
+		return nil
+	case tokenstat.FieldBurned:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurned(v)
+		return nil
+	case tokenstat.FieldSupply:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSupply(v)
+		return nil
+	case tokenstat.FieldHolders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolders(v)
+		return nil
+	case tokenstat.FieldTransferCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransferCount(v)
+		return nil
+	case tokenstat.FieldLastHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastHeight(v)
+		return nil
+	case tokenstat.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TokenStat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenStatMutation) AddedFields() []string {
+	var fields []string
+	if m.addminted != nil {
+		fields = append(fields, tokenstat.FieldMinted)
+	}
+	if m.addburned != nil {
+		fields = append(fields, tokenstat.FieldBurned)
+	}
+	if m.addsupply != nil {
+		fields = append(fields, tokenstat.FieldSupply)
+	}
+	if m.addholders != nil {
+		fields = append(fields, tokenstat.FieldHolders)
+	}
+	if m.addtransfer_count != nil {
+		fields = append(fields, tokenstat.FieldTransferCount)
+	}
+	if m.addlast_height != nil {
+		fields = append(fields, tokenstat.FieldLastHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenStatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tokenstat.FieldMinted:
+		return m.AddedMinted()
+	case tokenstat.FieldBurned:
+		return m.AddedBurned()
+	case tokenstat.FieldSupply:
+		return m.AddedSupply()
+	case tokenstat.FieldHolders:
+		return m.AddedHolders()
+	case tokenstat.FieldTransferCount:
+		return m.AddedTransferCount()
+	case tokenstat.FieldLastHeight:
+		return m.AddedLastHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenStatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tokenstat.FieldMinted:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinted(v)
+		return nil
+	case tokenstat.FieldBurned:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBurned(v)
+		return nil
+	case tokenstat.FieldSupply:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSupply(v)
+		return nil
+	case tokenstat.FieldHolders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHolders(v)
+		return nil
+	case tokenstat.FieldTransferCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTransferCount(v)
+		return nil
+	case tokenstat.FieldLastHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TokenStat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenStatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenStatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TokenStat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenStatMutation) ResetField(name string) error {
+	switch name {
+	case tokenstat.FieldMinted:
+		m.ResetMinted()
+		return nil
+	case tokenstat.FieldBurned:
+		m.ResetBurned()
+		return nil
+	case tokenstat.FieldSupply:
+		m.ResetSupply()
+		return nil
+	case tokenstat.FieldHolders:
+		m.ResetHolders()
+		return nil
+	case tokenstat.FieldTransferCount:
+		m.ResetTransferCount()
+		return nil
+	case tokenstat.FieldLastHeight:
+		m.ResetLastHeight()
+		return nil
+	case tokenstat.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenStat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenStatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenStatMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenStatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenStatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenStatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenStatMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenStatMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TokenStat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenStatMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TokenStat edge %s", name)
+}
+
+// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
+type TransactionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	index           *int
+	addindex        *int
+	hash            *string
+	success         *bool
+	block_height    *int
+	addblock_height *int
+	gas_wanted      *float64
+	addgas_wanted   *float64
+	gas_used        *float64
+	addgas_used     *float64
+	memo            *string
+	gas_fee         *schema.GasFee
+	messages        *[]schema.Message
+	appendmessages  []schema.Message
+	response        *schema.Response
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	block           *int
+	clearedblock    bool
+	done            bool
+	oldValue        func(context.Context) (*Transaction, error)
+	predicates      []predicate.Transaction
+}
+
+var _ ent.Mutation = (*TransactionMutation)(nil)
+
+// transactionOption allows management of the mutation configuration using functional options.
+type transactionOption func(*TransactionMutation)
+
+// newTransactionMutation creates new mutation for the Transaction entity.
+func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
+	m := &TransactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransactionID sets the ID field of the mutation.
+func withTransactionID(id int) transactionOption {
+	return func(m *TransactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transaction
+		)
+		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransaction sets the old Transaction of the mutation.
+func withTransaction(node *Transaction) transactionOption {
+	return func(m *TransactionMutation) {
+		m.oldValue = func(context.Context) (*Transaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransactionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIndex sets the "index" field.
+func (m *TransactionMutation) SetIndex(i int) {
+	m.index = &i
+	m.addindex = nil
+}
+
+// Index returns the value of the "index" field in the mutation.
+func (m *TransactionMutation) Index() (r int, exists bool) {
+	v := m.index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndex returns the old "index" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+	}
+	return oldValue.Index, nil
+}
+
+// AddIndex adds i to the "index" field.
+func (m *TransactionMutation) AddIndex(i int) {
+	if m.addindex != nil {
+		*m.addindex += i
+	} else {
+		m.addindex = &i
+	}
+}
+
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *TransactionMutation) AddedIndex() (r int, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIndex resets all changes to the "index" field.
+func (m *TransactionMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *TransactionMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *TransactionMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *TransactionMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetSuccess sets the "success" field.
+func (m *TransactionMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *TransactionMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *TransactionMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (m *TransactionMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *TransactionMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHeight returns the old "block_height" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
+}
+
+// AddBlockHeight adds i to the "block_height" field.
+func (m *TransactionMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
+	} else {
+		m.addblock_height = &i
+	}
+}
+
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *TransactionMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *TransactionMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
+}
+
+// SetGasWanted sets the "gas_wanted" field.
+func (m *TransactionMutation) SetGasWanted(f float64) {
+	m.gas_wanted = &f
+	m.addgas_wanted = nil
+}
+
+// GasWanted returns the value of the "gas_wanted" field in the mutation.
+func (m *TransactionMutation) GasWanted() (r float64, exists bool) {
+	v := m.gas_wanted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGasWanted returns the old "gas_wanted" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldGasWanted(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGasWanted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGasWanted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGasWanted: %w", err)
+	}
+	return oldValue.GasWanted, nil
+}
+
+// AddGasWanted adds f to the "gas_wanted" field.
+func (m *TransactionMutation) AddGasWanted(f float64) {
+	if m.addgas_wanted != nil {
+		*m.addgas_wanted += f
+	} else {
+		m.addgas_wanted = &f
+	}
+}
+
+// AddedGasWanted returns the value that was added to the "gas_wanted" field in this mutation.
+func (m *TransactionMutation) AddedGasWanted() (r float64, exists bool) {
+	v := m.addgas_wanted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGasWanted resets all changes to the "gas_wanted" field.
+func (m *TransactionMutation) ResetGasWanted() {
+	m.gas_wanted = nil
+	m.addgas_wanted = nil
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (m *TransactionMutation) SetGasUsed(f float64) {
+	m.gas_used = &f
+	m.addgas_used = nil
+}
+
+// GasUsed returns the value of the "gas_used" field in the mutation.
+func (m *TransactionMutation) GasUsed() (r float64, exists bool) {
+	v := m.gas_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGasUsed returns the old "gas_used" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldGasUsed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGasUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGasUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGasUsed: %w", err)
+	}
+	return oldValue.GasUsed, nil
+}
+
+// AddGasUsed adds f to the "gas_used" field.
+func (m *TransactionMutation) AddGasUsed(f float64) {
+	if m.addgas_used != nil {
+		*m.addgas_used += f
+	} else {
+		m.addgas_used = &f
+	}
+}
+
+// AddedGasUsed returns the value that was added to the "gas_used" field in this mutation.
+func (m *TransactionMutation) AddedGasUsed() (r float64, exists bool) {
+	v := m.addgas_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGasUsed resets all changes to the "gas_used" field.
+func (m *TransactionMutation) ResetGasUsed() {
+	m.gas_used = nil
+	m.addgas_used = nil
+}
+
+// SetMemo sets the "memo" field.
+func (m *TransactionMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *TransactionMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
 // ClearMemo clears the value of the "memo" field.
 func (m *TransactionMutation) ClearMemo() {
 	m.memo = nil
 	m.clearedFields[transaction.FieldMemo] = struct{}{}
 }
 
-// MemoCleared returns if the "memo" field was cleared in this mutation.
-func (m *TransactionMutation) MemoCleared() bool {
-	_, ok := m.clearedFields[transaction.FieldMemo]
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *TransactionMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *TransactionMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, transaction.FieldMemo)
+}
+
+// SetGasFee sets the "gas_fee" field.
+func (m *TransactionMutation) SetGasFee(sf schema.GasFee) {
+	m.gas_fee = &sf
+}
+
+// GasFee returns the value of the "gas_fee" field in the mutation.
+func (m *TransactionMutation) GasFee() (r schema.GasFee, exists bool) {
+	v := m.gas_fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGasFee returns the old "gas_fee" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldGasFee(ctx context.Context) (v schema.GasFee, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGasFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGasFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGasFee: %w", err)
+	}
+	return oldValue.GasFee, nil
+}
+
+// ClearGasFee clears the value of the "gas_fee" field.
+func (m *TransactionMutation) ClearGasFee() {
+	m.gas_fee = nil
+	m.clearedFields[transaction.FieldGasFee] = struct{}{}
+}
+
+// GasFeeCleared returns if the "gas_fee" field was cleared in this mutation.
+func (m *TransactionMutation) GasFeeCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldGasFee]
+	return ok
+}
+
+// ResetGasFee resets all changes to the "gas_fee" field.
+func (m *TransactionMutation) ResetGasFee() {
+	m.gas_fee = nil
+	delete(m.clearedFields, transaction.FieldGasFee)
+}
+
+// SetMessages sets the "messages" field.
+func (m *TransactionMutation) SetMessages(s []schema.Message) {
+	m.messages = &s
+	m.appendmessages = nil
+}
+
+// Messages returns the value of the "messages" field in the mutation.
+func (m *TransactionMutation) Messages() (r []schema.Message, exists bool) {
+	v := m.messages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessages returns the old "messages" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldMessages(ctx context.Context) (v []schema.Message, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessages: %w", err)
+	}
+	return oldValue.Messages, nil
+}
+
+// AppendMessages adds s to the "messages" field.
+func (m *TransactionMutation) AppendMessages(s []schema.Message) {
+	m.appendmessages = append(m.appendmessages, s...)
+}
+
+// AppendedMessages returns the list of values that were appended to the "messages" field in this mutation.
+func (m *TransactionMutation) AppendedMessages() ([]schema.Message, bool) {
+	if len(m.appendmessages) == 0 {
+		return nil, false
+	}
+	return m.appendmessages, true
+}
+
+// ClearMessages clears the value of the "messages" field.
+func (m *TransactionMutation) ClearMessages() {
+	m.messages = nil
+	m.appendmessages = nil
+	m.clearedFields[transaction.FieldMessages] = struct{}{}
+}
+
+// MessagesCleared returns if the "messages" field was cleared in this mutation.
+func (m *TransactionMutation) MessagesCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldMessages]
+	return ok
+}
+
+// ResetMessages resets all changes to the "messages" field.
+func (m *TransactionMutation) ResetMessages() {
+	m.messages = nil
+	m.appendmessages = nil
+	delete(m.clearedFields, transaction.FieldMessages)
+}
+
+// SetResponse sets the "response" field.
+func (m *TransactionMutation) SetResponse(s schema.Response) {
+	m.response = &s
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *TransactionMutation) Response() (r schema.Response, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldResponse(ctx context.Context) (v schema.Response, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ClearResponse clears the value of the "response" field.
+func (m *TransactionMutation) ClearResponse() {
+	m.response = nil
+	m.clearedFields[transaction.FieldResponse] = struct{}{}
+}
+
+// ResponseCleared returns if the "response" field was cleared in this mutation.
+func (m *TransactionMutation) ResponseCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldResponse]
+	return ok
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *TransactionMutation) ResetResponse() {
+	m.response = nil
+	delete(m.clearedFields, transaction.FieldResponse)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TransactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TransactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetBlockID sets the "block" edge to the Block entity by id.
+func (m *TransactionMutation) SetBlockID(id int) {
+	m.block = &id
+}
+
+// ClearBlock clears the "block" edge to the Block entity.
+func (m *TransactionMutation) ClearBlock() {
+	m.clearedblock = true
+}
+
+// BlockCleared reports if the "block" edge to the Block entity was cleared.
+func (m *TransactionMutation) BlockCleared() bool {
+	return m.clearedblock
+}
+
+// BlockID returns the "block" edge ID in the mutation.
+func (m *TransactionMutation) BlockID() (id int, exists bool) {
+	if m.block != nil {
+		return *m.block, true
+	}
+	return
+}
+
+// BlockIDs returns the "block" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BlockID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) BlockIDs() (ids []int) {
+	if id := m.block; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBlock resets all changes to the "block" edge.
+func (m *TransactionMutation) ResetBlock() {
+	m.block = nil
+	m.clearedblock = false
+}
+
+// Where appends a list predicates to the TransactionMutation builder.
+func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Transaction).
+func (m *TransactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransactionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.index != nil {
+		fields = append(fields, transaction.FieldIndex)
+	}
+	if m.hash != nil {
+		fields = append(fields, transaction.FieldHash)
+	}
+	if m.success != nil {
+		fields = append(fields, transaction.FieldSuccess)
+	}
+	if m.block_height != nil {
+		fields = append(fields, transaction.FieldBlockHeight)
+	}
+	if m.gas_wanted != nil {
+		fields = append(fields, transaction.FieldGasWanted)
+	}
+	if m.gas_used != nil {
+		fields = append(fields, transaction.FieldGasUsed)
+	}
+	if m.memo != nil {
+		fields = append(fields, transaction.FieldMemo)
+	}
+	if m.gas_fee != nil {
+		fields = append(fields, transaction.FieldGasFee)
+	}
+	if m.messages != nil {
+		fields = append(fields, transaction.FieldMessages)
+	}
+	if m.response != nil {
+		fields = append(fields, transaction.FieldResponse)
+	}
+	if m.created_at != nil {
+		fields = append(fields, transaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transaction.FieldIndex:
+		return m.Index()
+	case transaction.FieldHash:
+		return m.Hash()
+	case transaction.FieldSuccess:
+		return m.Success()
+	case transaction.FieldBlockHeight:
+		return m.BlockHeight()
+	case transaction.FieldGasWanted:
+		return m.GasWanted()
+	case transaction.FieldGasUsed:
+		return m.GasUsed()
+	case transaction.FieldMemo:
+		return m.Memo()
+	case transaction.FieldGasFee:
+		return m.GasFee()
+	case transaction.FieldMessages:
+		return m.Messages()
+	case transaction.FieldResponse:
+		return m.Response()
+	case transaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transaction.FieldIndex:
+		return m.OldIndex(ctx)
+	case transaction.FieldHash:
+		return m.OldHash(ctx)
+	case transaction.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case transaction.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case transaction.FieldGasWanted:
+		return m.OldGasWanted(ctx)
+	case transaction.FieldGasUsed:
+		return m.OldGasUsed(ctx)
+	case transaction.FieldMemo:
+		return m.OldMemo(ctx)
+	case transaction.FieldGasFee:
+		return m.OldGasFee(ctx)
+	case transaction.FieldMessages:
+		return m.OldMessages(ctx)
+	case transaction.FieldResponse:
+		return m.OldResponse(ctx)
+	case transaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transaction.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case transaction.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case transaction.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case transaction.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case transaction.FieldGasWanted:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGasWanted(v)
+		return nil
+	case transaction.FieldGasUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGasUsed(v)
+		return nil
+	case transaction.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	case transaction.FieldGasFee:
+		v, ok := value.(schema.GasFee)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGasFee(v)
+		return nil
+	case transaction.FieldMessages:
+		v, ok := value.([]schema.Message)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessages(v)
+		return nil
+	case transaction.FieldResponse:
+		v, ok := value.(schema.Response)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	case transaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransactionMutation) AddedFields() []string {
+	var fields []string
+	if m.addindex != nil {
+		fields = append(fields, transaction.FieldIndex)
+	}
+	if m.addblock_height != nil {
+		fields = append(fields, transaction.FieldBlockHeight)
+	}
+	if m.addgas_wanted != nil {
+		fields = append(fields, transaction.FieldGasWanted)
+	}
+	if m.addgas_used != nil {
+		fields = append(fields, transaction.FieldGasUsed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case transaction.FieldIndex:
+		return m.AddedIndex()
+	case transaction.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case transaction.FieldGasWanted:
+		return m.AddedGasWanted()
+	case transaction.FieldGasUsed:
+		return m.AddedGasUsed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case transaction.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIndex(v)
+		return nil
+	case transaction.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	case transaction.FieldGasWanted:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGasWanted(v)
+		return nil
+	case transaction.FieldGasUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGasUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransactionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transaction.FieldMemo) {
+		fields = append(fields, transaction.FieldMemo)
+	}
+	if m.FieldCleared(transaction.FieldGasFee) {
+		fields = append(fields, transaction.FieldGasFee)
+	}
+	if m.FieldCleared(transaction.FieldMessages) {
+		fields = append(fields, transaction.FieldMessages)
+	}
+	if m.FieldCleared(transaction.FieldResponse) {
+		fields = append(fields, transaction.FieldResponse)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransactionMutation) ClearField(name string) error {
+	switch name {
+	case transaction.FieldMemo:
+		m.ClearMemo()
+		return nil
+	case transaction.FieldGasFee:
+		m.ClearGasFee()
+		return nil
+	case transaction.FieldMessages:
+		m.ClearMessages()
+		return nil
+	case transaction.FieldResponse:
+		m.ClearResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransactionMutation) ResetField(name string) error {
+	switch name {
+	case transaction.FieldIndex:
+		m.ResetIndex()
+		return nil
+	case transaction.FieldHash:
+		m.ResetHash()
+		return nil
+	case transaction.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case transaction.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case transaction.FieldGasWanted:
+		m.ResetGasWanted()
+		return nil
+	case transaction.FieldGasUsed:
+		m.ResetGasUsed()
+		return nil
+	case transaction.FieldMemo:
+		m.ResetMemo()
+		return nil
+	case transaction.FieldGasFee:
+		m.ResetGasFee()
+		return nil
+	case transaction.FieldMessages:
+		m.ResetMessages()
+		return nil
+	case transaction.FieldResponse:
+		m.ResetResponse()
+		return nil
+	case transaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.block != nil {
+		edges = append(edges, transaction.EdgeBlock)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transaction.EdgeBlock:
+		if id := m.block; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedblock {
+		edges = append(edges, transaction.EdgeBlock)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transaction.EdgeBlock:
+		return m.clearedblock
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransactionMutation) ClearEdge(name string) error {
+	switch name {
+	case transaction.EdgeBlock:
+		m.ClearBlock()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransactionMutation) ResetEdge(name string) error {
+	switch name {
+	case transaction.EdgeBlock:
+		m.ResetBlock()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction edge %s", name)
+}
+
+// TransferMutation represents an operation that mutates the Transfer nodes in the graph.
+type TransferMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	hash            *string
+	_func           *string
+	from_address    *string
+	to_address      *string
+	token           *string
+	amount          *float64
+	addamount       *float64
+	denom           *string
+	block_height    *int
+	addblock_height *int
+	tx_index        *int
+	addtx_index     *int
+	msg_index       *int
+	addmsg_index    *int
+	event_index     *int
+	addevent_index  *int
+	block_time      *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Transfer, error)
+	predicates      []predicate.Transfer
+}
+
+var _ ent.Mutation = (*TransferMutation)(nil)
+
+// transferOption allows management of the mutation configuration using functional options.
+type transferOption func(*TransferMutation)
+
+// newTransferMutation creates new mutation for the Transfer entity.
+func newTransferMutation(c config, op Op, opts ...transferOption) *TransferMutation {
+	m := &TransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransferID sets the ID field of the mutation.
+func withTransferID(id int) transferOption {
+	return func(m *TransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transfer
+		)
+		m.oldValue = func(ctx context.Context) (*Transfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransfer sets the old Transfer of the mutation.
+func withTransfer(node *Transfer) transferOption {
+	return func(m *TransferMutation) {
+		m.oldValue = func(context.Context) (*Transfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Transfer entities.
+func (m *TransferMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransferMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransferMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *TransferMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *TransferMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *TransferMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetFunc sets the "func" field.
+func (m *TransferMutation) SetFunc(s string) {
+	m._func = &s
+}
+
+// Func returns the value of the "func" field in the mutation.
+func (m *TransferMutation) Func() (r string, exists bool) {
+	v := m._func
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFunc returns the old "func" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldFunc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFunc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFunc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFunc: %w", err)
+	}
+	return oldValue.Func, nil
+}
+
+// ResetFunc resets all changes to the "func" field.
+func (m *TransferMutation) ResetFunc() {
+	m._func = nil
+}
+
+// SetFromAddress sets the "from_address" field.
+func (m *TransferMutation) SetFromAddress(s string) {
+	m.from_address = &s
+}
+
+// FromAddress returns the value of the "from_address" field in the mutation.
+func (m *TransferMutation) FromAddress() (r string, exists bool) {
+	v := m.from_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromAddress returns the old "from_address" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldFromAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromAddress: %w", err)
+	}
+	return oldValue.FromAddress, nil
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (m *TransferMutation) ClearFromAddress() {
+	m.from_address = nil
+	m.clearedFields[transfer.FieldFromAddress] = struct{}{}
+}
+
+// FromAddressCleared returns if the "from_address" field was cleared in this mutation.
+func (m *TransferMutation) FromAddressCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldFromAddress]
+	return ok
+}
+
+// ResetFromAddress resets all changes to the "from_address" field.
+func (m *TransferMutation) ResetFromAddress() {
+	m.from_address = nil
+	delete(m.clearedFields, transfer.FieldFromAddress)
+}
+
+// SetToAddress sets the "to_address" field.
+func (m *TransferMutation) SetToAddress(s string) {
+	m.to_address = &s
+}
+
+// ToAddress returns the value of the "to_address" field in the mutation.
+func (m *TransferMutation) ToAddress() (r string, exists bool) {
+	v := m.to_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToAddress returns the old "to_address" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldToAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToAddress: %w", err)
+	}
+	return oldValue.ToAddress, nil
+}
+
+// ClearToAddress clears the value of the "to_address" field.
+func (m *TransferMutation) ClearToAddress() {
+	m.to_address = nil
+	m.clearedFields[transfer.FieldToAddress] = struct{}{}
+}
+
+// ToAddressCleared returns if the "to_address" field was cleared in this mutation.
+func (m *TransferMutation) ToAddressCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldToAddress]
+	return ok
+}
+
+// ResetToAddress resets all changes to the "to_address" field.
+func (m *TransferMutation) ResetToAddress() {
+	m.to_address = nil
+	delete(m.clearedFields, transfer.FieldToAddress)
+}
+
+// SetToken sets the "token" field.
+func (m *TransferMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *TransferMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *TransferMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *TransferMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *TransferMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *TransferMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *TransferMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *TransferMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetDenom sets the "denom" field.
+func (m *TransferMutation) SetDenom(s string) {
+	m.denom = &s
+}
+
+// Denom returns the value of the "denom" field in the mutation.
+func (m *TransferMutation) Denom() (r string, exists bool) {
+	v := m.denom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDenom returns the old "denom" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldDenom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDenom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDenom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDenom: %w", err)
+	}
+	return oldValue.Denom, nil
+}
+
+// ResetDenom resets all changes to the "denom" field.
+func (m *TransferMutation) ResetDenom() {
+	m.denom = nil
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (m *TransferMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *TransferMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHeight returns the old "block_height" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
+}
+
+// AddBlockHeight adds i to the "block_height" field.
+func (m *TransferMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
+	} else {
+		m.addblock_height = &i
+	}
+}
+
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *TransferMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *TransferMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (m *TransferMutation) SetTxIndex(i int) {
+	m.tx_index = &i
+	m.addtx_index = nil
+}
+
+// TxIndex returns the value of the "tx_index" field in the mutation.
+func (m *TransferMutation) TxIndex() (r int, exists bool) {
+	v := m.tx_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxIndex returns the old "tx_index" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldTxIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxIndex: %w", err)
+	}
+	return oldValue.TxIndex, nil
+}
+
+// AddTxIndex adds i to the "tx_index" field.
+func (m *TransferMutation) AddTxIndex(i int) {
+	if m.addtx_index != nil {
+		*m.addtx_index += i
+	} else {
+		m.addtx_index = &i
+	}
+}
+
+// AddedTxIndex returns the value that was added to the "tx_index" field in this mutation.
+func (m *TransferMutation) AddedTxIndex() (r int, exists bool) {
+	v := m.addtx_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTxIndex resets all changes to the "tx_index" field.
+func (m *TransferMutation) ResetTxIndex() {
+	m.tx_index = nil
+	m.addtx_index = nil
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (m *TransferMutation) SetMsgIndex(i int) {
+	m.msg_index = &i
+	m.addmsg_index = nil
+}
+
+// MsgIndex returns the value of the "msg_index" field in the mutation.
+func (m *TransferMutation) MsgIndex() (r int, exists bool) {
+	v := m.msg_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgIndex returns the old "msg_index" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldMsgIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgIndex: %w", err)
+	}
+	return oldValue.MsgIndex, nil
+}
+
+// AddMsgIndex adds i to the "msg_index" field.
+func (m *TransferMutation) AddMsgIndex(i int) {
+	if m.addmsg_index != nil {
+		*m.addmsg_index += i
+	} else {
+		m.addmsg_index = &i
+	}
+}
+
+// AddedMsgIndex returns the value that was added to the "msg_index" field in this mutation.
+func (m *TransferMutation) AddedMsgIndex() (r int, exists bool) {
+	v := m.addmsg_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMsgIndex resets all changes to the "msg_index" field.
+func (m *TransferMutation) ResetMsgIndex() {
+	m.msg_index = nil
+	m.addmsg_index = nil
+}
+
+// SetEventIndex sets the "event_index" field.
+func (m *TransferMutation) SetEventIndex(i int) {
+	m.event_index = &i
+	m.addevent_index = nil
+}
+
+// EventIndex returns the value of the "event_index" field in the mutation.
+func (m *TransferMutation) EventIndex() (r int, exists bool) {
+	v := m.event_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventIndex returns the old "event_index" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldEventIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventIndex: %w", err)
+	}
+	return oldValue.EventIndex, nil
+}
+
+// AddEventIndex adds i to the "event_index" field.
+func (m *TransferMutation) AddEventIndex(i int) {
+	if m.addevent_index != nil {
+		*m.addevent_index += i
+	} else {
+		m.addevent_index = &i
+	}
+}
+
+// AddedEventIndex returns the value that was added to the "event_index" field in this mutation.
+func (m *TransferMutation) AddedEventIndex() (r int, exists bool) {
+	v := m.addevent_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventIndex resets all changes to the "event_index" field.
+func (m *TransferMutation) ResetEventIndex() {
+	m.event_index = nil
+	m.addevent_index = nil
+}
+
+// SetBlockTime sets the "block_time" field.
+func (m *TransferMutation) SetBlockTime(t time.Time) {
+	m.block_time = &t
+}
+
+// BlockTime returns the value of the "block_time" field in the mutation.
+func (m *TransferMutation) BlockTime() (r time.Time, exists bool) {
+	v := m.block_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockTime returns the old "block_time" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldBlockTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockTime: %w", err)
+	}
+	return oldValue.BlockTime, nil
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (m *TransferMutation) ClearBlockTime() {
+	m.block_time = nil
+	m.clearedFields[transfer.FieldBlockTime] = struct{}{}
+}
+
+// BlockTimeCleared returns if the "block_time" field was cleared in this mutation.
+func (m *TransferMutation) BlockTimeCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldBlockTime]
+	return ok
+}
+
+// ResetBlockTime resets all changes to the "block_time" field.
+func (m *TransferMutation) ResetBlockTime() {
+	m.block_time = nil
+	delete(m.clearedFields, transfer.FieldBlockTime)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TransferMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TransferMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TransferMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TransferMutation builder.
+func (m *TransferMutation) Where(ps ...predicate.Transfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Transfer).
+func (m *TransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransferMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.hash != nil {
+		fields = append(fields, transfer.FieldHash)
+	}
+	if m._func != nil {
+		fields = append(fields, transfer.FieldFunc)
+	}
+	if m.from_address != nil {
+		fields = append(fields, transfer.FieldFromAddress)
+	}
+	if m.to_address != nil {
+		fields = append(fields, transfer.FieldToAddress)
+	}
+	if m.token != nil {
+		fields = append(fields, transfer.FieldToken)
+	}
+	if m.amount != nil {
+		fields = append(fields, transfer.FieldAmount)
+	}
+	if m.denom != nil {
+		fields = append(fields, transfer.FieldDenom)
+	}
+	if m.block_height != nil {
+		fields = append(fields, transfer.FieldBlockHeight)
+	}
+	if m.tx_index != nil {
+		fields = append(fields, transfer.FieldTxIndex)
+	}
+	if m.msg_index != nil {
+		fields = append(fields, transfer.FieldMsgIndex)
+	}
+	if m.event_index != nil {
+		fields = append(fields, transfer.FieldEventIndex)
+	}
+	if m.block_time != nil {
+		fields = append(fields, transfer.FieldBlockTime)
+	}
+	if m.created_at != nil {
+		fields = append(fields, transfer.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transfer.FieldHash:
+		return m.Hash()
+	case transfer.FieldFunc:
+		return m.Func()
+	case transfer.FieldFromAddress:
+		return m.FromAddress()
+	case transfer.FieldToAddress:
+		return m.ToAddress()
+	case transfer.FieldToken:
+		return m.Token()
+	case transfer.FieldAmount:
+		return m.Amount()
+	case transfer.FieldDenom:
+		return m.Denom()
+	case transfer.FieldBlockHeight:
+		return m.BlockHeight()
+	case transfer.FieldTxIndex:
+		return m.TxIndex()
+	case transfer.FieldMsgIndex:
+		return m.MsgIndex()
+	case transfer.FieldEventIndex:
+		return m.EventIndex()
+	case transfer.FieldBlockTime:
+		return m.BlockTime()
+	case transfer.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transfer.FieldHash:
+		return m.OldHash(ctx)
+	case transfer.FieldFunc:
+		return m.OldFunc(ctx)
+	case transfer.FieldFromAddress:
+		return m.OldFromAddress(ctx)
+	case transfer.FieldToAddress:
+		return m.OldToAddress(ctx)
+	case transfer.FieldToken:
+		return m.OldToken(ctx)
+	case transfer.FieldAmount:
+		return m.OldAmount(ctx)
+	case transfer.FieldDenom:
+		return m.OldDenom(ctx)
+	case transfer.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case transfer.FieldTxIndex:
+		return m.OldTxIndex(ctx)
+	case transfer.FieldMsgIndex:
+		return m.OldMsgIndex(ctx)
+	case transfer.FieldEventIndex:
+		return m.OldEventIndex(ctx)
+	case transfer.FieldBlockTime:
+		return m.OldBlockTime(ctx)
+	case transfer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transfer.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case transfer.FieldFunc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFunc(v)
+		return nil
+	case transfer.FieldFromAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromAddress(v)
+		return nil
+	case transfer.FieldToAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToAddress(v)
+		return nil
+	case transfer.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case transfer.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case transfer.FieldDenom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDenom(v)
+		return nil
+	case transfer.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case transfer.FieldTxIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxIndex(v)
+		return nil
+	case transfer.FieldMsgIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgIndex(v)
+		return nil
+	case transfer.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventIndex(v)
+		return nil
+	case transfer.FieldBlockTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockTime(v)
+		return nil
+	case transfer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransferMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, transfer.FieldAmount)
+	}
+	if m.addblock_height != nil {
+		fields = append(fields, transfer.FieldBlockHeight)
+	}
+	if m.addtx_index != nil {
+		fields = append(fields, transfer.FieldTxIndex)
+	}
+	if m.addmsg_index != nil {
+		fields = append(fields, transfer.FieldMsgIndex)
+	}
+	if m.addevent_index != nil {
+		fields = append(fields, transfer.FieldEventIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case transfer.FieldAmount:
+		return m.AddedAmount()
+	case transfer.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case transfer.FieldTxIndex:
+		return m.AddedTxIndex()
+	case transfer.FieldMsgIndex:
+		return m.AddedMsgIndex()
+	case transfer.FieldEventIndex:
+		return m.AddedEventIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case transfer.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case transfer.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	case transfer.FieldTxIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTxIndex(v)
+		return nil
+	case transfer.FieldMsgIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMsgIndex(v)
+		return nil
+	case transfer.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transfer.FieldFromAddress) {
+		fields = append(fields, transfer.FieldFromAddress)
+	}
+	if m.FieldCleared(transfer.FieldToAddress) {
+		fields = append(fields, transfer.FieldToAddress)
+	}
+	if m.FieldCleared(transfer.FieldBlockTime) {
+		fields = append(fields, transfer.FieldBlockTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransferMutation) ClearField(name string) error {
+	switch name {
+	case transfer.FieldFromAddress:
+		m.ClearFromAddress()
+		return nil
+	case transfer.FieldToAddress:
+		m.ClearToAddress()
+		return nil
+	case transfer.FieldBlockTime:
+		m.ClearBlockTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransferMutation) ResetField(name string) error {
+	switch name {
+	case transfer.FieldHash:
+		m.ResetHash()
+		return nil
+	case transfer.FieldFunc:
+		m.ResetFunc()
+		return nil
+	case transfer.FieldFromAddress:
+		m.ResetFromAddress()
+		return nil
+	case transfer.FieldToAddress:
+		m.ResetToAddress()
+		return nil
+	case transfer.FieldToken:
+		m.ResetToken()
+		return nil
+	case transfer.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case transfer.FieldDenom:
+		m.ResetDenom()
+		return nil
+	case transfer.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case transfer.FieldTxIndex:
+		m.ResetTxIndex()
+		return nil
+	case transfer.FieldMsgIndex:
+		m.ResetMsgIndex()
+		return nil
+	case transfer.FieldEventIndex:
+		m.ResetEventIndex()
+		return nil
+	case transfer.FieldBlockTime:
+		m.ResetBlockTime()
+		return nil
+	case transfer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransferMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransferMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransferMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransferMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Transfer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransferMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Transfer edge %s", name)
+}
+
+// WatchRuleMutation represents an operation that mutates the WatchRule nodes in the graph.
+type WatchRuleMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	url               *string
+	secret            *string
+	owner_hash        *string
+	address           *string
+	token             *string
+	min_amount        *float64
+	addmin_amount     *float64
+	event_type        *string
+	active            *bool
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	deliveries        map[int]struct{}
+	removeddeliveries map[int]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*WatchRule, error)
+	predicates        []predicate.WatchRule
+}
+
+var _ ent.Mutation = (*WatchRuleMutation)(nil)
+
+// watchruleOption allows management of the mutation configuration using functional options.
+type watchruleOption func(*WatchRuleMutation)
+
+// newWatchRuleMutation creates new mutation for the WatchRule entity.
+func newWatchRuleMutation(c config, op Op, opts ...watchruleOption) *WatchRuleMutation {
+	m := &WatchRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWatchRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWatchRuleID sets the ID field of the mutation.
+func withWatchRuleID(id int) watchruleOption {
+	return func(m *WatchRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WatchRule
+		)
+		m.oldValue = func(ctx context.Context) (*WatchRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WatchRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWatchRule sets the old WatchRule of the mutation.
+func withWatchRule(node *WatchRule) watchruleOption {
+	return func(m *WatchRuleMutation) {
+		m.oldValue = func(context.Context) (*WatchRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WatchRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WatchRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WatchRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WatchRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WatchRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetURL sets the "url" field.
+func (m *WatchRuleMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WatchRuleMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchRuleMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WatchRuleMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WatchRuleMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WatchRuleMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchRuleMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WatchRuleMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetOwnerHash sets the "owner_hash" field.
+func (m *WatchRuleMutation) SetOwnerHash(s string) {
+	m.owner_hash = &s
+}
+
+// OwnerHash returns the value of the "owner_hash" field in the mutation.
+func (m *WatchRuleMutation) OwnerHash() (r string, exists bool) {
+	v := m.owner_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerHash returns the old "owner_hash" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchRuleMutation) OldOwnerHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerHash: %w", err)
+	}
+	return oldValue.OwnerHash, nil
+}
+
+// ClearOwnerHash clears the value of the "owner_hash" field.
+func (m *WatchRuleMutation) ClearOwnerHash() {
+	m.owner_hash = nil
+	m.clearedFields[watchrule.FieldOwnerHash] = struct{}{}
+}
+
+// OwnerHashCleared returns if the "owner_hash" field was cleared in this mutation.
+func (m *WatchRuleMutation) OwnerHashCleared() bool {
+	_, ok := m.clearedFields[watchrule.FieldOwnerHash]
 	return ok
 }
 
-// ResetMemo resets all changes to the "memo" field.
-func (m *TransactionMutation) ResetMemo() {
-	m.memo = nil
-	delete(m.clearedFields, transaction.FieldMemo)
+// ResetOwnerHash resets all changes to the "owner_hash" field.
+func (m *WatchRuleMutation) ResetOwnerHash() {
+	m.owner_hash = nil
+	delete(m.clearedFields, watchrule.FieldOwnerHash)
 }
 
-// SetGasFee sets the "gas_fee" field.
-func (m *TransactionMutation) SetGasFee(sf schema.GasFee) {
-	m.gas_fee = &sf
+// SetAddress sets the "address" field.
+func (m *WatchRuleMutation) SetAddress(s string) {
+	m.address = &s
 }
 
-// GasFee returns the value of the "gas_fee" field in the mutation.
-func (m *TransactionMutation) GasFee() (r schema.GasFee, exists bool) {
-	v := m.gas_fee
+// Address returns the value of the "address" field in the mutation.
+func (m *WatchRuleMutation) Address() (r string, exists bool) {
+	v := m.address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGasFee returns the old "gas_fee" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldAddress returns the old "address" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldGasFee(ctx context.Context) (v schema.GasFee, err error) {
+func (m *WatchRuleMutation) OldAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGasFee is only allowed on UpdateOne operations")
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGasFee requires an ID field in the mutation")
+		return v, errors.New("OldAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGasFee: %w", err)
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
 	}
-	return oldValue.GasFee, nil
+	return oldValue.Address, nil
 }
 
-// ClearGasFee clears the value of the "gas_fee" field.
-func (m *TransactionMutation) ClearGasFee() {
-	m.gas_fee = nil
-	m.clearedFields[transaction.FieldGasFee] = struct{}{}
+// ClearAddress clears the value of the "address" field.
+func (m *WatchRuleMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[watchrule.FieldAddress] = struct{}{}
 }
 
-// GasFeeCleared returns if the "gas_fee" field was cleared in this mutation.
-func (m *TransactionMutation) GasFeeCleared() bool {
-	_, ok := m.clearedFields[transaction.FieldGasFee]
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *WatchRuleMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[watchrule.FieldAddress]
 	return ok
 }
 
-// ResetGasFee resets all changes to the "gas_fee" field.
-func (m *TransactionMutation) ResetGasFee() {
-	m.gas_fee = nil
-	delete(m.clearedFields, transaction.FieldGasFee)
+// ResetAddress resets all changes to the "address" field.
+func (m *WatchRuleMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, watchrule.FieldAddress)
 }
 
-// SetMessages sets the "messages" field.
-func (m *TransactionMutation) SetMessages(s []schema.Message) {
-	m.messages = &s
-	m.appendmessages = nil
+// SetToken sets the "token" field.
+func (m *WatchRuleMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// Messages returns the value of the "messages" field in the mutation.
-func (m *TransactionMutation) Messages() (r []schema.Message, exists bool) {
-	v := m.messages
+// Token returns the value of the "token" field in the mutation.
+func (m *WatchRuleMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessages returns the old "messages" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldMessages(ctx context.Context) (v []schema.Message, err error) {
+func (m *WatchRuleMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessages is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessages requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessages: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Messages, nil
+	return oldValue.Token, nil
 }
 
-// AppendMessages adds s to the "messages" field.
-func (m *TransactionMutation) AppendMessages(s []schema.Message) {
-	m.appendmessages = append(m.appendmessages, s...)
+// ClearToken clears the value of the "token" field.
+func (m *WatchRuleMutation) ClearToken() {
+	m.token = nil
+	m.clearedFields[watchrule.FieldToken] = struct{}{}
 }
 
-// AppendedMessages returns the list of values that were appended to the "messages" field in this mutation.
-func (m *TransactionMutation) AppendedMessages() ([]schema.Message, bool) {
-	if len(m.appendmessages) == 0 {
-		return nil, false
+// TokenCleared returns if the "token" field was cleared in this mutation.
+func (m *WatchRuleMutation) TokenCleared() bool {
+	_, ok := m.clearedFields[watchrule.FieldToken]
+	return ok
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *WatchRuleMutation) ResetToken() {
+	m.token = nil
+	delete(m.clearedFields, watchrule.FieldToken)
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (m *WatchRuleMutation) SetMinAmount(f float64) {
+	m.min_amount = &f
+	m.addmin_amount = nil
+}
+
+// MinAmount returns the value of the "min_amount" field in the mutation.
+func (m *WatchRuleMutation) MinAmount() (r float64, exists bool) {
+	v := m.min_amount
+	if v == nil {
+		return
 	}
-	return m.appendmessages, true
+	return *v, true
 }
 
-// ClearMessages clears the value of the "messages" field.
-func (m *TransactionMutation) ClearMessages() {
-	m.messages = nil
-	m.appendmessages = nil
-	m.clearedFields[transaction.FieldMessages] = struct{}{}
+// OldMinAmount returns the old "min_amount" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchRuleMutation) OldMinAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinAmount: %w", err)
+	}
+	return oldValue.MinAmount, nil
+}
+
+// AddMinAmount adds f to the "min_amount" field.
+func (m *WatchRuleMutation) AddMinAmount(f float64) {
+	if m.addmin_amount != nil {
+		*m.addmin_amount += f
+	} else {
+		m.addmin_amount = &f
+	}
+}
+
+// AddedMinAmount returns the value that was added to the "min_amount" field in this mutation.
+func (m *WatchRuleMutation) AddedMinAmount() (r float64, exists bool) {
+	v := m.addmin_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinAmount resets all changes to the "min_amount" field.
+func (m *WatchRuleMutation) ResetMinAmount() {
+	m.min_amount = nil
+	m.addmin_amount = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *WatchRuleMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WatchRuleMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchRuleMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
 }
 
-// MessagesCleared returns if the "messages" field was cleared in this mutation.
-func (m *TransactionMutation) MessagesCleared() bool {
-	_, ok := m.clearedFields[transaction.FieldMessages]
+// ClearEventType clears the value of the "event_type" field.
+func (m *WatchRuleMutation) ClearEventType() {
+	m.event_type = nil
+	m.clearedFields[watchrule.FieldEventType] = struct{}{}
+}
+
+// EventTypeCleared returns if the "event_type" field was cleared in this mutation.
+func (m *WatchRuleMutation) EventTypeCleared() bool {
+	_, ok := m.clearedFields[watchrule.FieldEventType]
 	return ok
 }
 
-// ResetMessages resets all changes to the "messages" field.
-func (m *TransactionMutation) ResetMessages() {
-	m.messages = nil
-	m.appendmessages = nil
-	delete(m.clearedFields, transaction.FieldMessages)
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WatchRuleMutation) ResetEventType() {
+	m.event_type = nil
+	delete(m.clearedFields, watchrule.FieldEventType)
 }
 
-// SetResponse sets the "response" field.
-func (m *TransactionMutation) SetResponse(s schema.Response) {
-	m.response = &s
+// SetActive sets the "active" field.
+func (m *WatchRuleMutation) SetActive(b bool) {
+	m.active = &b
 }
 
-// Response returns the value of the "response" field in the mutation.
-func (m *TransactionMutation) Response() (r schema.Response, exists bool) {
-	v := m.response
+// Active returns the value of the "active" field in the mutation.
+func (m *WatchRuleMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResponse returns the old "response" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldResponse(ctx context.Context) (v schema.Response, err error) {
+func (m *WatchRuleMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResponse requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.Response, nil
-}
-
-// ClearResponse clears the value of the "response" field.
-func (m *TransactionMutation) ClearResponse() {
-	m.response = nil
-	m.clearedFields[transaction.FieldResponse] = struct{}{}
-}
-
-// ResponseCleared returns if the "response" field was cleared in this mutation.
-func (m *TransactionMutation) ResponseCleared() bool {
-	_, ok := m.clearedFields[transaction.FieldResponse]
-	return ok
+	return oldValue.Active, nil
 }
 
-// ResetResponse resets all changes to the "response" field.
-func (m *TransactionMutation) ResetResponse() {
-	m.response = nil
-	delete(m.clearedFields, transaction.FieldResponse)
+// ResetActive resets all changes to the "active" field.
+func (m *WatchRuleMutation) ResetActive() {
+	m.active = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TransactionMutation) SetCreatedAt(t time.Time) {
+func (m *WatchRuleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WatchRuleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2536,10 +14916,10 @@ func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WatchRule entity.
+// If the WatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WatchRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2554,58 +14934,73 @@ func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TransactionMutation) ResetCreatedAt() {
+func (m *WatchRuleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetBlockID sets the "block" edge to the Block entity by id.
-func (m *TransactionMutation) SetBlockID(id int) {
-	m.block = &id
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WatchRuleMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
 }
 
-// ClearBlock clears the "block" edge to the Block entity.
-func (m *TransactionMutation) ClearBlock() {
-	m.clearedblock = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WatchRuleMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// BlockCleared reports if the "block" edge to the Block entity was cleared.
-func (m *TransactionMutation) BlockCleared() bool {
-	return m.clearedblock
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WatchRuleMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// BlockID returns the "block" edge ID in the mutation.
-func (m *TransactionMutation) BlockID() (id int, exists bool) {
-	if m.block != nil {
-		return *m.block, true
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WatchRuleMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WatchRuleMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// BlockIDs returns the "block" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BlockID instead. It exists only for internal usage by the builders.
-func (m *TransactionMutation) BlockIDs() (ids []int) {
-	if id := m.block; id != nil {
-		ids = append(ids, *id)
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WatchRuleMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetBlock resets all changes to the "block" edge.
-func (m *TransactionMutation) ResetBlock() {
-	m.block = nil
-	m.clearedblock = false
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WatchRuleMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the TransactionMutation builder.
-func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
+// Where appends a list predicates to the WatchRuleMutation builder.
+func (m *WatchRuleMutation) Where(ps ...predicate.WatchRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WatchRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Transaction, len(ps))
+func (m *WatchRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WatchRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2613,57 +15008,51 @@ func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TransactionMutation) Op() Op {
+func (m *WatchRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TransactionMutation) SetOp(op Op) {
+func (m *WatchRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Transaction).
-func (m *TransactionMutation) Type() string {
+// Type returns the node type of this mutation (WatchRule).
+func (m *WatchRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TransactionMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.index != nil {
-		fields = append(fields, transaction.FieldIndex)
-	}
-	if m.hash != nil {
-		fields = append(fields, transaction.FieldHash)
-	}
-	if m.success != nil {
-		fields = append(fields, transaction.FieldSuccess)
+func (m *WatchRuleMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.url != nil {
+		fields = append(fields, watchrule.FieldURL)
 	}
-	if m.block_height != nil {
-		fields = append(fields, transaction.FieldBlockHeight)
+	if m.secret != nil {
+		fields = append(fields, watchrule.FieldSecret)
 	}
-	if m.gas_wanted != nil {
-		fields = append(fields, transaction.FieldGasWanted)
+	if m.owner_hash != nil {
+		fields = append(fields, watchrule.FieldOwnerHash)
 	}
-	if m.gas_used != nil {
-		fields = append(fields, transaction.FieldGasUsed)
+	if m.address != nil {
+		fields = append(fields, watchrule.FieldAddress)
 	}
-	if m.memo != nil {
-		fields = append(fields, transaction.FieldMemo)
+	if m.token != nil {
+		fields = append(fields, watchrule.FieldToken)
 	}
-	if m.gas_fee != nil {
-		fields = append(fields, transaction.FieldGasFee)
+	if m.min_amount != nil {
+		fields = append(fields, watchrule.FieldMinAmount)
 	}
-	if m.messages != nil {
-		fields = append(fields, transaction.FieldMessages)
+	if m.event_type != nil {
+		fields = append(fields, watchrule.FieldEventType)
 	}
-	if m.response != nil {
-		fields = append(fields, transaction.FieldResponse)
+	if m.active != nil {
+		fields = append(fields, watchrule.FieldActive)
 	}
 	if m.created_at != nil {
-		fields = append(fields, transaction.FieldCreatedAt)
+		fields = append(fields, watchrule.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2671,29 +15060,25 @@ func (m *TransactionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
+func (m *WatchRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case transaction.FieldIndex:
-		return m.Index()
-	case transaction.FieldHash:
-		return m.Hash()
-	case transaction.FieldSuccess:
-		return m.Success()
-	case transaction.FieldBlockHeight:
-		return m.BlockHeight()
-	case transaction.FieldGasWanted:
-		return m.GasWanted()
-	case transaction.FieldGasUsed:
-		return m.GasUsed()
-	case transaction.FieldMemo:
-		return m.Memo()
-	case transaction.FieldGasFee:
-		return m.GasFee()
-	case transaction.FieldMessages:
-		return m.Messages()
-	case transaction.FieldResponse:
-		return m.Response()
-	case transaction.FieldCreatedAt:
+	case watchrule.FieldURL:
+		return m.URL()
+	case watchrule.FieldSecret:
+		return m.Secret()
+	case watchrule.FieldOwnerHash:
+		return m.OwnerHash()
+	case watchrule.FieldAddress:
+		return m.Address()
+	case watchrule.FieldToken:
+		return m.Token()
+	case watchrule.FieldMinAmount:
+		return m.MinAmount()
+	case watchrule.FieldEventType:
+		return m.EventType()
+	case watchrule.FieldActive:
+		return m.Active()
+	case watchrule.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2702,110 +15087,92 @@ func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WatchRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case transaction.FieldIndex:
-		return m.OldIndex(ctx)
-	case transaction.FieldHash:
-		return m.OldHash(ctx)
-	case transaction.FieldSuccess:
-		return m.OldSuccess(ctx)
-	case transaction.FieldBlockHeight:
-		return m.OldBlockHeight(ctx)
-	case transaction.FieldGasWanted:
-		return m.OldGasWanted(ctx)
-	case transaction.FieldGasUsed:
-		return m.OldGasUsed(ctx)
-	case transaction.FieldMemo:
-		return m.OldMemo(ctx)
-	case transaction.FieldGasFee:
-		return m.OldGasFee(ctx)
-	case transaction.FieldMessages:
-		return m.OldMessages(ctx)
-	case transaction.FieldResponse:
-		return m.OldResponse(ctx)
-	case transaction.FieldCreatedAt:
+	case watchrule.FieldURL:
+		return m.OldURL(ctx)
+	case watchrule.FieldSecret:
+		return m.OldSecret(ctx)
+	case watchrule.FieldOwnerHash:
+		return m.OldOwnerHash(ctx)
+	case watchrule.FieldAddress:
+		return m.OldAddress(ctx)
+	case watchrule.FieldToken:
+		return m.OldToken(ctx)
+	case watchrule.FieldMinAmount:
+		return m.OldMinAmount(ctx)
+	case watchrule.FieldEventType:
+		return m.OldEventType(ctx)
+	case watchrule.FieldActive:
+		return m.OldActive(ctx)
+	case watchrule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Transaction field %s", name)
+	return nil, fmt.Errorf("unknown WatchRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransactionMutation) SetField(name string, value ent.Value) error {
+func (m *WatchRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case transaction.FieldIndex:
-		v, ok := value.(int)
+	case watchrule.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIndex(v)
+		m.SetURL(v)
 		return nil
-	case transaction.FieldHash:
+	case watchrule.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHash(v)
+		m.SetSecret(v)
 		return nil
-	case transaction.FieldSuccess:
-		v, ok := value.(bool)
+	case watchrule.FieldOwnerHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuccess(v)
+		m.SetOwnerHash(v)
 		return nil
-	case transaction.FieldBlockHeight:
-		v, ok := value.(int)
+	case watchrule.FieldAddress:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBlockHeight(v)
+		m.SetAddress(v)
 		return nil
-	case transaction.FieldGasWanted:
-		v, ok := value.(float64)
+	case watchrule.FieldToken:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGasWanted(v)
+		m.SetToken(v)
 		return nil
-	case transaction.FieldGasUsed:
+	case watchrule.FieldMinAmount:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGasUsed(v)
+		m.SetMinAmount(v)
 		return nil
-	case transaction.FieldMemo:
+	case watchrule.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMemo(v)
-		return nil
-	case transaction.FieldGasFee:
-		v, ok := value.(schema.GasFee)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGasFee(v)
-		return nil
-	case transaction.FieldMessages:
-		v, ok := value.([]schema.Message)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMessages(v)
+		m.SetEventType(v)
 		return nil
-	case transaction.FieldResponse:
-		v, ok := value.(schema.Response)
+	case watchrule.FieldActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResponse(v)
+		m.SetActive(v)
 		return nil
-	case transaction.FieldCreatedAt:
+	case watchrule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2813,24 +15180,15 @@ func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction field %s", name)
+	return fmt.Errorf("unknown WatchRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TransactionMutation) AddedFields() []string {
+func (m *WatchRuleMutation) AddedFields() []string {
 	var fields []string
-	if m.addindex != nil {
-		fields = append(fields, transaction.FieldIndex)
-	}
-	if m.addblock_height != nil {
-		fields = append(fields, transaction.FieldBlockHeight)
-	}
-	if m.addgas_wanted != nil {
-		fields = append(fields, transaction.FieldGasWanted)
-	}
-	if m.addgas_used != nil {
-		fields = append(fields, transaction.FieldGasUsed)
+	if m.addmin_amount != nil {
+		fields = append(fields, watchrule.FieldMinAmount)
 	}
 	return fields
 }
@@ -2838,648 +15196,882 @@ func (m *TransactionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case transaction.FieldIndex:
-		return m.AddedIndex()
-	case transaction.FieldBlockHeight:
-		return m.AddedBlockHeight()
-	case transaction.FieldGasWanted:
-		return m.AddedGasWanted()
-	case transaction.FieldGasUsed:
-		return m.AddedGasUsed()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TransactionMutation) AddField(name string, value ent.Value) error {
+func (m *WatchRuleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case transaction.FieldIndex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIndex(v)
-		return nil
-	case transaction.FieldBlockHeight:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBlockHeight(v)
-		return nil
-	case transaction.FieldGasWanted:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddGasWanted(v)
-		return nil
-	case transaction.FieldGasUsed:
+	case watchrule.FieldMinAmount:
+		return m.AddedMinAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WatchRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case watchrule.FieldMinAmount:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddGasUsed(v)
+		m.AddMinAmount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction numeric field %s", name)
+	return fmt.Errorf("unknown WatchRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TransactionMutation) ClearedFields() []string {
+func (m *WatchRuleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(transaction.FieldMemo) {
-		fields = append(fields, transaction.FieldMemo)
+	if m.FieldCleared(watchrule.FieldOwnerHash) {
+		fields = append(fields, watchrule.FieldOwnerHash)
 	}
-	if m.FieldCleared(transaction.FieldGasFee) {
-		fields = append(fields, transaction.FieldGasFee)
+	if m.FieldCleared(watchrule.FieldAddress) {
+		fields = append(fields, watchrule.FieldAddress)
 	}
-	if m.FieldCleared(transaction.FieldMessages) {
-		fields = append(fields, transaction.FieldMessages)
+	if m.FieldCleared(watchrule.FieldToken) {
+		fields = append(fields, watchrule.FieldToken)
 	}
-	if m.FieldCleared(transaction.FieldResponse) {
-		fields = append(fields, transaction.FieldResponse)
+	if m.FieldCleared(watchrule.FieldEventType) {
+		fields = append(fields, watchrule.FieldEventType)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TransactionMutation) FieldCleared(name string) bool {
+func (m *WatchRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TransactionMutation) ClearField(name string) error {
+func (m *WatchRuleMutation) ClearField(name string) error {
 	switch name {
-	case transaction.FieldMemo:
-		m.ClearMemo()
+	case watchrule.FieldOwnerHash:
+		m.ClearOwnerHash()
 		return nil
-	case transaction.FieldGasFee:
-		m.ClearGasFee()
+	case watchrule.FieldAddress:
+		m.ClearAddress()
 		return nil
-	case transaction.FieldMessages:
-		m.ClearMessages()
+	case watchrule.FieldToken:
+		m.ClearToken()
 		return nil
-	case transaction.FieldResponse:
-		m.ClearResponse()
+	case watchrule.FieldEventType:
+		m.ClearEventType()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction nullable field %s", name)
+	return fmt.Errorf("unknown WatchRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TransactionMutation) ResetField(name string) error {
+func (m *WatchRuleMutation) ResetField(name string) error {
 	switch name {
-	case transaction.FieldIndex:
-		m.ResetIndex()
-		return nil
-	case transaction.FieldHash:
-		m.ResetHash()
+	case watchrule.FieldURL:
+		m.ResetURL()
 		return nil
-	case transaction.FieldSuccess:
-		m.ResetSuccess()
-		return nil
-	case transaction.FieldBlockHeight:
-		m.ResetBlockHeight()
+	case watchrule.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case transaction.FieldGasWanted:
-		m.ResetGasWanted()
+	case watchrule.FieldOwnerHash:
+		m.ResetOwnerHash()
 		return nil
-	case transaction.FieldGasUsed:
-		m.ResetGasUsed()
+	case watchrule.FieldAddress:
+		m.ResetAddress()
 		return nil
-	case transaction.FieldMemo:
-		m.ResetMemo()
+	case watchrule.FieldToken:
+		m.ResetToken()
 		return nil
-	case transaction.FieldGasFee:
-		m.ResetGasFee()
+	case watchrule.FieldMinAmount:
+		m.ResetMinAmount()
 		return nil
-	case transaction.FieldMessages:
-		m.ResetMessages()
+	case watchrule.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case transaction.FieldResponse:
-		m.ResetResponse()
+	case watchrule.FieldActive:
+		m.ResetActive()
 		return nil
-	case transaction.FieldCreatedAt:
+	case watchrule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction field %s", name)
+	return fmt.Errorf("unknown WatchRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TransactionMutation) AddedEdges() []string {
+func (m *WatchRuleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.block != nil {
-		edges = append(edges, transaction.EdgeBlock)
+	if m.deliveries != nil {
+		edges = append(edges, watchrule.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
+func (m *WatchRuleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case transaction.EdgeBlock:
-		if id := m.block; id != nil {
-			return []ent.Value{*id}
+	case watchrule.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TransactionMutation) RemovedEdges() []string {
+func (m *WatchRuleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removeddeliveries != nil {
+		edges = append(edges, watchrule.EdgeDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
+func (m *WatchRuleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case watchrule.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TransactionMutation) ClearedEdges() []string {
+func (m *WatchRuleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedblock {
-		edges = append(edges, transaction.EdgeBlock)
+	if m.cleareddeliveries {
+		edges = append(edges, watchrule.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TransactionMutation) EdgeCleared(name string) bool {
+func (m *WatchRuleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case transaction.EdgeBlock:
-		return m.clearedblock
+	case watchrule.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TransactionMutation) ClearEdge(name string) error {
+func (m *WatchRuleMutation) ClearEdge(name string) error {
 	switch name {
-	case transaction.EdgeBlock:
-		m.ClearBlock()
-		return nil
 	}
-	return fmt.Errorf("unknown Transaction unique edge %s", name)
+	return fmt.Errorf("unknown WatchRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TransactionMutation) ResetEdge(name string) error {
+func (m *WatchRuleMutation) ResetEdge(name string) error {
 	switch name {
-	case transaction.EdgeBlock:
-		m.ResetBlock()
+	case watchrule.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction edge %s", name)
+	return fmt.Errorf("unknown WatchRule edge %s", name)
 }
 
-// TransferMutation represents an operation that mutates the Transfer nodes in the graph.
-type TransferMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	hash          *string
-	_func         *string
-	from_address  *string
-	to_address    *string
-	token         *string
-	amount        *float64
-	addamount     *float64
-	denom         *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Transfer, error)
-	predicates    []predicate.Transfer
-}
-
-var _ ent.Mutation = (*TransferMutation)(nil)
-
-// transferOption allows management of the mutation configuration using functional options.
-type transferOption func(*TransferMutation)
-
-// newTransferMutation creates new mutation for the Transfer entity.
-func newTransferMutation(c config, op Op, opts ...transferOption) *TransferMutation {
-	m := &TransferMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	hash               *string
+	event_index        *int
+	addevent_index     *int
+	token              *string
+	block_height       *int
+	addblock_height    *int
+	payload            *string
+	status             *webhookdelivery.Status
+	attempts           *int
+	addattempts        *int
+	next_attempt_at    *time.Time
+	last_attempt_at    *time.Time
+	response_status    *int
+	addresponse_status *int
+	last_error         *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	rule               *int
+	clearedrule        bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTransfer,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
 		opt(m)
 	}
-	return m
+	return m
+}
+
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRuleID sets the "rule_id" field.
+func (m *WebhookDeliveryMutation) SetRuleID(i int) {
+	m.rule = &i
+}
+
+// RuleID returns the value of the "rule_id" field in the mutation.
+func (m *WebhookDeliveryMutation) RuleID() (r int, exists bool) {
+	v := m.rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleID returns the old "rule_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldRuleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleID: %w", err)
+	}
+	return oldValue.RuleID, nil
+}
+
+// ResetRuleID resets all changes to the "rule_id" field.
+func (m *WebhookDeliveryMutation) ResetRuleID() {
+	m.rule = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *WebhookDeliveryMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *WebhookDeliveryMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *WebhookDeliveryMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetEventIndex sets the "event_index" field.
+func (m *WebhookDeliveryMutation) SetEventIndex(i int) {
+	m.event_index = &i
+	m.addevent_index = nil
+}
+
+// EventIndex returns the value of the "event_index" field in the mutation.
+func (m *WebhookDeliveryMutation) EventIndex() (r int, exists bool) {
+	v := m.event_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventIndex returns the old "event_index" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventIndex: %w", err)
+	}
+	return oldValue.EventIndex, nil
+}
+
+// AddEventIndex adds i to the "event_index" field.
+func (m *WebhookDeliveryMutation) AddEventIndex(i int) {
+	if m.addevent_index != nil {
+		*m.addevent_index += i
+	} else {
+		m.addevent_index = &i
+	}
+}
+
+// AddedEventIndex returns the value that was added to the "event_index" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedEventIndex() (r int, exists bool) {
+	v := m.addevent_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventIndex resets all changes to the "event_index" field.
+func (m *WebhookDeliveryMutation) ResetEventIndex() {
+	m.event_index = nil
+	m.addevent_index = nil
+}
+
+// SetToken sets the "token" field.
+func (m *WebhookDeliveryMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *WebhookDeliveryMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// withTransferID sets the ID field of the mutation.
-func withTransferID(id int) transferOption {
-	return func(m *TransferMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Transfer
-		)
-		m.oldValue = func(ctx context.Context) (*Transfer, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Transfer.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldToken returns the old "token" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
+	return oldValue.Token, nil
 }
 
-// withTransfer sets the old Transfer of the mutation.
-func withTransfer(node *Transfer) transferOption {
-	return func(m *TransferMutation) {
-		m.oldValue = func(context.Context) (*Transfer, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetToken resets all changes to the "token" field.
+func (m *WebhookDeliveryMutation) ResetToken() {
+	m.token = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TransferMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetBlockHeight sets the "block_height" field.
+func (m *WebhookDeliveryMutation) SetBlockHeight(i int) {
+	m.block_height = &i
+	m.addblock_height = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TransferMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *WebhookDeliveryMutation) BlockHeight() (r int, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Transfer entities.
-func (m *TransferMutation) SetID(id int) {
-	m.id = &id
+// OldBlockHeight returns the old "block_height" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldBlockHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TransferMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// AddBlockHeight adds i to the "block_height" field.
+func (m *WebhookDeliveryMutation) AddBlockHeight(i int) {
+	if m.addblock_height != nil {
+		*m.addblock_height += i
+	} else {
+		m.addblock_height = &i
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TransferMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Transfer.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedBlockHeight() (r int, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// SetHash sets the "hash" field.
-func (m *TransferMutation) SetHash(s string) {
-	m.hash = &s
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *WebhookDeliveryMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
 }
 
-// Hash returns the value of the "hash" field in the mutation.
-func (m *TransferMutation) Hash() (r string, exists bool) {
-	v := m.hash
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHash returns the old "hash" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldHash(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHash requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.Hash, nil
+	return oldValue.Payload, nil
 }
 
-// ResetHash resets all changes to the "hash" field.
-func (m *TransferMutation) ResetHash() {
-	m.hash = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetFunc sets the "func" field.
-func (m *TransferMutation) SetFunc(s string) {
-	m._func = &s
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
 }
 
-// Func returns the value of the "func" field in the mutation.
-func (m *TransferMutation) Func() (r string, exists bool) {
-	v := m._func
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFunc returns the old "func" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldFunc(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFunc is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFunc requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFunc: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Func, nil
+	return oldValue.Status, nil
 }
 
-// ResetFunc resets all changes to the "func" field.
-func (m *TransferMutation) ResetFunc() {
-	m._func = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetFromAddress sets the "from_address" field.
-func (m *TransferMutation) SetFromAddress(s string) {
-	m.from_address = &s
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// FromAddress returns the value of the "from_address" field in the mutation.
-func (m *TransferMutation) FromAddress() (r string, exists bool) {
-	v := m.from_address
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromAddress returns the old "from_address" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldFromAddress(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromAddress requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.FromAddress, nil
+	return oldValue.Attempts, nil
 }
 
-// ClearFromAddress clears the value of the "from_address" field.
-func (m *TransferMutation) ClearFromAddress() {
-	m.from_address = nil
-	m.clearedFields[transfer.FieldFromAddress] = struct{}{}
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// FromAddressCleared returns if the "from_address" field was cleared in this mutation.
-func (m *TransferMutation) FromAddressCleared() bool {
-	_, ok := m.clearedFields[transfer.FieldFromAddress]
-	return ok
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetFromAddress resets all changes to the "from_address" field.
-func (m *TransferMutation) ResetFromAddress() {
-	m.from_address = nil
-	delete(m.clearedFields, transfer.FieldFromAddress)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetToAddress sets the "to_address" field.
-func (m *TransferMutation) SetToAddress(s string) {
-	m.to_address = &s
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// ToAddress returns the value of the "to_address" field in the mutation.
-func (m *TransferMutation) ToAddress() (r string, exists bool) {
-	v := m.to_address
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToAddress returns the old "to_address" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldToAddress(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToAddress requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.ToAddress, nil
-}
-
-// ClearToAddress clears the value of the "to_address" field.
-func (m *TransferMutation) ClearToAddress() {
-	m.to_address = nil
-	m.clearedFields[transfer.FieldToAddress] = struct{}{}
+	return oldValue.NextAttemptAt, nil
 }
 
-// ToAddressCleared returns if the "to_address" field was cleared in this mutation.
-func (m *TransferMutation) ToAddressCleared() bool {
-	_, ok := m.clearedFields[transfer.FieldToAddress]
-	return ok
-}
-
-// ResetToAddress resets all changes to the "to_address" field.
-func (m *TransferMutation) ResetToAddress() {
-	m.to_address = nil
-	delete(m.clearedFields, transfer.FieldToAddress)
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
 }
 
-// SetToken sets the "token" field.
-func (m *TransferMutation) SetToken(s string) {
-	m.token = &s
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetLastAttemptAt(t time.Time) {
+	m.last_attempt_at = &t
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *TransferMutation) Token() (r string, exists bool) {
-	v := m.token
+// LastAttemptAt returns the value of the "last_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAt() (r time.Time, exists bool) {
+	v := m.last_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldLastAttemptAt returns the old "last_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldLastAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastAttemptAt: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.LastAttemptAt, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *TransferMutation) ResetToken() {
-	m.token = nil
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearLastAttemptAt() {
+	m.last_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldLastAttemptAt] = struct{}{}
 }
 
-// SetAmount sets the "amount" field.
-func (m *TransferMutation) SetAmount(f float64) {
-	m.amount = &f
-	m.addamount = nil
+// LastAttemptAtCleared returns if the "last_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastAttemptAt]
+	return ok
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *TransferMutation) Amount() (r float64, exists bool) {
-	v := m.amount
+// ResetLastAttemptAt resets all changes to the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetLastAttemptAt() {
+	m.last_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastAttemptAt)
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldAmount(ctx context.Context) (v float64, err error) {
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
-	return oldValue.Amount, nil
+	return oldValue.ResponseStatus, nil
 }
 
-// AddAmount adds f to the "amount" field.
-func (m *TransferMutation) AddAmount(f float64) {
-	if m.addamount != nil {
-		*m.addamount += f
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
 	} else {
-		m.addamount = &f
+		m.addresponse_status = &i
 	}
 }
 
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *TransferMutation) AddedAmount() (r float64, exists bool) {
-	v := m.addamount
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *TransferMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
 }
 
-// SetDenom sets the "denom" field.
-func (m *TransferMutation) SetDenom(s string) {
-	m.denom = &s
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// Denom returns the value of the "denom" field in the mutation.
-func (m *TransferMutation) Denom() (r string, exists bool) {
-	v := m.denom
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDenom returns the old "denom" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldDenom(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDenom is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDenom requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDenom: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.Denom, nil
+	return oldValue.LastError, nil
 }
 
-// ResetDenom resets all changes to the "denom" field.
-func (m *TransferMutation) ResetDenom() {
-	m.denom = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TransferMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TransferMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3487,10 +16079,10 @@ func (m *TransferMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3505,19 +16097,46 @@ func (m *TransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TransferMutation) ResetCreatedAt() {
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the TransferMutation builder.
-func (m *TransferMutation) Where(ps ...predicate.Transfer) {
+// ClearRule clears the "rule" edge to the WatchRule entity.
+func (m *WebhookDeliveryMutation) ClearRule() {
+	m.clearedrule = true
+	m.clearedFields[webhookdelivery.FieldRuleID] = struct{}{}
+}
+
+// RuleCleared reports if the "rule" edge to the WatchRule entity was cleared.
+func (m *WebhookDeliveryMutation) RuleCleared() bool {
+	return m.clearedrule
+}
+
+// RuleIDs returns the "rule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RuleID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) RuleIDs() (ids []int) {
+	if id := m.rule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRule resets all changes to the "rule" edge.
+func (m *WebhookDeliveryMutation) ResetRule() {
+	m.rule = nil
+	m.clearedrule = false
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TransferMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TransferMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Transfer, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3525,48 +16144,63 @@ func (m *TransferMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TransferMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TransferMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Transfer).
-func (m *TransferMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TransferMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.rule != nil {
+		fields = append(fields, webhookdelivery.FieldRuleID)
+	}
 	if m.hash != nil {
-		fields = append(fields, transfer.FieldHash)
+		fields = append(fields, webhookdelivery.FieldHash)
 	}
-	if m._func != nil {
-		fields = append(fields, transfer.FieldFunc)
+	if m.event_index != nil {
+		fields = append(fields, webhookdelivery.FieldEventIndex)
 	}
-	if m.from_address != nil {
-		fields = append(fields, transfer.FieldFromAddress)
+	if m.token != nil {
+		fields = append(fields, webhookdelivery.FieldToken)
 	}
-	if m.to_address != nil {
-		fields = append(fields, transfer.FieldToAddress)
+	if m.block_height != nil {
+		fields = append(fields, webhookdelivery.FieldBlockHeight)
 	}
-	if m.token != nil {
-		fields = append(fields, transfer.FieldToken)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.amount != nil {
-		fields = append(fields, transfer.FieldAmount)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.denom != nil {
-		fields = append(fields, transfer.FieldDenom)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.last_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, transfer.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3574,23 +16208,33 @@ func (m *TransferMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TransferMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case transfer.FieldHash:
+	case webhookdelivery.FieldRuleID:
+		return m.RuleID()
+	case webhookdelivery.FieldHash:
 		return m.Hash()
-	case transfer.FieldFunc:
-		return m.Func()
-	case transfer.FieldFromAddress:
-		return m.FromAddress()
-	case transfer.FieldToAddress:
-		return m.ToAddress()
-	case transfer.FieldToken:
+	case webhookdelivery.FieldEventIndex:
+		return m.EventIndex()
+	case webhookdelivery.FieldToken:
 		return m.Token()
-	case transfer.FieldAmount:
-		return m.Amount()
-	case transfer.FieldDenom:
-		return m.Denom()
-	case transfer.FieldCreatedAt:
+	case webhookdelivery.FieldBlockHeight:
+		return m.BlockHeight()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.LastAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -3599,83 +16243,128 @@ func (m *TransferMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case transfer.FieldHash:
+	case webhookdelivery.FieldRuleID:
+		return m.OldRuleID(ctx)
+	case webhookdelivery.FieldHash:
 		return m.OldHash(ctx)
-	case transfer.FieldFunc:
-		return m.OldFunc(ctx)
-	case transfer.FieldFromAddress:
-		return m.OldFromAddress(ctx)
-	case transfer.FieldToAddress:
-		return m.OldToAddress(ctx)
-	case transfer.FieldToken:
+	case webhookdelivery.FieldEventIndex:
+		return m.OldEventIndex(ctx)
+	case webhookdelivery.FieldToken:
 		return m.OldToken(ctx)
-	case transfer.FieldAmount:
-		return m.OldAmount(ctx)
-	case transfer.FieldDenom:
-		return m.OldDenom(ctx)
-	case transfer.FieldCreatedAt:
+	case webhookdelivery.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.OldLastAttemptAt(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Transfer field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransferMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case transfer.FieldHash:
+	case webhookdelivery.FieldRuleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleID(v)
+		return nil
+	case webhookdelivery.FieldHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetHash(v)
 		return nil
-	case transfer.FieldFunc:
-		v, ok := value.(string)
+	case webhookdelivery.FieldEventIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFunc(v)
+		m.SetEventIndex(v)
 		return nil
-	case transfer.FieldFromAddress:
+	case webhookdelivery.FieldToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFromAddress(v)
+		m.SetToken(v)
 		return nil
-	case transfer.FieldToAddress:
-		v, ok := value.(string)
+	case webhookdelivery.FieldBlockHeight:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToAddress(v)
+		m.SetBlockHeight(v)
 		return nil
-	case transfer.FieldToken:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetPayload(v)
 		return nil
-	case transfer.FieldAmount:
-		v, ok := value.(float64)
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
+		m.SetStatus(v)
 		return nil
-	case transfer.FieldDenom:
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDenom(v)
+		m.SetLastError(v)
 		return nil
-	case transfer.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3683,15 +16372,24 @@ func (m *TransferMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Transfer field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TransferMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, transfer.FieldAmount)
+	if m.addevent_index != nil {
+		fields = append(fields, webhookdelivery.FieldEventIndex)
+	}
+	if m.addblock_height != nil {
+		fields = append(fields, webhookdelivery.FieldBlockHeight)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
 	return fields
 }
@@ -3699,10 +16397,16 @@ func (m *TransferMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case transfer.FieldAmount:
-		return m.AddedAmount()
+	case webhookdelivery.FieldEventIndex:
+		return m.AddedEventIndex()
+	case webhookdelivery.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
 	}
 	return nil, false
 }
@@ -3710,129 +16414,197 @@ func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransferMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case transfer.FieldAmount:
-		v, ok := value.(float64)
+	case webhookdelivery.FieldEventIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAmount(v)
+		m.AddEventIndex(v)
+		return nil
+	case webhookdelivery.FieldBlockHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Transfer numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TransferMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(transfer.FieldFromAddress) {
-		fields = append(fields, transfer.FieldFromAddress)
+	if m.FieldCleared(webhookdelivery.FieldLastAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
 	}
-	if m.FieldCleared(transfer.FieldToAddress) {
-		fields = append(fields, transfer.FieldToAddress)
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TransferMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TransferMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case transfer.FieldFromAddress:
-		m.ClearFromAddress()
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ClearLastAttemptAt()
 		return nil
-	case transfer.FieldToAddress:
-		m.ClearToAddress()
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown Transfer nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TransferMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case transfer.FieldHash:
+	case webhookdelivery.FieldRuleID:
+		m.ResetRuleID()
+		return nil
+	case webhookdelivery.FieldHash:
 		m.ResetHash()
 		return nil
-	case transfer.FieldFunc:
-		m.ResetFunc()
+	case webhookdelivery.FieldEventIndex:
+		m.ResetEventIndex()
 		return nil
-	case transfer.FieldFromAddress:
-		m.ResetFromAddress()
+	case webhookdelivery.FieldToken:
+		m.ResetToken()
 		return nil
-	case transfer.FieldToAddress:
-		m.ResetToAddress()
+	case webhookdelivery.FieldBlockHeight:
+		m.ResetBlockHeight()
 		return nil
-	case transfer.FieldToken:
-		m.ResetToken()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case transfer.FieldAmount:
-		m.ResetAmount()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case transfer.FieldDenom:
-		m.ResetDenom()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case transfer.FieldCreatedAt:
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ResetLastAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Transfer field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TransferMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.rule != nil {
+		edges = append(edges, webhookdelivery.EdgeRule)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TransferMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookdelivery.EdgeRule:
+		if id := m.rule; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TransferMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TransferMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TransferMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrule {
+		edges = append(edges, webhookdelivery.EdgeRule)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TransferMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhookdelivery.EdgeRule:
+		return m.clearedrule
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TransferMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Transfer unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeRule:
+		m.ClearRule()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TransferMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Transfer edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeRule:
+		m.ResetRule()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
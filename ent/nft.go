@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/nft"
+)
+
+// Nft is the model entity for the Nft schema.
+type Nft struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Package path of the GRC721 collection
+	Collection string `json:"collection,omitempty"`
+	// Token ID within the collection
+	TokenID string `json:"token_id,omitempty"`
+	// Address of the current owner, empty once burned
+	Owner string `json:"owner,omitempty"`
+	// Whether the token has been burned
+	Burned bool `json:"burned,omitempty"`
+	// Height of the block in which the token was minted
+	MintedHeight int `json:"minted_height,omitempty"`
+	// Height of the block of the last ownership change
+	LastHeight int `json:"last_height,omitempty"`
+	// Creation time of the token
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Nft) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nft.FieldBurned:
+			values[i] = new(sql.NullBool)
+		case nft.FieldID, nft.FieldMintedHeight, nft.FieldLastHeight:
+			values[i] = new(sql.NullInt64)
+		case nft.FieldCollection, nft.FieldTokenID, nft.FieldOwner:
+			values[i] = new(sql.NullString)
+		case nft.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Nft fields.
+func (_m *Nft) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nft.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case nft.FieldCollection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collection", values[i])
+			} else if value.Valid {
+				_m.Collection = value.String
+			}
+		case nft.FieldTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_id", values[i])
+			} else if value.Valid {
+				_m.TokenID = value.String
+			}
+		case nft.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = value.String
+			}
+		case nft.FieldBurned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field burned", values[i])
+			} else if value.Valid {
+				_m.Burned = value.Bool
+			}
+		case nft.FieldMintedHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field minted_height", values[i])
+			} else if value.Valid {
+				_m.MintedHeight = int(value.Int64)
+			}
+		case nft.FieldLastHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_height", values[i])
+			} else if value.Valid {
+				_m.LastHeight = int(value.Int64)
+			}
+		case nft.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Nft.
+// This includes values selected through modifiers, order, etc.
+func (_m *Nft) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Nft.
+// Note that you need to call Nft.Unwrap() before calling this method if this Nft
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Nft) Update() *NftUpdateOne {
+	return NewNftClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Nft entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Nft) Unwrap() *Nft {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Nft is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Nft) String() string {
+	var builder strings.Builder
+	builder.WriteString("Nft(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collection=")
+	builder.WriteString(_m.Collection)
+	builder.WriteString(", ")
+	builder.WriteString("token_id=")
+	builder.WriteString(_m.TokenID)
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(_m.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("burned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Burned))
+	builder.WriteString(", ")
+	builder.WriteString("minted_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MintedHeight))
+	builder.WriteString(", ")
+	builder.WriteString("last_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastHeight))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Nfts is a parsable slice of Nft.
+type Nfts []*Nft
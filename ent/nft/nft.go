@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nft
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the nft type in the database.
+	Label = "nft"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCollection holds the string denoting the collection field in the database.
+	FieldCollection = "collection"
+	// FieldTokenID holds the string denoting the token_id field in the database.
+	FieldTokenID = "token_id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldBurned holds the string denoting the burned field in the database.
+	FieldBurned = "burned"
+	// FieldMintedHeight holds the string denoting the minted_height field in the database.
+	FieldMintedHeight = "minted_height"
+	// FieldLastHeight holds the string denoting the last_height field in the database.
+	FieldLastHeight = "last_height"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the nft in the database.
+	Table = "nfts"
+)
+
+// Columns holds all SQL columns for nft fields.
+var Columns = []string{
+	FieldID,
+	FieldCollection,
+	FieldTokenID,
+	FieldOwner,
+	FieldBurned,
+	FieldMintedHeight,
+	FieldLastHeight,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CollectionValidator is a validator for the "collection" field. It is called by the builders before save.
+	CollectionValidator func(string) error
+	// TokenIDValidator is a validator for the "token_id" field. It is called by the builders before save.
+	TokenIDValidator func(string) error
+	// DefaultBurned holds the default value on creation for the "burned" field.
+	DefaultBurned bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Nft queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollection orders the results by the collection field.
+func ByCollection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollection, opts...).ToFunc()
+}
+
+// ByTokenID orders the results by the token_id field.
+func ByTokenID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenID, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByBurned orders the results by the burned field.
+func ByBurned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurned, opts...).ToFunc()
+}
+
+// ByMintedHeight orders the results by the minted_height field.
+func ByMintedHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMintedHeight, opts...).ToFunc()
+}
+
+// ByLastHeight orders the results by the last_height field.
+func ByLastHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHeight, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
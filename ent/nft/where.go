@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nft
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Nft {
+	return predicate.Nft(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Nft {
+	return predicate.Nft(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Nft {
+	return predicate.Nft(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Nft {
+	return predicate.Nft(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Nft {
+	return predicate.Nft(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Nft {
+	return predicate.Nft(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Nft {
+	return predicate.Nft(sql.FieldLTE(FieldID, id))
+}
+
+// Collection applies equality check predicate on the "collection" field. It's identical to CollectionEQ.
+func Collection(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldCollection, v))
+}
+
+// TokenID applies equality check predicate on the "token_id" field. It's identical to TokenIDEQ.
+func TokenID(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldTokenID, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldOwner, v))
+}
+
+// Burned applies equality check predicate on the "burned" field. It's identical to BurnedEQ.
+func Burned(v bool) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldBurned, v))
+}
+
+// MintedHeight applies equality check predicate on the "minted_height" field. It's identical to MintedHeightEQ.
+func MintedHeight(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldMintedHeight, v))
+}
+
+// LastHeight applies equality check predicate on the "last_height" field. It's identical to LastHeightEQ.
+func LastHeight(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldLastHeight, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CollectionEQ applies the EQ predicate on the "collection" field.
+func CollectionEQ(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldCollection, v))
+}
+
+// CollectionNEQ applies the NEQ predicate on the "collection" field.
+func CollectionNEQ(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldNEQ(FieldCollection, v))
+}
+
+// CollectionIn applies the In predicate on the "collection" field.
+func CollectionIn(vs ...string) predicate.Nft {
+	return predicate.Nft(sql.FieldIn(FieldCollection, vs...))
+}
+
+// CollectionNotIn applies the NotIn predicate on the "collection" field.
+func CollectionNotIn(vs ...string) predicate.Nft {
+	return predicate.Nft(sql.FieldNotIn(FieldCollection, vs...))
+}
+
+// CollectionGT applies the GT predicate on the "collection" field.
+func CollectionGT(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldGT(FieldCollection, v))
+}
+
+// CollectionGTE applies the GTE predicate on the "collection" field.
+func CollectionGTE(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldGTE(FieldCollection, v))
+}
+
+// CollectionLT applies the LT predicate on the "collection" field.
+func CollectionLT(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldLT(FieldCollection, v))
+}
+
+// CollectionLTE applies the LTE predicate on the "collection" field.
+func CollectionLTE(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldLTE(FieldCollection, v))
+}
+
+// CollectionContains applies the Contains predicate on the "collection" field.
+func CollectionContains(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldContains(FieldCollection, v))
+}
+
+// CollectionHasPrefix applies the HasPrefix predicate on the "collection" field.
+func CollectionHasPrefix(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldHasPrefix(FieldCollection, v))
+}
+
+// CollectionHasSuffix applies the HasSuffix predicate on the "collection" field.
+func CollectionHasSuffix(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldHasSuffix(FieldCollection, v))
+}
+
+// CollectionEqualFold applies the EqualFold predicate on the "collection" field.
+func CollectionEqualFold(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEqualFold(FieldCollection, v))
+}
+
+// CollectionContainsFold applies the ContainsFold predicate on the "collection" field.
+func CollectionContainsFold(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldContainsFold(FieldCollection, v))
+}
+
+// TokenIDEQ applies the EQ predicate on the "token_id" field.
+func TokenIDEQ(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldTokenID, v))
+}
+
+// TokenIDNEQ applies the NEQ predicate on the "token_id" field.
+func TokenIDNEQ(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldNEQ(FieldTokenID, v))
+}
+
+// TokenIDIn applies the In predicate on the "token_id" field.
+func TokenIDIn(vs ...string) predicate.Nft {
+	return predicate.Nft(sql.FieldIn(FieldTokenID, vs...))
+}
+
+// TokenIDNotIn applies the NotIn predicate on the "token_id" field.
+func TokenIDNotIn(vs ...string) predicate.Nft {
+	return predicate.Nft(sql.FieldNotIn(FieldTokenID, vs...))
+}
+
+// TokenIDGT applies the GT predicate on the "token_id" field.
+func TokenIDGT(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldGT(FieldTokenID, v))
+}
+
+// TokenIDGTE applies the GTE predicate on the "token_id" field.
+func TokenIDGTE(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldGTE(FieldTokenID, v))
+}
+
+// TokenIDLT applies the LT predicate on the "token_id" field.
+func TokenIDLT(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldLT(FieldTokenID, v))
+}
+
+// TokenIDLTE applies the LTE predicate on the "token_id" field.
+func TokenIDLTE(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldLTE(FieldTokenID, v))
+}
+
+// TokenIDContains applies the Contains predicate on the "token_id" field.
+func TokenIDContains(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldContains(FieldTokenID, v))
+}
+
+// TokenIDHasPrefix applies the HasPrefix predicate on the "token_id" field.
+func TokenIDHasPrefix(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldHasPrefix(FieldTokenID, v))
+}
+
+// TokenIDHasSuffix applies the HasSuffix predicate on the "token_id" field.
+func TokenIDHasSuffix(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldHasSuffix(FieldTokenID, v))
+}
+
+// TokenIDEqualFold applies the EqualFold predicate on the "token_id" field.
+func TokenIDEqualFold(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEqualFold(FieldTokenID, v))
+}
+
+// TokenIDContainsFold applies the ContainsFold predicate on the "token_id" field.
+func TokenIDContainsFold(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldContainsFold(FieldTokenID, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.Nft {
+	return predicate.Nft(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.Nft {
+	return predicate.Nft(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.Nft {
+	return predicate.Nft(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.Nft {
+	return predicate.Nft(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.Nft {
+	return predicate.Nft(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// BurnedEQ applies the EQ predicate on the "burned" field.
+func BurnedEQ(v bool) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldBurned, v))
+}
+
+// BurnedNEQ applies the NEQ predicate on the "burned" field.
+func BurnedNEQ(v bool) predicate.Nft {
+	return predicate.Nft(sql.FieldNEQ(FieldBurned, v))
+}
+
+// MintedHeightEQ applies the EQ predicate on the "minted_height" field.
+func MintedHeightEQ(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldMintedHeight, v))
+}
+
+// MintedHeightNEQ applies the NEQ predicate on the "minted_height" field.
+func MintedHeightNEQ(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldNEQ(FieldMintedHeight, v))
+}
+
+// MintedHeightIn applies the In predicate on the "minted_height" field.
+func MintedHeightIn(vs ...int) predicate.Nft {
+	return predicate.Nft(sql.FieldIn(FieldMintedHeight, vs...))
+}
+
+// MintedHeightNotIn applies the NotIn predicate on the "minted_height" field.
+func MintedHeightNotIn(vs ...int) predicate.Nft {
+	return predicate.Nft(sql.FieldNotIn(FieldMintedHeight, vs...))
+}
+
+// MintedHeightGT applies the GT predicate on the "minted_height" field.
+func MintedHeightGT(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldGT(FieldMintedHeight, v))
+}
+
+// MintedHeightGTE applies the GTE predicate on the "minted_height" field.
+func MintedHeightGTE(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldGTE(FieldMintedHeight, v))
+}
+
+// MintedHeightLT applies the LT predicate on the "minted_height" field.
+func MintedHeightLT(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldLT(FieldMintedHeight, v))
+}
+
+// MintedHeightLTE applies the LTE predicate on the "minted_height" field.
+func MintedHeightLTE(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldLTE(FieldMintedHeight, v))
+}
+
+// MintedHeightIsNil applies the IsNil predicate on the "minted_height" field.
+func MintedHeightIsNil() predicate.Nft {
+	return predicate.Nft(sql.FieldIsNull(FieldMintedHeight))
+}
+
+// MintedHeightNotNil applies the NotNil predicate on the "minted_height" field.
+func MintedHeightNotNil() predicate.Nft {
+	return predicate.Nft(sql.FieldNotNull(FieldMintedHeight))
+}
+
+// LastHeightEQ applies the EQ predicate on the "last_height" field.
+func LastHeightEQ(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldLastHeight, v))
+}
+
+// LastHeightNEQ applies the NEQ predicate on the "last_height" field.
+func LastHeightNEQ(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldNEQ(FieldLastHeight, v))
+}
+
+// LastHeightIn applies the In predicate on the "last_height" field.
+func LastHeightIn(vs ...int) predicate.Nft {
+	return predicate.Nft(sql.FieldIn(FieldLastHeight, vs...))
+}
+
+// LastHeightNotIn applies the NotIn predicate on the "last_height" field.
+func LastHeightNotIn(vs ...int) predicate.Nft {
+	return predicate.Nft(sql.FieldNotIn(FieldLastHeight, vs...))
+}
+
+// LastHeightGT applies the GT predicate on the "last_height" field.
+func LastHeightGT(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldGT(FieldLastHeight, v))
+}
+
+// LastHeightGTE applies the GTE predicate on the "last_height" field.
+func LastHeightGTE(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldGTE(FieldLastHeight, v))
+}
+
+// LastHeightLT applies the LT predicate on the "last_height" field.
+func LastHeightLT(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldLT(FieldLastHeight, v))
+}
+
+// LastHeightLTE applies the LTE predicate on the "last_height" field.
+func LastHeightLTE(v int) predicate.Nft {
+	return predicate.Nft(sql.FieldLTE(FieldLastHeight, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Nft {
+	return predicate.Nft(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Nft) predicate.Nft {
+	return predicate.Nft(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Nft) predicate.Nft {
+	return predicate.Nft(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Nft) predicate.Nft {
+	return predicate.Nft(sql.NotPredicates(p))
+}
@@ -0,0 +1,875 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/nft"
+)
+
+// NftCreate is the builder for creating a Nft entity.
+type NftCreate struct {
+	config
+	mutation *NftMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCollection sets the "collection" field.
+func (_c *NftCreate) SetCollection(v string) *NftCreate {
+	_c.mutation.SetCollection(v)
+	return _c
+}
+
+// SetTokenID sets the "token_id" field.
+func (_c *NftCreate) SetTokenID(v string) *NftCreate {
+	_c.mutation.SetTokenID(v)
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *NftCreate) SetOwner(v string) *NftCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_c *NftCreate) SetNillableOwner(v *string) *NftCreate {
+	if v != nil {
+		_c.SetOwner(*v)
+	}
+	return _c
+}
+
+// SetBurned sets the "burned" field.
+func (_c *NftCreate) SetBurned(v bool) *NftCreate {
+	_c.mutation.SetBurned(v)
+	return _c
+}
+
+// SetNillableBurned sets the "burned" field if the given value is not nil.
+func (_c *NftCreate) SetNillableBurned(v *bool) *NftCreate {
+	if v != nil {
+		_c.SetBurned(*v)
+	}
+	return _c
+}
+
+// SetMintedHeight sets the "minted_height" field.
+func (_c *NftCreate) SetMintedHeight(v int) *NftCreate {
+	_c.mutation.SetMintedHeight(v)
+	return _c
+}
+
+// SetNillableMintedHeight sets the "minted_height" field if the given value is not nil.
+func (_c *NftCreate) SetNillableMintedHeight(v *int) *NftCreate {
+	if v != nil {
+		_c.SetMintedHeight(*v)
+	}
+	return _c
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_c *NftCreate) SetLastHeight(v int) *NftCreate {
+	_c.mutation.SetLastHeight(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NftCreate) SetCreatedAt(v time.Time) *NftCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NftCreate) SetNillableCreatedAt(v *time.Time) *NftCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NftMutation object of the builder.
+func (_c *NftCreate) Mutation() *NftMutation {
+	return _c.mutation
+}
+
+// Save creates the Nft in the database.
+func (_c *NftCreate) Save(ctx context.Context) (*Nft, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NftCreate) SaveX(ctx context.Context) *Nft {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NftCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NftCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NftCreate) defaults() {
+	if _, ok := _c.mutation.Burned(); !ok {
+		v := nft.DefaultBurned
+		_c.mutation.SetBurned(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := nft.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NftCreate) check() error {
+	if _, ok := _c.mutation.Collection(); !ok {
+		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required field "Nft.collection"`)}
+	}
+	if v, ok := _c.mutation.Collection(); ok {
+		if err := nft.CollectionValidator(v); err != nil {
+			return &ValidationError{Name: "collection", err: fmt.Errorf(`ent: validator failed for field "Nft.collection": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenID(); !ok {
+		return &ValidationError{Name: "token_id", err: errors.New(`ent: missing required field "Nft.token_id"`)}
+	}
+	if v, ok := _c.mutation.TokenID(); ok {
+		if err := nft.TokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "token_id", err: fmt.Errorf(`ent: validator failed for field "Nft.token_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Burned(); !ok {
+		return &ValidationError{Name: "burned", err: errors.New(`ent: missing required field "Nft.burned"`)}
+	}
+	if _, ok := _c.mutation.LastHeight(); !ok {
+		return &ValidationError{Name: "last_height", err: errors.New(`ent: missing required field "Nft.last_height"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Nft.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *NftCreate) sqlSave(ctx context.Context) (*Nft, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NftCreate) createSpec() (*Nft, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Nft{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(nft.Table, sqlgraph.NewFieldSpec(nft.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Collection(); ok {
+		_spec.SetField(nft.FieldCollection, field.TypeString, value)
+		_node.Collection = value
+	}
+	if value, ok := _c.mutation.TokenID(); ok {
+		_spec.SetField(nft.FieldTokenID, field.TypeString, value)
+		_node.TokenID = value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(nft.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := _c.mutation.Burned(); ok {
+		_spec.SetField(nft.FieldBurned, field.TypeBool, value)
+		_node.Burned = value
+	}
+	if value, ok := _c.mutation.MintedHeight(); ok {
+		_spec.SetField(nft.FieldMintedHeight, field.TypeInt, value)
+		_node.MintedHeight = value
+	}
+	if value, ok := _c.mutation.LastHeight(); ok {
+		_spec.SetField(nft.FieldLastHeight, field.TypeInt, value)
+		_node.LastHeight = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(nft.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Nft.Create().
+//		SetCollection(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NftUpsert) {
+//			SetCollection(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NftCreate) OnConflict(opts ...sql.ConflictOption) *NftUpsertOne {
+	_c.conflict = opts
+	return &NftUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Nft.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NftCreate) OnConflictColumns(columns ...string) *NftUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NftUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NftUpsertOne is the builder for "upsert"-ing
+	//  one Nft node.
+	NftUpsertOne struct {
+		create *NftCreate
+	}
+
+	// NftUpsert is the "OnConflict" setter.
+	NftUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCollection sets the "collection" field.
+func (u *NftUpsert) SetCollection(v string) *NftUpsert {
+	u.Set(nft.FieldCollection, v)
+	return u
+}
+
+// UpdateCollection sets the "collection" field to the value that was provided on create.
+func (u *NftUpsert) UpdateCollection() *NftUpsert {
+	u.SetExcluded(nft.FieldCollection)
+	return u
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *NftUpsert) SetTokenID(v string) *NftUpsert {
+	u.Set(nft.FieldTokenID, v)
+	return u
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *NftUpsert) UpdateTokenID() *NftUpsert {
+	u.SetExcluded(nft.FieldTokenID)
+	return u
+}
+
+// SetOwner sets the "owner" field.
+func (u *NftUpsert) SetOwner(v string) *NftUpsert {
+	u.Set(nft.FieldOwner, v)
+	return u
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *NftUpsert) UpdateOwner() *NftUpsert {
+	u.SetExcluded(nft.FieldOwner)
+	return u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *NftUpsert) ClearOwner() *NftUpsert {
+	u.SetNull(nft.FieldOwner)
+	return u
+}
+
+// SetBurned sets the "burned" field.
+func (u *NftUpsert) SetBurned(v bool) *NftUpsert {
+	u.Set(nft.FieldBurned, v)
+	return u
+}
+
+// UpdateBurned sets the "burned" field to the value that was provided on create.
+func (u *NftUpsert) UpdateBurned() *NftUpsert {
+	u.SetExcluded(nft.FieldBurned)
+	return u
+}
+
+// SetMintedHeight sets the "minted_height" field.
+func (u *NftUpsert) SetMintedHeight(v int) *NftUpsert {
+	u.Set(nft.FieldMintedHeight, v)
+	return u
+}
+
+// UpdateMintedHeight sets the "minted_height" field to the value that was provided on create.
+func (u *NftUpsert) UpdateMintedHeight() *NftUpsert {
+	u.SetExcluded(nft.FieldMintedHeight)
+	return u
+}
+
+// AddMintedHeight adds v to the "minted_height" field.
+func (u *NftUpsert) AddMintedHeight(v int) *NftUpsert {
+	u.Add(nft.FieldMintedHeight, v)
+	return u
+}
+
+// ClearMintedHeight clears the value of the "minted_height" field.
+func (u *NftUpsert) ClearMintedHeight() *NftUpsert {
+	u.SetNull(nft.FieldMintedHeight)
+	return u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (u *NftUpsert) SetLastHeight(v int) *NftUpsert {
+	u.Set(nft.FieldLastHeight, v)
+	return u
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *NftUpsert) UpdateLastHeight() *NftUpsert {
+	u.SetExcluded(nft.FieldLastHeight)
+	return u
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *NftUpsert) AddLastHeight(v int) *NftUpsert {
+	u.Add(nft.FieldLastHeight, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Nft.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NftUpsertOne) UpdateNewValues() *NftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(nft.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Nft.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NftUpsertOne) Ignore() *NftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NftUpsertOne) DoNothing() *NftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NftCreate.OnConflict
+// documentation for more info.
+func (u *NftUpsertOne) Update(set func(*NftUpsert)) *NftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCollection sets the "collection" field.
+func (u *NftUpsertOne) SetCollection(v string) *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.SetCollection(v)
+	})
+}
+
+// UpdateCollection sets the "collection" field to the value that was provided on create.
+func (u *NftUpsertOne) UpdateCollection() *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateCollection()
+	})
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *NftUpsertOne) SetTokenID(v string) *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.SetTokenID(v)
+	})
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *NftUpsertOne) UpdateTokenID() *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateTokenID()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *NftUpsertOne) SetOwner(v string) *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *NftUpsertOne) UpdateOwner() *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *NftUpsertOne) ClearOwner() *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.ClearOwner()
+	})
+}
+
+// SetBurned sets the "burned" field.
+func (u *NftUpsertOne) SetBurned(v bool) *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.SetBurned(v)
+	})
+}
+
+// UpdateBurned sets the "burned" field to the value that was provided on create.
+func (u *NftUpsertOne) UpdateBurned() *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateBurned()
+	})
+}
+
+// SetMintedHeight sets the "minted_height" field.
+func (u *NftUpsertOne) SetMintedHeight(v int) *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.SetMintedHeight(v)
+	})
+}
+
+// AddMintedHeight adds v to the "minted_height" field.
+func (u *NftUpsertOne) AddMintedHeight(v int) *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.AddMintedHeight(v)
+	})
+}
+
+// UpdateMintedHeight sets the "minted_height" field to the value that was provided on create.
+func (u *NftUpsertOne) UpdateMintedHeight() *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateMintedHeight()
+	})
+}
+
+// ClearMintedHeight clears the value of the "minted_height" field.
+func (u *NftUpsertOne) ClearMintedHeight() *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.ClearMintedHeight()
+	})
+}
+
+// SetLastHeight sets the "last_height" field.
+func (u *NftUpsertOne) SetLastHeight(v int) *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.SetLastHeight(v)
+	})
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *NftUpsertOne) AddLastHeight(v int) *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.AddLastHeight(v)
+	})
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *NftUpsertOne) UpdateLastHeight() *NftUpsertOne {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateLastHeight()
+	})
+}
+
+// Exec executes the query.
+func (u *NftUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NftCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NftUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NftUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NftUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NftCreateBulk is the builder for creating many Nft entities in bulk.
+type NftCreateBulk struct {
+	config
+	err      error
+	builders []*NftCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Nft entities in the database.
+func (_c *NftCreateBulk) Save(ctx context.Context) ([]*Nft, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Nft, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NftCreateBulk) SaveX(ctx context.Context) []*Nft {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NftCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NftCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Nft.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NftUpsert) {
+//			SetCollection(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NftCreateBulk) OnConflict(opts ...sql.ConflictOption) *NftUpsertBulk {
+	_c.conflict = opts
+	return &NftUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Nft.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NftCreateBulk) OnConflictColumns(columns ...string) *NftUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NftUpsertBulk{
+		create: _c,
+	}
+}
+
+// NftUpsertBulk is the builder for "upsert"-ing
+// a bulk of Nft nodes.
+type NftUpsertBulk struct {
+	create *NftCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Nft.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NftUpsertBulk) UpdateNewValues() *NftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(nft.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Nft.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NftUpsertBulk) Ignore() *NftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NftUpsertBulk) DoNothing() *NftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NftCreateBulk.OnConflict
+// documentation for more info.
+func (u *NftUpsertBulk) Update(set func(*NftUpsert)) *NftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCollection sets the "collection" field.
+func (u *NftUpsertBulk) SetCollection(v string) *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.SetCollection(v)
+	})
+}
+
+// UpdateCollection sets the "collection" field to the value that was provided on create.
+func (u *NftUpsertBulk) UpdateCollection() *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateCollection()
+	})
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *NftUpsertBulk) SetTokenID(v string) *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.SetTokenID(v)
+	})
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *NftUpsertBulk) UpdateTokenID() *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateTokenID()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *NftUpsertBulk) SetOwner(v string) *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *NftUpsertBulk) UpdateOwner() *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *NftUpsertBulk) ClearOwner() *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.ClearOwner()
+	})
+}
+
+// SetBurned sets the "burned" field.
+func (u *NftUpsertBulk) SetBurned(v bool) *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.SetBurned(v)
+	})
+}
+
+// UpdateBurned sets the "burned" field to the value that was provided on create.
+func (u *NftUpsertBulk) UpdateBurned() *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateBurned()
+	})
+}
+
+// SetMintedHeight sets the "minted_height" field.
+func (u *NftUpsertBulk) SetMintedHeight(v int) *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.SetMintedHeight(v)
+	})
+}
+
+// AddMintedHeight adds v to the "minted_height" field.
+func (u *NftUpsertBulk) AddMintedHeight(v int) *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.AddMintedHeight(v)
+	})
+}
+
+// UpdateMintedHeight sets the "minted_height" field to the value that was provided on create.
+func (u *NftUpsertBulk) UpdateMintedHeight() *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateMintedHeight()
+	})
+}
+
+// ClearMintedHeight clears the value of the "minted_height" field.
+func (u *NftUpsertBulk) ClearMintedHeight() *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.ClearMintedHeight()
+	})
+}
+
+// SetLastHeight sets the "last_height" field.
+func (u *NftUpsertBulk) SetLastHeight(v int) *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.SetLastHeight(v)
+	})
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *NftUpsertBulk) AddLastHeight(v int) *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.AddLastHeight(v)
+	})
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *NftUpsertBulk) UpdateLastHeight() *NftUpsertBulk {
+	return u.Update(func(s *NftUpsert) {
+		s.UpdateLastHeight()
+	})
+}
+
+// Exec executes the query.
+func (u *NftUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NftCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NftCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NftUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
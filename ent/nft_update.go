@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/nft"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// NftUpdate is the builder for updating Nft entities.
+type NftUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NftMutation
+}
+
+// Where appends a list predicates to the NftUpdate builder.
+func (_u *NftUpdate) Where(ps ...predicate.Nft) *NftUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollection sets the "collection" field.
+func (_u *NftUpdate) SetCollection(v string) *NftUpdate {
+	_u.mutation.SetCollection(v)
+	return _u
+}
+
+// SetNillableCollection sets the "collection" field if the given value is not nil.
+func (_u *NftUpdate) SetNillableCollection(v *string) *NftUpdate {
+	if v != nil {
+		_u.SetCollection(*v)
+	}
+	return _u
+}
+
+// SetTokenID sets the "token_id" field.
+func (_u *NftUpdate) SetTokenID(v string) *NftUpdate {
+	_u.mutation.SetTokenID(v)
+	return _u
+}
+
+// SetNillableTokenID sets the "token_id" field if the given value is not nil.
+func (_u *NftUpdate) SetNillableTokenID(v *string) *NftUpdate {
+	if v != nil {
+		_u.SetTokenID(*v)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *NftUpdate) SetOwner(v string) *NftUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *NftUpdate) SetNillableOwner(v *string) *NftUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *NftUpdate) ClearOwner() *NftUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetBurned sets the "burned" field.
+func (_u *NftUpdate) SetBurned(v bool) *NftUpdate {
+	_u.mutation.SetBurned(v)
+	return _u
+}
+
+// SetNillableBurned sets the "burned" field if the given value is not nil.
+func (_u *NftUpdate) SetNillableBurned(v *bool) *NftUpdate {
+	if v != nil {
+		_u.SetBurned(*v)
+	}
+	return _u
+}
+
+// SetMintedHeight sets the "minted_height" field.
+func (_u *NftUpdate) SetMintedHeight(v int) *NftUpdate {
+	_u.mutation.ResetMintedHeight()
+	_u.mutation.SetMintedHeight(v)
+	return _u
+}
+
+// SetNillableMintedHeight sets the "minted_height" field if the given value is not nil.
+func (_u *NftUpdate) SetNillableMintedHeight(v *int) *NftUpdate {
+	if v != nil {
+		_u.SetMintedHeight(*v)
+	}
+	return _u
+}
+
+// AddMintedHeight adds value to the "minted_height" field.
+func (_u *NftUpdate) AddMintedHeight(v int) *NftUpdate {
+	_u.mutation.AddMintedHeight(v)
+	return _u
+}
+
+// ClearMintedHeight clears the value of the "minted_height" field.
+func (_u *NftUpdate) ClearMintedHeight() *NftUpdate {
+	_u.mutation.ClearMintedHeight()
+	return _u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_u *NftUpdate) SetLastHeight(v int) *NftUpdate {
+	_u.mutation.ResetLastHeight()
+	_u.mutation.SetLastHeight(v)
+	return _u
+}
+
+// SetNillableLastHeight sets the "last_height" field if the given value is not nil.
+func (_u *NftUpdate) SetNillableLastHeight(v *int) *NftUpdate {
+	if v != nil {
+		_u.SetLastHeight(*v)
+	}
+	return _u
+}
+
+// AddLastHeight adds value to the "last_height" field.
+func (_u *NftUpdate) AddLastHeight(v int) *NftUpdate {
+	_u.mutation.AddLastHeight(v)
+	return _u
+}
+
+// Mutation returns the NftMutation object of the builder.
+func (_u *NftUpdate) Mutation() *NftMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NftUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NftUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NftUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NftUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NftUpdate) check() error {
+	if v, ok := _u.mutation.Collection(); ok {
+		if err := nft.CollectionValidator(v); err != nil {
+			return &ValidationError{Name: "collection", err: fmt.Errorf(`ent: validator failed for field "Nft.collection": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenID(); ok {
+		if err := nft.TokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "token_id", err: fmt.Errorf(`ent: validator failed for field "Nft.token_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NftUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nft.Table, nft.Columns, sqlgraph.NewFieldSpec(nft.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Collection(); ok {
+		_spec.SetField(nft.FieldCollection, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenID(); ok {
+		_spec.SetField(nft.FieldTokenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(nft.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(nft.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.Burned(); ok {
+		_spec.SetField(nft.FieldBurned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MintedHeight(); ok {
+		_spec.SetField(nft.FieldMintedHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMintedHeight(); ok {
+		_spec.AddField(nft.FieldMintedHeight, field.TypeInt, value)
+	}
+	if _u.mutation.MintedHeightCleared() {
+		_spec.ClearField(nft.FieldMintedHeight, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastHeight(); ok {
+		_spec.SetField(nft.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastHeight(); ok {
+		_spec.AddField(nft.FieldLastHeight, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nft.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NftUpdateOne is the builder for updating a single Nft entity.
+type NftUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NftMutation
+}
+
+// SetCollection sets the "collection" field.
+func (_u *NftUpdateOne) SetCollection(v string) *NftUpdateOne {
+	_u.mutation.SetCollection(v)
+	return _u
+}
+
+// SetNillableCollection sets the "collection" field if the given value is not nil.
+func (_u *NftUpdateOne) SetNillableCollection(v *string) *NftUpdateOne {
+	if v != nil {
+		_u.SetCollection(*v)
+	}
+	return _u
+}
+
+// SetTokenID sets the "token_id" field.
+func (_u *NftUpdateOne) SetTokenID(v string) *NftUpdateOne {
+	_u.mutation.SetTokenID(v)
+	return _u
+}
+
+// SetNillableTokenID sets the "token_id" field if the given value is not nil.
+func (_u *NftUpdateOne) SetNillableTokenID(v *string) *NftUpdateOne {
+	if v != nil {
+		_u.SetTokenID(*v)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *NftUpdateOne) SetOwner(v string) *NftUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *NftUpdateOne) SetNillableOwner(v *string) *NftUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *NftUpdateOne) ClearOwner() *NftUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetBurned sets the "burned" field.
+func (_u *NftUpdateOne) SetBurned(v bool) *NftUpdateOne {
+	_u.mutation.SetBurned(v)
+	return _u
+}
+
+// SetNillableBurned sets the "burned" field if the given value is not nil.
+func (_u *NftUpdateOne) SetNillableBurned(v *bool) *NftUpdateOne {
+	if v != nil {
+		_u.SetBurned(*v)
+	}
+	return _u
+}
+
+// SetMintedHeight sets the "minted_height" field.
+func (_u *NftUpdateOne) SetMintedHeight(v int) *NftUpdateOne {
+	_u.mutation.ResetMintedHeight()
+	_u.mutation.SetMintedHeight(v)
+	return _u
+}
+
+// SetNillableMintedHeight sets the "minted_height" field if the given value is not nil.
+func (_u *NftUpdateOne) SetNillableMintedHeight(v *int) *NftUpdateOne {
+	if v != nil {
+		_u.SetMintedHeight(*v)
+	}
+	return _u
+}
+
+// AddMintedHeight adds value to the "minted_height" field.
+func (_u *NftUpdateOne) AddMintedHeight(v int) *NftUpdateOne {
+	_u.mutation.AddMintedHeight(v)
+	return _u
+}
+
+// ClearMintedHeight clears the value of the "minted_height" field.
+func (_u *NftUpdateOne) ClearMintedHeight() *NftUpdateOne {
+	_u.mutation.ClearMintedHeight()
+	return _u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_u *NftUpdateOne) SetLastHeight(v int) *NftUpdateOne {
+	_u.mutation.ResetLastHeight()
+	_u.mutation.SetLastHeight(v)
+	return _u
+}
+
+// SetNillableLastHeight sets the "last_height" field if the given value is not nil.
+func (_u *NftUpdateOne) SetNillableLastHeight(v *int) *NftUpdateOne {
+	if v != nil {
+		_u.SetLastHeight(*v)
+	}
+	return _u
+}
+
+// AddLastHeight adds value to the "last_height" field.
+func (_u *NftUpdateOne) AddLastHeight(v int) *NftUpdateOne {
+	_u.mutation.AddLastHeight(v)
+	return _u
+}
+
+// Mutation returns the NftMutation object of the builder.
+func (_u *NftUpdateOne) Mutation() *NftMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NftUpdate builder.
+func (_u *NftUpdateOne) Where(ps ...predicate.Nft) *NftUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NftUpdateOne) Select(field string, fields ...string) *NftUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Nft entity.
+func (_u *NftUpdateOne) Save(ctx context.Context) (*Nft, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NftUpdateOne) SaveX(ctx context.Context) *Nft {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NftUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NftUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NftUpdateOne) check() error {
+	if v, ok := _u.mutation.Collection(); ok {
+		if err := nft.CollectionValidator(v); err != nil {
+			return &ValidationError{Name: "collection", err: fmt.Errorf(`ent: validator failed for field "Nft.collection": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenID(); ok {
+		if err := nft.TokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "token_id", err: fmt.Errorf(`ent: validator failed for field "Nft.token_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NftUpdateOne) sqlSave(ctx context.Context) (_node *Nft, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nft.Table, nft.Columns, sqlgraph.NewFieldSpec(nft.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Nft.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nft.FieldID)
+		for _, f := range fields {
+			if !nft.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nft.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Collection(); ok {
+		_spec.SetField(nft.FieldCollection, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenID(); ok {
+		_spec.SetField(nft.FieldTokenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(nft.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(nft.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.Burned(); ok {
+		_spec.SetField(nft.FieldBurned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MintedHeight(); ok {
+		_spec.SetField(nft.FieldMintedHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMintedHeight(); ok {
+		_spec.AddField(nft.FieldMintedHeight, field.TypeInt, value)
+	}
+	if _u.mutation.MintedHeightCleared() {
+		_spec.ClearField(nft.FieldMintedHeight, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastHeight(); ok {
+		_spec.SetField(nft.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastHeight(); ok {
+		_spec.AddField(nft.FieldLastHeight, field.TypeInt, value)
+	}
+	_node = &Nft{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nft.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
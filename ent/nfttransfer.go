@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/nfttransfer"
+)
+
+// NftTransfer is the model entity for the NftTransfer schema.
+type NftTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Hash of the transaction
+	Hash string `json:"hash,omitempty"`
+	// Kind of the transfer (mint, burn or transfer)
+	Func string `json:"func,omitempty"`
+	// Package path of the GRC721 collection
+	Collection string `json:"collection,omitempty"`
+	// Token ID within the collection
+	TokenID string `json:"token_id,omitempty"`
+	// Address of the previous owner
+	FromAddress string `json:"from_address,omitempty"`
+	// Address of the new owner
+	ToAddress string `json:"to_address,omitempty"`
+	// Height of the block containing the transfer
+	BlockHeight int `json:"block_height,omitempty"`
+	// Creation time of the transfer
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NftTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nfttransfer.FieldID, nfttransfer.FieldBlockHeight:
+			values[i] = new(sql.NullInt64)
+		case nfttransfer.FieldHash, nfttransfer.FieldFunc, nfttransfer.FieldCollection, nfttransfer.FieldTokenID, nfttransfer.FieldFromAddress, nfttransfer.FieldToAddress:
+			values[i] = new(sql.NullString)
+		case nfttransfer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NftTransfer fields.
+func (_m *NftTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nfttransfer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case nfttransfer.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case nfttransfer.FieldFunc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field func", values[i])
+			} else if value.Valid {
+				_m.Func = value.String
+			}
+		case nfttransfer.FieldCollection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collection", values[i])
+			} else if value.Valid {
+				_m.Collection = value.String
+			}
+		case nfttransfer.FieldTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_id", values[i])
+			} else if value.Valid {
+				_m.TokenID = value.String
+			}
+		case nfttransfer.FieldFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_address", values[i])
+			} else if value.Valid {
+				_m.FromAddress = value.String
+			}
+		case nfttransfer.FieldToAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_address", values[i])
+			} else if value.Valid {
+				_m.ToAddress = value.String
+			}
+		case nfttransfer.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = int(value.Int64)
+			}
+		case nfttransfer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NftTransfer.
+// This includes values selected through modifiers, order, etc.
+func (_m *NftTransfer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NftTransfer.
+// Note that you need to call NftTransfer.Unwrap() before calling this method if this NftTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NftTransfer) Update() *NftTransferUpdateOne {
+	return NewNftTransferClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NftTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NftTransfer) Unwrap() *NftTransfer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NftTransfer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NftTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("NftTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("func=")
+	builder.WriteString(_m.Func)
+	builder.WriteString(", ")
+	builder.WriteString("collection=")
+	builder.WriteString(_m.Collection)
+	builder.WriteString(", ")
+	builder.WriteString("token_id=")
+	builder.WriteString(_m.TokenID)
+	builder.WriteString(", ")
+	builder.WriteString("from_address=")
+	builder.WriteString(_m.FromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("to_address=")
+	builder.WriteString(_m.ToAddress)
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NftTransfers is a parsable slice of NftTransfer.
+type NftTransfers []*NftTransfer
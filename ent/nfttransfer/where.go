@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nfttransfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldHash, v))
+}
+
+// Func applies equality check predicate on the "func" field. It's identical to FuncEQ.
+func Func(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldFunc, v))
+}
+
+// Collection applies equality check predicate on the "collection" field. It's identical to CollectionEQ.
+func Collection(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldCollection, v))
+}
+
+// TokenID applies equality check predicate on the "token_id" field. It's identical to TokenIDEQ.
+func TokenID(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldTokenID, v))
+}
+
+// FromAddress applies equality check predicate on the "from_address" field. It's identical to FromAddressEQ.
+func FromAddress(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// ToAddress applies equality check predicate on the "to_address" field. It's identical to ToAddressEQ.
+func ToAddress(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldToAddress, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContainsFold(FieldHash, v))
+}
+
+// FuncEQ applies the EQ predicate on the "func" field.
+func FuncEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldFunc, v))
+}
+
+// FuncNEQ applies the NEQ predicate on the "func" field.
+func FuncNEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldFunc, v))
+}
+
+// FuncIn applies the In predicate on the "func" field.
+func FuncIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldFunc, vs...))
+}
+
+// FuncNotIn applies the NotIn predicate on the "func" field.
+func FuncNotIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldFunc, vs...))
+}
+
+// FuncGT applies the GT predicate on the "func" field.
+func FuncGT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldFunc, v))
+}
+
+// FuncGTE applies the GTE predicate on the "func" field.
+func FuncGTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldFunc, v))
+}
+
+// FuncLT applies the LT predicate on the "func" field.
+func FuncLT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldFunc, v))
+}
+
+// FuncLTE applies the LTE predicate on the "func" field.
+func FuncLTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldFunc, v))
+}
+
+// FuncContains applies the Contains predicate on the "func" field.
+func FuncContains(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContains(FieldFunc, v))
+}
+
+// FuncHasPrefix applies the HasPrefix predicate on the "func" field.
+func FuncHasPrefix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasPrefix(FieldFunc, v))
+}
+
+// FuncHasSuffix applies the HasSuffix predicate on the "func" field.
+func FuncHasSuffix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasSuffix(FieldFunc, v))
+}
+
+// FuncEqualFold applies the EqualFold predicate on the "func" field.
+func FuncEqualFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEqualFold(FieldFunc, v))
+}
+
+// FuncContainsFold applies the ContainsFold predicate on the "func" field.
+func FuncContainsFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContainsFold(FieldFunc, v))
+}
+
+// CollectionEQ applies the EQ predicate on the "collection" field.
+func CollectionEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldCollection, v))
+}
+
+// CollectionNEQ applies the NEQ predicate on the "collection" field.
+func CollectionNEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldCollection, v))
+}
+
+// CollectionIn applies the In predicate on the "collection" field.
+func CollectionIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldCollection, vs...))
+}
+
+// CollectionNotIn applies the NotIn predicate on the "collection" field.
+func CollectionNotIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldCollection, vs...))
+}
+
+// CollectionGT applies the GT predicate on the "collection" field.
+func CollectionGT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldCollection, v))
+}
+
+// CollectionGTE applies the GTE predicate on the "collection" field.
+func CollectionGTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldCollection, v))
+}
+
+// CollectionLT applies the LT predicate on the "collection" field.
+func CollectionLT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldCollection, v))
+}
+
+// CollectionLTE applies the LTE predicate on the "collection" field.
+func CollectionLTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldCollection, v))
+}
+
+// CollectionContains applies the Contains predicate on the "collection" field.
+func CollectionContains(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContains(FieldCollection, v))
+}
+
+// CollectionHasPrefix applies the HasPrefix predicate on the "collection" field.
+func CollectionHasPrefix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasPrefix(FieldCollection, v))
+}
+
+// CollectionHasSuffix applies the HasSuffix predicate on the "collection" field.
+func CollectionHasSuffix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasSuffix(FieldCollection, v))
+}
+
+// CollectionEqualFold applies the EqualFold predicate on the "collection" field.
+func CollectionEqualFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEqualFold(FieldCollection, v))
+}
+
+// CollectionContainsFold applies the ContainsFold predicate on the "collection" field.
+func CollectionContainsFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContainsFold(FieldCollection, v))
+}
+
+// TokenIDEQ applies the EQ predicate on the "token_id" field.
+func TokenIDEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldTokenID, v))
+}
+
+// TokenIDNEQ applies the NEQ predicate on the "token_id" field.
+func TokenIDNEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldTokenID, v))
+}
+
+// TokenIDIn applies the In predicate on the "token_id" field.
+func TokenIDIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldTokenID, vs...))
+}
+
+// TokenIDNotIn applies the NotIn predicate on the "token_id" field.
+func TokenIDNotIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldTokenID, vs...))
+}
+
+// TokenIDGT applies the GT predicate on the "token_id" field.
+func TokenIDGT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldTokenID, v))
+}
+
+// TokenIDGTE applies the GTE predicate on the "token_id" field.
+func TokenIDGTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldTokenID, v))
+}
+
+// TokenIDLT applies the LT predicate on the "token_id" field.
+func TokenIDLT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldTokenID, v))
+}
+
+// TokenIDLTE applies the LTE predicate on the "token_id" field.
+func TokenIDLTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldTokenID, v))
+}
+
+// TokenIDContains applies the Contains predicate on the "token_id" field.
+func TokenIDContains(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContains(FieldTokenID, v))
+}
+
+// TokenIDHasPrefix applies the HasPrefix predicate on the "token_id" field.
+func TokenIDHasPrefix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasPrefix(FieldTokenID, v))
+}
+
+// TokenIDHasSuffix applies the HasSuffix predicate on the "token_id" field.
+func TokenIDHasSuffix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasSuffix(FieldTokenID, v))
+}
+
+// TokenIDEqualFold applies the EqualFold predicate on the "token_id" field.
+func TokenIDEqualFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEqualFold(FieldTokenID, v))
+}
+
+// TokenIDContainsFold applies the ContainsFold predicate on the "token_id" field.
+func TokenIDContainsFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContainsFold(FieldTokenID, v))
+}
+
+// FromAddressEQ applies the EQ predicate on the "from_address" field.
+func FromAddressEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromAddressNEQ applies the NEQ predicate on the "from_address" field.
+func FromAddressNEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldFromAddress, v))
+}
+
+// FromAddressIn applies the In predicate on the "from_address" field.
+func FromAddressIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldFromAddress, vs...))
+}
+
+// FromAddressNotIn applies the NotIn predicate on the "from_address" field.
+func FromAddressNotIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldFromAddress, vs...))
+}
+
+// FromAddressGT applies the GT predicate on the "from_address" field.
+func FromAddressGT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldFromAddress, v))
+}
+
+// FromAddressGTE applies the GTE predicate on the "from_address" field.
+func FromAddressGTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldFromAddress, v))
+}
+
+// FromAddressLT applies the LT predicate on the "from_address" field.
+func FromAddressLT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldFromAddress, v))
+}
+
+// FromAddressLTE applies the LTE predicate on the "from_address" field.
+func FromAddressLTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldFromAddress, v))
+}
+
+// FromAddressContains applies the Contains predicate on the "from_address" field.
+func FromAddressContains(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContains(FieldFromAddress, v))
+}
+
+// FromAddressHasPrefix applies the HasPrefix predicate on the "from_address" field.
+func FromAddressHasPrefix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasPrefix(FieldFromAddress, v))
+}
+
+// FromAddressHasSuffix applies the HasSuffix predicate on the "from_address" field.
+func FromAddressHasSuffix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasSuffix(FieldFromAddress, v))
+}
+
+// FromAddressIsNil applies the IsNil predicate on the "from_address" field.
+func FromAddressIsNil() predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIsNull(FieldFromAddress))
+}
+
+// FromAddressNotNil applies the NotNil predicate on the "from_address" field.
+func FromAddressNotNil() predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotNull(FieldFromAddress))
+}
+
+// FromAddressEqualFold applies the EqualFold predicate on the "from_address" field.
+func FromAddressEqualFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEqualFold(FieldFromAddress, v))
+}
+
+// FromAddressContainsFold applies the ContainsFold predicate on the "from_address" field.
+func FromAddressContainsFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContainsFold(FieldFromAddress, v))
+}
+
+// ToAddressEQ applies the EQ predicate on the "to_address" field.
+func ToAddressEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldToAddress, v))
+}
+
+// ToAddressNEQ applies the NEQ predicate on the "to_address" field.
+func ToAddressNEQ(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldToAddress, v))
+}
+
+// ToAddressIn applies the In predicate on the "to_address" field.
+func ToAddressIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldToAddress, vs...))
+}
+
+// ToAddressNotIn applies the NotIn predicate on the "to_address" field.
+func ToAddressNotIn(vs ...string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldToAddress, vs...))
+}
+
+// ToAddressGT applies the GT predicate on the "to_address" field.
+func ToAddressGT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldToAddress, v))
+}
+
+// ToAddressGTE applies the GTE predicate on the "to_address" field.
+func ToAddressGTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldToAddress, v))
+}
+
+// ToAddressLT applies the LT predicate on the "to_address" field.
+func ToAddressLT(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldToAddress, v))
+}
+
+// ToAddressLTE applies the LTE predicate on the "to_address" field.
+func ToAddressLTE(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldToAddress, v))
+}
+
+// ToAddressContains applies the Contains predicate on the "to_address" field.
+func ToAddressContains(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContains(FieldToAddress, v))
+}
+
+// ToAddressHasPrefix applies the HasPrefix predicate on the "to_address" field.
+func ToAddressHasPrefix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasPrefix(FieldToAddress, v))
+}
+
+// ToAddressHasSuffix applies the HasSuffix predicate on the "to_address" field.
+func ToAddressHasSuffix(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldHasSuffix(FieldToAddress, v))
+}
+
+// ToAddressIsNil applies the IsNil predicate on the "to_address" field.
+func ToAddressIsNil() predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIsNull(FieldToAddress))
+}
+
+// ToAddressNotNil applies the NotNil predicate on the "to_address" field.
+func ToAddressNotNil() predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotNull(FieldToAddress))
+}
+
+// ToAddressEqualFold applies the EqualFold predicate on the "to_address" field.
+func ToAddressEqualFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEqualFold(FieldToAddress, v))
+}
+
+// ToAddressContainsFold applies the ContainsFold predicate on the "to_address" field.
+func ToAddressContainsFold(v string) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldContainsFold(FieldToAddress, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v int) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NftTransfer) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NftTransfer) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NftTransfer) predicate.NftTransfer {
+	return predicate.NftTransfer(sql.NotPredicates(p))
+}
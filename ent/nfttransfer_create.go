@@ -0,0 +1,906 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/nfttransfer"
+)
+
+// NftTransferCreate is the builder for creating a NftTransfer entity.
+type NftTransferCreate struct {
+	config
+	mutation *NftTransferMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHash sets the "hash" field.
+func (_c *NftTransferCreate) SetHash(v string) *NftTransferCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetFunc sets the "func" field.
+func (_c *NftTransferCreate) SetFunc(v string) *NftTransferCreate {
+	_c.mutation.SetFunc(v)
+	return _c
+}
+
+// SetCollection sets the "collection" field.
+func (_c *NftTransferCreate) SetCollection(v string) *NftTransferCreate {
+	_c.mutation.SetCollection(v)
+	return _c
+}
+
+// SetTokenID sets the "token_id" field.
+func (_c *NftTransferCreate) SetTokenID(v string) *NftTransferCreate {
+	_c.mutation.SetTokenID(v)
+	return _c
+}
+
+// SetFromAddress sets the "from_address" field.
+func (_c *NftTransferCreate) SetFromAddress(v string) *NftTransferCreate {
+	_c.mutation.SetFromAddress(v)
+	return _c
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (_c *NftTransferCreate) SetNillableFromAddress(v *string) *NftTransferCreate {
+	if v != nil {
+		_c.SetFromAddress(*v)
+	}
+	return _c
+}
+
+// SetToAddress sets the "to_address" field.
+func (_c *NftTransferCreate) SetToAddress(v string) *NftTransferCreate {
+	_c.mutation.SetToAddress(v)
+	return _c
+}
+
+// SetNillableToAddress sets the "to_address" field if the given value is not nil.
+func (_c *NftTransferCreate) SetNillableToAddress(v *string) *NftTransferCreate {
+	if v != nil {
+		_c.SetToAddress(*v)
+	}
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *NftTransferCreate) SetBlockHeight(v int) *NftTransferCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NftTransferCreate) SetCreatedAt(v time.Time) *NftTransferCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NftTransferCreate) SetNillableCreatedAt(v *time.Time) *NftTransferCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NftTransferMutation object of the builder.
+func (_c *NftTransferCreate) Mutation() *NftTransferMutation {
+	return _c.mutation
+}
+
+// Save creates the NftTransfer in the database.
+func (_c *NftTransferCreate) Save(ctx context.Context) (*NftTransfer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NftTransferCreate) SaveX(ctx context.Context) *NftTransfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NftTransferCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NftTransferCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NftTransferCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := nfttransfer.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NftTransferCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "NftTransfer.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := nfttransfer.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Func(); !ok {
+		return &ValidationError{Name: "func", err: errors.New(`ent: missing required field "NftTransfer.func"`)}
+	}
+	if v, ok := _c.mutation.Func(); ok {
+		if err := nfttransfer.FuncValidator(v); err != nil {
+			return &ValidationError{Name: "func", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.func": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Collection(); !ok {
+		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required field "NftTransfer.collection"`)}
+	}
+	if v, ok := _c.mutation.Collection(); ok {
+		if err := nfttransfer.CollectionValidator(v); err != nil {
+			return &ValidationError{Name: "collection", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.collection": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenID(); !ok {
+		return &ValidationError{Name: "token_id", err: errors.New(`ent: missing required field "NftTransfer.token_id"`)}
+	}
+	if v, ok := _c.mutation.TokenID(); ok {
+		if err := nfttransfer.TokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "token_id", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.token_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "NftTransfer.block_height"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NftTransfer.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *NftTransferCreate) sqlSave(ctx context.Context) (*NftTransfer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NftTransferCreate) createSpec() (*NftTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NftTransfer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(nfttransfer.Table, sqlgraph.NewFieldSpec(nfttransfer.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(nfttransfer.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Func(); ok {
+		_spec.SetField(nfttransfer.FieldFunc, field.TypeString, value)
+		_node.Func = value
+	}
+	if value, ok := _c.mutation.Collection(); ok {
+		_spec.SetField(nfttransfer.FieldCollection, field.TypeString, value)
+		_node.Collection = value
+	}
+	if value, ok := _c.mutation.TokenID(); ok {
+		_spec.SetField(nfttransfer.FieldTokenID, field.TypeString, value)
+		_node.TokenID = value
+	}
+	if value, ok := _c.mutation.FromAddress(); ok {
+		_spec.SetField(nfttransfer.FieldFromAddress, field.TypeString, value)
+		_node.FromAddress = value
+	}
+	if value, ok := _c.mutation.ToAddress(); ok {
+		_spec.SetField(nfttransfer.FieldToAddress, field.TypeString, value)
+		_node.ToAddress = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(nfttransfer.FieldBlockHeight, field.TypeInt, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(nfttransfer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NftTransfer.Create().
+//		SetHash(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NftTransferUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NftTransferCreate) OnConflict(opts ...sql.ConflictOption) *NftTransferUpsertOne {
+	_c.conflict = opts
+	return &NftTransferUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NftTransfer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NftTransferCreate) OnConflictColumns(columns ...string) *NftTransferUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NftTransferUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NftTransferUpsertOne is the builder for "upsert"-ing
+	//  one NftTransfer node.
+	NftTransferUpsertOne struct {
+		create *NftTransferCreate
+	}
+
+	// NftTransferUpsert is the "OnConflict" setter.
+	NftTransferUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHash sets the "hash" field.
+func (u *NftTransferUpsert) SetHash(v string) *NftTransferUpsert {
+	u.Set(nfttransfer.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *NftTransferUpsert) UpdateHash() *NftTransferUpsert {
+	u.SetExcluded(nfttransfer.FieldHash)
+	return u
+}
+
+// SetFunc sets the "func" field.
+func (u *NftTransferUpsert) SetFunc(v string) *NftTransferUpsert {
+	u.Set(nfttransfer.FieldFunc, v)
+	return u
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *NftTransferUpsert) UpdateFunc() *NftTransferUpsert {
+	u.SetExcluded(nfttransfer.FieldFunc)
+	return u
+}
+
+// SetCollection sets the "collection" field.
+func (u *NftTransferUpsert) SetCollection(v string) *NftTransferUpsert {
+	u.Set(nfttransfer.FieldCollection, v)
+	return u
+}
+
+// UpdateCollection sets the "collection" field to the value that was provided on create.
+func (u *NftTransferUpsert) UpdateCollection() *NftTransferUpsert {
+	u.SetExcluded(nfttransfer.FieldCollection)
+	return u
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *NftTransferUpsert) SetTokenID(v string) *NftTransferUpsert {
+	u.Set(nfttransfer.FieldTokenID, v)
+	return u
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *NftTransferUpsert) UpdateTokenID() *NftTransferUpsert {
+	u.SetExcluded(nfttransfer.FieldTokenID)
+	return u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *NftTransferUpsert) SetFromAddress(v string) *NftTransferUpsert {
+	u.Set(nfttransfer.FieldFromAddress, v)
+	return u
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *NftTransferUpsert) UpdateFromAddress() *NftTransferUpsert {
+	u.SetExcluded(nfttransfer.FieldFromAddress)
+	return u
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *NftTransferUpsert) ClearFromAddress() *NftTransferUpsert {
+	u.SetNull(nfttransfer.FieldFromAddress)
+	return u
+}
+
+// SetToAddress sets the "to_address" field.
+func (u *NftTransferUpsert) SetToAddress(v string) *NftTransferUpsert {
+	u.Set(nfttransfer.FieldToAddress, v)
+	return u
+}
+
+// UpdateToAddress sets the "to_address" field to the value that was provided on create.
+func (u *NftTransferUpsert) UpdateToAddress() *NftTransferUpsert {
+	u.SetExcluded(nfttransfer.FieldToAddress)
+	return u
+}
+
+// ClearToAddress clears the value of the "to_address" field.
+func (u *NftTransferUpsert) ClearToAddress() *NftTransferUpsert {
+	u.SetNull(nfttransfer.FieldToAddress)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *NftTransferUpsert) SetBlockHeight(v int) *NftTransferUpsert {
+	u.Set(nfttransfer.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *NftTransferUpsert) UpdateBlockHeight() *NftTransferUpsert {
+	u.SetExcluded(nfttransfer.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *NftTransferUpsert) AddBlockHeight(v int) *NftTransferUpsert {
+	u.Add(nfttransfer.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NftTransfer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NftTransferUpsertOne) UpdateNewValues() *NftTransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(nfttransfer.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NftTransfer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NftTransferUpsertOne) Ignore() *NftTransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NftTransferUpsertOne) DoNothing() *NftTransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NftTransferCreate.OnConflict
+// documentation for more info.
+func (u *NftTransferUpsertOne) Update(set func(*NftTransferUpsert)) *NftTransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NftTransferUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *NftTransferUpsertOne) SetHash(v string) *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *NftTransferUpsertOne) UpdateHash() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetFunc sets the "func" field.
+func (u *NftTransferUpsertOne) SetFunc(v string) *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetFunc(v)
+	})
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *NftTransferUpsertOne) UpdateFunc() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateFunc()
+	})
+}
+
+// SetCollection sets the "collection" field.
+func (u *NftTransferUpsertOne) SetCollection(v string) *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetCollection(v)
+	})
+}
+
+// UpdateCollection sets the "collection" field to the value that was provided on create.
+func (u *NftTransferUpsertOne) UpdateCollection() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateCollection()
+	})
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *NftTransferUpsertOne) SetTokenID(v string) *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetTokenID(v)
+	})
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *NftTransferUpsertOne) UpdateTokenID() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateTokenID()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *NftTransferUpsertOne) SetFromAddress(v string) *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *NftTransferUpsertOne) UpdateFromAddress() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *NftTransferUpsertOne) ClearFromAddress() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.ClearFromAddress()
+	})
+}
+
+// SetToAddress sets the "to_address" field.
+func (u *NftTransferUpsertOne) SetToAddress(v string) *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetToAddress(v)
+	})
+}
+
+// UpdateToAddress sets the "to_address" field to the value that was provided on create.
+func (u *NftTransferUpsertOne) UpdateToAddress() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateToAddress()
+	})
+}
+
+// ClearToAddress clears the value of the "to_address" field.
+func (u *NftTransferUpsertOne) ClearToAddress() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.ClearToAddress()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *NftTransferUpsertOne) SetBlockHeight(v int) *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *NftTransferUpsertOne) AddBlockHeight(v int) *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *NftTransferUpsertOne) UpdateBlockHeight() *NftTransferUpsertOne {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// Exec executes the query.
+func (u *NftTransferUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NftTransferCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NftTransferUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NftTransferUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NftTransferUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NftTransferCreateBulk is the builder for creating many NftTransfer entities in bulk.
+type NftTransferCreateBulk struct {
+	config
+	err      error
+	builders []*NftTransferCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NftTransfer entities in the database.
+func (_c *NftTransferCreateBulk) Save(ctx context.Context) ([]*NftTransfer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NftTransfer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NftTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NftTransferCreateBulk) SaveX(ctx context.Context) []*NftTransfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NftTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NftTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NftTransfer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NftTransferUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NftTransferCreateBulk) OnConflict(opts ...sql.ConflictOption) *NftTransferUpsertBulk {
+	_c.conflict = opts
+	return &NftTransferUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NftTransfer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NftTransferCreateBulk) OnConflictColumns(columns ...string) *NftTransferUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NftTransferUpsertBulk{
+		create: _c,
+	}
+}
+
+// NftTransferUpsertBulk is the builder for "upsert"-ing
+// a bulk of NftTransfer nodes.
+type NftTransferUpsertBulk struct {
+	create *NftTransferCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NftTransfer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NftTransferUpsertBulk) UpdateNewValues() *NftTransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(nfttransfer.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NftTransfer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NftTransferUpsertBulk) Ignore() *NftTransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NftTransferUpsertBulk) DoNothing() *NftTransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NftTransferCreateBulk.OnConflict
+// documentation for more info.
+func (u *NftTransferUpsertBulk) Update(set func(*NftTransferUpsert)) *NftTransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NftTransferUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *NftTransferUpsertBulk) SetHash(v string) *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *NftTransferUpsertBulk) UpdateHash() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetFunc sets the "func" field.
+func (u *NftTransferUpsertBulk) SetFunc(v string) *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetFunc(v)
+	})
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *NftTransferUpsertBulk) UpdateFunc() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateFunc()
+	})
+}
+
+// SetCollection sets the "collection" field.
+func (u *NftTransferUpsertBulk) SetCollection(v string) *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetCollection(v)
+	})
+}
+
+// UpdateCollection sets the "collection" field to the value that was provided on create.
+func (u *NftTransferUpsertBulk) UpdateCollection() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateCollection()
+	})
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *NftTransferUpsertBulk) SetTokenID(v string) *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetTokenID(v)
+	})
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *NftTransferUpsertBulk) UpdateTokenID() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateTokenID()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *NftTransferUpsertBulk) SetFromAddress(v string) *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *NftTransferUpsertBulk) UpdateFromAddress() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *NftTransferUpsertBulk) ClearFromAddress() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.ClearFromAddress()
+	})
+}
+
+// SetToAddress sets the "to_address" field.
+func (u *NftTransferUpsertBulk) SetToAddress(v string) *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetToAddress(v)
+	})
+}
+
+// UpdateToAddress sets the "to_address" field to the value that was provided on create.
+func (u *NftTransferUpsertBulk) UpdateToAddress() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateToAddress()
+	})
+}
+
+// ClearToAddress clears the value of the "to_address" field.
+func (u *NftTransferUpsertBulk) ClearToAddress() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.ClearToAddress()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *NftTransferUpsertBulk) SetBlockHeight(v int) *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *NftTransferUpsertBulk) AddBlockHeight(v int) *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *NftTransferUpsertBulk) UpdateBlockHeight() *NftTransferUpsertBulk {
+	return u.Update(func(s *NftTransferUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// Exec executes the query.
+func (u *NftTransferUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NftTransferCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NftTransferCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NftTransferUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
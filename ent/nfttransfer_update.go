@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/nfttransfer"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// NftTransferUpdate is the builder for updating NftTransfer entities.
+type NftTransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NftTransferMutation
+}
+
+// Where appends a list predicates to the NftTransferUpdate builder.
+func (_u *NftTransferUpdate) Where(ps ...predicate.NftTransfer) *NftTransferUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *NftTransferUpdate) SetHash(v string) *NftTransferUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *NftTransferUpdate) SetNillableHash(v *string) *NftTransferUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetFunc sets the "func" field.
+func (_u *NftTransferUpdate) SetFunc(v string) *NftTransferUpdate {
+	_u.mutation.SetFunc(v)
+	return _u
+}
+
+// SetNillableFunc sets the "func" field if the given value is not nil.
+func (_u *NftTransferUpdate) SetNillableFunc(v *string) *NftTransferUpdate {
+	if v != nil {
+		_u.SetFunc(*v)
+	}
+	return _u
+}
+
+// SetCollection sets the "collection" field.
+func (_u *NftTransferUpdate) SetCollection(v string) *NftTransferUpdate {
+	_u.mutation.SetCollection(v)
+	return _u
+}
+
+// SetNillableCollection sets the "collection" field if the given value is not nil.
+func (_u *NftTransferUpdate) SetNillableCollection(v *string) *NftTransferUpdate {
+	if v != nil {
+		_u.SetCollection(*v)
+	}
+	return _u
+}
+
+// SetTokenID sets the "token_id" field.
+func (_u *NftTransferUpdate) SetTokenID(v string) *NftTransferUpdate {
+	_u.mutation.SetTokenID(v)
+	return _u
+}
+
+// SetNillableTokenID sets the "token_id" field if the given value is not nil.
+func (_u *NftTransferUpdate) SetNillableTokenID(v *string) *NftTransferUpdate {
+	if v != nil {
+		_u.SetTokenID(*v)
+	}
+	return _u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (_u *NftTransferUpdate) SetFromAddress(v string) *NftTransferUpdate {
+	_u.mutation.SetFromAddress(v)
+	return _u
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (_u *NftTransferUpdate) SetNillableFromAddress(v *string) *NftTransferUpdate {
+	if v != nil {
+		_u.SetFromAddress(*v)
+	}
+	return _u
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (_u *NftTransferUpdate) ClearFromAddress() *NftTransferUpdate {
+	_u.mutation.ClearFromAddress()
+	return _u
+}
+
+// SetToAddress sets the "to_address" field.
+func (_u *NftTransferUpdate) SetToAddress(v string) *NftTransferUpdate {
+	_u.mutation.SetToAddress(v)
+	return _u
+}
+
+// SetNillableToAddress sets the "to_address" field if the given value is not nil.
+func (_u *NftTransferUpdate) SetNillableToAddress(v *string) *NftTransferUpdate {
+	if v != nil {
+		_u.SetToAddress(*v)
+	}
+	return _u
+}
+
+// ClearToAddress clears the value of the "to_address" field.
+func (_u *NftTransferUpdate) ClearToAddress() *NftTransferUpdate {
+	_u.mutation.ClearToAddress()
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *NftTransferUpdate) SetBlockHeight(v int) *NftTransferUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *NftTransferUpdate) SetNillableBlockHeight(v *int) *NftTransferUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *NftTransferUpdate) AddBlockHeight(v int) *NftTransferUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// Mutation returns the NftTransferMutation object of the builder.
+func (_u *NftTransferUpdate) Mutation() *NftTransferMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NftTransferUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NftTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NftTransferUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NftTransferUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NftTransferUpdate) check() error {
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := nfttransfer.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Func(); ok {
+		if err := nfttransfer.FuncValidator(v); err != nil {
+			return &ValidationError{Name: "func", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.func": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Collection(); ok {
+		if err := nfttransfer.CollectionValidator(v); err != nil {
+			return &ValidationError{Name: "collection", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.collection": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenID(); ok {
+		if err := nfttransfer.TokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "token_id", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.token_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NftTransferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nfttransfer.Table, nfttransfer.Columns, sqlgraph.NewFieldSpec(nfttransfer.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(nfttransfer.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Func(); ok {
+		_spec.SetField(nfttransfer.FieldFunc, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Collection(); ok {
+		_spec.SetField(nfttransfer.FieldCollection, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenID(); ok {
+		_spec.SetField(nfttransfer.FieldTokenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FromAddress(); ok {
+		_spec.SetField(nfttransfer.FieldFromAddress, field.TypeString, value)
+	}
+	if _u.mutation.FromAddressCleared() {
+		_spec.ClearField(nfttransfer.FieldFromAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToAddress(); ok {
+		_spec.SetField(nfttransfer.FieldToAddress, field.TypeString, value)
+	}
+	if _u.mutation.ToAddressCleared() {
+		_spec.ClearField(nfttransfer.FieldToAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(nfttransfer.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(nfttransfer.FieldBlockHeight, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nfttransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NftTransferUpdateOne is the builder for updating a single NftTransfer entity.
+type NftTransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NftTransferMutation
+}
+
+// SetHash sets the "hash" field.
+func (_u *NftTransferUpdateOne) SetHash(v string) *NftTransferUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *NftTransferUpdateOne) SetNillableHash(v *string) *NftTransferUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetFunc sets the "func" field.
+func (_u *NftTransferUpdateOne) SetFunc(v string) *NftTransferUpdateOne {
+	_u.mutation.SetFunc(v)
+	return _u
+}
+
+// SetNillableFunc sets the "func" field if the given value is not nil.
+func (_u *NftTransferUpdateOne) SetNillableFunc(v *string) *NftTransferUpdateOne {
+	if v != nil {
+		_u.SetFunc(*v)
+	}
+	return _u
+}
+
+// SetCollection sets the "collection" field.
+func (_u *NftTransferUpdateOne) SetCollection(v string) *NftTransferUpdateOne {
+	_u.mutation.SetCollection(v)
+	return _u
+}
+
+// SetNillableCollection sets the "collection" field if the given value is not nil.
+func (_u *NftTransferUpdateOne) SetNillableCollection(v *string) *NftTransferUpdateOne {
+	if v != nil {
+		_u.SetCollection(*v)
+	}
+	return _u
+}
+
+// SetTokenID sets the "token_id" field.
+func (_u *NftTransferUpdateOne) SetTokenID(v string) *NftTransferUpdateOne {
+	_u.mutation.SetTokenID(v)
+	return _u
+}
+
+// SetNillableTokenID sets the "token_id" field if the given value is not nil.
+func (_u *NftTransferUpdateOne) SetNillableTokenID(v *string) *NftTransferUpdateOne {
+	if v != nil {
+		_u.SetTokenID(*v)
+	}
+	return _u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (_u *NftTransferUpdateOne) SetFromAddress(v string) *NftTransferUpdateOne {
+	_u.mutation.SetFromAddress(v)
+	return _u
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (_u *NftTransferUpdateOne) SetNillableFromAddress(v *string) *NftTransferUpdateOne {
+	if v != nil {
+		_u.SetFromAddress(*v)
+	}
+	return _u
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (_u *NftTransferUpdateOne) ClearFromAddress() *NftTransferUpdateOne {
+	_u.mutation.ClearFromAddress()
+	return _u
+}
+
+// SetToAddress sets the "to_address" field.
+func (_u *NftTransferUpdateOne) SetToAddress(v string) *NftTransferUpdateOne {
+	_u.mutation.SetToAddress(v)
+	return _u
+}
+
+// SetNillableToAddress sets the "to_address" field if the given value is not nil.
+func (_u *NftTransferUpdateOne) SetNillableToAddress(v *string) *NftTransferUpdateOne {
+	if v != nil {
+		_u.SetToAddress(*v)
+	}
+	return _u
+}
+
+// ClearToAddress clears the value of the "to_address" field.
+func (_u *NftTransferUpdateOne) ClearToAddress() *NftTransferUpdateOne {
+	_u.mutation.ClearToAddress()
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *NftTransferUpdateOne) SetBlockHeight(v int) *NftTransferUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *NftTransferUpdateOne) SetNillableBlockHeight(v *int) *NftTransferUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *NftTransferUpdateOne) AddBlockHeight(v int) *NftTransferUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// Mutation returns the NftTransferMutation object of the builder.
+func (_u *NftTransferUpdateOne) Mutation() *NftTransferMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NftTransferUpdate builder.
+func (_u *NftTransferUpdateOne) Where(ps ...predicate.NftTransfer) *NftTransferUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NftTransferUpdateOne) Select(field string, fields ...string) *NftTransferUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NftTransfer entity.
+func (_u *NftTransferUpdateOne) Save(ctx context.Context) (*NftTransfer, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NftTransferUpdateOne) SaveX(ctx context.Context) *NftTransfer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NftTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NftTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NftTransferUpdateOne) check() error {
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := nfttransfer.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Func(); ok {
+		if err := nfttransfer.FuncValidator(v); err != nil {
+			return &ValidationError{Name: "func", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.func": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Collection(); ok {
+		if err := nfttransfer.CollectionValidator(v); err != nil {
+			return &ValidationError{Name: "collection", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.collection": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenID(); ok {
+		if err := nfttransfer.TokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "token_id", err: fmt.Errorf(`ent: validator failed for field "NftTransfer.token_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NftTransferUpdateOne) sqlSave(ctx context.Context) (_node *NftTransfer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nfttransfer.Table, nfttransfer.Columns, sqlgraph.NewFieldSpec(nfttransfer.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NftTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nfttransfer.FieldID)
+		for _, f := range fields {
+			if !nfttransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nfttransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(nfttransfer.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Func(); ok {
+		_spec.SetField(nfttransfer.FieldFunc, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Collection(); ok {
+		_spec.SetField(nfttransfer.FieldCollection, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenID(); ok {
+		_spec.SetField(nfttransfer.FieldTokenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FromAddress(); ok {
+		_spec.SetField(nfttransfer.FieldFromAddress, field.TypeString, value)
+	}
+	if _u.mutation.FromAddressCleared() {
+		_spec.ClearField(nfttransfer.FieldFromAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToAddress(); ok {
+		_spec.SetField(nfttransfer.FieldToAddress, field.TypeString, value)
+	}
+	if _u.mutation.ToAddressCleared() {
+		_spec.ClearField(nfttransfer.FieldToAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(nfttransfer.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(nfttransfer.FieldBlockHeight, field.TypeInt, value)
+	}
+	_node = &NftTransfer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nfttransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
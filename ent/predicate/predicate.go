@@ -9,14 +9,59 @@ import (
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// AddressTransaction is the predicate function for addresstransaction builders.
+type AddressTransaction func(*sql.Selector)
+
+// BalanceChange is the predicate function for balancechange builders.
+type BalanceChange func(*sql.Selector)
+
+// BalanceCheckpoint is the predicate function for balancecheckpoint builders.
+type BalanceCheckpoint func(*sql.Selector)
+
 // Block is the predicate function for block builders.
 type Block func(*sql.Selector)
 
+// GnoEvent is the predicate function for gnoevent builders.
+type GnoEvent func(*sql.Selector)
+
+// GnoPackage is the predicate function for gnopackage builders.
+type GnoPackage func(*sql.Selector)
+
+// GnoPackageFile is the predicate function for gnopackagefile builders.
+type GnoPackageFile func(*sql.Selector)
+
+// Holding is the predicate function for holding builders.
+type Holding func(*sql.Selector)
+
+// Nft is the predicate function for nft builders.
+type Nft func(*sql.Selector)
+
+// NftTransfer is the predicate function for nfttransfer builders.
+type NftTransfer func(*sql.Selector)
+
+// RealmCall is the predicate function for realmcall builders.
+type RealmCall func(*sql.Selector)
+
+// RebuildProgress is the predicate function for rebuildprogress builders.
+type RebuildProgress func(*sql.Selector)
+
 // RestoreHistory is the predicate function for restorehistory builders.
 type RestoreHistory func(*sql.Selector)
 
+// Token is the predicate function for token builders.
+type Token func(*sql.Selector)
+
+// TokenStat is the predicate function for tokenstat builders.
+type TokenStat func(*sql.Selector)
+
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
 
 // Transfer is the predicate function for transfer builders.
 type Transfer func(*sql.Selector)
+
+// WatchRule is the predicate function for watchrule builders.
+type WatchRule func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/realmcall"
+)
+
+// RealmCall is the model entity for the RealmCall schema.
+type RealmCall struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Hash of the transaction
+	Hash string `json:"hash,omitempty"`
+	// Index of the message in the transaction
+	MsgIndex int `json:"msg_index,omitempty"`
+	// Height of the block containing the call
+	BlockHeight int `json:"block_height,omitempty"`
+	// Timestamp of the block containing the call
+	BlockTime time.Time `json:"block_time,omitempty"`
+	// Package path of the called realm
+	PkgPath string `json:"pkg_path,omitempty"`
+	// Name of the called function
+	Func string `json:"func,omitempty"`
+	// Address of the caller
+	Caller string `json:"caller,omitempty"`
+	// Arguments of the call
+	Args []string `json:"args,omitempty"`
+	// Coins sent with the call
+	Send string `json:"send,omitempty"`
+	// Whether the transaction was successful
+	Success bool `json:"success,omitempty"`
+	// Creation time of the call
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RealmCall) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case realmcall.FieldArgs:
+			values[i] = new([]byte)
+		case realmcall.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case realmcall.FieldID, realmcall.FieldMsgIndex, realmcall.FieldBlockHeight:
+			values[i] = new(sql.NullInt64)
+		case realmcall.FieldHash, realmcall.FieldPkgPath, realmcall.FieldFunc, realmcall.FieldCaller, realmcall.FieldSend:
+			values[i] = new(sql.NullString)
+		case realmcall.FieldBlockTime, realmcall.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RealmCall fields.
+func (_m *RealmCall) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case realmcall.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case realmcall.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case realmcall.FieldMsgIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field msg_index", values[i])
+			} else if value.Valid {
+				_m.MsgIndex = int(value.Int64)
+			}
+		case realmcall.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = int(value.Int64)
+			}
+		case realmcall.FieldBlockTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field block_time", values[i])
+			} else if value.Valid {
+				_m.BlockTime = value.Time
+			}
+		case realmcall.FieldPkgPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pkg_path", values[i])
+			} else if value.Valid {
+				_m.PkgPath = value.String
+			}
+		case realmcall.FieldFunc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field func", values[i])
+			} else if value.Valid {
+				_m.Func = value.String
+			}
+		case realmcall.FieldCaller:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caller", values[i])
+			} else if value.Valid {
+				_m.Caller = value.String
+			}
+		case realmcall.FieldArgs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field args", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Args); err != nil {
+					return fmt.Errorf("unmarshal field args: %w", err)
+				}
+			}
+		case realmcall.FieldSend:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field send", values[i])
+			} else if value.Valid {
+				_m.Send = value.String
+			}
+		case realmcall.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				_m.Success = value.Bool
+			}
+		case realmcall.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RealmCall.
+// This includes values selected through modifiers, order, etc.
+func (_m *RealmCall) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RealmCall.
+// Note that you need to call RealmCall.Unwrap() before calling this method if this RealmCall
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RealmCall) Update() *RealmCallUpdateOne {
+	return NewRealmCallClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RealmCall entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RealmCall) Unwrap() *RealmCall {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RealmCall is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RealmCall) String() string {
+	var builder strings.Builder
+	builder.WriteString("RealmCall(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("msg_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MsgIndex))
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("block_time=")
+	builder.WriteString(_m.BlockTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("pkg_path=")
+	builder.WriteString(_m.PkgPath)
+	builder.WriteString(", ")
+	builder.WriteString("func=")
+	builder.WriteString(_m.Func)
+	builder.WriteString(", ")
+	builder.WriteString("caller=")
+	builder.WriteString(_m.Caller)
+	builder.WriteString(", ")
+	builder.WriteString("args=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Args))
+	builder.WriteString(", ")
+	builder.WriteString("send=")
+	builder.WriteString(_m.Send)
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Success))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RealmCalls is a parsable slice of RealmCall.
+type RealmCalls []*RealmCall
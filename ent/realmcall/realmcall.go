@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package realmcall
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the realmcall type in the database.
+	Label = "realm_call"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldMsgIndex holds the string denoting the msg_index field in the database.
+	FieldMsgIndex = "msg_index"
+	// FieldBlockHeight holds the string denoting the block_height field in the database.
+	FieldBlockHeight = "block_height"
+	// FieldBlockTime holds the string denoting the block_time field in the database.
+	FieldBlockTime = "block_time"
+	// FieldPkgPath holds the string denoting the pkg_path field in the database.
+	FieldPkgPath = "pkg_path"
+	// FieldFunc holds the string denoting the func field in the database.
+	FieldFunc = "func"
+	// FieldCaller holds the string denoting the caller field in the database.
+	FieldCaller = "caller"
+	// FieldArgs holds the string denoting the args field in the database.
+	FieldArgs = "args"
+	// FieldSend holds the string denoting the send field in the database.
+	FieldSend = "send"
+	// FieldSuccess holds the string denoting the success field in the database.
+	FieldSuccess = "success"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the realmcall in the database.
+	Table = "realm_calls"
+)
+
+// Columns holds all SQL columns for realmcall fields.
+var Columns = []string{
+	FieldID,
+	FieldHash,
+	FieldMsgIndex,
+	FieldBlockHeight,
+	FieldBlockTime,
+	FieldPkgPath,
+	FieldFunc,
+	FieldCaller,
+	FieldArgs,
+	FieldSend,
+	FieldSuccess,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	HashValidator func(string) error
+	// PkgPathValidator is a validator for the "pkg_path" field. It is called by the builders before save.
+	PkgPathValidator func(string) error
+	// FuncValidator is a validator for the "func" field. It is called by the builders before save.
+	FuncValidator func(string) error
+	// DefaultSuccess holds the default value on creation for the "success" field.
+	DefaultSuccess bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the RealmCall queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByMsgIndex orders the results by the msg_index field.
+func ByMsgIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgIndex, opts...).ToFunc()
+}
+
+// ByBlockHeight orders the results by the block_height field.
+func ByBlockHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHeight, opts...).ToFunc()
+}
+
+// ByBlockTime orders the results by the block_time field.
+func ByBlockTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockTime, opts...).ToFunc()
+}
+
+// ByPkgPath orders the results by the pkg_path field.
+func ByPkgPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPkgPath, opts...).ToFunc()
+}
+
+// ByFunc orders the results by the func field.
+func ByFunc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFunc, opts...).ToFunc()
+}
+
+// ByCaller orders the results by the caller field.
+func ByCaller(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaller, opts...).ToFunc()
+}
+
+// BySend orders the results by the send field.
+func BySend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSend, opts...).ToFunc()
+}
+
+// BySuccess orders the results by the success field.
+func BySuccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuccess, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
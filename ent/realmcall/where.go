@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package realmcall
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldID, id))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldHash, v))
+}
+
+// MsgIndex applies equality check predicate on the "msg_index" field. It's identical to MsgIndexEQ.
+func MsgIndex(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldMsgIndex, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
+func BlockTime(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// PkgPath applies equality check predicate on the "pkg_path" field. It's identical to PkgPathEQ.
+func PkgPath(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldPkgPath, v))
+}
+
+// Func applies equality check predicate on the "func" field. It's identical to FuncEQ.
+func Func(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldFunc, v))
+}
+
+// Caller applies equality check predicate on the "caller" field. It's identical to CallerEQ.
+func Caller(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldCaller, v))
+}
+
+// Send applies equality check predicate on the "send" field. It's identical to SendEQ.
+func Send(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldSend, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldSuccess, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContainsFold(FieldHash, v))
+}
+
+// MsgIndexEQ applies the EQ predicate on the "msg_index" field.
+func MsgIndexEQ(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldMsgIndex, v))
+}
+
+// MsgIndexNEQ applies the NEQ predicate on the "msg_index" field.
+func MsgIndexNEQ(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldMsgIndex, v))
+}
+
+// MsgIndexIn applies the In predicate on the "msg_index" field.
+func MsgIndexIn(vs ...int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldMsgIndex, vs...))
+}
+
+// MsgIndexNotIn applies the NotIn predicate on the "msg_index" field.
+func MsgIndexNotIn(vs ...int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldMsgIndex, vs...))
+}
+
+// MsgIndexGT applies the GT predicate on the "msg_index" field.
+func MsgIndexGT(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldMsgIndex, v))
+}
+
+// MsgIndexGTE applies the GTE predicate on the "msg_index" field.
+func MsgIndexGTE(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldMsgIndex, v))
+}
+
+// MsgIndexLT applies the LT predicate on the "msg_index" field.
+func MsgIndexLT(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldMsgIndex, v))
+}
+
+// MsgIndexLTE applies the LTE predicate on the "msg_index" field.
+func MsgIndexLTE(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldMsgIndex, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v int) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// BlockTimeEQ applies the EQ predicate on the "block_time" field.
+func BlockTimeEQ(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// BlockTimeNEQ applies the NEQ predicate on the "block_time" field.
+func BlockTimeNEQ(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldBlockTime, v))
+}
+
+// BlockTimeIn applies the In predicate on the "block_time" field.
+func BlockTimeIn(vs ...time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeNotIn applies the NotIn predicate on the "block_time" field.
+func BlockTimeNotIn(vs ...time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeGT applies the GT predicate on the "block_time" field.
+func BlockTimeGT(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldBlockTime, v))
+}
+
+// BlockTimeGTE applies the GTE predicate on the "block_time" field.
+func BlockTimeGTE(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldBlockTime, v))
+}
+
+// BlockTimeLT applies the LT predicate on the "block_time" field.
+func BlockTimeLT(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldBlockTime, v))
+}
+
+// BlockTimeLTE applies the LTE predicate on the "block_time" field.
+func BlockTimeLTE(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldBlockTime, v))
+}
+
+// PkgPathEQ applies the EQ predicate on the "pkg_path" field.
+func PkgPathEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldPkgPath, v))
+}
+
+// PkgPathNEQ applies the NEQ predicate on the "pkg_path" field.
+func PkgPathNEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldPkgPath, v))
+}
+
+// PkgPathIn applies the In predicate on the "pkg_path" field.
+func PkgPathIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldPkgPath, vs...))
+}
+
+// PkgPathNotIn applies the NotIn predicate on the "pkg_path" field.
+func PkgPathNotIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldPkgPath, vs...))
+}
+
+// PkgPathGT applies the GT predicate on the "pkg_path" field.
+func PkgPathGT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldPkgPath, v))
+}
+
+// PkgPathGTE applies the GTE predicate on the "pkg_path" field.
+func PkgPathGTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldPkgPath, v))
+}
+
+// PkgPathLT applies the LT predicate on the "pkg_path" field.
+func PkgPathLT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldPkgPath, v))
+}
+
+// PkgPathLTE applies the LTE predicate on the "pkg_path" field.
+func PkgPathLTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldPkgPath, v))
+}
+
+// PkgPathContains applies the Contains predicate on the "pkg_path" field.
+func PkgPathContains(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContains(FieldPkgPath, v))
+}
+
+// PkgPathHasPrefix applies the HasPrefix predicate on the "pkg_path" field.
+func PkgPathHasPrefix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasPrefix(FieldPkgPath, v))
+}
+
+// PkgPathHasSuffix applies the HasSuffix predicate on the "pkg_path" field.
+func PkgPathHasSuffix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasSuffix(FieldPkgPath, v))
+}
+
+// PkgPathEqualFold applies the EqualFold predicate on the "pkg_path" field.
+func PkgPathEqualFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEqualFold(FieldPkgPath, v))
+}
+
+// PkgPathContainsFold applies the ContainsFold predicate on the "pkg_path" field.
+func PkgPathContainsFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContainsFold(FieldPkgPath, v))
+}
+
+// FuncEQ applies the EQ predicate on the "func" field.
+func FuncEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldFunc, v))
+}
+
+// FuncNEQ applies the NEQ predicate on the "func" field.
+func FuncNEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldFunc, v))
+}
+
+// FuncIn applies the In predicate on the "func" field.
+func FuncIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldFunc, vs...))
+}
+
+// FuncNotIn applies the NotIn predicate on the "func" field.
+func FuncNotIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldFunc, vs...))
+}
+
+// FuncGT applies the GT predicate on the "func" field.
+func FuncGT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldFunc, v))
+}
+
+// FuncGTE applies the GTE predicate on the "func" field.
+func FuncGTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldFunc, v))
+}
+
+// FuncLT applies the LT predicate on the "func" field.
+func FuncLT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldFunc, v))
+}
+
+// FuncLTE applies the LTE predicate on the "func" field.
+func FuncLTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldFunc, v))
+}
+
+// FuncContains applies the Contains predicate on the "func" field.
+func FuncContains(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContains(FieldFunc, v))
+}
+
+// FuncHasPrefix applies the HasPrefix predicate on the "func" field.
+func FuncHasPrefix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasPrefix(FieldFunc, v))
+}
+
+// FuncHasSuffix applies the HasSuffix predicate on the "func" field.
+func FuncHasSuffix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasSuffix(FieldFunc, v))
+}
+
+// FuncEqualFold applies the EqualFold predicate on the "func" field.
+func FuncEqualFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEqualFold(FieldFunc, v))
+}
+
+// FuncContainsFold applies the ContainsFold predicate on the "func" field.
+func FuncContainsFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContainsFold(FieldFunc, v))
+}
+
+// CallerEQ applies the EQ predicate on the "caller" field.
+func CallerEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldCaller, v))
+}
+
+// CallerNEQ applies the NEQ predicate on the "caller" field.
+func CallerNEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldCaller, v))
+}
+
+// CallerIn applies the In predicate on the "caller" field.
+func CallerIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldCaller, vs...))
+}
+
+// CallerNotIn applies the NotIn predicate on the "caller" field.
+func CallerNotIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldCaller, vs...))
+}
+
+// CallerGT applies the GT predicate on the "caller" field.
+func CallerGT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldCaller, v))
+}
+
+// CallerGTE applies the GTE predicate on the "caller" field.
+func CallerGTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldCaller, v))
+}
+
+// CallerLT applies the LT predicate on the "caller" field.
+func CallerLT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldCaller, v))
+}
+
+// CallerLTE applies the LTE predicate on the "caller" field.
+func CallerLTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldCaller, v))
+}
+
+// CallerContains applies the Contains predicate on the "caller" field.
+func CallerContains(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContains(FieldCaller, v))
+}
+
+// CallerHasPrefix applies the HasPrefix predicate on the "caller" field.
+func CallerHasPrefix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasPrefix(FieldCaller, v))
+}
+
+// CallerHasSuffix applies the HasSuffix predicate on the "caller" field.
+func CallerHasSuffix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasSuffix(FieldCaller, v))
+}
+
+// CallerEqualFold applies the EqualFold predicate on the "caller" field.
+func CallerEqualFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEqualFold(FieldCaller, v))
+}
+
+// CallerContainsFold applies the ContainsFold predicate on the "caller" field.
+func CallerContainsFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContainsFold(FieldCaller, v))
+}
+
+// ArgsIsNil applies the IsNil predicate on the "args" field.
+func ArgsIsNil() predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIsNull(FieldArgs))
+}
+
+// ArgsNotNil applies the NotNil predicate on the "args" field.
+func ArgsNotNil() predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotNull(FieldArgs))
+}
+
+// SendEQ applies the EQ predicate on the "send" field.
+func SendEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldSend, v))
+}
+
+// SendNEQ applies the NEQ predicate on the "send" field.
+func SendNEQ(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldSend, v))
+}
+
+// SendIn applies the In predicate on the "send" field.
+func SendIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldSend, vs...))
+}
+
+// SendNotIn applies the NotIn predicate on the "send" field.
+func SendNotIn(vs ...string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldSend, vs...))
+}
+
+// SendGT applies the GT predicate on the "send" field.
+func SendGT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldSend, v))
+}
+
+// SendGTE applies the GTE predicate on the "send" field.
+func SendGTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldSend, v))
+}
+
+// SendLT applies the LT predicate on the "send" field.
+func SendLT(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldSend, v))
+}
+
+// SendLTE applies the LTE predicate on the "send" field.
+func SendLTE(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldSend, v))
+}
+
+// SendContains applies the Contains predicate on the "send" field.
+func SendContains(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContains(FieldSend, v))
+}
+
+// SendHasPrefix applies the HasPrefix predicate on the "send" field.
+func SendHasPrefix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasPrefix(FieldSend, v))
+}
+
+// SendHasSuffix applies the HasSuffix predicate on the "send" field.
+func SendHasSuffix(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldHasSuffix(FieldSend, v))
+}
+
+// SendIsNil applies the IsNil predicate on the "send" field.
+func SendIsNil() predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIsNull(FieldSend))
+}
+
+// SendNotNil applies the NotNil predicate on the "send" field.
+func SendNotNil() predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotNull(FieldSend))
+}
+
+// SendEqualFold applies the EqualFold predicate on the "send" field.
+func SendEqualFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEqualFold(FieldSend, v))
+}
+
+// SendContainsFold applies the ContainsFold predicate on the "send" field.
+func SendContainsFold(v string) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldContainsFold(FieldSend, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RealmCall {
+	return predicate.RealmCall(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RealmCall) predicate.RealmCall {
+	return predicate.RealmCall(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RealmCall) predicate.RealmCall {
+	return predicate.RealmCall(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RealmCall) predicate.RealmCall {
+	return predicate.RealmCall(sql.NotPredicates(p))
+}
@@ -0,0 +1,1084 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/realmcall"
+)
+
+// RealmCallCreate is the builder for creating a RealmCall entity.
+type RealmCallCreate struct {
+	config
+	mutation *RealmCallMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHash sets the "hash" field.
+func (_c *RealmCallCreate) SetHash(v string) *RealmCallCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (_c *RealmCallCreate) SetMsgIndex(v int) *RealmCallCreate {
+	_c.mutation.SetMsgIndex(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *RealmCallCreate) SetBlockHeight(v int) *RealmCallCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_c *RealmCallCreate) SetBlockTime(v time.Time) *RealmCallCreate {
+	_c.mutation.SetBlockTime(v)
+	return _c
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (_c *RealmCallCreate) SetPkgPath(v string) *RealmCallCreate {
+	_c.mutation.SetPkgPath(v)
+	return _c
+}
+
+// SetFunc sets the "func" field.
+func (_c *RealmCallCreate) SetFunc(v string) *RealmCallCreate {
+	_c.mutation.SetFunc(v)
+	return _c
+}
+
+// SetCaller sets the "caller" field.
+func (_c *RealmCallCreate) SetCaller(v string) *RealmCallCreate {
+	_c.mutation.SetCaller(v)
+	return _c
+}
+
+// SetArgs sets the "args" field.
+func (_c *RealmCallCreate) SetArgs(v []string) *RealmCallCreate {
+	_c.mutation.SetArgs(v)
+	return _c
+}
+
+// SetSend sets the "send" field.
+func (_c *RealmCallCreate) SetSend(v string) *RealmCallCreate {
+	_c.mutation.SetSend(v)
+	return _c
+}
+
+// SetNillableSend sets the "send" field if the given value is not nil.
+func (_c *RealmCallCreate) SetNillableSend(v *string) *RealmCallCreate {
+	if v != nil {
+		_c.SetSend(*v)
+	}
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *RealmCallCreate) SetSuccess(v bool) *RealmCallCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_c *RealmCallCreate) SetNillableSuccess(v *bool) *RealmCallCreate {
+	if v != nil {
+		_c.SetSuccess(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RealmCallCreate) SetCreatedAt(v time.Time) *RealmCallCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RealmCallCreate) SetNillableCreatedAt(v *time.Time) *RealmCallCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RealmCallMutation object of the builder.
+func (_c *RealmCallCreate) Mutation() *RealmCallMutation {
+	return _c.mutation
+}
+
+// Save creates the RealmCall in the database.
+func (_c *RealmCallCreate) Save(ctx context.Context) (*RealmCall, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RealmCallCreate) SaveX(ctx context.Context) *RealmCall {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RealmCallCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RealmCallCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RealmCallCreate) defaults() {
+	if _, ok := _c.mutation.Success(); !ok {
+		v := realmcall.DefaultSuccess
+		_c.mutation.SetSuccess(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := realmcall.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RealmCallCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "RealmCall.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := realmcall.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "RealmCall.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MsgIndex(); !ok {
+		return &ValidationError{Name: "msg_index", err: errors.New(`ent: missing required field "RealmCall.msg_index"`)}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "RealmCall.block_height"`)}
+	}
+	if _, ok := _c.mutation.BlockTime(); !ok {
+		return &ValidationError{Name: "block_time", err: errors.New(`ent: missing required field "RealmCall.block_time"`)}
+	}
+	if _, ok := _c.mutation.PkgPath(); !ok {
+		return &ValidationError{Name: "pkg_path", err: errors.New(`ent: missing required field "RealmCall.pkg_path"`)}
+	}
+	if v, ok := _c.mutation.PkgPath(); ok {
+		if err := realmcall.PkgPathValidator(v); err != nil {
+			return &ValidationError{Name: "pkg_path", err: fmt.Errorf(`ent: validator failed for field "RealmCall.pkg_path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Func(); !ok {
+		return &ValidationError{Name: "func", err: errors.New(`ent: missing required field "RealmCall.func"`)}
+	}
+	if v, ok := _c.mutation.Func(); ok {
+		if err := realmcall.FuncValidator(v); err != nil {
+			return &ValidationError{Name: "func", err: fmt.Errorf(`ent: validator failed for field "RealmCall.func": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Caller(); !ok {
+		return &ValidationError{Name: "caller", err: errors.New(`ent: missing required field "RealmCall.caller"`)}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "RealmCall.success"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RealmCall.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *RealmCallCreate) sqlSave(ctx context.Context) (*RealmCall, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RealmCallCreate) createSpec() (*RealmCall, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RealmCall{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(realmcall.Table, sqlgraph.NewFieldSpec(realmcall.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(realmcall.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.MsgIndex(); ok {
+		_spec.SetField(realmcall.FieldMsgIndex, field.TypeInt, value)
+		_node.MsgIndex = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(realmcall.FieldBlockHeight, field.TypeInt, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.BlockTime(); ok {
+		_spec.SetField(realmcall.FieldBlockTime, field.TypeTime, value)
+		_node.BlockTime = value
+	}
+	if value, ok := _c.mutation.PkgPath(); ok {
+		_spec.SetField(realmcall.FieldPkgPath, field.TypeString, value)
+		_node.PkgPath = value
+	}
+	if value, ok := _c.mutation.Func(); ok {
+		_spec.SetField(realmcall.FieldFunc, field.TypeString, value)
+		_node.Func = value
+	}
+	if value, ok := _c.mutation.Caller(); ok {
+		_spec.SetField(realmcall.FieldCaller, field.TypeString, value)
+		_node.Caller = value
+	}
+	if value, ok := _c.mutation.Args(); ok {
+		_spec.SetField(realmcall.FieldArgs, field.TypeJSON, value)
+		_node.Args = value
+	}
+	if value, ok := _c.mutation.Send(); ok {
+		_spec.SetField(realmcall.FieldSend, field.TypeString, value)
+		_node.Send = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(realmcall.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(realmcall.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RealmCall.Create().
+//		SetHash(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RealmCallUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RealmCallCreate) OnConflict(opts ...sql.ConflictOption) *RealmCallUpsertOne {
+	_c.conflict = opts
+	return &RealmCallUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RealmCall.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RealmCallCreate) OnConflictColumns(columns ...string) *RealmCallUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RealmCallUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RealmCallUpsertOne is the builder for "upsert"-ing
+	//  one RealmCall node.
+	RealmCallUpsertOne struct {
+		create *RealmCallCreate
+	}
+
+	// RealmCallUpsert is the "OnConflict" setter.
+	RealmCallUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHash sets the "hash" field.
+func (u *RealmCallUpsert) SetHash(v string) *RealmCallUpsert {
+	u.Set(realmcall.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateHash() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldHash)
+	return u
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (u *RealmCallUpsert) SetMsgIndex(v int) *RealmCallUpsert {
+	u.Set(realmcall.FieldMsgIndex, v)
+	return u
+}
+
+// UpdateMsgIndex sets the "msg_index" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateMsgIndex() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldMsgIndex)
+	return u
+}
+
+// AddMsgIndex adds v to the "msg_index" field.
+func (u *RealmCallUpsert) AddMsgIndex(v int) *RealmCallUpsert {
+	u.Add(realmcall.FieldMsgIndex, v)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *RealmCallUpsert) SetBlockHeight(v int) *RealmCallUpsert {
+	u.Set(realmcall.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateBlockHeight() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *RealmCallUpsert) AddBlockHeight(v int) *RealmCallUpsert {
+	u.Add(realmcall.FieldBlockHeight, v)
+	return u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *RealmCallUpsert) SetBlockTime(v time.Time) *RealmCallUpsert {
+	u.Set(realmcall.FieldBlockTime, v)
+	return u
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateBlockTime() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldBlockTime)
+	return u
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (u *RealmCallUpsert) SetPkgPath(v string) *RealmCallUpsert {
+	u.Set(realmcall.FieldPkgPath, v)
+	return u
+}
+
+// UpdatePkgPath sets the "pkg_path" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdatePkgPath() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldPkgPath)
+	return u
+}
+
+// SetFunc sets the "func" field.
+func (u *RealmCallUpsert) SetFunc(v string) *RealmCallUpsert {
+	u.Set(realmcall.FieldFunc, v)
+	return u
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateFunc() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldFunc)
+	return u
+}
+
+// SetCaller sets the "caller" field.
+func (u *RealmCallUpsert) SetCaller(v string) *RealmCallUpsert {
+	u.Set(realmcall.FieldCaller, v)
+	return u
+}
+
+// UpdateCaller sets the "caller" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateCaller() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldCaller)
+	return u
+}
+
+// SetArgs sets the "args" field.
+func (u *RealmCallUpsert) SetArgs(v []string) *RealmCallUpsert {
+	u.Set(realmcall.FieldArgs, v)
+	return u
+}
+
+// UpdateArgs sets the "args" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateArgs() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldArgs)
+	return u
+}
+
+// ClearArgs clears the value of the "args" field.
+func (u *RealmCallUpsert) ClearArgs() *RealmCallUpsert {
+	u.SetNull(realmcall.FieldArgs)
+	return u
+}
+
+// SetSend sets the "send" field.
+func (u *RealmCallUpsert) SetSend(v string) *RealmCallUpsert {
+	u.Set(realmcall.FieldSend, v)
+	return u
+}
+
+// UpdateSend sets the "send" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateSend() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldSend)
+	return u
+}
+
+// ClearSend clears the value of the "send" field.
+func (u *RealmCallUpsert) ClearSend() *RealmCallUpsert {
+	u.SetNull(realmcall.FieldSend)
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *RealmCallUpsert) SetSuccess(v bool) *RealmCallUpsert {
+	u.Set(realmcall.FieldSuccess, v)
+	return u
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *RealmCallUpsert) UpdateSuccess() *RealmCallUpsert {
+	u.SetExcluded(realmcall.FieldSuccess)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RealmCall.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RealmCallUpsertOne) UpdateNewValues() *RealmCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(realmcall.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RealmCall.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RealmCallUpsertOne) Ignore() *RealmCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RealmCallUpsertOne) DoNothing() *RealmCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RealmCallCreate.OnConflict
+// documentation for more info.
+func (u *RealmCallUpsertOne) Update(set func(*RealmCallUpsert)) *RealmCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RealmCallUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *RealmCallUpsertOne) SetHash(v string) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateHash() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (u *RealmCallUpsertOne) SetMsgIndex(v int) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetMsgIndex(v)
+	})
+}
+
+// AddMsgIndex adds v to the "msg_index" field.
+func (u *RealmCallUpsertOne) AddMsgIndex(v int) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.AddMsgIndex(v)
+	})
+}
+
+// UpdateMsgIndex sets the "msg_index" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateMsgIndex() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateMsgIndex()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *RealmCallUpsertOne) SetBlockHeight(v int) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *RealmCallUpsertOne) AddBlockHeight(v int) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateBlockHeight() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *RealmCallUpsertOne) SetBlockTime(v time.Time) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateBlockTime() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (u *RealmCallUpsertOne) SetPkgPath(v string) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetPkgPath(v)
+	})
+}
+
+// UpdatePkgPath sets the "pkg_path" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdatePkgPath() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdatePkgPath()
+	})
+}
+
+// SetFunc sets the "func" field.
+func (u *RealmCallUpsertOne) SetFunc(v string) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetFunc(v)
+	})
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateFunc() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateFunc()
+	})
+}
+
+// SetCaller sets the "caller" field.
+func (u *RealmCallUpsertOne) SetCaller(v string) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetCaller(v)
+	})
+}
+
+// UpdateCaller sets the "caller" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateCaller() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateCaller()
+	})
+}
+
+// SetArgs sets the "args" field.
+func (u *RealmCallUpsertOne) SetArgs(v []string) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetArgs(v)
+	})
+}
+
+// UpdateArgs sets the "args" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateArgs() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateArgs()
+	})
+}
+
+// ClearArgs clears the value of the "args" field.
+func (u *RealmCallUpsertOne) ClearArgs() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.ClearArgs()
+	})
+}
+
+// SetSend sets the "send" field.
+func (u *RealmCallUpsertOne) SetSend(v string) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetSend(v)
+	})
+}
+
+// UpdateSend sets the "send" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateSend() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateSend()
+	})
+}
+
+// ClearSend clears the value of the "send" field.
+func (u *RealmCallUpsertOne) ClearSend() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.ClearSend()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *RealmCallUpsertOne) SetSuccess(v bool) *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *RealmCallUpsertOne) UpdateSuccess() *RealmCallUpsertOne {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// Exec executes the query.
+func (u *RealmCallUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RealmCallCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RealmCallUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RealmCallUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RealmCallUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RealmCallCreateBulk is the builder for creating many RealmCall entities in bulk.
+type RealmCallCreateBulk struct {
+	config
+	err      error
+	builders []*RealmCallCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RealmCall entities in the database.
+func (_c *RealmCallCreateBulk) Save(ctx context.Context) ([]*RealmCall, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RealmCall, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RealmCallMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RealmCallCreateBulk) SaveX(ctx context.Context) []*RealmCall {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RealmCallCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RealmCallCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RealmCall.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RealmCallUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RealmCallCreateBulk) OnConflict(opts ...sql.ConflictOption) *RealmCallUpsertBulk {
+	_c.conflict = opts
+	return &RealmCallUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RealmCall.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RealmCallCreateBulk) OnConflictColumns(columns ...string) *RealmCallUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RealmCallUpsertBulk{
+		create: _c,
+	}
+}
+
+// RealmCallUpsertBulk is the builder for "upsert"-ing
+// a bulk of RealmCall nodes.
+type RealmCallUpsertBulk struct {
+	create *RealmCallCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RealmCall.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RealmCallUpsertBulk) UpdateNewValues() *RealmCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(realmcall.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RealmCall.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RealmCallUpsertBulk) Ignore() *RealmCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RealmCallUpsertBulk) DoNothing() *RealmCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RealmCallCreateBulk.OnConflict
+// documentation for more info.
+func (u *RealmCallUpsertBulk) Update(set func(*RealmCallUpsert)) *RealmCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RealmCallUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *RealmCallUpsertBulk) SetHash(v string) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateHash() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (u *RealmCallUpsertBulk) SetMsgIndex(v int) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetMsgIndex(v)
+	})
+}
+
+// AddMsgIndex adds v to the "msg_index" field.
+func (u *RealmCallUpsertBulk) AddMsgIndex(v int) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.AddMsgIndex(v)
+	})
+}
+
+// UpdateMsgIndex sets the "msg_index" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateMsgIndex() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateMsgIndex()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *RealmCallUpsertBulk) SetBlockHeight(v int) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *RealmCallUpsertBulk) AddBlockHeight(v int) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateBlockHeight() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *RealmCallUpsertBulk) SetBlockTime(v time.Time) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateBlockTime() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (u *RealmCallUpsertBulk) SetPkgPath(v string) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetPkgPath(v)
+	})
+}
+
+// UpdatePkgPath sets the "pkg_path" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdatePkgPath() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdatePkgPath()
+	})
+}
+
+// SetFunc sets the "func" field.
+func (u *RealmCallUpsertBulk) SetFunc(v string) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetFunc(v)
+	})
+}
+
+// UpdateFunc sets the "func" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateFunc() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateFunc()
+	})
+}
+
+// SetCaller sets the "caller" field.
+func (u *RealmCallUpsertBulk) SetCaller(v string) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetCaller(v)
+	})
+}
+
+// UpdateCaller sets the "caller" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateCaller() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateCaller()
+	})
+}
+
+// SetArgs sets the "args" field.
+func (u *RealmCallUpsertBulk) SetArgs(v []string) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetArgs(v)
+	})
+}
+
+// UpdateArgs sets the "args" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateArgs() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateArgs()
+	})
+}
+
+// ClearArgs clears the value of the "args" field.
+func (u *RealmCallUpsertBulk) ClearArgs() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.ClearArgs()
+	})
+}
+
+// SetSend sets the "send" field.
+func (u *RealmCallUpsertBulk) SetSend(v string) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetSend(v)
+	})
+}
+
+// UpdateSend sets the "send" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateSend() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateSend()
+	})
+}
+
+// ClearSend clears the value of the "send" field.
+func (u *RealmCallUpsertBulk) ClearSend() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.ClearSend()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *RealmCallUpsertBulk) SetSuccess(v bool) *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *RealmCallUpsertBulk) UpdateSuccess() *RealmCallUpsertBulk {
+	return u.Update(func(s *RealmCallUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// Exec executes the query.
+func (u *RealmCallUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RealmCallCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RealmCallCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RealmCallUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
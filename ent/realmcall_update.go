@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/realmcall"
+)
+
+// RealmCallUpdate is the builder for updating RealmCall entities.
+type RealmCallUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RealmCallMutation
+}
+
+// Where appends a list predicates to the RealmCallUpdate builder.
+func (_u *RealmCallUpdate) Where(ps ...predicate.RealmCall) *RealmCallUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *RealmCallUpdate) SetHash(v string) *RealmCallUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillableHash(v *string) *RealmCallUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (_u *RealmCallUpdate) SetMsgIndex(v int) *RealmCallUpdate {
+	_u.mutation.ResetMsgIndex()
+	_u.mutation.SetMsgIndex(v)
+	return _u
+}
+
+// SetNillableMsgIndex sets the "msg_index" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillableMsgIndex(v *int) *RealmCallUpdate {
+	if v != nil {
+		_u.SetMsgIndex(*v)
+	}
+	return _u
+}
+
+// AddMsgIndex adds value to the "msg_index" field.
+func (_u *RealmCallUpdate) AddMsgIndex(v int) *RealmCallUpdate {
+	_u.mutation.AddMsgIndex(v)
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *RealmCallUpdate) SetBlockHeight(v int) *RealmCallUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillableBlockHeight(v *int) *RealmCallUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *RealmCallUpdate) AddBlockHeight(v int) *RealmCallUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *RealmCallUpdate) SetBlockTime(v time.Time) *RealmCallUpdate {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillableBlockTime(v *time.Time) *RealmCallUpdate {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (_u *RealmCallUpdate) SetPkgPath(v string) *RealmCallUpdate {
+	_u.mutation.SetPkgPath(v)
+	return _u
+}
+
+// SetNillablePkgPath sets the "pkg_path" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillablePkgPath(v *string) *RealmCallUpdate {
+	if v != nil {
+		_u.SetPkgPath(*v)
+	}
+	return _u
+}
+
+// SetFunc sets the "func" field.
+func (_u *RealmCallUpdate) SetFunc(v string) *RealmCallUpdate {
+	_u.mutation.SetFunc(v)
+	return _u
+}
+
+// SetNillableFunc sets the "func" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillableFunc(v *string) *RealmCallUpdate {
+	if v != nil {
+		_u.SetFunc(*v)
+	}
+	return _u
+}
+
+// SetCaller sets the "caller" field.
+func (_u *RealmCallUpdate) SetCaller(v string) *RealmCallUpdate {
+	_u.mutation.SetCaller(v)
+	return _u
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillableCaller(v *string) *RealmCallUpdate {
+	if v != nil {
+		_u.SetCaller(*v)
+	}
+	return _u
+}
+
+// SetArgs sets the "args" field.
+func (_u *RealmCallUpdate) SetArgs(v []string) *RealmCallUpdate {
+	_u.mutation.SetArgs(v)
+	return _u
+}
+
+// AppendArgs appends value to the "args" field.
+func (_u *RealmCallUpdate) AppendArgs(v []string) *RealmCallUpdate {
+	_u.mutation.AppendArgs(v)
+	return _u
+}
+
+// ClearArgs clears the value of the "args" field.
+func (_u *RealmCallUpdate) ClearArgs() *RealmCallUpdate {
+	_u.mutation.ClearArgs()
+	return _u
+}
+
+// SetSend sets the "send" field.
+func (_u *RealmCallUpdate) SetSend(v string) *RealmCallUpdate {
+	_u.mutation.SetSend(v)
+	return _u
+}
+
+// SetNillableSend sets the "send" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillableSend(v *string) *RealmCallUpdate {
+	if v != nil {
+		_u.SetSend(*v)
+	}
+	return _u
+}
+
+// ClearSend clears the value of the "send" field.
+func (_u *RealmCallUpdate) ClearSend() *RealmCallUpdate {
+	_u.mutation.ClearSend()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *RealmCallUpdate) SetSuccess(v bool) *RealmCallUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *RealmCallUpdate) SetNillableSuccess(v *bool) *RealmCallUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RealmCallMutation object of the builder.
+func (_u *RealmCallUpdate) Mutation() *RealmCallMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RealmCallUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RealmCallUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RealmCallUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RealmCallUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RealmCallUpdate) check() error {
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := realmcall.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "RealmCall.hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PkgPath(); ok {
+		if err := realmcall.PkgPathValidator(v); err != nil {
+			return &ValidationError{Name: "pkg_path", err: fmt.Errorf(`ent: validator failed for field "RealmCall.pkg_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Func(); ok {
+		if err := realmcall.FuncValidator(v); err != nil {
+			return &ValidationError{Name: "func", err: fmt.Errorf(`ent: validator failed for field "RealmCall.func": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RealmCallUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(realmcall.Table, realmcall.Columns, sqlgraph.NewFieldSpec(realmcall.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(realmcall.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MsgIndex(); ok {
+		_spec.SetField(realmcall.FieldMsgIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMsgIndex(); ok {
+		_spec.AddField(realmcall.FieldMsgIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(realmcall.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(realmcall.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(realmcall.FieldBlockTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PkgPath(); ok {
+		_spec.SetField(realmcall.FieldPkgPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Func(); ok {
+		_spec.SetField(realmcall.FieldFunc, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Caller(); ok {
+		_spec.SetField(realmcall.FieldCaller, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Args(); ok {
+		_spec.SetField(realmcall.FieldArgs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedArgs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, realmcall.FieldArgs, value)
+		})
+	}
+	if _u.mutation.ArgsCleared() {
+		_spec.ClearField(realmcall.FieldArgs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Send(); ok {
+		_spec.SetField(realmcall.FieldSend, field.TypeString, value)
+	}
+	if _u.mutation.SendCleared() {
+		_spec.ClearField(realmcall.FieldSend, field.TypeString)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(realmcall.FieldSuccess, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{realmcall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RealmCallUpdateOne is the builder for updating a single RealmCall entity.
+type RealmCallUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RealmCallMutation
+}
+
+// SetHash sets the "hash" field.
+func (_u *RealmCallUpdateOne) SetHash(v string) *RealmCallUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillableHash(v *string) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (_u *RealmCallUpdateOne) SetMsgIndex(v int) *RealmCallUpdateOne {
+	_u.mutation.ResetMsgIndex()
+	_u.mutation.SetMsgIndex(v)
+	return _u
+}
+
+// SetNillableMsgIndex sets the "msg_index" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillableMsgIndex(v *int) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetMsgIndex(*v)
+	}
+	return _u
+}
+
+// AddMsgIndex adds value to the "msg_index" field.
+func (_u *RealmCallUpdateOne) AddMsgIndex(v int) *RealmCallUpdateOne {
+	_u.mutation.AddMsgIndex(v)
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *RealmCallUpdateOne) SetBlockHeight(v int) *RealmCallUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillableBlockHeight(v *int) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *RealmCallUpdateOne) AddBlockHeight(v int) *RealmCallUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *RealmCallUpdateOne) SetBlockTime(v time.Time) *RealmCallUpdateOne {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillableBlockTime(v *time.Time) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// SetPkgPath sets the "pkg_path" field.
+func (_u *RealmCallUpdateOne) SetPkgPath(v string) *RealmCallUpdateOne {
+	_u.mutation.SetPkgPath(v)
+	return _u
+}
+
+// SetNillablePkgPath sets the "pkg_path" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillablePkgPath(v *string) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetPkgPath(*v)
+	}
+	return _u
+}
+
+// SetFunc sets the "func" field.
+func (_u *RealmCallUpdateOne) SetFunc(v string) *RealmCallUpdateOne {
+	_u.mutation.SetFunc(v)
+	return _u
+}
+
+// SetNillableFunc sets the "func" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillableFunc(v *string) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetFunc(*v)
+	}
+	return _u
+}
+
+// SetCaller sets the "caller" field.
+func (_u *RealmCallUpdateOne) SetCaller(v string) *RealmCallUpdateOne {
+	_u.mutation.SetCaller(v)
+	return _u
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillableCaller(v *string) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetCaller(*v)
+	}
+	return _u
+}
+
+// SetArgs sets the "args" field.
+func (_u *RealmCallUpdateOne) SetArgs(v []string) *RealmCallUpdateOne {
+	_u.mutation.SetArgs(v)
+	return _u
+}
+
+// AppendArgs appends value to the "args" field.
+func (_u *RealmCallUpdateOne) AppendArgs(v []string) *RealmCallUpdateOne {
+	_u.mutation.AppendArgs(v)
+	return _u
+}
+
+// ClearArgs clears the value of the "args" field.
+func (_u *RealmCallUpdateOne) ClearArgs() *RealmCallUpdateOne {
+	_u.mutation.ClearArgs()
+	return _u
+}
+
+// SetSend sets the "send" field.
+func (_u *RealmCallUpdateOne) SetSend(v string) *RealmCallUpdateOne {
+	_u.mutation.SetSend(v)
+	return _u
+}
+
+// SetNillableSend sets the "send" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillableSend(v *string) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetSend(*v)
+	}
+	return _u
+}
+
+// ClearSend clears the value of the "send" field.
+func (_u *RealmCallUpdateOne) ClearSend() *RealmCallUpdateOne {
+	_u.mutation.ClearSend()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *RealmCallUpdateOne) SetSuccess(v bool) *RealmCallUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *RealmCallUpdateOne) SetNillableSuccess(v *bool) *RealmCallUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RealmCallMutation object of the builder.
+func (_u *RealmCallUpdateOne) Mutation() *RealmCallMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RealmCallUpdate builder.
+func (_u *RealmCallUpdateOne) Where(ps ...predicate.RealmCall) *RealmCallUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RealmCallUpdateOne) Select(field string, fields ...string) *RealmCallUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RealmCall entity.
+func (_u *RealmCallUpdateOne) Save(ctx context.Context) (*RealmCall, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RealmCallUpdateOne) SaveX(ctx context.Context) *RealmCall {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RealmCallUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RealmCallUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RealmCallUpdateOne) check() error {
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := realmcall.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "RealmCall.hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PkgPath(); ok {
+		if err := realmcall.PkgPathValidator(v); err != nil {
+			return &ValidationError{Name: "pkg_path", err: fmt.Errorf(`ent: validator failed for field "RealmCall.pkg_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Func(); ok {
+		if err := realmcall.FuncValidator(v); err != nil {
+			return &ValidationError{Name: "func", err: fmt.Errorf(`ent: validator failed for field "RealmCall.func": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RealmCallUpdateOne) sqlSave(ctx context.Context) (_node *RealmCall, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(realmcall.Table, realmcall.Columns, sqlgraph.NewFieldSpec(realmcall.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RealmCall.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, realmcall.FieldID)
+		for _, f := range fields {
+			if !realmcall.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != realmcall.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(realmcall.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MsgIndex(); ok {
+		_spec.SetField(realmcall.FieldMsgIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMsgIndex(); ok {
+		_spec.AddField(realmcall.FieldMsgIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(realmcall.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(realmcall.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(realmcall.FieldBlockTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PkgPath(); ok {
+		_spec.SetField(realmcall.FieldPkgPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Func(); ok {
+		_spec.SetField(realmcall.FieldFunc, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Caller(); ok {
+		_spec.SetField(realmcall.FieldCaller, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Args(); ok {
+		_spec.SetField(realmcall.FieldArgs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedArgs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, realmcall.FieldArgs, value)
+		})
+	}
+	if _u.mutation.ArgsCleared() {
+		_spec.ClearField(realmcall.FieldArgs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Send(); ok {
+		_spec.SetField(realmcall.FieldSend, field.TypeString, value)
+	}
+	if _u.mutation.SendCleared() {
+		_spec.ClearField(realmcall.FieldSend, field.TypeString)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(realmcall.FieldSuccess, field.TypeBool, value)
+	}
+	_node = &RealmCall{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{realmcall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/rebuildprogress"
+)
+
+// RebuildProgress is the model entity for the RebuildProgress schema.
+type RebuildProgress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Last block height replayed into the rebuild schema
+	LastHeight int `json:"last_height,omitempty"`
+	// Start time of the rebuild
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// Time of the last progress update
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RebuildProgress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rebuildprogress.FieldID, rebuildprogress.FieldLastHeight:
+			values[i] = new(sql.NullInt64)
+		case rebuildprogress.FieldStartedAt, rebuildprogress.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RebuildProgress fields.
+func (_m *RebuildProgress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rebuildprogress.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case rebuildprogress.FieldLastHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_height", values[i])
+			} else if value.Valid {
+				_m.LastHeight = int(value.Int64)
+			}
+		case rebuildprogress.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case rebuildprogress.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RebuildProgress.
+// This includes values selected through modifiers, order, etc.
+func (_m *RebuildProgress) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RebuildProgress.
+// Note that you need to call RebuildProgress.Unwrap() before calling this method if this RebuildProgress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RebuildProgress) Update() *RebuildProgressUpdateOne {
+	return NewRebuildProgressClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RebuildProgress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RebuildProgress) Unwrap() *RebuildProgress {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RebuildProgress is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RebuildProgress) String() string {
+	var builder strings.Builder
+	builder.WriteString("RebuildProgress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("last_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastHeight))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RebuildProgresses is a parsable slice of RebuildProgress.
+type RebuildProgresses []*RebuildProgress
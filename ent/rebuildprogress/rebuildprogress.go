@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rebuildprogress
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the rebuildprogress type in the database.
+	Label = "rebuild_progress"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLastHeight holds the string denoting the last_height field in the database.
+	FieldLastHeight = "last_height"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the rebuildprogress in the database.
+	Table = "rebuild_progresses"
+)
+
+// Columns holds all SQL columns for rebuildprogress fields.
+var Columns = []string{
+	FieldID,
+	FieldLastHeight,
+	FieldStartedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the RebuildProgress queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLastHeight orders the results by the last_height field.
+func ByLastHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHeight, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rebuildprogress
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldLTE(FieldID, id))
+}
+
+// LastHeight applies equality check predicate on the "last_height" field. It's identical to LastHeightEQ.
+func LastHeight(v int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldEQ(FieldLastHeight, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LastHeightEQ applies the EQ predicate on the "last_height" field.
+func LastHeightEQ(v int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldEQ(FieldLastHeight, v))
+}
+
+// LastHeightNEQ applies the NEQ predicate on the "last_height" field.
+func LastHeightNEQ(v int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldNEQ(FieldLastHeight, v))
+}
+
+// LastHeightIn applies the In predicate on the "last_height" field.
+func LastHeightIn(vs ...int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldIn(FieldLastHeight, vs...))
+}
+
+// LastHeightNotIn applies the NotIn predicate on the "last_height" field.
+func LastHeightNotIn(vs ...int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldNotIn(FieldLastHeight, vs...))
+}
+
+// LastHeightGT applies the GT predicate on the "last_height" field.
+func LastHeightGT(v int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldGT(FieldLastHeight, v))
+}
+
+// LastHeightGTE applies the GTE predicate on the "last_height" field.
+func LastHeightGTE(v int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldGTE(FieldLastHeight, v))
+}
+
+// LastHeightLT applies the LT predicate on the "last_height" field.
+func LastHeightLT(v int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldLT(FieldLastHeight, v))
+}
+
+// LastHeightLTE applies the LTE predicate on the "last_height" field.
+func LastHeightLTE(v int) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldLTE(FieldLastHeight, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RebuildProgress) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RebuildProgress) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RebuildProgress) predicate.RebuildProgress {
+	return predicate.RebuildProgress(sql.NotPredicates(p))
+}
@@ -0,0 +1,583 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/rebuildprogress"
+)
+
+// RebuildProgressCreate is the builder for creating a RebuildProgress entity.
+type RebuildProgressCreate struct {
+	config
+	mutation *RebuildProgressMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_c *RebuildProgressCreate) SetLastHeight(v int) *RebuildProgressCreate {
+	_c.mutation.SetLastHeight(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *RebuildProgressCreate) SetStartedAt(v time.Time) *RebuildProgressCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *RebuildProgressCreate) SetNillableStartedAt(v *time.Time) *RebuildProgressCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RebuildProgressCreate) SetUpdatedAt(v time.Time) *RebuildProgressCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RebuildProgressCreate) SetNillableUpdatedAt(v *time.Time) *RebuildProgressCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RebuildProgressMutation object of the builder.
+func (_c *RebuildProgressCreate) Mutation() *RebuildProgressMutation {
+	return _c.mutation
+}
+
+// Save creates the RebuildProgress in the database.
+func (_c *RebuildProgressCreate) Save(ctx context.Context) (*RebuildProgress, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RebuildProgressCreate) SaveX(ctx context.Context) *RebuildProgress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RebuildProgressCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RebuildProgressCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RebuildProgressCreate) defaults() {
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := rebuildprogress.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := rebuildprogress.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RebuildProgressCreate) check() error {
+	if _, ok := _c.mutation.LastHeight(); !ok {
+		return &ValidationError{Name: "last_height", err: errors.New(`ent: missing required field "RebuildProgress.last_height"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "RebuildProgress.started_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RebuildProgress.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *RebuildProgressCreate) sqlSave(ctx context.Context) (*RebuildProgress, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RebuildProgressCreate) createSpec() (*RebuildProgress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RebuildProgress{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rebuildprogress.Table, sqlgraph.NewFieldSpec(rebuildprogress.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.LastHeight(); ok {
+		_spec.SetField(rebuildprogress.FieldLastHeight, field.TypeInt, value)
+		_node.LastHeight = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(rebuildprogress.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(rebuildprogress.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RebuildProgress.Create().
+//		SetLastHeight(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RebuildProgressUpsert) {
+//			SetLastHeight(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RebuildProgressCreate) OnConflict(opts ...sql.ConflictOption) *RebuildProgressUpsertOne {
+	_c.conflict = opts
+	return &RebuildProgressUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RebuildProgress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RebuildProgressCreate) OnConflictColumns(columns ...string) *RebuildProgressUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RebuildProgressUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RebuildProgressUpsertOne is the builder for "upsert"-ing
+	//  one RebuildProgress node.
+	RebuildProgressUpsertOne struct {
+		create *RebuildProgressCreate
+	}
+
+	// RebuildProgressUpsert is the "OnConflict" setter.
+	RebuildProgressUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLastHeight sets the "last_height" field.
+func (u *RebuildProgressUpsert) SetLastHeight(v int) *RebuildProgressUpsert {
+	u.Set(rebuildprogress.FieldLastHeight, v)
+	return u
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *RebuildProgressUpsert) UpdateLastHeight() *RebuildProgressUpsert {
+	u.SetExcluded(rebuildprogress.FieldLastHeight)
+	return u
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *RebuildProgressUpsert) AddLastHeight(v int) *RebuildProgressUpsert {
+	u.Add(rebuildprogress.FieldLastHeight, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RebuildProgressUpsert) SetUpdatedAt(v time.Time) *RebuildProgressUpsert {
+	u.Set(rebuildprogress.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RebuildProgressUpsert) UpdateUpdatedAt() *RebuildProgressUpsert {
+	u.SetExcluded(rebuildprogress.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RebuildProgress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RebuildProgressUpsertOne) UpdateNewValues() *RebuildProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(rebuildprogress.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RebuildProgress.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RebuildProgressUpsertOne) Ignore() *RebuildProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RebuildProgressUpsertOne) DoNothing() *RebuildProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RebuildProgressCreate.OnConflict
+// documentation for more info.
+func (u *RebuildProgressUpsertOne) Update(set func(*RebuildProgressUpsert)) *RebuildProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RebuildProgressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (u *RebuildProgressUpsertOne) SetLastHeight(v int) *RebuildProgressUpsertOne {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.SetLastHeight(v)
+	})
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *RebuildProgressUpsertOne) AddLastHeight(v int) *RebuildProgressUpsertOne {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.AddLastHeight(v)
+	})
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *RebuildProgressUpsertOne) UpdateLastHeight() *RebuildProgressUpsertOne {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.UpdateLastHeight()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RebuildProgressUpsertOne) SetUpdatedAt(v time.Time) *RebuildProgressUpsertOne {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RebuildProgressUpsertOne) UpdateUpdatedAt() *RebuildProgressUpsertOne {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RebuildProgressUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RebuildProgressCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RebuildProgressUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RebuildProgressUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RebuildProgressUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RebuildProgressCreateBulk is the builder for creating many RebuildProgress entities in bulk.
+type RebuildProgressCreateBulk struct {
+	config
+	err      error
+	builders []*RebuildProgressCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RebuildProgress entities in the database.
+func (_c *RebuildProgressCreateBulk) Save(ctx context.Context) ([]*RebuildProgress, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RebuildProgress, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RebuildProgressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RebuildProgressCreateBulk) SaveX(ctx context.Context) []*RebuildProgress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RebuildProgressCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RebuildProgressCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RebuildProgress.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RebuildProgressUpsert) {
+//			SetLastHeight(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RebuildProgressCreateBulk) OnConflict(opts ...sql.ConflictOption) *RebuildProgressUpsertBulk {
+	_c.conflict = opts
+	return &RebuildProgressUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RebuildProgress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RebuildProgressCreateBulk) OnConflictColumns(columns ...string) *RebuildProgressUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RebuildProgressUpsertBulk{
+		create: _c,
+	}
+}
+
+// RebuildProgressUpsertBulk is the builder for "upsert"-ing
+// a bulk of RebuildProgress nodes.
+type RebuildProgressUpsertBulk struct {
+	create *RebuildProgressCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RebuildProgress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RebuildProgressUpsertBulk) UpdateNewValues() *RebuildProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(rebuildprogress.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RebuildProgress.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RebuildProgressUpsertBulk) Ignore() *RebuildProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RebuildProgressUpsertBulk) DoNothing() *RebuildProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RebuildProgressCreateBulk.OnConflict
+// documentation for more info.
+func (u *RebuildProgressUpsertBulk) Update(set func(*RebuildProgressUpsert)) *RebuildProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RebuildProgressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (u *RebuildProgressUpsertBulk) SetLastHeight(v int) *RebuildProgressUpsertBulk {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.SetLastHeight(v)
+	})
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *RebuildProgressUpsertBulk) AddLastHeight(v int) *RebuildProgressUpsertBulk {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.AddLastHeight(v)
+	})
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *RebuildProgressUpsertBulk) UpdateLastHeight() *RebuildProgressUpsertBulk {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.UpdateLastHeight()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RebuildProgressUpsertBulk) SetUpdatedAt(v time.Time) *RebuildProgressUpsertBulk {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RebuildProgressUpsertBulk) UpdateUpdatedAt() *RebuildProgressUpsertBulk {
+	return u.Update(func(s *RebuildProgressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RebuildProgressUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RebuildProgressCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RebuildProgressCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RebuildProgressUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
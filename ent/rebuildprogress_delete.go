@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/rebuildprogress"
+)
+
+// RebuildProgressDelete is the builder for deleting a RebuildProgress entity.
+type RebuildProgressDelete struct {
+	config
+	hooks    []Hook
+	mutation *RebuildProgressMutation
+}
+
+// Where appends a list predicates to the RebuildProgressDelete builder.
+func (_d *RebuildProgressDelete) Where(ps ...predicate.RebuildProgress) *RebuildProgressDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RebuildProgressDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RebuildProgressDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RebuildProgressDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(rebuildprogress.Table, sqlgraph.NewFieldSpec(rebuildprogress.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RebuildProgressDeleteOne is the builder for deleting a single RebuildProgress entity.
+type RebuildProgressDeleteOne struct {
+	_d *RebuildProgressDelete
+}
+
+// Where appends a list predicates to the RebuildProgressDelete builder.
+func (_d *RebuildProgressDeleteOne) Where(ps ...predicate.RebuildProgress) *RebuildProgressDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RebuildProgressDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{rebuildprogress.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RebuildProgressDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
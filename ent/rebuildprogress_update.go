@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/rebuildprogress"
+)
+
+// RebuildProgressUpdate is the builder for updating RebuildProgress entities.
+type RebuildProgressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RebuildProgressMutation
+}
+
+// Where appends a list predicates to the RebuildProgressUpdate builder.
+func (_u *RebuildProgressUpdate) Where(ps ...predicate.RebuildProgress) *RebuildProgressUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_u *RebuildProgressUpdate) SetLastHeight(v int) *RebuildProgressUpdate {
+	_u.mutation.ResetLastHeight()
+	_u.mutation.SetLastHeight(v)
+	return _u
+}
+
+// SetNillableLastHeight sets the "last_height" field if the given value is not nil.
+func (_u *RebuildProgressUpdate) SetNillableLastHeight(v *int) *RebuildProgressUpdate {
+	if v != nil {
+		_u.SetLastHeight(*v)
+	}
+	return _u
+}
+
+// AddLastHeight adds value to the "last_height" field.
+func (_u *RebuildProgressUpdate) AddLastHeight(v int) *RebuildProgressUpdate {
+	_u.mutation.AddLastHeight(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RebuildProgressUpdate) SetUpdatedAt(v time.Time) *RebuildProgressUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RebuildProgressUpdate) SetNillableUpdatedAt(v *time.Time) *RebuildProgressUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RebuildProgressMutation object of the builder.
+func (_u *RebuildProgressUpdate) Mutation() *RebuildProgressMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RebuildProgressUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RebuildProgressUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RebuildProgressUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RebuildProgressUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *RebuildProgressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rebuildprogress.Table, rebuildprogress.Columns, sqlgraph.NewFieldSpec(rebuildprogress.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LastHeight(); ok {
+		_spec.SetField(rebuildprogress.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastHeight(); ok {
+		_spec.AddField(rebuildprogress.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rebuildprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rebuildprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RebuildProgressUpdateOne is the builder for updating a single RebuildProgress entity.
+type RebuildProgressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RebuildProgressMutation
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_u *RebuildProgressUpdateOne) SetLastHeight(v int) *RebuildProgressUpdateOne {
+	_u.mutation.ResetLastHeight()
+	_u.mutation.SetLastHeight(v)
+	return _u
+}
+
+// SetNillableLastHeight sets the "last_height" field if the given value is not nil.
+func (_u *RebuildProgressUpdateOne) SetNillableLastHeight(v *int) *RebuildProgressUpdateOne {
+	if v != nil {
+		_u.SetLastHeight(*v)
+	}
+	return _u
+}
+
+// AddLastHeight adds value to the "last_height" field.
+func (_u *RebuildProgressUpdateOne) AddLastHeight(v int) *RebuildProgressUpdateOne {
+	_u.mutation.AddLastHeight(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RebuildProgressUpdateOne) SetUpdatedAt(v time.Time) *RebuildProgressUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RebuildProgressUpdateOne) SetNillableUpdatedAt(v *time.Time) *RebuildProgressUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RebuildProgressMutation object of the builder.
+func (_u *RebuildProgressUpdateOne) Mutation() *RebuildProgressMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RebuildProgressUpdate builder.
+func (_u *RebuildProgressUpdateOne) Where(ps ...predicate.RebuildProgress) *RebuildProgressUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RebuildProgressUpdateOne) Select(field string, fields ...string) *RebuildProgressUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RebuildProgress entity.
+func (_u *RebuildProgressUpdateOne) Save(ctx context.Context) (*RebuildProgress, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RebuildProgressUpdateOne) SaveX(ctx context.Context) *RebuildProgress {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RebuildProgressUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RebuildProgressUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *RebuildProgressUpdateOne) sqlSave(ctx context.Context) (_node *RebuildProgress, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rebuildprogress.Table, rebuildprogress.Columns, sqlgraph.NewFieldSpec(rebuildprogress.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RebuildProgress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rebuildprogress.FieldID)
+		for _, f := range fields {
+			if !rebuildprogress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rebuildprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LastHeight(); ok {
+		_spec.SetField(rebuildprogress.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastHeight(); ok {
+		_spec.AddField(rebuildprogress.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rebuildprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &RebuildProgress{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rebuildprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,10 +6,25 @@ import (
 	"time"
 
 	"gno.land-block-indexer/ent/account"
+	"gno.land-block-indexer/ent/addresstransaction"
+	"gno.land-block-indexer/ent/balancechange"
+	"gno.land-block-indexer/ent/balancecheckpoint"
 	"gno.land-block-indexer/ent/block"
+	"gno.land-block-indexer/ent/gnoevent"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+	"gno.land-block-indexer/ent/holding"
+	"gno.land-block-indexer/ent/nft"
+	"gno.land-block-indexer/ent/nfttransfer"
+	"gno.land-block-indexer/ent/realmcall"
+	"gno.land-block-indexer/ent/rebuildprogress"
 	"gno.land-block-indexer/ent/schema"
+	"gno.land-block-indexer/ent/token"
+	"gno.land-block-indexer/ent/tokenstat"
 	"gno.land-block-indexer/ent/transaction"
 	"gno.land-block-indexer/ent/transfer"
+	"gno.land-block-indexer/ent/watchrule"
+	"gno.land-block-indexer/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -18,14 +33,76 @@ import (
 func init() {
 	accountFields := schema.Account{}.Fields()
 	_ = accountFields
-	// accountDescToken is the schema descriptor for token field.
-	accountDescToken := accountFields[1].Descriptor()
-	// account.TokenValidator is a validator for the "token" field. It is called by the builders before save.
-	account.TokenValidator = accountDescToken.Validators[0].(func(string) error)
+	// accountDescFirstSeenHeight is the schema descriptor for first_seen_height field.
+	accountDescFirstSeenHeight := accountFields[1].Descriptor()
+	// account.DefaultFirstSeenHeight holds the default value on creation for the first_seen_height field.
+	account.DefaultFirstSeenHeight = accountDescFirstSeenHeight.Default.(int)
+	// accountDescCreatedAt is the schema descriptor for created_at field.
+	accountDescCreatedAt := accountFields[2].Descriptor()
+	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
+	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
 	// accountDescID is the schema descriptor for id field.
 	accountDescID := accountFields[0].Descriptor()
 	// account.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	account.IDValidator = accountDescID.Validators[0].(func(string) error)
+	addresstransactionFields := schema.AddressTransaction{}.Fields()
+	_ = addresstransactionFields
+	// addresstransactionDescAddress is the schema descriptor for address field.
+	addresstransactionDescAddress := addresstransactionFields[0].Descriptor()
+	// addresstransaction.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	addresstransaction.AddressValidator = addresstransactionDescAddress.Validators[0].(func(string) error)
+	// addresstransactionDescHash is the schema descriptor for hash field.
+	addresstransactionDescHash := addresstransactionFields[1].Descriptor()
+	// addresstransaction.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	addresstransaction.HashValidator = addresstransactionDescHash.Validators[0].(func(string) error)
+	// addresstransactionDescRole is the schema descriptor for role field.
+	addresstransactionDescRole := addresstransactionFields[2].Descriptor()
+	// addresstransaction.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	addresstransaction.RoleValidator = addresstransactionDescRole.Validators[0].(func(string) error)
+	// addresstransactionDescSuccess is the schema descriptor for success field.
+	addresstransactionDescSuccess := addresstransactionFields[6].Descriptor()
+	// addresstransaction.DefaultSuccess holds the default value on creation for the success field.
+	addresstransaction.DefaultSuccess = addresstransactionDescSuccess.Default.(bool)
+	// addresstransactionDescCreatedAt is the schema descriptor for created_at field.
+	addresstransactionDescCreatedAt := addresstransactionFields[7].Descriptor()
+	// addresstransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	addresstransaction.DefaultCreatedAt = addresstransactionDescCreatedAt.Default.(func() time.Time)
+	balancechangeFields := schema.BalanceChange{}.Fields()
+	_ = balancechangeFields
+	// balancechangeDescAddress is the schema descriptor for address field.
+	balancechangeDescAddress := balancechangeFields[0].Descriptor()
+	// balancechange.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	balancechange.AddressValidator = balancechangeDescAddress.Validators[0].(func(string) error)
+	// balancechangeDescToken is the schema descriptor for token field.
+	balancechangeDescToken := balancechangeFields[1].Descriptor()
+	// balancechange.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	balancechange.TokenValidator = balancechangeDescToken.Validators[0].(func(string) error)
+	// balancechangeDescReason is the schema descriptor for reason field.
+	balancechangeDescReason := balancechangeFields[4].Descriptor()
+	// balancechange.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	balancechange.ReasonValidator = balancechangeDescReason.Validators[0].(func(string) error)
+	// balancechangeDescHash is the schema descriptor for hash field.
+	balancechangeDescHash := balancechangeFields[5].Descriptor()
+	// balancechange.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	balancechange.HashValidator = balancechangeDescHash.Validators[0].(func(string) error)
+	// balancechangeDescCreatedAt is the schema descriptor for created_at field.
+	balancechangeDescCreatedAt := balancechangeFields[7].Descriptor()
+	// balancechange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancechange.DefaultCreatedAt = balancechangeDescCreatedAt.Default.(func() time.Time)
+	balancecheckpointFields := schema.BalanceCheckpoint{}.Fields()
+	_ = balancecheckpointFields
+	// balancecheckpointDescAddress is the schema descriptor for address field.
+	balancecheckpointDescAddress := balancecheckpointFields[0].Descriptor()
+	// balancecheckpoint.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	balancecheckpoint.AddressValidator = balancecheckpointDescAddress.Validators[0].(func(string) error)
+	// balancecheckpointDescToken is the schema descriptor for token field.
+	balancecheckpointDescToken := balancecheckpointFields[1].Descriptor()
+	// balancecheckpoint.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	balancecheckpoint.TokenValidator = balancecheckpointDescToken.Validators[0].(func(string) error)
+	// balancecheckpointDescCreatedAt is the schema descriptor for created_at field.
+	balancecheckpointDescCreatedAt := balancecheckpointFields[4].Descriptor()
+	// balancecheckpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancecheckpoint.DefaultCreatedAt = balancecheckpointDescCreatedAt.Default.(func() time.Time)
 	blockFields := schema.Block{}.Fields()
 	_ = blockFields
 	// blockDescHash is the schema descriptor for hash field.
@@ -36,6 +113,196 @@ func init() {
 	blockDescCreatedAt := blockFields[5].Descriptor()
 	// block.DefaultCreatedAt holds the default value on creation for the created_at field.
 	block.DefaultCreatedAt = blockDescCreatedAt.Default.(func() time.Time)
+	gnoeventFields := schema.GnoEvent{}.Fields()
+	_ = gnoeventFields
+	// gnoeventDescHash is the schema descriptor for hash field.
+	gnoeventDescHash := gnoeventFields[0].Descriptor()
+	// gnoevent.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	gnoevent.HashValidator = gnoeventDescHash.Validators[0].(func(string) error)
+	// gnoeventDescCreatedAt is the schema descriptor for created_at field.
+	gnoeventDescCreatedAt := gnoeventFields[10].Descriptor()
+	// gnoevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	gnoevent.DefaultCreatedAt = gnoeventDescCreatedAt.Default.(func() time.Time)
+	gnopackageFields := schema.GnoPackage{}.Fields()
+	_ = gnopackageFields
+	// gnopackageDescName is the schema descriptor for name field.
+	gnopackageDescName := gnopackageFields[1].Descriptor()
+	// gnopackage.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	gnopackage.NameValidator = gnopackageDescName.Validators[0].(func(string) error)
+	// gnopackageDescHash is the schema descriptor for hash field.
+	gnopackageDescHash := gnopackageFields[6].Descriptor()
+	// gnopackage.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	gnopackage.HashValidator = gnopackageDescHash.Validators[0].(func(string) error)
+	// gnopackageDescCreatedAt is the schema descriptor for created_at field.
+	gnopackageDescCreatedAt := gnopackageFields[7].Descriptor()
+	// gnopackage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	gnopackage.DefaultCreatedAt = gnopackageDescCreatedAt.Default.(func() time.Time)
+	// gnopackageDescID is the schema descriptor for id field.
+	gnopackageDescID := gnopackageFields[0].Descriptor()
+	// gnopackage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	gnopackage.IDValidator = gnopackageDescID.Validators[0].(func(string) error)
+	gnopackagefileFields := schema.GnoPackageFile{}.Fields()
+	_ = gnopackagefileFields
+	// gnopackagefileDescName is the schema descriptor for name field.
+	gnopackagefileDescName := gnopackagefileFields[0].Descriptor()
+	// gnopackagefile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	gnopackagefile.NameValidator = gnopackagefileDescName.Validators[0].(func(string) error)
+	holdingFields := schema.Holding{}.Fields()
+	_ = holdingFields
+	// holdingDescAddress is the schema descriptor for address field.
+	holdingDescAddress := holdingFields[0].Descriptor()
+	// holding.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	holding.AddressValidator = holdingDescAddress.Validators[0].(func(string) error)
+	// holdingDescToken is the schema descriptor for token field.
+	holdingDescToken := holdingFields[1].Descriptor()
+	// holding.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	holding.TokenValidator = holdingDescToken.Validators[0].(func(string) error)
+	// holdingDescAmount is the schema descriptor for amount field.
+	holdingDescAmount := holdingFields[2].Descriptor()
+	// holding.DefaultAmount holds the default value on creation for the amount field.
+	holding.DefaultAmount = holdingDescAmount.Default.(float64)
+	// holdingDescFirstSeenHeight is the schema descriptor for first_seen_height field.
+	holdingDescFirstSeenHeight := holdingFields[3].Descriptor()
+	// holding.DefaultFirstSeenHeight holds the default value on creation for the first_seen_height field.
+	holding.DefaultFirstSeenHeight = holdingDescFirstSeenHeight.Default.(int)
+	// holdingDescLastActiveHeight is the schema descriptor for last_active_height field.
+	holdingDescLastActiveHeight := holdingFields[4].Descriptor()
+	// holding.DefaultLastActiveHeight holds the default value on creation for the last_active_height field.
+	holding.DefaultLastActiveHeight = holdingDescLastActiveHeight.Default.(int)
+	// holdingDescTxCount is the schema descriptor for tx_count field.
+	holdingDescTxCount := holdingFields[5].Descriptor()
+	// holding.DefaultTxCount holds the default value on creation for the tx_count field.
+	holding.DefaultTxCount = holdingDescTxCount.Default.(int)
+	// holdingDescCreatedAt is the schema descriptor for created_at field.
+	holdingDescCreatedAt := holdingFields[7].Descriptor()
+	// holding.DefaultCreatedAt holds the default value on creation for the created_at field.
+	holding.DefaultCreatedAt = holdingDescCreatedAt.Default.(func() time.Time)
+	nftFields := schema.Nft{}.Fields()
+	_ = nftFields
+	// nftDescCollection is the schema descriptor for collection field.
+	nftDescCollection := nftFields[0].Descriptor()
+	// nft.CollectionValidator is a validator for the "collection" field. It is called by the builders before save.
+	nft.CollectionValidator = nftDescCollection.Validators[0].(func(string) error)
+	// nftDescTokenID is the schema descriptor for token_id field.
+	nftDescTokenID := nftFields[1].Descriptor()
+	// nft.TokenIDValidator is a validator for the "token_id" field. It is called by the builders before save.
+	nft.TokenIDValidator = nftDescTokenID.Validators[0].(func(string) error)
+	// nftDescBurned is the schema descriptor for burned field.
+	nftDescBurned := nftFields[3].Descriptor()
+	// nft.DefaultBurned holds the default value on creation for the burned field.
+	nft.DefaultBurned = nftDescBurned.Default.(bool)
+	// nftDescCreatedAt is the schema descriptor for created_at field.
+	nftDescCreatedAt := nftFields[6].Descriptor()
+	// nft.DefaultCreatedAt holds the default value on creation for the created_at field.
+	nft.DefaultCreatedAt = nftDescCreatedAt.Default.(func() time.Time)
+	nfttransferFields := schema.NftTransfer{}.Fields()
+	_ = nfttransferFields
+	// nfttransferDescHash is the schema descriptor for hash field.
+	nfttransferDescHash := nfttransferFields[0].Descriptor()
+	// nfttransfer.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	nfttransfer.HashValidator = nfttransferDescHash.Validators[0].(func(string) error)
+	// nfttransferDescFunc is the schema descriptor for func field.
+	nfttransferDescFunc := nfttransferFields[1].Descriptor()
+	// nfttransfer.FuncValidator is a validator for the "func" field. It is called by the builders before save.
+	nfttransfer.FuncValidator = nfttransferDescFunc.Validators[0].(func(string) error)
+	// nfttransferDescCollection is the schema descriptor for collection field.
+	nfttransferDescCollection := nfttransferFields[2].Descriptor()
+	// nfttransfer.CollectionValidator is a validator for the "collection" field. It is called by the builders before save.
+	nfttransfer.CollectionValidator = nfttransferDescCollection.Validators[0].(func(string) error)
+	// nfttransferDescTokenID is the schema descriptor for token_id field.
+	nfttransferDescTokenID := nfttransferFields[3].Descriptor()
+	// nfttransfer.TokenIDValidator is a validator for the "token_id" field. It is called by the builders before save.
+	nfttransfer.TokenIDValidator = nfttransferDescTokenID.Validators[0].(func(string) error)
+	// nfttransferDescCreatedAt is the schema descriptor for created_at field.
+	nfttransferDescCreatedAt := nfttransferFields[7].Descriptor()
+	// nfttransfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	nfttransfer.DefaultCreatedAt = nfttransferDescCreatedAt.Default.(func() time.Time)
+	realmcallFields := schema.RealmCall{}.Fields()
+	_ = realmcallFields
+	// realmcallDescHash is the schema descriptor for hash field.
+	realmcallDescHash := realmcallFields[0].Descriptor()
+	// realmcall.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	realmcall.HashValidator = realmcallDescHash.Validators[0].(func(string) error)
+	// realmcallDescPkgPath is the schema descriptor for pkg_path field.
+	realmcallDescPkgPath := realmcallFields[4].Descriptor()
+	// realmcall.PkgPathValidator is a validator for the "pkg_path" field. It is called by the builders before save.
+	realmcall.PkgPathValidator = realmcallDescPkgPath.Validators[0].(func(string) error)
+	// realmcallDescFunc is the schema descriptor for func field.
+	realmcallDescFunc := realmcallFields[5].Descriptor()
+	// realmcall.FuncValidator is a validator for the "func" field. It is called by the builders before save.
+	realmcall.FuncValidator = realmcallDescFunc.Validators[0].(func(string) error)
+	// realmcallDescSuccess is the schema descriptor for success field.
+	realmcallDescSuccess := realmcallFields[9].Descriptor()
+	// realmcall.DefaultSuccess holds the default value on creation for the success field.
+	realmcall.DefaultSuccess = realmcallDescSuccess.Default.(bool)
+	// realmcallDescCreatedAt is the schema descriptor for created_at field.
+	realmcallDescCreatedAt := realmcallFields[10].Descriptor()
+	// realmcall.DefaultCreatedAt holds the default value on creation for the created_at field.
+	realmcall.DefaultCreatedAt = realmcallDescCreatedAt.Default.(func() time.Time)
+	rebuildprogressFields := schema.RebuildProgress{}.Fields()
+	_ = rebuildprogressFields
+	// rebuildprogressDescStartedAt is the schema descriptor for started_at field.
+	rebuildprogressDescStartedAt := rebuildprogressFields[1].Descriptor()
+	// rebuildprogress.DefaultStartedAt holds the default value on creation for the started_at field.
+	rebuildprogress.DefaultStartedAt = rebuildprogressDescStartedAt.Default.(func() time.Time)
+	// rebuildprogressDescUpdatedAt is the schema descriptor for updated_at field.
+	rebuildprogressDescUpdatedAt := rebuildprogressFields[2].Descriptor()
+	// rebuildprogress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rebuildprogress.DefaultUpdatedAt = rebuildprogressDescUpdatedAt.Default.(func() time.Time)
+	tokenFields := schema.Token{}.Fields()
+	_ = tokenFields
+	// tokenDescDecimals is the schema descriptor for decimals field.
+	tokenDescDecimals := tokenFields[3].Descriptor()
+	// token.DefaultDecimals holds the default value on creation for the decimals field.
+	token.DefaultDecimals = tokenDescDecimals.Default.(int)
+	// token.DecimalsValidator is a validator for the "decimals" field. It is called by the builders before save.
+	token.DecimalsValidator = tokenDescDecimals.Validators[0].(func(int) error)
+	// tokenDescFirstSeenHeight is the schema descriptor for first_seen_height field.
+	tokenDescFirstSeenHeight := tokenFields[5].Descriptor()
+	// token.DefaultFirstSeenHeight holds the default value on creation for the first_seen_height field.
+	token.DefaultFirstSeenHeight = tokenDescFirstSeenHeight.Default.(int)
+	// tokenDescCreatedAt is the schema descriptor for created_at field.
+	tokenDescCreatedAt := tokenFields[6].Descriptor()
+	// token.DefaultCreatedAt holds the default value on creation for the created_at field.
+	token.DefaultCreatedAt = tokenDescCreatedAt.Default.(func() time.Time)
+	// tokenDescID is the schema descriptor for id field.
+	tokenDescID := tokenFields[0].Descriptor()
+	// token.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	token.IDValidator = tokenDescID.Validators[0].(func(string) error)
+	tokenstatFields := schema.TokenStat{}.Fields()
+	_ = tokenstatFields
+	// tokenstatDescMinted is the schema descriptor for minted field.
+	tokenstatDescMinted := tokenstatFields[1].Descriptor()
+	// tokenstat.DefaultMinted holds the default value on creation for the minted field.
+	tokenstat.DefaultMinted = tokenstatDescMinted.Default.(int64)
+	// tokenstatDescBurned is the schema descriptor for burned field.
+	tokenstatDescBurned := tokenstatFields[2].Descriptor()
+	// tokenstat.DefaultBurned holds the default value on creation for the burned field.
+	tokenstat.DefaultBurned = tokenstatDescBurned.Default.(int64)
+	// tokenstatDescSupply is the schema descriptor for supply field.
+	tokenstatDescSupply := tokenstatFields[3].Descriptor()
+	// tokenstat.DefaultSupply holds the default value on creation for the supply field.
+	tokenstat.DefaultSupply = tokenstatDescSupply.Default.(int64)
+	// tokenstatDescHolders is the schema descriptor for holders field.
+	tokenstatDescHolders := tokenstatFields[4].Descriptor()
+	// tokenstat.DefaultHolders holds the default value on creation for the holders field.
+	tokenstat.DefaultHolders = tokenstatDescHolders.Default.(int)
+	// tokenstatDescTransferCount is the schema descriptor for transfer_count field.
+	tokenstatDescTransferCount := tokenstatFields[5].Descriptor()
+	// tokenstat.DefaultTransferCount holds the default value on creation for the transfer_count field.
+	tokenstat.DefaultTransferCount = tokenstatDescTransferCount.Default.(int)
+	// tokenstatDescLastHeight is the schema descriptor for last_height field.
+	tokenstatDescLastHeight := tokenstatFields[6].Descriptor()
+	// tokenstat.DefaultLastHeight holds the default value on creation for the last_height field.
+	tokenstat.DefaultLastHeight = tokenstatDescLastHeight.Default.(int)
+	// tokenstatDescUpdatedAt is the schema descriptor for updated_at field.
+	tokenstatDescUpdatedAt := tokenstatFields[7].Descriptor()
+	// tokenstat.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tokenstat.DefaultUpdatedAt = tokenstatDescUpdatedAt.Default.(func() time.Time)
+	// tokenstatDescID is the schema descriptor for id field.
+	tokenstatDescID := tokenstatFields[0].Descriptor()
+	// tokenstat.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tokenstat.IDValidator = tokenstatDescID.Validators[0].(func(string) error)
 	transactionFields := schema.Transaction{}.Fields()
 	_ = transactionFields
 	// transactionDescHash is the schema descriptor for hash field.
@@ -72,8 +339,56 @@ func init() {
 	transferDescDenom := transferFields[7].Descriptor()
 	// transfer.DenomValidator is a validator for the "denom" field. It is called by the builders before save.
 	transfer.DenomValidator = transferDescDenom.Validators[0].(func(string) error)
+	// transferDescBlockHeight is the schema descriptor for block_height field.
+	transferDescBlockHeight := transferFields[8].Descriptor()
+	// transfer.DefaultBlockHeight holds the default value on creation for the block_height field.
+	transfer.DefaultBlockHeight = transferDescBlockHeight.Default.(int)
+	// transferDescTxIndex is the schema descriptor for tx_index field.
+	transferDescTxIndex := transferFields[9].Descriptor()
+	// transfer.DefaultTxIndex holds the default value on creation for the tx_index field.
+	transfer.DefaultTxIndex = transferDescTxIndex.Default.(int)
+	// transferDescMsgIndex is the schema descriptor for msg_index field.
+	transferDescMsgIndex := transferFields[10].Descriptor()
+	// transfer.DefaultMsgIndex holds the default value on creation for the msg_index field.
+	transfer.DefaultMsgIndex = transferDescMsgIndex.Default.(int)
 	// transferDescCreatedAt is the schema descriptor for created_at field.
-	transferDescCreatedAt := transferFields[8].Descriptor()
+	transferDescCreatedAt := transferFields[13].Descriptor()
 	// transfer.DefaultCreatedAt holds the default value on creation for the created_at field.
 	transfer.DefaultCreatedAt = transferDescCreatedAt.Default.(func() time.Time)
+	watchruleFields := schema.WatchRule{}.Fields()
+	_ = watchruleFields
+	// watchruleDescURL is the schema descriptor for url field.
+	watchruleDescURL := watchruleFields[0].Descriptor()
+	// watchrule.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	watchrule.URLValidator = watchruleDescURL.Validators[0].(func(string) error)
+	// watchruleDescSecret is the schema descriptor for secret field.
+	watchruleDescSecret := watchruleFields[1].Descriptor()
+	// watchrule.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	watchrule.SecretValidator = watchruleDescSecret.Validators[0].(func(string) error)
+	// watchruleDescMinAmount is the schema descriptor for min_amount field.
+	watchruleDescMinAmount := watchruleFields[5].Descriptor()
+	// watchrule.DefaultMinAmount holds the default value on creation for the min_amount field.
+	watchrule.DefaultMinAmount = watchruleDescMinAmount.Default.(float64)
+	// watchruleDescActive is the schema descriptor for active field.
+	watchruleDescActive := watchruleFields[7].Descriptor()
+	// watchrule.DefaultActive holds the default value on creation for the active field.
+	watchrule.DefaultActive = watchruleDescActive.Default.(bool)
+	// watchruleDescCreatedAt is the schema descriptor for created_at field.
+	watchruleDescCreatedAt := watchruleFields[8].Descriptor()
+	// watchrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	watchrule.DefaultCreatedAt = watchruleDescCreatedAt.Default.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescHash is the schema descriptor for hash field.
+	webhookdeliveryDescHash := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	webhookdelivery.HashValidator = webhookdeliveryDescHash.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[12].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
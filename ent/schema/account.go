@@ -1,27 +1,28 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
-	"entgo.io/ent/schema/index"
 )
 
-// Block holds the schema definition for the Block entity.
+// Account holds the schema definition for the Account entity, one row per address.
 type Account struct {
 	ent.Schema
 }
 
-// Fields of the Block.
+// Fields of the Account.
 func (Account) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").StorageKey("address").NotEmpty().Comment("Address of the account"),
-		field.String("token").NotEmpty().Comment("Token associated with the account"),
-		field.Float("amount").Comment("Amount of the token in the account"),
+		field.Int("first_seen_height").Default(0).Comment("Height of the block in which the address was first seen"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the account"),
 	}
 }
 
-// Edges of the Block.
+// Edges of the Account.
 func (Account) Edges() []ent.Edge {
 	return []ent.Edge{
 		// Allow transfer_to and transfer_from edge to optionals
@@ -29,13 +30,6 @@ func (Account) Edges() []ent.Edge {
 			StorageKey(edge.Column("to_address")),
 		edge.To("transfers_from", Transfer.Type).
 			StorageKey(edge.Column("from_address")),
-	}
-}
-
-// Indexes - address와 token의 조합을 유니크로 설정
-func (Account) Indexes() []ent.Index {
-	return []ent.Index{
-		// Composite unique index
-		index.Fields("id", "token").Unique(),
+		edge.To("holdings", Holding.Type),
 	}
 }
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AddressTransaction holds the transactions an address took part in, one row per role.
+type AddressTransaction struct {
+	ent.Schema
+}
+
+// Fields of the AddressTransaction.
+func (AddressTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("address").NotEmpty().Comment("Address taking part in the transaction"),
+		field.String("hash").NotEmpty().Comment("Hash of the transaction"),
+		field.String("role").NotEmpty().Comment("Role of the address, e.g. signer, sender or receiver"),
+		field.Int("block_height").Comment("Height of the block containing the transaction"),
+		field.Int("tx_index").Comment("Index of the transaction in the block"),
+		field.Time("block_time").Comment("Timestamp of the block containing the transaction"),
+		field.Bool("success").Default(false).Comment("Whether the transaction was successful"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the row"),
+	}
+}
+
+// Edges of the AddressTransaction.
+func (AddressTransaction) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the AddressTransaction.
+func (AddressTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address", "hash", "role").Unique(),
+		index.Fields("address", "block_height", "tx_index"),
+		index.Fields("address", "role", "block_height"),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BalanceChange holds every change of an account balance, so balances can be computed at any height.
+type BalanceChange struct {
+	ent.Schema
+}
+
+// Fields of the BalanceChange.
+func (BalanceChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("address").NotEmpty().Comment("Address of the account"),
+		field.String("token").NotEmpty().Comment("Token of the balance"),
+		field.Int("block_height").Comment("Height of the block in which the balance changed"),
+		field.Int64("delta").Comment("Signed change of the balance"),
+		field.String("reason").NotEmpty().Comment("Reason of the change (fee, transfer, mint, burn, genesis, ...)"),
+		field.String("hash").NotEmpty().Comment("Hash of the transaction"),
+		field.Int("event_index").Comment("Index of the event in the transaction, negative for changes derived from the fee (-1) and messages (-2 - message index)"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the change"),
+	}
+}
+
+// Edges of the BalanceChange.
+func (BalanceChange) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the BalanceChange.
+func (BalanceChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address", "token", "block_height"),
+		index.Fields("hash"),
+	}
+}
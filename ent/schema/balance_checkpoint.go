@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BalanceCheckpoint holds the balance of an account at the end of a checkpoint height, to
+// bound the number of balance changes summed by historical balance queries.
+type BalanceCheckpoint struct {
+	ent.Schema
+}
+
+// Fields of the BalanceCheckpoint.
+func (BalanceCheckpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("address").NotEmpty().Comment("Address of the account"),
+		field.String("token").NotEmpty().Comment("Token of the balance"),
+		field.Int("block_height").Comment("Height of the checkpoint"),
+		field.Int64("amount").Comment("Balance at the end of the checkpoint height"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the checkpoint"),
+	}
+}
+
+// Edges of the BalanceCheckpoint.
+func (BalanceCheckpoint) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the BalanceCheckpoint.
+func (BalanceCheckpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address", "token", "block_height").Unique(),
+		index.Fields("block_height"),
+	}
+}
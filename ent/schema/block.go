@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Block holds the schema definition for the Block entity.
@@ -31,3 +32,10 @@ func (Block) Edges() []ent.Edge {
 		edge.To("transactions", Transaction.Type),
 	}
 }
+
+// Indexes of the Block.
+func (Block) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash"),
+	}
+}
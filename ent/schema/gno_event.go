@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventAttr is a key/value attribute of a GnoEvent
+type EventAttr struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// GnoEvent holds every GnoEvent emitted by transactions.
+type GnoEvent struct {
+	ent.Schema
+}
+
+// Fields of the GnoEvent.
+func (GnoEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash").NotEmpty().Comment("Hash of the transaction"),
+		field.Int("event_index").Comment("Index of the event in the transaction"),
+		field.Int("block_height").Comment("Height of the block containing the event"),
+		field.Int("tx_index").Comment("Index of the transaction in the block"),
+		field.Time("block_time").Comment("Timestamp of the block containing the event"),
+		field.String("type").Comment("Type of the event"),
+		field.String("func").Optional().Comment("Function emitting the event"),
+		field.String("pkg_path").Comment("Package path of the realm emitting the event"),
+		field.JSON("attrs", []EventAttr{}).Optional().Comment("Attributes of the event"),
+		field.Bool("decoded").Optional().Nillable().Comment("Whether a decoder of the event-processor handled the event, null if unknown"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the event"),
+	}
+}
+
+// Edges of the GnoEvent.
+func (GnoEvent) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the GnoEvent.
+func (GnoEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash", "event_index").Unique(),
+		index.Fields("pkg_path", "type", "block_height"),
+		index.Fields("type", "block_height"),
+		// Undecoded events are counted by package and type
+		index.Fields("decoded", "pkg_path", "type"),
+		index.Fields("block_height", "tx_index", "event_index"),
+		// Attribute filters are containment queries on the JSONB array
+		index.Fields("attrs").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GnoPackage holds the schema definition for packages and realms deployed with MsgAddPackage.
+type GnoPackage struct {
+	ent.Schema
+}
+
+// Annotations of the GnoPackage.
+func (GnoPackage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "packages"},
+	}
+}
+
+// Fields of the GnoPackage.
+func (GnoPackage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").StorageKey("path").NotEmpty().Comment("Path of the package used as primary key"),
+		field.String("name").NotEmpty().Comment("Name of the package"),
+		field.String("namespace").Optional().Comment("Namespace of the package path (e.g. demo in gno.land/r/demo/foo)"),
+		field.String("creator").Optional().Comment("Address of the package creator"),
+		field.String("deposit").Optional().Comment("Deposit paid for the deployment"),
+		field.Int("block_height").Comment("Height of the block in which the package was deployed"),
+		field.String("hash").NotEmpty().Comment("Hash of the deploying transaction"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the package"),
+	}
+}
+
+// Edges of the GnoPackage.
+func (GnoPackage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("files", GnoPackageFile.Type).
+			StorageKey(edge.Column("package_path")),
+	}
+}
+
+// Indexes of the GnoPackage.
+func (GnoPackage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("creator"),
+		index.Fields("namespace"),
+	}
+}
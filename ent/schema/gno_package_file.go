@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GnoPackageFile holds the source files of a deployed package.
+type GnoPackageFile struct {
+	ent.Schema
+}
+
+// Annotations of the GnoPackageFile.
+func (GnoPackageFile) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "package_files"},
+	}
+}
+
+// Fields of the GnoPackageFile.
+func (GnoPackageFile) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().Comment("Name of the file"),
+		field.Text("body").Comment("Source of the file as deployed"),
+	}
+}
+
+// Edges of the GnoPackageFile.
+func (GnoPackageFile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("package", GnoPackage.Type).
+			Ref("files").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the GnoPackageFile.
+func (GnoPackageFile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("package").Unique(),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Holding holds the balance of a token held by an account, with its activity.
+type Holding struct {
+	ent.Schema
+}
+
+// Fields of the Holding.
+func (Holding) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("address").NotEmpty().Comment("Address of the holding account"),
+		field.String("token").NotEmpty().Comment("Token held"),
+		field.Float("amount").Default(0).Comment("Amount of the token held"),
+		field.Int("first_seen_height").Default(0).Comment("Height of the block of the first balance change"),
+		field.Int("last_active_height").Default(0).Comment("Height of the block of the latest balance change"),
+		field.Int("tx_count").Default(0).Comment("Number of transactions which changed the balance"),
+		field.String("last_tx_hash").Optional().Comment("Hash of the last transaction which changed the balance, to count each transaction once"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the holding"),
+	}
+}
+
+// Edges of the Holding.
+func (Holding) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("holdings").
+			Field("address").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Holding.
+func (Holding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address", "token").Unique(),
+		index.Fields("token", "amount"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Nft holds the current owner of a GRC721 token.
+type Nft struct {
+	ent.Schema
+}
+
+// Fields of the Nft.
+func (Nft) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("collection").NotEmpty().Comment("Package path of the GRC721 collection"),
+		field.String("token_id").NotEmpty().Comment("Token ID within the collection"),
+		field.String("owner").Optional().Comment("Address of the current owner, empty once burned"),
+		field.Bool("burned").Default(false).Comment("Whether the token has been burned"),
+		field.Int("minted_height").Optional().Comment("Height of the block in which the token was minted"),
+		field.Int("last_height").Comment("Height of the block of the last ownership change"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the token"),
+	}
+}
+
+// Edges of the Nft.
+func (Nft) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the Nft.
+func (Nft) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("collection", "token_id").Unique(),
+		index.Fields("owner"),
+	}
+}
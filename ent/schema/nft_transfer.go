@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NftTransfer holds the ownership history of GRC721 tokens.
+type NftTransfer struct {
+	ent.Schema
+}
+
+// Fields of the NftTransfer.
+func (NftTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash").NotEmpty().Comment("Hash of the transaction"),
+		field.String("func").NotEmpty().Comment("Kind of the transfer (mint, burn or transfer)"),
+		field.String("collection").NotEmpty().Comment("Package path of the GRC721 collection"),
+		field.String("token_id").NotEmpty().Comment("Token ID within the collection"),
+		field.String("from_address").Optional().Comment("Address of the previous owner"),
+		field.String("to_address").Optional().Comment("Address of the new owner"),
+		field.Int("block_height").Comment("Height of the block containing the transfer"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the transfer"),
+	}
+}
+
+// Edges of the NftTransfer.
+func (NftTransfer) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the NftTransfer.
+func (NftTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("collection", "token_id", "block_height"),
+		index.Fields("from_address"),
+		index.Fields("to_address"),
+	}
+}
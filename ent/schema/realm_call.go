@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RealmCall holds the MsgCall messages sent to realms.
+type RealmCall struct {
+	ent.Schema
+}
+
+// Fields of the RealmCall.
+func (RealmCall) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash").NotEmpty().Comment("Hash of the transaction"),
+		field.Int("msg_index").Comment("Index of the message in the transaction"),
+		field.Int("block_height").Comment("Height of the block containing the call"),
+		field.Time("block_time").Comment("Timestamp of the block containing the call"),
+		field.String("pkg_path").NotEmpty().Comment("Package path of the called realm"),
+		field.String("func").NotEmpty().Comment("Name of the called function"),
+		field.String("caller").Comment("Address of the caller"),
+		field.Strings("args").Optional().Comment("Arguments of the call"),
+		field.String("send").Optional().Comment("Coins sent with the call"),
+		field.Bool("success").Default(false).Comment("Whether the transaction was successful"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the call"),
+	}
+}
+
+// Edges of the RealmCall.
+func (RealmCall) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the RealmCall.
+func (RealmCall) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash", "msg_index").Unique(),
+		index.Fields("pkg_path", "func", "block_time"),
+		index.Fields("caller", "block_time"),
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RebuildProgress holds the last height replayed by a rebuild of the derived tables, so it can resume.
+type RebuildProgress struct {
+	ent.Schema
+}
+
+// Fields of the RebuildProgress.
+func (RebuildProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("last_height").Comment("Last block height replayed into the rebuild schema"),
+		field.Time("started_at").Default(time.Now).Immutable().Comment("Start time of the rebuild"),
+		field.Time("updated_at").Default(time.Now).Comment("Time of the last progress update"),
+	}
+}
+
+// Edges of the RebuildProgress.
+func (RebuildProgress) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
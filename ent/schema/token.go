@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Token holds the metadata of the native coin and GRC20 tokens.
+type Token struct {
+	ent.Schema
+}
+
+// Fields of the Token.
+func (Token) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").StorageKey("path").NotEmpty().Comment("Package path of the token (or the denom of a native coin) used as primary key"),
+		field.String("name").Optional().Comment("Name of the token"),
+		field.String("symbol").Optional().Comment("Symbol of the token"),
+		field.Int("decimals").Default(0).NonNegative().Comment("Number of decimals of the token amounts"),
+		field.String("creator").Optional().Comment("Address of the token creator"),
+		field.Int("first_seen_height").Default(0).Comment("Height of the block in which the token was first seen"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the token"),
+	}
+}
+
+// Edges of the Token.
+func (Token) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the Token.
+func (Token) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("symbol"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TokenStat holds the supply and holder statistics of a token. Genesis balances
+// count as minted and gas fees, which no indexed account receives, as burned, so
+// the supply matches the sum of the holdings.
+type TokenStat struct {
+	ent.Schema
+}
+
+// Fields of the TokenStat.
+func (TokenStat) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").StorageKey("token").NotEmpty().Comment("Package path of the token (or the denom of a native coin) used as primary key"),
+		field.Int64("minted").Default(0).Comment("Total amount minted"),
+		field.Int64("burned").Default(0).Comment("Total amount burned"),
+		field.Int64("supply").Default(0).Comment("Current supply, minted minus burned"),
+		field.Int("holders").Default(0).Comment("Number of accounts with a positive balance"),
+		field.Int("transfer_count").Default(0).Comment("Number of transfers between accounts"),
+		field.Int("last_height").Default(0).Comment("Height of the latest block which changed the statistics"),
+		field.Time("updated_at").Default(time.Now).Comment("Last update time of the statistics"),
+	}
+}
+
+// Edges of the TokenStat.
+func (TokenStat) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the TokenStat.
+func (TokenStat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("supply"),
+		index.Fields("holders"),
+	}
+}
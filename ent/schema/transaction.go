@@ -5,8 +5,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Transaction holds the schema definition for the Transaction entity.
@@ -68,3 +71,15 @@ func (Transaction) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Indexes of the Transaction.
+func (Transaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash"),
+		index.Fields("block_height", "index"),
+		index.Fields("success", "block_height"),
+		// Message type filters are containment queries on the JSONB array
+		index.Fields("messages").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
+	}
+}
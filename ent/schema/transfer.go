@@ -22,6 +22,11 @@ func (Transfer) Fields() []ent.Field {
 		field.String("token").NotEmpty().Comment("Token associated with the transfer"),
 		field.Float("amount").Positive().Comment("Amount transferred"),
 		field.String("denom").NotEmpty().Comment("Denomination of the transferred amount"),
+		field.Int("block_height").Default(0).Comment("Height of the block containing the transfer"),
+		field.Int("tx_index").Default(0).Comment("Index of the transaction in the block"),
+		field.Int("msg_index").Default(-1).Comment("Index of the message in the transaction, -1 if unknown"),
+		field.Int("event_index").Comment("Index of the event in the transaction, negative for fee (-1) and message (-2 - message index) transfers"),
+		field.Time("block_time").Optional().Comment("Timestamp of the block containing the transfer"),
 		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the transfer"),
 	}
 }
@@ -34,5 +39,15 @@ func (Transfer) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("from_address", "token"),
 		index.Fields("to_address", "token"),
+		// A bank send of several coins yields one transfer per token for the same message
+		index.Fields("hash", "event_index", "token").Unique(),
+		index.Fields("block_height", "tx_index", "event_index"),
+		// Transfer history is paginated with a keyset on (block_height, id)
+		index.Fields("block_height", "id"),
+		index.Fields("from_address", "block_height", "id"),
+		index.Fields("to_address", "block_height", "id"),
+		index.Fields("token", "block_height", "id"),
+		index.Fields("func", "block_height", "id"),
+		index.Fields("block_time"),
 	}
 }
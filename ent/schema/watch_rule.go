@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WatchRule holds the transfers a client is notified of by webhook.
+type WatchRule struct {
+	ent.Schema
+}
+
+// Fields of the WatchRule.
+func (WatchRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").NotEmpty().Comment("URL the webhooks are posted to"),
+		field.String("secret").NotEmpty().Sensitive().Comment("Secret signing the webhooks"),
+		field.String("owner_hash").Optional().Sensitive().Comment("SHA-256 of the token of the owner managing the rule, hex encoded"),
+		field.String("address").Optional().Comment("Address sending or receiving the transfers, any if empty"),
+		field.String("token").Optional().Comment("Token of the transfers, any if empty"),
+		field.Float("min_amount").Default(0).Comment("Smallest amount of the transfers"),
+		field.String("event_type").Optional().Comment("Function of the transfers, e.g. transfer, mint or burn, any if empty"),
+		field.Bool("active").Default(true).Comment("Whether transfers are matched against the rule"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the rule"),
+	}
+}
+
+// Edges of the WatchRule.
+func (WatchRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the WatchRule.
+func (WatchRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("active"),
+		index.Fields("owner_hash"),
+	}
+}
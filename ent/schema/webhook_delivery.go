@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the webhooks of the transfers matching a watch rule and their delivery.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("rule_id").Comment("Watch rule the transfer matched"),
+		field.String("hash").NotEmpty().Comment("Hash of the transaction of the transfer"),
+		field.Int("event_index").Comment("Event index of the transfer"),
+		field.String("token").Comment("Token of the transfer"),
+		field.Int("block_height").Comment("Height of the block containing the transfer"),
+		field.Text("payload").Comment("JSON body of the webhook"),
+		field.Enum("status").Values("pending", "delivered", "failed").Default("pending").Comment("Delivery status"),
+		field.Int("attempts").Default(0).Comment("Number of delivery attempts"),
+		field.Time("next_attempt_at").Comment("Time of the next delivery attempt"),
+		field.Time("last_attempt_at").Optional().Nillable().Comment("Time of the last delivery attempt"),
+		field.Int("response_status").Optional().Comment("HTTP status of the last attempt"),
+		field.String("last_error").Optional().Comment("Error of the last attempt"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time of the delivery"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("rule", WatchRule.Type).
+			Ref("deliveries").
+			Field("rule_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("rule_id", "hash", "event_index", "token").Unique(),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("rule_id", "created_at"),
+	}
+}
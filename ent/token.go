@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/token"
+)
+
+// Token is the model entity for the Token schema.
+type Token struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Package path of the token (or the denom of a native coin) used as primary key
+	ID string `json:"id,omitempty"`
+	// Name of the token
+	Name string `json:"name,omitempty"`
+	// Symbol of the token
+	Symbol string `json:"symbol,omitempty"`
+	// Number of decimals of the token amounts
+	Decimals int `json:"decimals,omitempty"`
+	// Address of the token creator
+	Creator string `json:"creator,omitempty"`
+	// Height of the block in which the token was first seen
+	FirstSeenHeight int `json:"first_seen_height,omitempty"`
+	// Creation time of the token
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Token) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case token.FieldDecimals, token.FieldFirstSeenHeight:
+			values[i] = new(sql.NullInt64)
+		case token.FieldID, token.FieldName, token.FieldSymbol, token.FieldCreator:
+			values[i] = new(sql.NullString)
+		case token.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Token fields.
+func (_m *Token) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case token.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case token.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case token.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case token.FieldDecimals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field decimals", values[i])
+			} else if value.Valid {
+				_m.Decimals = int(value.Int64)
+			}
+		case token.FieldCreator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field creator", values[i])
+			} else if value.Valid {
+				_m.Creator = value.String
+			}
+		case token.FieldFirstSeenHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen_height", values[i])
+			} else if value.Valid {
+				_m.FirstSeenHeight = int(value.Int64)
+			}
+		case token.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Token.
+// This includes values selected through modifiers, order, etc.
+func (_m *Token) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Token.
+// Note that you need to call Token.Unwrap() before calling this method if this Token
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Token) Update() *TokenUpdateOne {
+	return NewTokenClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Token entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Token) Unwrap() *Token {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Token is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Token) String() string {
+	var builder strings.Builder
+	builder.WriteString("Token(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("decimals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Decimals))
+	builder.WriteString(", ")
+	builder.WriteString("creator=")
+	builder.WriteString(_m.Creator)
+	builder.WriteString(", ")
+	builder.WriteString("first_seen_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FirstSeenHeight))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Tokens is a parsable slice of Token.
+type Tokens []*Token
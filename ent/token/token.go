@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package token
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the token type in the database.
+	Label = "token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "path"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldDecimals holds the string denoting the decimals field in the database.
+	FieldDecimals = "decimals"
+	// FieldCreator holds the string denoting the creator field in the database.
+	FieldCreator = "creator"
+	// FieldFirstSeenHeight holds the string denoting the first_seen_height field in the database.
+	FieldFirstSeenHeight = "first_seen_height"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the token in the database.
+	Table = "tokens"
+)
+
+// Columns holds all SQL columns for token fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldSymbol,
+	FieldDecimals,
+	FieldCreator,
+	FieldFirstSeenHeight,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDecimals holds the default value on creation for the "decimals" field.
+	DefaultDecimals int
+	// DecimalsValidator is a validator for the "decimals" field. It is called by the builders before save.
+	DecimalsValidator func(int) error
+	// DefaultFirstSeenHeight holds the default value on creation for the "first_seen_height" field.
+	DefaultFirstSeenHeight int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Token queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByDecimals orders the results by the decimals field.
+func ByDecimals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecimals, opts...).ToFunc()
+}
+
+// ByCreator orders the results by the creator field.
+func ByCreator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreator, opts...).ToFunc()
+}
+
+// ByFirstSeenHeight orders the results by the first_seen_height field.
+func ByFirstSeenHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeenHeight, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
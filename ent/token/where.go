@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package token
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Token {
+	return predicate.Token(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Token {
+	return predicate.Token(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldName, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Decimals applies equality check predicate on the "decimals" field. It's identical to DecimalsEQ.
+func Decimals(v int) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldDecimals, v))
+}
+
+// Creator applies equality check predicate on the "creator" field. It's identical to CreatorEQ.
+func Creator(v string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldCreator, v))
+}
+
+// FirstSeenHeight applies equality check predicate on the "first_seen_height" field. It's identical to FirstSeenHeightEQ.
+func FirstSeenHeight(v int) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldFirstSeenHeight, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Token {
+	return predicate.Token(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldContainsFold(FieldName, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.Token {
+	return predicate.Token(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolIsNil applies the IsNil predicate on the "symbol" field.
+func SymbolIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldSymbol))
+}
+
+// SymbolNotNil applies the NotNil predicate on the "symbol" field.
+func SymbolNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldSymbol))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// DecimalsEQ applies the EQ predicate on the "decimals" field.
+func DecimalsEQ(v int) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldDecimals, v))
+}
+
+// DecimalsNEQ applies the NEQ predicate on the "decimals" field.
+func DecimalsNEQ(v int) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldDecimals, v))
+}
+
+// DecimalsIn applies the In predicate on the "decimals" field.
+func DecimalsIn(vs ...int) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldDecimals, vs...))
+}
+
+// DecimalsNotIn applies the NotIn predicate on the "decimals" field.
+func DecimalsNotIn(vs ...int) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldDecimals, vs...))
+}
+
+// DecimalsGT applies the GT predicate on the "decimals" field.
+func DecimalsGT(v int) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldDecimals, v))
+}
+
+// DecimalsGTE applies the GTE predicate on the "decimals" field.
+func DecimalsGTE(v int) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldDecimals, v))
+}
+
+// DecimalsLT applies the LT predicate on the "decimals" field.
+func DecimalsLT(v int) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldDecimals, v))
+}
+
+// DecimalsLTE applies the LTE predicate on the "decimals" field.
+func DecimalsLTE(v int) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldDecimals, v))
+}
+
+// CreatorEQ applies the EQ predicate on the "creator" field.
+func CreatorEQ(v string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldCreator, v))
+}
+
+// CreatorNEQ applies the NEQ predicate on the "creator" field.
+func CreatorNEQ(v string) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldCreator, v))
+}
+
+// CreatorIn applies the In predicate on the "creator" field.
+func CreatorIn(vs ...string) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldCreator, vs...))
+}
+
+// CreatorNotIn applies the NotIn predicate on the "creator" field.
+func CreatorNotIn(vs ...string) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldCreator, vs...))
+}
+
+// CreatorGT applies the GT predicate on the "creator" field.
+func CreatorGT(v string) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldCreator, v))
+}
+
+// CreatorGTE applies the GTE predicate on the "creator" field.
+func CreatorGTE(v string) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldCreator, v))
+}
+
+// CreatorLT applies the LT predicate on the "creator" field.
+func CreatorLT(v string) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldCreator, v))
+}
+
+// CreatorLTE applies the LTE predicate on the "creator" field.
+func CreatorLTE(v string) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldCreator, v))
+}
+
+// CreatorContains applies the Contains predicate on the "creator" field.
+func CreatorContains(v string) predicate.Token {
+	return predicate.Token(sql.FieldContains(FieldCreator, v))
+}
+
+// CreatorHasPrefix applies the HasPrefix predicate on the "creator" field.
+func CreatorHasPrefix(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasPrefix(FieldCreator, v))
+}
+
+// CreatorHasSuffix applies the HasSuffix predicate on the "creator" field.
+func CreatorHasSuffix(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasSuffix(FieldCreator, v))
+}
+
+// CreatorIsNil applies the IsNil predicate on the "creator" field.
+func CreatorIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldCreator))
+}
+
+// CreatorNotNil applies the NotNil predicate on the "creator" field.
+func CreatorNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldCreator))
+}
+
+// CreatorEqualFold applies the EqualFold predicate on the "creator" field.
+func CreatorEqualFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldEqualFold(FieldCreator, v))
+}
+
+// CreatorContainsFold applies the ContainsFold predicate on the "creator" field.
+func CreatorContainsFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldContainsFold(FieldCreator, v))
+}
+
+// FirstSeenHeightEQ applies the EQ predicate on the "first_seen_height" field.
+func FirstSeenHeightEQ(v int) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightNEQ applies the NEQ predicate on the "first_seen_height" field.
+func FirstSeenHeightNEQ(v int) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightIn applies the In predicate on the "first_seen_height" field.
+func FirstSeenHeightIn(vs ...int) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldFirstSeenHeight, vs...))
+}
+
+// FirstSeenHeightNotIn applies the NotIn predicate on the "first_seen_height" field.
+func FirstSeenHeightNotIn(vs ...int) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldFirstSeenHeight, vs...))
+}
+
+// FirstSeenHeightGT applies the GT predicate on the "first_seen_height" field.
+func FirstSeenHeightGT(v int) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightGTE applies the GTE predicate on the "first_seen_height" field.
+func FirstSeenHeightGTE(v int) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightLT applies the LT predicate on the "first_seen_height" field.
+func FirstSeenHeightLT(v int) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldFirstSeenHeight, v))
+}
+
+// FirstSeenHeightLTE applies the LTE predicate on the "first_seen_height" field.
+func FirstSeenHeightLTE(v int) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldFirstSeenHeight, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Token) predicate.Token {
+	return predicate.Token(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Token) predicate.Token {
+	return predicate.Token(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Token) predicate.Token {
+	return predicate.Token(sql.NotPredicates(p))
+}
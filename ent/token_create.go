@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/token"
+)
+
+// TokenCreate is the builder for creating a Token entity.
+type TokenCreate struct {
+	config
+	mutation *TokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *TokenCreate) SetName(v string) *TokenCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableName(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *TokenCreate) SetSymbol(v string) *TokenCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableSymbol(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetSymbol(*v)
+	}
+	return _c
+}
+
+// SetDecimals sets the "decimals" field.
+func (_c *TokenCreate) SetDecimals(v int) *TokenCreate {
+	_c.mutation.SetDecimals(v)
+	return _c
+}
+
+// SetNillableDecimals sets the "decimals" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableDecimals(v *int) *TokenCreate {
+	if v != nil {
+		_c.SetDecimals(*v)
+	}
+	return _c
+}
+
+// SetCreator sets the "creator" field.
+func (_c *TokenCreate) SetCreator(v string) *TokenCreate {
+	_c.mutation.SetCreator(v)
+	return _c
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableCreator(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetCreator(*v)
+	}
+	return _c
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_c *TokenCreate) SetFirstSeenHeight(v int) *TokenCreate {
+	_c.mutation.SetFirstSeenHeight(v)
+	return _c
+}
+
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableFirstSeenHeight(v *int) *TokenCreate {
+	if v != nil {
+		_c.SetFirstSeenHeight(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TokenCreate) SetCreatedAt(v time.Time) *TokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableCreatedAt(v *time.Time) *TokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TokenCreate) SetID(v string) *TokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TokenMutation object of the builder.
+func (_c *TokenCreate) Mutation() *TokenMutation {
+	return _c.mutation
+}
+
+// Save creates the Token in the database.
+func (_c *TokenCreate) Save(ctx context.Context) (*Token, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TokenCreate) SaveX(ctx context.Context) *Token {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TokenCreate) defaults() {
+	if _, ok := _c.mutation.Decimals(); !ok {
+		v := token.DefaultDecimals
+		_c.mutation.SetDecimals(v)
+	}
+	if _, ok := _c.mutation.FirstSeenHeight(); !ok {
+		v := token.DefaultFirstSeenHeight
+		_c.mutation.SetFirstSeenHeight(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := token.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TokenCreate) check() error {
+	if _, ok := _c.mutation.Decimals(); !ok {
+		return &ValidationError{Name: "decimals", err: errors.New(`ent: missing required field "Token.decimals"`)}
+	}
+	if v, ok := _c.mutation.Decimals(); ok {
+		if err := token.DecimalsValidator(v); err != nil {
+			return &ValidationError{Name: "decimals", err: fmt.Errorf(`ent: validator failed for field "Token.decimals": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FirstSeenHeight(); !ok {
+		return &ValidationError{Name: "first_seen_height", err: errors.New(`ent: missing required field "Token.first_seen_height"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Token.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := token.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Token.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TokenCreate) sqlSave(ctx context.Context) (*Token, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Token.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Token{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(token.Table, sqlgraph.NewFieldSpec(token.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(token.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(token.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Decimals(); ok {
+		_spec.SetField(token.FieldDecimals, field.TypeInt, value)
+		_node.Decimals = value
+	}
+	if value, ok := _c.mutation.Creator(); ok {
+		_spec.SetField(token.FieldCreator, field.TypeString, value)
+		_node.Creator = value
+	}
+	if value, ok := _c.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(token.FieldFirstSeenHeight, field.TypeInt, value)
+		_node.FirstSeenHeight = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(token.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Token.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TokenCreate) OnConflict(opts ...sql.ConflictOption) *TokenUpsertOne {
+	_c.conflict = opts
+	return &TokenUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TokenCreate) OnConflictColumns(columns ...string) *TokenUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TokenUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TokenUpsertOne is the builder for "upsert"-ing
+	//  one Token node.
+	TokenUpsertOne struct {
+		create *TokenCreate
+	}
+
+	// TokenUpsert is the "OnConflict" setter.
+	TokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TokenUpsert) SetName(v string) *TokenUpsert {
+	u.Set(token.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateName() *TokenUpsert {
+	u.SetExcluded(token.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TokenUpsert) ClearName() *TokenUpsert {
+	u.SetNull(token.FieldName)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *TokenUpsert) SetSymbol(v string) *TokenUpsert {
+	u.Set(token.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateSymbol() *TokenUpsert {
+	u.SetExcluded(token.FieldSymbol)
+	return u
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (u *TokenUpsert) ClearSymbol() *TokenUpsert {
+	u.SetNull(token.FieldSymbol)
+	return u
+}
+
+// SetDecimals sets the "decimals" field.
+func (u *TokenUpsert) SetDecimals(v int) *TokenUpsert {
+	u.Set(token.FieldDecimals, v)
+	return u
+}
+
+// UpdateDecimals sets the "decimals" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateDecimals() *TokenUpsert {
+	u.SetExcluded(token.FieldDecimals)
+	return u
+}
+
+// AddDecimals adds v to the "decimals" field.
+func (u *TokenUpsert) AddDecimals(v int) *TokenUpsert {
+	u.Add(token.FieldDecimals, v)
+	return u
+}
+
+// SetCreator sets the "creator" field.
+func (u *TokenUpsert) SetCreator(v string) *TokenUpsert {
+	u.Set(token.FieldCreator, v)
+	return u
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateCreator() *TokenUpsert {
+	u.SetExcluded(token.FieldCreator)
+	return u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *TokenUpsert) ClearCreator() *TokenUpsert {
+	u.SetNull(token.FieldCreator)
+	return u
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *TokenUpsert) SetFirstSeenHeight(v int) *TokenUpsert {
+	u.Set(token.FieldFirstSeenHeight, v)
+	return u
+}
+
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateFirstSeenHeight() *TokenUpsert {
+	u.SetExcluded(token.FieldFirstSeenHeight)
+	return u
+}
+
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *TokenUpsert) AddFirstSeenHeight(v int) *TokenUpsert {
+	u.Add(token.FieldFirstSeenHeight, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(token.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenUpsertOne) UpdateNewValues() *TokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(token.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(token.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenUpsertOne) Ignore() *TokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenUpsertOne) DoNothing() *TokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenCreate.OnConflict
+// documentation for more info.
+func (u *TokenUpsertOne) Update(set func(*TokenUpsert)) *TokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TokenUpsertOne) SetName(v string) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateName() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TokenUpsertOne) ClearName() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *TokenUpsertOne) SetSymbol(v string) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateSymbol() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (u *TokenUpsertOne) ClearSymbol() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearSymbol()
+	})
+}
+
+// SetDecimals sets the "decimals" field.
+func (u *TokenUpsertOne) SetDecimals(v int) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetDecimals(v)
+	})
+}
+
+// AddDecimals adds v to the "decimals" field.
+func (u *TokenUpsertOne) AddDecimals(v int) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.AddDecimals(v)
+	})
+}
+
+// UpdateDecimals sets the "decimals" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateDecimals() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateDecimals()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *TokenUpsertOne) SetCreator(v string) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateCreator() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *TokenUpsertOne) ClearCreator() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *TokenUpsertOne) SetFirstSeenHeight(v int) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetFirstSeenHeight(v)
+	})
+}
+
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *TokenUpsertOne) AddFirstSeenHeight(v int) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.AddFirstSeenHeight(v)
+	})
+}
+
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateFirstSeenHeight() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateFirstSeenHeight()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TokenUpsertOne.ID is not supported by MySQL driver. Use TokenUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenCreateBulk is the builder for creating many Token entities in bulk.
+type TokenCreateBulk struct {
+	config
+	err      error
+	builders []*TokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Token entities in the database.
+func (_c *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Token, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TokenCreateBulk) SaveX(ctx context.Context) []*Token {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Token.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenUpsertBulk {
+	_c.conflict = opts
+	return &TokenUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TokenCreateBulk) OnConflictColumns(columns ...string) *TokenUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TokenUpsertBulk{
+		create: _c,
+	}
+}
+
+// TokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of Token nodes.
+type TokenUpsertBulk struct {
+	create *TokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(token.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenUpsertBulk) UpdateNewValues() *TokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(token.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(token.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenUpsertBulk) Ignore() *TokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenUpsertBulk) DoNothing() *TokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenUpsertBulk) Update(set func(*TokenUpsert)) *TokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TokenUpsertBulk) SetName(v string) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateName() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TokenUpsertBulk) ClearName() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *TokenUpsertBulk) SetSymbol(v string) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateSymbol() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (u *TokenUpsertBulk) ClearSymbol() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearSymbol()
+	})
+}
+
+// SetDecimals sets the "decimals" field.
+func (u *TokenUpsertBulk) SetDecimals(v int) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetDecimals(v)
+	})
+}
+
+// AddDecimals adds v to the "decimals" field.
+func (u *TokenUpsertBulk) AddDecimals(v int) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.AddDecimals(v)
+	})
+}
+
+// UpdateDecimals sets the "decimals" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateDecimals() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateDecimals()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *TokenUpsertBulk) SetCreator(v string) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateCreator() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *TokenUpsertBulk) ClearCreator() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (u *TokenUpsertBulk) SetFirstSeenHeight(v int) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetFirstSeenHeight(v)
+	})
+}
+
+// AddFirstSeenHeight adds v to the "first_seen_height" field.
+func (u *TokenUpsertBulk) AddFirstSeenHeight(v int) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.AddFirstSeenHeight(v)
+	})
+}
+
+// UpdateFirstSeenHeight sets the "first_seen_height" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateFirstSeenHeight() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateFirstSeenHeight()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/token"
+)
+
+// TokenUpdate is the builder for updating Token entities.
+type TokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TokenMutation
+}
+
+// Where appends a list predicates to the TokenUpdate builder.
+func (_u *TokenUpdate) Where(ps ...predicate.Token) *TokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TokenUpdate) SetName(v string) *TokenUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableName(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *TokenUpdate) ClearName() *TokenUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *TokenUpdate) SetSymbol(v string) *TokenUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableSymbol(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (_u *TokenUpdate) ClearSymbol() *TokenUpdate {
+	_u.mutation.ClearSymbol()
+	return _u
+}
+
+// SetDecimals sets the "decimals" field.
+func (_u *TokenUpdate) SetDecimals(v int) *TokenUpdate {
+	_u.mutation.ResetDecimals()
+	_u.mutation.SetDecimals(v)
+	return _u
+}
+
+// SetNillableDecimals sets the "decimals" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableDecimals(v *int) *TokenUpdate {
+	if v != nil {
+		_u.SetDecimals(*v)
+	}
+	return _u
+}
+
+// AddDecimals adds value to the "decimals" field.
+func (_u *TokenUpdate) AddDecimals(v int) *TokenUpdate {
+	_u.mutation.AddDecimals(v)
+	return _u
+}
+
+// SetCreator sets the "creator" field.
+func (_u *TokenUpdate) SetCreator(v string) *TokenUpdate {
+	_u.mutation.SetCreator(v)
+	return _u
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableCreator(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetCreator(*v)
+	}
+	return _u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (_u *TokenUpdate) ClearCreator() *TokenUpdate {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_u *TokenUpdate) SetFirstSeenHeight(v int) *TokenUpdate {
+	_u.mutation.ResetFirstSeenHeight()
+	_u.mutation.SetFirstSeenHeight(v)
+	return _u
+}
+
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableFirstSeenHeight(v *int) *TokenUpdate {
+	if v != nil {
+		_u.SetFirstSeenHeight(*v)
+	}
+	return _u
+}
+
+// AddFirstSeenHeight adds value to the "first_seen_height" field.
+func (_u *TokenUpdate) AddFirstSeenHeight(v int) *TokenUpdate {
+	_u.mutation.AddFirstSeenHeight(v)
+	return _u
+}
+
+// Mutation returns the TokenMutation object of the builder.
+func (_u *TokenUpdate) Mutation() *TokenMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TokenUpdate) check() error {
+	if v, ok := _u.mutation.Decimals(); ok {
+		if err := token.DecimalsValidator(v); err != nil {
+			return &ValidationError{Name: "decimals", err: fmt.Errorf(`ent: validator failed for field "Token.decimals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(token.Table, token.Columns, sqlgraph.NewFieldSpec(token.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(token.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(token.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(token.FieldSymbol, field.TypeString, value)
+	}
+	if _u.mutation.SymbolCleared() {
+		_spec.ClearField(token.FieldSymbol, field.TypeString)
+	}
+	if value, ok := _u.mutation.Decimals(); ok {
+		_spec.SetField(token.FieldDecimals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDecimals(); ok {
+		_spec.AddField(token.FieldDecimals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Creator(); ok {
+		_spec.SetField(token.FieldCreator, field.TypeString, value)
+	}
+	if _u.mutation.CreatorCleared() {
+		_spec.ClearField(token.FieldCreator, field.TypeString)
+	}
+	if value, ok := _u.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(token.FieldFirstSeenHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFirstSeenHeight(); ok {
+		_spec.AddField(token.FieldFirstSeenHeight, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{token.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TokenUpdateOne is the builder for updating a single Token entity.
+type TokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TokenMutation
+}
+
+// SetName sets the "name" field.
+func (_u *TokenUpdateOne) SetName(v string) *TokenUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableName(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *TokenUpdateOne) ClearName() *TokenUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *TokenUpdateOne) SetSymbol(v string) *TokenUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableSymbol(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (_u *TokenUpdateOne) ClearSymbol() *TokenUpdateOne {
+	_u.mutation.ClearSymbol()
+	return _u
+}
+
+// SetDecimals sets the "decimals" field.
+func (_u *TokenUpdateOne) SetDecimals(v int) *TokenUpdateOne {
+	_u.mutation.ResetDecimals()
+	_u.mutation.SetDecimals(v)
+	return _u
+}
+
+// SetNillableDecimals sets the "decimals" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableDecimals(v *int) *TokenUpdateOne {
+	if v != nil {
+		_u.SetDecimals(*v)
+	}
+	return _u
+}
+
+// AddDecimals adds value to the "decimals" field.
+func (_u *TokenUpdateOne) AddDecimals(v int) *TokenUpdateOne {
+	_u.mutation.AddDecimals(v)
+	return _u
+}
+
+// SetCreator sets the "creator" field.
+func (_u *TokenUpdateOne) SetCreator(v string) *TokenUpdateOne {
+	_u.mutation.SetCreator(v)
+	return _u
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableCreator(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetCreator(*v)
+	}
+	return _u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (_u *TokenUpdateOne) ClearCreator() *TokenUpdateOne {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// SetFirstSeenHeight sets the "first_seen_height" field.
+func (_u *TokenUpdateOne) SetFirstSeenHeight(v int) *TokenUpdateOne {
+	_u.mutation.ResetFirstSeenHeight()
+	_u.mutation.SetFirstSeenHeight(v)
+	return _u
+}
+
+// SetNillableFirstSeenHeight sets the "first_seen_height" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableFirstSeenHeight(v *int) *TokenUpdateOne {
+	if v != nil {
+		_u.SetFirstSeenHeight(*v)
+	}
+	return _u
+}
+
+// AddFirstSeenHeight adds value to the "first_seen_height" field.
+func (_u *TokenUpdateOne) AddFirstSeenHeight(v int) *TokenUpdateOne {
+	_u.mutation.AddFirstSeenHeight(v)
+	return _u
+}
+
+// Mutation returns the TokenMutation object of the builder.
+func (_u *TokenUpdateOne) Mutation() *TokenMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TokenUpdate builder.
+func (_u *TokenUpdateOne) Where(ps ...predicate.Token) *TokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TokenUpdateOne) Select(field string, fields ...string) *TokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Token entity.
+func (_u *TokenUpdateOne) Save(ctx context.Context) (*Token, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TokenUpdateOne) SaveX(ctx context.Context) *Token {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TokenUpdateOne) check() error {
+	if v, ok := _u.mutation.Decimals(); ok {
+		if err := token.DecimalsValidator(v); err != nil {
+			return &ValidationError{Name: "decimals", err: fmt.Errorf(`ent: validator failed for field "Token.decimals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(token.Table, token.Columns, sqlgraph.NewFieldSpec(token.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Token.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, token.FieldID)
+		for _, f := range fields {
+			if !token.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != token.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(token.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(token.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(token.FieldSymbol, field.TypeString, value)
+	}
+	if _u.mutation.SymbolCleared() {
+		_spec.ClearField(token.FieldSymbol, field.TypeString)
+	}
+	if value, ok := _u.mutation.Decimals(); ok {
+		_spec.SetField(token.FieldDecimals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDecimals(); ok {
+		_spec.AddField(token.FieldDecimals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Creator(); ok {
+		_spec.SetField(token.FieldCreator, field.TypeString, value)
+	}
+	if _u.mutation.CreatorCleared() {
+		_spec.ClearField(token.FieldCreator, field.TypeString)
+	}
+	if value, ok := _u.mutation.FirstSeenHeight(); ok {
+		_spec.SetField(token.FieldFirstSeenHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFirstSeenHeight(); ok {
+		_spec.AddField(token.FieldFirstSeenHeight, field.TypeInt, value)
+	}
+	_node = &Token{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{token.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/tokenstat"
+)
+
+// TokenStat is the model entity for the TokenStat schema.
+type TokenStat struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Package path of the token (or the denom of a native coin) used as primary key
+	ID string `json:"id,omitempty"`
+	// Total amount minted
+	Minted int64 `json:"minted,omitempty"`
+	// Total amount burned
+	Burned int64 `json:"burned,omitempty"`
+	// Current supply, minted minus burned
+	Supply int64 `json:"supply,omitempty"`
+	// Number of accounts with a positive balance
+	Holders int `json:"holders,omitempty"`
+	// Number of transfers between accounts
+	TransferCount int `json:"transfer_count,omitempty"`
+	// Height of the latest block which changed the statistics
+	LastHeight int `json:"last_height,omitempty"`
+	// Last update time of the statistics
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TokenStat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tokenstat.FieldMinted, tokenstat.FieldBurned, tokenstat.FieldSupply, tokenstat.FieldHolders, tokenstat.FieldTransferCount, tokenstat.FieldLastHeight:
+			values[i] = new(sql.NullInt64)
+		case tokenstat.FieldID:
+			values[i] = new(sql.NullString)
+		case tokenstat.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TokenStat fields.
+func (_m *TokenStat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tokenstat.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case tokenstat.FieldMinted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field minted", values[i])
+			} else if value.Valid {
+				_m.Minted = value.Int64
+			}
+		case tokenstat.FieldBurned:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burned", values[i])
+			} else if value.Valid {
+				_m.Burned = value.Int64
+			}
+		case tokenstat.FieldSupply:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field supply", values[i])
+			} else if value.Valid {
+				_m.Supply = value.Int64
+			}
+		case tokenstat.FieldHolders:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field holders", values[i])
+			} else if value.Valid {
+				_m.Holders = int(value.Int64)
+			}
+		case tokenstat.FieldTransferCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_count", values[i])
+			} else if value.Valid {
+				_m.TransferCount = int(value.Int64)
+			}
+		case tokenstat.FieldLastHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_height", values[i])
+			} else if value.Valid {
+				_m.LastHeight = int(value.Int64)
+			}
+		case tokenstat.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TokenStat.
+// This includes values selected through modifiers, order, etc.
+func (_m *TokenStat) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TokenStat.
+// Note that you need to call TokenStat.Unwrap() before calling this method if this TokenStat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TokenStat) Update() *TokenStatUpdateOne {
+	return NewTokenStatClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TokenStat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TokenStat) Unwrap() *TokenStat {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TokenStat is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TokenStat) String() string {
+	var builder strings.Builder
+	builder.WriteString("TokenStat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("minted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Minted))
+	builder.WriteString(", ")
+	builder.WriteString("burned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Burned))
+	builder.WriteString(", ")
+	builder.WriteString("supply=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Supply))
+	builder.WriteString(", ")
+	builder.WriteString("holders=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Holders))
+	builder.WriteString(", ")
+	builder.WriteString("transfer_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TransferCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastHeight))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TokenStats is a parsable slice of TokenStat.
+type TokenStats []*TokenStat
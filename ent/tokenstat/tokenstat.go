@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenstat
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tokenstat type in the database.
+	Label = "token_stat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "token"
+	// FieldMinted holds the string denoting the minted field in the database.
+	FieldMinted = "minted"
+	// FieldBurned holds the string denoting the burned field in the database.
+	FieldBurned = "burned"
+	// FieldSupply holds the string denoting the supply field in the database.
+	FieldSupply = "supply"
+	// FieldHolders holds the string denoting the holders field in the database.
+	FieldHolders = "holders"
+	// FieldTransferCount holds the string denoting the transfer_count field in the database.
+	FieldTransferCount = "transfer_count"
+	// FieldLastHeight holds the string denoting the last_height field in the database.
+	FieldLastHeight = "last_height"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the tokenstat in the database.
+	Table = "token_stats"
+)
+
+// Columns holds all SQL columns for tokenstat fields.
+var Columns = []string{
+	FieldID,
+	FieldMinted,
+	FieldBurned,
+	FieldSupply,
+	FieldHolders,
+	FieldTransferCount,
+	FieldLastHeight,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMinted holds the default value on creation for the "minted" field.
+	DefaultMinted int64
+	// DefaultBurned holds the default value on creation for the "burned" field.
+	DefaultBurned int64
+	// DefaultSupply holds the default value on creation for the "supply" field.
+	DefaultSupply int64
+	// DefaultHolders holds the default value on creation for the "holders" field.
+	DefaultHolders int
+	// DefaultTransferCount holds the default value on creation for the "transfer_count" field.
+	DefaultTransferCount int
+	// DefaultLastHeight holds the default value on creation for the "last_height" field.
+	DefaultLastHeight int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the TokenStat queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMinted orders the results by the minted field.
+func ByMinted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinted, opts...).ToFunc()
+}
+
+// ByBurned orders the results by the burned field.
+func ByBurned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurned, opts...).ToFunc()
+}
+
+// BySupply orders the results by the supply field.
+func BySupply(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSupply, opts...).ToFunc()
+}
+
+// ByHolders orders the results by the holders field.
+func ByHolders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHolders, opts...).ToFunc()
+}
+
+// ByTransferCount orders the results by the transfer_count field.
+func ByTransferCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferCount, opts...).ToFunc()
+}
+
+// ByLastHeight orders the results by the last_height field.
+func ByLastHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHeight, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
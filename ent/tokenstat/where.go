@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenstat
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldContainsFold(FieldID, id))
+}
+
+// Minted applies equality check predicate on the "minted" field. It's identical to MintedEQ.
+func Minted(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldMinted, v))
+}
+
+// Burned applies equality check predicate on the "burned" field. It's identical to BurnedEQ.
+func Burned(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldBurned, v))
+}
+
+// Supply applies equality check predicate on the "supply" field. It's identical to SupplyEQ.
+func Supply(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldSupply, v))
+}
+
+// Holders applies equality check predicate on the "holders" field. It's identical to HoldersEQ.
+func Holders(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldHolders, v))
+}
+
+// TransferCount applies equality check predicate on the "transfer_count" field. It's identical to TransferCountEQ.
+func TransferCount(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldTransferCount, v))
+}
+
+// LastHeight applies equality check predicate on the "last_height" field. It's identical to LastHeightEQ.
+func LastHeight(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldLastHeight, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MintedEQ applies the EQ predicate on the "minted" field.
+func MintedEQ(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldMinted, v))
+}
+
+// MintedNEQ applies the NEQ predicate on the "minted" field.
+func MintedNEQ(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNEQ(FieldMinted, v))
+}
+
+// MintedIn applies the In predicate on the "minted" field.
+func MintedIn(vs ...int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldIn(FieldMinted, vs...))
+}
+
+// MintedNotIn applies the NotIn predicate on the "minted" field.
+func MintedNotIn(vs ...int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNotIn(FieldMinted, vs...))
+}
+
+// MintedGT applies the GT predicate on the "minted" field.
+func MintedGT(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGT(FieldMinted, v))
+}
+
+// MintedGTE applies the GTE predicate on the "minted" field.
+func MintedGTE(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGTE(FieldMinted, v))
+}
+
+// MintedLT applies the LT predicate on the "minted" field.
+func MintedLT(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLT(FieldMinted, v))
+}
+
+// MintedLTE applies the LTE predicate on the "minted" field.
+func MintedLTE(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLTE(FieldMinted, v))
+}
+
+// BurnedEQ applies the EQ predicate on the "burned" field.
+func BurnedEQ(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldBurned, v))
+}
+
+// BurnedNEQ applies the NEQ predicate on the "burned" field.
+func BurnedNEQ(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNEQ(FieldBurned, v))
+}
+
+// BurnedIn applies the In predicate on the "burned" field.
+func BurnedIn(vs ...int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldIn(FieldBurned, vs...))
+}
+
+// BurnedNotIn applies the NotIn predicate on the "burned" field.
+func BurnedNotIn(vs ...int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNotIn(FieldBurned, vs...))
+}
+
+// BurnedGT applies the GT predicate on the "burned" field.
+func BurnedGT(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGT(FieldBurned, v))
+}
+
+// BurnedGTE applies the GTE predicate on the "burned" field.
+func BurnedGTE(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGTE(FieldBurned, v))
+}
+
+// BurnedLT applies the LT predicate on the "burned" field.
+func BurnedLT(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLT(FieldBurned, v))
+}
+
+// BurnedLTE applies the LTE predicate on the "burned" field.
+func BurnedLTE(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLTE(FieldBurned, v))
+}
+
+// SupplyEQ applies the EQ predicate on the "supply" field.
+func SupplyEQ(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldSupply, v))
+}
+
+// SupplyNEQ applies the NEQ predicate on the "supply" field.
+func SupplyNEQ(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNEQ(FieldSupply, v))
+}
+
+// SupplyIn applies the In predicate on the "supply" field.
+func SupplyIn(vs ...int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldIn(FieldSupply, vs...))
+}
+
+// SupplyNotIn applies the NotIn predicate on the "supply" field.
+func SupplyNotIn(vs ...int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNotIn(FieldSupply, vs...))
+}
+
+// SupplyGT applies the GT predicate on the "supply" field.
+func SupplyGT(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGT(FieldSupply, v))
+}
+
+// SupplyGTE applies the GTE predicate on the "supply" field.
+func SupplyGTE(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGTE(FieldSupply, v))
+}
+
+// SupplyLT applies the LT predicate on the "supply" field.
+func SupplyLT(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLT(FieldSupply, v))
+}
+
+// SupplyLTE applies the LTE predicate on the "supply" field.
+func SupplyLTE(v int64) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLTE(FieldSupply, v))
+}
+
+// HoldersEQ applies the EQ predicate on the "holders" field.
+func HoldersEQ(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldHolders, v))
+}
+
+// HoldersNEQ applies the NEQ predicate on the "holders" field.
+func HoldersNEQ(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNEQ(FieldHolders, v))
+}
+
+// HoldersIn applies the In predicate on the "holders" field.
+func HoldersIn(vs ...int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldIn(FieldHolders, vs...))
+}
+
+// HoldersNotIn applies the NotIn predicate on the "holders" field.
+func HoldersNotIn(vs ...int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNotIn(FieldHolders, vs...))
+}
+
+// HoldersGT applies the GT predicate on the "holders" field.
+func HoldersGT(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGT(FieldHolders, v))
+}
+
+// HoldersGTE applies the GTE predicate on the "holders" field.
+func HoldersGTE(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGTE(FieldHolders, v))
+}
+
+// HoldersLT applies the LT predicate on the "holders" field.
+func HoldersLT(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLT(FieldHolders, v))
+}
+
+// HoldersLTE applies the LTE predicate on the "holders" field.
+func HoldersLTE(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLTE(FieldHolders, v))
+}
+
+// TransferCountEQ applies the EQ predicate on the "transfer_count" field.
+func TransferCountEQ(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldTransferCount, v))
+}
+
+// TransferCountNEQ applies the NEQ predicate on the "transfer_count" field.
+func TransferCountNEQ(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNEQ(FieldTransferCount, v))
+}
+
+// TransferCountIn applies the In predicate on the "transfer_count" field.
+func TransferCountIn(vs ...int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldIn(FieldTransferCount, vs...))
+}
+
+// TransferCountNotIn applies the NotIn predicate on the "transfer_count" field.
+func TransferCountNotIn(vs ...int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNotIn(FieldTransferCount, vs...))
+}
+
+// TransferCountGT applies the GT predicate on the "transfer_count" field.
+func TransferCountGT(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGT(FieldTransferCount, v))
+}
+
+// TransferCountGTE applies the GTE predicate on the "transfer_count" field.
+func TransferCountGTE(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGTE(FieldTransferCount, v))
+}
+
+// TransferCountLT applies the LT predicate on the "transfer_count" field.
+func TransferCountLT(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLT(FieldTransferCount, v))
+}
+
+// TransferCountLTE applies the LTE predicate on the "transfer_count" field.
+func TransferCountLTE(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLTE(FieldTransferCount, v))
+}
+
+// LastHeightEQ applies the EQ predicate on the "last_height" field.
+func LastHeightEQ(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldLastHeight, v))
+}
+
+// LastHeightNEQ applies the NEQ predicate on the "last_height" field.
+func LastHeightNEQ(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNEQ(FieldLastHeight, v))
+}
+
+// LastHeightIn applies the In predicate on the "last_height" field.
+func LastHeightIn(vs ...int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldIn(FieldLastHeight, vs...))
+}
+
+// LastHeightNotIn applies the NotIn predicate on the "last_height" field.
+func LastHeightNotIn(vs ...int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNotIn(FieldLastHeight, vs...))
+}
+
+// LastHeightGT applies the GT predicate on the "last_height" field.
+func LastHeightGT(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGT(FieldLastHeight, v))
+}
+
+// LastHeightGTE applies the GTE predicate on the "last_height" field.
+func LastHeightGTE(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGTE(FieldLastHeight, v))
+}
+
+// LastHeightLT applies the LT predicate on the "last_height" field.
+func LastHeightLT(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLT(FieldLastHeight, v))
+}
+
+// LastHeightLTE applies the LTE predicate on the "last_height" field.
+func LastHeightLTE(v int) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLTE(FieldLastHeight, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TokenStat {
+	return predicate.TokenStat(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TokenStat) predicate.TokenStat {
+	return predicate.TokenStat(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TokenStat) predicate.TokenStat {
+	return predicate.TokenStat(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TokenStat) predicate.TokenStat {
+	return predicate.TokenStat(sql.NotPredicates(p))
+}
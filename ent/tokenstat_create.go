@@ -0,0 +1,1023 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/tokenstat"
+)
+
+// TokenStatCreate is the builder for creating a TokenStat entity.
+type TokenStatCreate struct {
+	config
+	mutation *TokenStatMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMinted sets the "minted" field.
+func (_c *TokenStatCreate) SetMinted(v int64) *TokenStatCreate {
+	_c.mutation.SetMinted(v)
+	return _c
+}
+
+// SetNillableMinted sets the "minted" field if the given value is not nil.
+func (_c *TokenStatCreate) SetNillableMinted(v *int64) *TokenStatCreate {
+	if v != nil {
+		_c.SetMinted(*v)
+	}
+	return _c
+}
+
+// SetBurned sets the "burned" field.
+func (_c *TokenStatCreate) SetBurned(v int64) *TokenStatCreate {
+	_c.mutation.SetBurned(v)
+	return _c
+}
+
+// SetNillableBurned sets the "burned" field if the given value is not nil.
+func (_c *TokenStatCreate) SetNillableBurned(v *int64) *TokenStatCreate {
+	if v != nil {
+		_c.SetBurned(*v)
+	}
+	return _c
+}
+
+// SetSupply sets the "supply" field.
+func (_c *TokenStatCreate) SetSupply(v int64) *TokenStatCreate {
+	_c.mutation.SetSupply(v)
+	return _c
+}
+
+// SetNillableSupply sets the "supply" field if the given value is not nil.
+func (_c *TokenStatCreate) SetNillableSupply(v *int64) *TokenStatCreate {
+	if v != nil {
+		_c.SetSupply(*v)
+	}
+	return _c
+}
+
+// SetHolders sets the "holders" field.
+func (_c *TokenStatCreate) SetHolders(v int) *TokenStatCreate {
+	_c.mutation.SetHolders(v)
+	return _c
+}
+
+// SetNillableHolders sets the "holders" field if the given value is not nil.
+func (_c *TokenStatCreate) SetNillableHolders(v *int) *TokenStatCreate {
+	if v != nil {
+		_c.SetHolders(*v)
+	}
+	return _c
+}
+
+// SetTransferCount sets the "transfer_count" field.
+func (_c *TokenStatCreate) SetTransferCount(v int) *TokenStatCreate {
+	_c.mutation.SetTransferCount(v)
+	return _c
+}
+
+// SetNillableTransferCount sets the "transfer_count" field if the given value is not nil.
+func (_c *TokenStatCreate) SetNillableTransferCount(v *int) *TokenStatCreate {
+	if v != nil {
+		_c.SetTransferCount(*v)
+	}
+	return _c
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_c *TokenStatCreate) SetLastHeight(v int) *TokenStatCreate {
+	_c.mutation.SetLastHeight(v)
+	return _c
+}
+
+// SetNillableLastHeight sets the "last_height" field if the given value is not nil.
+func (_c *TokenStatCreate) SetNillableLastHeight(v *int) *TokenStatCreate {
+	if v != nil {
+		_c.SetLastHeight(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TokenStatCreate) SetUpdatedAt(v time.Time) *TokenStatCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TokenStatCreate) SetNillableUpdatedAt(v *time.Time) *TokenStatCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TokenStatCreate) SetID(v string) *TokenStatCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TokenStatMutation object of the builder.
+func (_c *TokenStatCreate) Mutation() *TokenStatMutation {
+	return _c.mutation
+}
+
+// Save creates the TokenStat in the database.
+func (_c *TokenStatCreate) Save(ctx context.Context) (*TokenStat, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TokenStatCreate) SaveX(ctx context.Context) *TokenStat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TokenStatCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TokenStatCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TokenStatCreate) defaults() {
+	if _, ok := _c.mutation.Minted(); !ok {
+		v := tokenstat.DefaultMinted
+		_c.mutation.SetMinted(v)
+	}
+	if _, ok := _c.mutation.Burned(); !ok {
+		v := tokenstat.DefaultBurned
+		_c.mutation.SetBurned(v)
+	}
+	if _, ok := _c.mutation.Supply(); !ok {
+		v := tokenstat.DefaultSupply
+		_c.mutation.SetSupply(v)
+	}
+	if _, ok := _c.mutation.Holders(); !ok {
+		v := tokenstat.DefaultHolders
+		_c.mutation.SetHolders(v)
+	}
+	if _, ok := _c.mutation.TransferCount(); !ok {
+		v := tokenstat.DefaultTransferCount
+		_c.mutation.SetTransferCount(v)
+	}
+	if _, ok := _c.mutation.LastHeight(); !ok {
+		v := tokenstat.DefaultLastHeight
+		_c.mutation.SetLastHeight(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := tokenstat.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TokenStatCreate) check() error {
+	if _, ok := _c.mutation.Minted(); !ok {
+		return &ValidationError{Name: "minted", err: errors.New(`ent: missing required field "TokenStat.minted"`)}
+	}
+	if _, ok := _c.mutation.Burned(); !ok {
+		return &ValidationError{Name: "burned", err: errors.New(`ent: missing required field "TokenStat.burned"`)}
+	}
+	if _, ok := _c.mutation.Supply(); !ok {
+		return &ValidationError{Name: "supply", err: errors.New(`ent: missing required field "TokenStat.supply"`)}
+	}
+	if _, ok := _c.mutation.Holders(); !ok {
+		return &ValidationError{Name: "holders", err: errors.New(`ent: missing required field "TokenStat.holders"`)}
+	}
+	if _, ok := _c.mutation.TransferCount(); !ok {
+		return &ValidationError{Name: "transfer_count", err: errors.New(`ent: missing required field "TokenStat.transfer_count"`)}
+	}
+	if _, ok := _c.mutation.LastHeight(); !ok {
+		return &ValidationError{Name: "last_height", err: errors.New(`ent: missing required field "TokenStat.last_height"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TokenStat.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tokenstat.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TokenStat.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TokenStatCreate) sqlSave(ctx context.Context) (*TokenStat, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TokenStat.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TokenStatCreate) createSpec() (*TokenStat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TokenStat{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tokenstat.Table, sqlgraph.NewFieldSpec(tokenstat.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Minted(); ok {
+		_spec.SetField(tokenstat.FieldMinted, field.TypeInt64, value)
+		_node.Minted = value
+	}
+	if value, ok := _c.mutation.Burned(); ok {
+		_spec.SetField(tokenstat.FieldBurned, field.TypeInt64, value)
+		_node.Burned = value
+	}
+	if value, ok := _c.mutation.Supply(); ok {
+		_spec.SetField(tokenstat.FieldSupply, field.TypeInt64, value)
+		_node.Supply = value
+	}
+	if value, ok := _c.mutation.Holders(); ok {
+		_spec.SetField(tokenstat.FieldHolders, field.TypeInt, value)
+		_node.Holders = value
+	}
+	if value, ok := _c.mutation.TransferCount(); ok {
+		_spec.SetField(tokenstat.FieldTransferCount, field.TypeInt, value)
+		_node.TransferCount = value
+	}
+	if value, ok := _c.mutation.LastHeight(); ok {
+		_spec.SetField(tokenstat.FieldLastHeight, field.TypeInt, value)
+		_node.LastHeight = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenstat.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenStat.Create().
+//		SetMinted(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenStatUpsert) {
+//			SetMinted(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TokenStatCreate) OnConflict(opts ...sql.ConflictOption) *TokenStatUpsertOne {
+	_c.conflict = opts
+	return &TokenStatUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenStat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TokenStatCreate) OnConflictColumns(columns ...string) *TokenStatUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TokenStatUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TokenStatUpsertOne is the builder for "upsert"-ing
+	//  one TokenStat node.
+	TokenStatUpsertOne struct {
+		create *TokenStatCreate
+	}
+
+	// TokenStatUpsert is the "OnConflict" setter.
+	TokenStatUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMinted sets the "minted" field.
+func (u *TokenStatUpsert) SetMinted(v int64) *TokenStatUpsert {
+	u.Set(tokenstat.FieldMinted, v)
+	return u
+}
+
+// UpdateMinted sets the "minted" field to the value that was provided on create.
+func (u *TokenStatUpsert) UpdateMinted() *TokenStatUpsert {
+	u.SetExcluded(tokenstat.FieldMinted)
+	return u
+}
+
+// AddMinted adds v to the "minted" field.
+func (u *TokenStatUpsert) AddMinted(v int64) *TokenStatUpsert {
+	u.Add(tokenstat.FieldMinted, v)
+	return u
+}
+
+// SetBurned sets the "burned" field.
+func (u *TokenStatUpsert) SetBurned(v int64) *TokenStatUpsert {
+	u.Set(tokenstat.FieldBurned, v)
+	return u
+}
+
+// UpdateBurned sets the "burned" field to the value that was provided on create.
+func (u *TokenStatUpsert) UpdateBurned() *TokenStatUpsert {
+	u.SetExcluded(tokenstat.FieldBurned)
+	return u
+}
+
+// AddBurned adds v to the "burned" field.
+func (u *TokenStatUpsert) AddBurned(v int64) *TokenStatUpsert {
+	u.Add(tokenstat.FieldBurned, v)
+	return u
+}
+
+// SetSupply sets the "supply" field.
+func (u *TokenStatUpsert) SetSupply(v int64) *TokenStatUpsert {
+	u.Set(tokenstat.FieldSupply, v)
+	return u
+}
+
+// UpdateSupply sets the "supply" field to the value that was provided on create.
+func (u *TokenStatUpsert) UpdateSupply() *TokenStatUpsert {
+	u.SetExcluded(tokenstat.FieldSupply)
+	return u
+}
+
+// AddSupply adds v to the "supply" field.
+func (u *TokenStatUpsert) AddSupply(v int64) *TokenStatUpsert {
+	u.Add(tokenstat.FieldSupply, v)
+	return u
+}
+
+// SetHolders sets the "holders" field.
+func (u *TokenStatUpsert) SetHolders(v int) *TokenStatUpsert {
+	u.Set(tokenstat.FieldHolders, v)
+	return u
+}
+
+// UpdateHolders sets the "holders" field to the value that was provided on create.
+func (u *TokenStatUpsert) UpdateHolders() *TokenStatUpsert {
+	u.SetExcluded(tokenstat.FieldHolders)
+	return u
+}
+
+// AddHolders adds v to the "holders" field.
+func (u *TokenStatUpsert) AddHolders(v int) *TokenStatUpsert {
+	u.Add(tokenstat.FieldHolders, v)
+	return u
+}
+
+// SetTransferCount sets the "transfer_count" field.
+func (u *TokenStatUpsert) SetTransferCount(v int) *TokenStatUpsert {
+	u.Set(tokenstat.FieldTransferCount, v)
+	return u
+}
+
+// UpdateTransferCount sets the "transfer_count" field to the value that was provided on create.
+func (u *TokenStatUpsert) UpdateTransferCount() *TokenStatUpsert {
+	u.SetExcluded(tokenstat.FieldTransferCount)
+	return u
+}
+
+// AddTransferCount adds v to the "transfer_count" field.
+func (u *TokenStatUpsert) AddTransferCount(v int) *TokenStatUpsert {
+	u.Add(tokenstat.FieldTransferCount, v)
+	return u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (u *TokenStatUpsert) SetLastHeight(v int) *TokenStatUpsert {
+	u.Set(tokenstat.FieldLastHeight, v)
+	return u
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *TokenStatUpsert) UpdateLastHeight() *TokenStatUpsert {
+	u.SetExcluded(tokenstat.FieldLastHeight)
+	return u
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *TokenStatUpsert) AddLastHeight(v int) *TokenStatUpsert {
+	u.Add(tokenstat.FieldLastHeight, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenStatUpsert) SetUpdatedAt(v time.Time) *TokenStatUpsert {
+	u.Set(tokenstat.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenStatUpsert) UpdateUpdatedAt() *TokenStatUpsert {
+	u.SetExcluded(tokenstat.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TokenStat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenstat.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenStatUpsertOne) UpdateNewValues() *TokenStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tokenstat.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenStat.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenStatUpsertOne) Ignore() *TokenStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenStatUpsertOne) DoNothing() *TokenStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenStatCreate.OnConflict
+// documentation for more info.
+func (u *TokenStatUpsertOne) Update(set func(*TokenStatUpsert)) *TokenStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenStatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMinted sets the "minted" field.
+func (u *TokenStatUpsertOne) SetMinted(v int64) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetMinted(v)
+	})
+}
+
+// AddMinted adds v to the "minted" field.
+func (u *TokenStatUpsertOne) AddMinted(v int64) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddMinted(v)
+	})
+}
+
+// UpdateMinted sets the "minted" field to the value that was provided on create.
+func (u *TokenStatUpsertOne) UpdateMinted() *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateMinted()
+	})
+}
+
+// SetBurned sets the "burned" field.
+func (u *TokenStatUpsertOne) SetBurned(v int64) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetBurned(v)
+	})
+}
+
+// AddBurned adds v to the "burned" field.
+func (u *TokenStatUpsertOne) AddBurned(v int64) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddBurned(v)
+	})
+}
+
+// UpdateBurned sets the "burned" field to the value that was provided on create.
+func (u *TokenStatUpsertOne) UpdateBurned() *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateBurned()
+	})
+}
+
+// SetSupply sets the "supply" field.
+func (u *TokenStatUpsertOne) SetSupply(v int64) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetSupply(v)
+	})
+}
+
+// AddSupply adds v to the "supply" field.
+func (u *TokenStatUpsertOne) AddSupply(v int64) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddSupply(v)
+	})
+}
+
+// UpdateSupply sets the "supply" field to the value that was provided on create.
+func (u *TokenStatUpsertOne) UpdateSupply() *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateSupply()
+	})
+}
+
+// SetHolders sets the "holders" field.
+func (u *TokenStatUpsertOne) SetHolders(v int) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetHolders(v)
+	})
+}
+
+// AddHolders adds v to the "holders" field.
+func (u *TokenStatUpsertOne) AddHolders(v int) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddHolders(v)
+	})
+}
+
+// UpdateHolders sets the "holders" field to the value that was provided on create.
+func (u *TokenStatUpsertOne) UpdateHolders() *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateHolders()
+	})
+}
+
+// SetTransferCount sets the "transfer_count" field.
+func (u *TokenStatUpsertOne) SetTransferCount(v int) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetTransferCount(v)
+	})
+}
+
+// AddTransferCount adds v to the "transfer_count" field.
+func (u *TokenStatUpsertOne) AddTransferCount(v int) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddTransferCount(v)
+	})
+}
+
+// UpdateTransferCount sets the "transfer_count" field to the value that was provided on create.
+func (u *TokenStatUpsertOne) UpdateTransferCount() *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateTransferCount()
+	})
+}
+
+// SetLastHeight sets the "last_height" field.
+func (u *TokenStatUpsertOne) SetLastHeight(v int) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetLastHeight(v)
+	})
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *TokenStatUpsertOne) AddLastHeight(v int) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddLastHeight(v)
+	})
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *TokenStatUpsertOne) UpdateLastHeight() *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateLastHeight()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenStatUpsertOne) SetUpdatedAt(v time.Time) *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenStatUpsertOne) UpdateUpdatedAt() *TokenStatUpsertOne {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenStatUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenStatCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenStatUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenStatUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TokenStatUpsertOne.ID is not supported by MySQL driver. Use TokenStatUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenStatUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenStatCreateBulk is the builder for creating many TokenStat entities in bulk.
+type TokenStatCreateBulk struct {
+	config
+	err      error
+	builders []*TokenStatCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TokenStat entities in the database.
+func (_c *TokenStatCreateBulk) Save(ctx context.Context) ([]*TokenStat, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TokenStat, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TokenStatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TokenStatCreateBulk) SaveX(ctx context.Context) []*TokenStat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TokenStatCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TokenStatCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenStat.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenStatUpsert) {
+//			SetMinted(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TokenStatCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenStatUpsertBulk {
+	_c.conflict = opts
+	return &TokenStatUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenStat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TokenStatCreateBulk) OnConflictColumns(columns ...string) *TokenStatUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TokenStatUpsertBulk{
+		create: _c,
+	}
+}
+
+// TokenStatUpsertBulk is the builder for "upsert"-ing
+// a bulk of TokenStat nodes.
+type TokenStatUpsertBulk struct {
+	create *TokenStatCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TokenStat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenstat.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenStatUpsertBulk) UpdateNewValues() *TokenStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tokenstat.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenStat.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenStatUpsertBulk) Ignore() *TokenStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenStatUpsertBulk) DoNothing() *TokenStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenStatCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenStatUpsertBulk) Update(set func(*TokenStatUpsert)) *TokenStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenStatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMinted sets the "minted" field.
+func (u *TokenStatUpsertBulk) SetMinted(v int64) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetMinted(v)
+	})
+}
+
+// AddMinted adds v to the "minted" field.
+func (u *TokenStatUpsertBulk) AddMinted(v int64) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddMinted(v)
+	})
+}
+
+// UpdateMinted sets the "minted" field to the value that was provided on create.
+func (u *TokenStatUpsertBulk) UpdateMinted() *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateMinted()
+	})
+}
+
+// SetBurned sets the "burned" field.
+func (u *TokenStatUpsertBulk) SetBurned(v int64) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetBurned(v)
+	})
+}
+
+// AddBurned adds v to the "burned" field.
+func (u *TokenStatUpsertBulk) AddBurned(v int64) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddBurned(v)
+	})
+}
+
+// UpdateBurned sets the "burned" field to the value that was provided on create.
+func (u *TokenStatUpsertBulk) UpdateBurned() *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateBurned()
+	})
+}
+
+// SetSupply sets the "supply" field.
+func (u *TokenStatUpsertBulk) SetSupply(v int64) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetSupply(v)
+	})
+}
+
+// AddSupply adds v to the "supply" field.
+func (u *TokenStatUpsertBulk) AddSupply(v int64) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddSupply(v)
+	})
+}
+
+// UpdateSupply sets the "supply" field to the value that was provided on create.
+func (u *TokenStatUpsertBulk) UpdateSupply() *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateSupply()
+	})
+}
+
+// SetHolders sets the "holders" field.
+func (u *TokenStatUpsertBulk) SetHolders(v int) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetHolders(v)
+	})
+}
+
+// AddHolders adds v to the "holders" field.
+func (u *TokenStatUpsertBulk) AddHolders(v int) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddHolders(v)
+	})
+}
+
+// UpdateHolders sets the "holders" field to the value that was provided on create.
+func (u *TokenStatUpsertBulk) UpdateHolders() *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateHolders()
+	})
+}
+
+// SetTransferCount sets the "transfer_count" field.
+func (u *TokenStatUpsertBulk) SetTransferCount(v int) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetTransferCount(v)
+	})
+}
+
+// AddTransferCount adds v to the "transfer_count" field.
+func (u *TokenStatUpsertBulk) AddTransferCount(v int) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddTransferCount(v)
+	})
+}
+
+// UpdateTransferCount sets the "transfer_count" field to the value that was provided on create.
+func (u *TokenStatUpsertBulk) UpdateTransferCount() *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateTransferCount()
+	})
+}
+
+// SetLastHeight sets the "last_height" field.
+func (u *TokenStatUpsertBulk) SetLastHeight(v int) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetLastHeight(v)
+	})
+}
+
+// AddLastHeight adds v to the "last_height" field.
+func (u *TokenStatUpsertBulk) AddLastHeight(v int) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.AddLastHeight(v)
+	})
+}
+
+// UpdateLastHeight sets the "last_height" field to the value that was provided on create.
+func (u *TokenStatUpsertBulk) UpdateLastHeight() *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateLastHeight()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenStatUpsertBulk) SetUpdatedAt(v time.Time) *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenStatUpsertBulk) UpdateUpdatedAt() *TokenStatUpsertBulk {
+	return u.Update(func(s *TokenStatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenStatUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenStatCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenStatCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenStatUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
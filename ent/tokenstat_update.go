@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/tokenstat"
+)
+
+// TokenStatUpdate is the builder for updating TokenStat entities.
+type TokenStatUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TokenStatMutation
+}
+
+// Where appends a list predicates to the TokenStatUpdate builder.
+func (_u *TokenStatUpdate) Where(ps ...predicate.TokenStat) *TokenStatUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMinted sets the "minted" field.
+func (_u *TokenStatUpdate) SetMinted(v int64) *TokenStatUpdate {
+	_u.mutation.ResetMinted()
+	_u.mutation.SetMinted(v)
+	return _u
+}
+
+// SetNillableMinted sets the "minted" field if the given value is not nil.
+func (_u *TokenStatUpdate) SetNillableMinted(v *int64) *TokenStatUpdate {
+	if v != nil {
+		_u.SetMinted(*v)
+	}
+	return _u
+}
+
+// AddMinted adds value to the "minted" field.
+func (_u *TokenStatUpdate) AddMinted(v int64) *TokenStatUpdate {
+	_u.mutation.AddMinted(v)
+	return _u
+}
+
+// SetBurned sets the "burned" field.
+func (_u *TokenStatUpdate) SetBurned(v int64) *TokenStatUpdate {
+	_u.mutation.ResetBurned()
+	_u.mutation.SetBurned(v)
+	return _u
+}
+
+// SetNillableBurned sets the "burned" field if the given value is not nil.
+func (_u *TokenStatUpdate) SetNillableBurned(v *int64) *TokenStatUpdate {
+	if v != nil {
+		_u.SetBurned(*v)
+	}
+	return _u
+}
+
+// AddBurned adds value to the "burned" field.
+func (_u *TokenStatUpdate) AddBurned(v int64) *TokenStatUpdate {
+	_u.mutation.AddBurned(v)
+	return _u
+}
+
+// SetSupply sets the "supply" field.
+func (_u *TokenStatUpdate) SetSupply(v int64) *TokenStatUpdate {
+	_u.mutation.ResetSupply()
+	_u.mutation.SetSupply(v)
+	return _u
+}
+
+// SetNillableSupply sets the "supply" field if the given value is not nil.
+func (_u *TokenStatUpdate) SetNillableSupply(v *int64) *TokenStatUpdate {
+	if v != nil {
+		_u.SetSupply(*v)
+	}
+	return _u
+}
+
+// AddSupply adds value to the "supply" field.
+func (_u *TokenStatUpdate) AddSupply(v int64) *TokenStatUpdate {
+	_u.mutation.AddSupply(v)
+	return _u
+}
+
+// SetHolders sets the "holders" field.
+func (_u *TokenStatUpdate) SetHolders(v int) *TokenStatUpdate {
+	_u.mutation.ResetHolders()
+	_u.mutation.SetHolders(v)
+	return _u
+}
+
+// SetNillableHolders sets the "holders" field if the given value is not nil.
+func (_u *TokenStatUpdate) SetNillableHolders(v *int) *TokenStatUpdate {
+	if v != nil {
+		_u.SetHolders(*v)
+	}
+	return _u
+}
+
+// AddHolders adds value to the "holders" field.
+func (_u *TokenStatUpdate) AddHolders(v int) *TokenStatUpdate {
+	_u.mutation.AddHolders(v)
+	return _u
+}
+
+// SetTransferCount sets the "transfer_count" field.
+func (_u *TokenStatUpdate) SetTransferCount(v int) *TokenStatUpdate {
+	_u.mutation.ResetTransferCount()
+	_u.mutation.SetTransferCount(v)
+	return _u
+}
+
+// SetNillableTransferCount sets the "transfer_count" field if the given value is not nil.
+func (_u *TokenStatUpdate) SetNillableTransferCount(v *int) *TokenStatUpdate {
+	if v != nil {
+		_u.SetTransferCount(*v)
+	}
+	return _u
+}
+
+// AddTransferCount adds value to the "transfer_count" field.
+func (_u *TokenStatUpdate) AddTransferCount(v int) *TokenStatUpdate {
+	_u.mutation.AddTransferCount(v)
+	return _u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_u *TokenStatUpdate) SetLastHeight(v int) *TokenStatUpdate {
+	_u.mutation.ResetLastHeight()
+	_u.mutation.SetLastHeight(v)
+	return _u
+}
+
+// SetNillableLastHeight sets the "last_height" field if the given value is not nil.
+func (_u *TokenStatUpdate) SetNillableLastHeight(v *int) *TokenStatUpdate {
+	if v != nil {
+		_u.SetLastHeight(*v)
+	}
+	return _u
+}
+
+// AddLastHeight adds value to the "last_height" field.
+func (_u *TokenStatUpdate) AddLastHeight(v int) *TokenStatUpdate {
+	_u.mutation.AddLastHeight(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TokenStatUpdate) SetUpdatedAt(v time.Time) *TokenStatUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TokenStatUpdate) SetNillableUpdatedAt(v *time.Time) *TokenStatUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TokenStatMutation object of the builder.
+func (_u *TokenStatUpdate) Mutation() *TokenStatMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TokenStatUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TokenStatUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TokenStatUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TokenStatUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TokenStatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tokenstat.Table, tokenstat.Columns, sqlgraph.NewFieldSpec(tokenstat.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Minted(); ok {
+		_spec.SetField(tokenstat.FieldMinted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinted(); ok {
+		_spec.AddField(tokenstat.FieldMinted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Burned(); ok {
+		_spec.SetField(tokenstat.FieldBurned, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBurned(); ok {
+		_spec.AddField(tokenstat.FieldBurned, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Supply(); ok {
+		_spec.SetField(tokenstat.FieldSupply, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSupply(); ok {
+		_spec.AddField(tokenstat.FieldSupply, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Holders(); ok {
+		_spec.SetField(tokenstat.FieldHolders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHolders(); ok {
+		_spec.AddField(tokenstat.FieldHolders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TransferCount(); ok {
+		_spec.SetField(tokenstat.FieldTransferCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTransferCount(); ok {
+		_spec.AddField(tokenstat.FieldTransferCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHeight(); ok {
+		_spec.SetField(tokenstat.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastHeight(); ok {
+		_spec.AddField(tokenstat.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenstat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenstat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TokenStatUpdateOne is the builder for updating a single TokenStat entity.
+type TokenStatUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TokenStatMutation
+}
+
+// SetMinted sets the "minted" field.
+func (_u *TokenStatUpdateOne) SetMinted(v int64) *TokenStatUpdateOne {
+	_u.mutation.ResetMinted()
+	_u.mutation.SetMinted(v)
+	return _u
+}
+
+// SetNillableMinted sets the "minted" field if the given value is not nil.
+func (_u *TokenStatUpdateOne) SetNillableMinted(v *int64) *TokenStatUpdateOne {
+	if v != nil {
+		_u.SetMinted(*v)
+	}
+	return _u
+}
+
+// AddMinted adds value to the "minted" field.
+func (_u *TokenStatUpdateOne) AddMinted(v int64) *TokenStatUpdateOne {
+	_u.mutation.AddMinted(v)
+	return _u
+}
+
+// SetBurned sets the "burned" field.
+func (_u *TokenStatUpdateOne) SetBurned(v int64) *TokenStatUpdateOne {
+	_u.mutation.ResetBurned()
+	_u.mutation.SetBurned(v)
+	return _u
+}
+
+// SetNillableBurned sets the "burned" field if the given value is not nil.
+func (_u *TokenStatUpdateOne) SetNillableBurned(v *int64) *TokenStatUpdateOne {
+	if v != nil {
+		_u.SetBurned(*v)
+	}
+	return _u
+}
+
+// AddBurned adds value to the "burned" field.
+func (_u *TokenStatUpdateOne) AddBurned(v int64) *TokenStatUpdateOne {
+	_u.mutation.AddBurned(v)
+	return _u
+}
+
+// SetSupply sets the "supply" field.
+func (_u *TokenStatUpdateOne) SetSupply(v int64) *TokenStatUpdateOne {
+	_u.mutation.ResetSupply()
+	_u.mutation.SetSupply(v)
+	return _u
+}
+
+// SetNillableSupply sets the "supply" field if the given value is not nil.
+func (_u *TokenStatUpdateOne) SetNillableSupply(v *int64) *TokenStatUpdateOne {
+	if v != nil {
+		_u.SetSupply(*v)
+	}
+	return _u
+}
+
+// AddSupply adds value to the "supply" field.
+func (_u *TokenStatUpdateOne) AddSupply(v int64) *TokenStatUpdateOne {
+	_u.mutation.AddSupply(v)
+	return _u
+}
+
+// SetHolders sets the "holders" field.
+func (_u *TokenStatUpdateOne) SetHolders(v int) *TokenStatUpdateOne {
+	_u.mutation.ResetHolders()
+	_u.mutation.SetHolders(v)
+	return _u
+}
+
+// SetNillableHolders sets the "holders" field if the given value is not nil.
+func (_u *TokenStatUpdateOne) SetNillableHolders(v *int) *TokenStatUpdateOne {
+	if v != nil {
+		_u.SetHolders(*v)
+	}
+	return _u
+}
+
+// AddHolders adds value to the "holders" field.
+func (_u *TokenStatUpdateOne) AddHolders(v int) *TokenStatUpdateOne {
+	_u.mutation.AddHolders(v)
+	return _u
+}
+
+// SetTransferCount sets the "transfer_count" field.
+func (_u *TokenStatUpdateOne) SetTransferCount(v int) *TokenStatUpdateOne {
+	_u.mutation.ResetTransferCount()
+	_u.mutation.SetTransferCount(v)
+	return _u
+}
+
+// SetNillableTransferCount sets the "transfer_count" field if the given value is not nil.
+func (_u *TokenStatUpdateOne) SetNillableTransferCount(v *int) *TokenStatUpdateOne {
+	if v != nil {
+		_u.SetTransferCount(*v)
+	}
+	return _u
+}
+
+// AddTransferCount adds value to the "transfer_count" field.
+func (_u *TokenStatUpdateOne) AddTransferCount(v int) *TokenStatUpdateOne {
+	_u.mutation.AddTransferCount(v)
+	return _u
+}
+
+// SetLastHeight sets the "last_height" field.
+func (_u *TokenStatUpdateOne) SetLastHeight(v int) *TokenStatUpdateOne {
+	_u.mutation.ResetLastHeight()
+	_u.mutation.SetLastHeight(v)
+	return _u
+}
+
+// SetNillableLastHeight sets the "last_height" field if the given value is not nil.
+func (_u *TokenStatUpdateOne) SetNillableLastHeight(v *int) *TokenStatUpdateOne {
+	if v != nil {
+		_u.SetLastHeight(*v)
+	}
+	return _u
+}
+
+// AddLastHeight adds value to the "last_height" field.
+func (_u *TokenStatUpdateOne) AddLastHeight(v int) *TokenStatUpdateOne {
+	_u.mutation.AddLastHeight(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TokenStatUpdateOne) SetUpdatedAt(v time.Time) *TokenStatUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TokenStatUpdateOne) SetNillableUpdatedAt(v *time.Time) *TokenStatUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TokenStatMutation object of the builder.
+func (_u *TokenStatUpdateOne) Mutation() *TokenStatMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TokenStatUpdate builder.
+func (_u *TokenStatUpdateOne) Where(ps ...predicate.TokenStat) *TokenStatUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TokenStatUpdateOne) Select(field string, fields ...string) *TokenStatUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TokenStat entity.
+func (_u *TokenStatUpdateOne) Save(ctx context.Context) (*TokenStat, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TokenStatUpdateOne) SaveX(ctx context.Context) *TokenStat {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TokenStatUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TokenStatUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TokenStatUpdateOne) sqlSave(ctx context.Context) (_node *TokenStat, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tokenstat.Table, tokenstat.Columns, sqlgraph.NewFieldSpec(tokenstat.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TokenStat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenstat.FieldID)
+		for _, f := range fields {
+			if !tokenstat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tokenstat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Minted(); ok {
+		_spec.SetField(tokenstat.FieldMinted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinted(); ok {
+		_spec.AddField(tokenstat.FieldMinted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Burned(); ok {
+		_spec.SetField(tokenstat.FieldBurned, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBurned(); ok {
+		_spec.AddField(tokenstat.FieldBurned, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Supply(); ok {
+		_spec.SetField(tokenstat.FieldSupply, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSupply(); ok {
+		_spec.AddField(tokenstat.FieldSupply, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Holders(); ok {
+		_spec.SetField(tokenstat.FieldHolders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHolders(); ok {
+		_spec.AddField(tokenstat.FieldHolders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TransferCount(); ok {
+		_spec.SetField(tokenstat.FieldTransferCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTransferCount(); ok {
+		_spec.AddField(tokenstat.FieldTransferCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHeight(); ok {
+		_spec.SetField(tokenstat.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastHeight(); ok {
+		_spec.AddField(tokenstat.FieldLastHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenstat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TokenStat{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenstat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
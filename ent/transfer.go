@@ -32,6 +32,16 @@ type Transfer struct {
 	Amount float64 `json:"amount,omitempty"`
 	// Denomination of the transferred amount
 	Denom string `json:"denom,omitempty"`
+	// Height of the block containing the transfer
+	BlockHeight int `json:"block_height,omitempty"`
+	// Index of the transaction in the block
+	TxIndex int `json:"tx_index,omitempty"`
+	// Index of the message in the transaction, -1 if unknown
+	MsgIndex int `json:"msg_index,omitempty"`
+	// Index of the event in the transaction, negative for fee (-1) and message (-2 - message index) transfers
+	EventIndex int `json:"event_index,omitempty"`
+	// Timestamp of the block containing the transfer
+	BlockTime time.Time `json:"block_time,omitempty"`
 	// Creation time of the transfer
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	to_address   *string
@@ -46,11 +56,11 @@ func (*Transfer) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case transfer.FieldAmount:
 			values[i] = new(sql.NullFloat64)
-		case transfer.FieldID:
+		case transfer.FieldID, transfer.FieldBlockHeight, transfer.FieldTxIndex, transfer.FieldMsgIndex, transfer.FieldEventIndex:
 			values[i] = new(sql.NullInt64)
 		case transfer.FieldHash, transfer.FieldFunc, transfer.FieldFromAddress, transfer.FieldToAddress, transfer.FieldToken, transfer.FieldDenom:
 			values[i] = new(sql.NullString)
-		case transfer.FieldCreatedAt:
+		case transfer.FieldBlockTime, transfer.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case transfer.ForeignKeys[0]: // to_address
 			values[i] = new(sql.NullString)
@@ -119,6 +129,36 @@ func (_m *Transfer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Denom = value.String
 			}
+		case transfer.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = int(value.Int64)
+			}
+		case transfer.FieldTxIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_index", values[i])
+			} else if value.Valid {
+				_m.TxIndex = int(value.Int64)
+			}
+		case transfer.FieldMsgIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field msg_index", values[i])
+			} else if value.Valid {
+				_m.MsgIndex = int(value.Int64)
+			}
+		case transfer.FieldEventIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_index", values[i])
+			} else if value.Valid {
+				_m.EventIndex = int(value.Int64)
+			}
+		case transfer.FieldBlockTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field block_time", values[i])
+			} else if value.Valid {
+				_m.BlockTime = value.Time
+			}
 		case transfer.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -196,6 +236,21 @@ func (_m *Transfer) String() string {
 	builder.WriteString("denom=")
 	builder.WriteString(_m.Denom)
 	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("tx_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TxIndex))
+	builder.WriteString(", ")
+	builder.WriteString("msg_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MsgIndex))
+	builder.WriteString(", ")
+	builder.WriteString("event_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventIndex))
+	builder.WriteString(", ")
+	builder.WriteString("block_time=")
+	builder.WriteString(_m.BlockTime.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
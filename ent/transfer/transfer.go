@@ -27,6 +27,16 @@ const (
 	FieldAmount = "amount"
 	// FieldDenom holds the string denoting the denom field in the database.
 	FieldDenom = "denom"
+	// FieldBlockHeight holds the string denoting the block_height field in the database.
+	FieldBlockHeight = "block_height"
+	// FieldTxIndex holds the string denoting the tx_index field in the database.
+	FieldTxIndex = "tx_index"
+	// FieldMsgIndex holds the string denoting the msg_index field in the database.
+	FieldMsgIndex = "msg_index"
+	// FieldEventIndex holds the string denoting the event_index field in the database.
+	FieldEventIndex = "event_index"
+	// FieldBlockTime holds the string denoting the block_time field in the database.
+	FieldBlockTime = "block_time"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the transfer in the database.
@@ -43,6 +53,11 @@ var Columns = []string{
 	FieldToken,
 	FieldAmount,
 	FieldDenom,
+	FieldBlockHeight,
+	FieldTxIndex,
+	FieldMsgIndex,
+	FieldEventIndex,
+	FieldBlockTime,
 	FieldCreatedAt,
 }
 
@@ -79,6 +94,12 @@ var (
 	AmountValidator func(float64) error
 	// DenomValidator is a validator for the "denom" field. It is called by the builders before save.
 	DenomValidator func(string) error
+	// DefaultBlockHeight holds the default value on creation for the "block_height" field.
+	DefaultBlockHeight int
+	// DefaultTxIndex holds the default value on creation for the "tx_index" field.
+	DefaultTxIndex int
+	// DefaultMsgIndex holds the default value on creation for the "msg_index" field.
+	DefaultMsgIndex int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -126,6 +147,31 @@ func ByDenom(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDenom, opts...).ToFunc()
 }
 
+// ByBlockHeight orders the results by the block_height field.
+func ByBlockHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHeight, opts...).ToFunc()
+}
+
+// ByTxIndex orders the results by the tx_index field.
+func ByTxIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxIndex, opts...).ToFunc()
+}
+
+// ByMsgIndex orders the results by the msg_index field.
+func ByMsgIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMsgIndex, opts...).ToFunc()
+}
+
+// ByEventIndex orders the results by the event_index field.
+func ByEventIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventIndex, opts...).ToFunc()
+}
+
+// ByBlockTime orders the results by the block_time field.
+func ByBlockTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockTime, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -89,6 +89,31 @@ func Denom(v string) predicate.Transfer {
 	return predicate.Transfer(sql.FieldEQ(FieldDenom, v))
 }
 
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// TxIndex applies equality check predicate on the "tx_index" field. It's identical to TxIndexEQ.
+func TxIndex(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// MsgIndex applies equality check predicate on the "msg_index" field. It's identical to MsgIndexEQ.
+func MsgIndex(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldMsgIndex, v))
+}
+
+// EventIndex applies equality check predicate on the "event_index" field. It's identical to EventIndexEQ.
+func EventIndex(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
+func BlockTime(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldBlockTime, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Transfer {
 	return predicate.Transfer(sql.FieldEQ(FieldCreatedAt, v))
@@ -544,6 +569,216 @@ func DenomContainsFold(v string) predicate.Transfer {
 	return predicate.Transfer(sql.FieldContainsFold(FieldDenom, v))
 }
 
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// TxIndexEQ applies the EQ predicate on the "tx_index" field.
+func TxIndexEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// TxIndexNEQ applies the NEQ predicate on the "tx_index" field.
+func TxIndexNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldTxIndex, v))
+}
+
+// TxIndexIn applies the In predicate on the "tx_index" field.
+func TxIndexIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldTxIndex, vs...))
+}
+
+// TxIndexNotIn applies the NotIn predicate on the "tx_index" field.
+func TxIndexNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldTxIndex, vs...))
+}
+
+// TxIndexGT applies the GT predicate on the "tx_index" field.
+func TxIndexGT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldTxIndex, v))
+}
+
+// TxIndexGTE applies the GTE predicate on the "tx_index" field.
+func TxIndexGTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldTxIndex, v))
+}
+
+// TxIndexLT applies the LT predicate on the "tx_index" field.
+func TxIndexLT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldTxIndex, v))
+}
+
+// TxIndexLTE applies the LTE predicate on the "tx_index" field.
+func TxIndexLTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldTxIndex, v))
+}
+
+// MsgIndexEQ applies the EQ predicate on the "msg_index" field.
+func MsgIndexEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldMsgIndex, v))
+}
+
+// MsgIndexNEQ applies the NEQ predicate on the "msg_index" field.
+func MsgIndexNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldMsgIndex, v))
+}
+
+// MsgIndexIn applies the In predicate on the "msg_index" field.
+func MsgIndexIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldMsgIndex, vs...))
+}
+
+// MsgIndexNotIn applies the NotIn predicate on the "msg_index" field.
+func MsgIndexNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldMsgIndex, vs...))
+}
+
+// MsgIndexGT applies the GT predicate on the "msg_index" field.
+func MsgIndexGT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldMsgIndex, v))
+}
+
+// MsgIndexGTE applies the GTE predicate on the "msg_index" field.
+func MsgIndexGTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldMsgIndex, v))
+}
+
+// MsgIndexLT applies the LT predicate on the "msg_index" field.
+func MsgIndexLT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldMsgIndex, v))
+}
+
+// MsgIndexLTE applies the LTE predicate on the "msg_index" field.
+func MsgIndexLTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldMsgIndex, v))
+}
+
+// EventIndexEQ applies the EQ predicate on the "event_index" field.
+func EventIndexEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// EventIndexNEQ applies the NEQ predicate on the "event_index" field.
+func EventIndexNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldEventIndex, v))
+}
+
+// EventIndexIn applies the In predicate on the "event_index" field.
+func EventIndexIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldEventIndex, vs...))
+}
+
+// EventIndexNotIn applies the NotIn predicate on the "event_index" field.
+func EventIndexNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldEventIndex, vs...))
+}
+
+// EventIndexGT applies the GT predicate on the "event_index" field.
+func EventIndexGT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldEventIndex, v))
+}
+
+// EventIndexGTE applies the GTE predicate on the "event_index" field.
+func EventIndexGTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldEventIndex, v))
+}
+
+// EventIndexLT applies the LT predicate on the "event_index" field.
+func EventIndexLT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldEventIndex, v))
+}
+
+// EventIndexLTE applies the LTE predicate on the "event_index" field.
+func EventIndexLTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldEventIndex, v))
+}
+
+// BlockTimeEQ applies the EQ predicate on the "block_time" field.
+func BlockTimeEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// BlockTimeNEQ applies the NEQ predicate on the "block_time" field.
+func BlockTimeNEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldBlockTime, v))
+}
+
+// BlockTimeIn applies the In predicate on the "block_time" field.
+func BlockTimeIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeNotIn applies the NotIn predicate on the "block_time" field.
+func BlockTimeNotIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeGT applies the GT predicate on the "block_time" field.
+func BlockTimeGT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldBlockTime, v))
+}
+
+// BlockTimeGTE applies the GTE predicate on the "block_time" field.
+func BlockTimeGTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldBlockTime, v))
+}
+
+// BlockTimeLT applies the LT predicate on the "block_time" field.
+func BlockTimeLT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldBlockTime, v))
+}
+
+// BlockTimeLTE applies the LTE predicate on the "block_time" field.
+func BlockTimeLTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldBlockTime, v))
+}
+
+// BlockTimeIsNil applies the IsNil predicate on the "block_time" field.
+func BlockTimeIsNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldIsNull(FieldBlockTime))
+}
+
+// BlockTimeNotNil applies the NotNil predicate on the "block_time" field.
+func BlockTimeNotNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotNull(FieldBlockTime))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Transfer {
 	return predicate.Transfer(sql.FieldEQ(FieldCreatedAt, v))
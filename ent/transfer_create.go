@@ -80,6 +80,68 @@ func (_c *TransferCreate) SetDenom(v string) *TransferCreate {
 	return _c
 }
 
+// SetBlockHeight sets the "block_height" field.
+func (_c *TransferCreate) SetBlockHeight(v int) *TransferCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_c *TransferCreate) SetNillableBlockHeight(v *int) *TransferCreate {
+	if v != nil {
+		_c.SetBlockHeight(*v)
+	}
+	return _c
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_c *TransferCreate) SetTxIndex(v int) *TransferCreate {
+	_c.mutation.SetTxIndex(v)
+	return _c
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_c *TransferCreate) SetNillableTxIndex(v *int) *TransferCreate {
+	if v != nil {
+		_c.SetTxIndex(*v)
+	}
+	return _c
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (_c *TransferCreate) SetMsgIndex(v int) *TransferCreate {
+	_c.mutation.SetMsgIndex(v)
+	return _c
+}
+
+// SetNillableMsgIndex sets the "msg_index" field if the given value is not nil.
+func (_c *TransferCreate) SetNillableMsgIndex(v *int) *TransferCreate {
+	if v != nil {
+		_c.SetMsgIndex(*v)
+	}
+	return _c
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_c *TransferCreate) SetEventIndex(v int) *TransferCreate {
+	_c.mutation.SetEventIndex(v)
+	return _c
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_c *TransferCreate) SetBlockTime(v time.Time) *TransferCreate {
+	_c.mutation.SetBlockTime(v)
+	return _c
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_c *TransferCreate) SetNillableBlockTime(v *time.Time) *TransferCreate {
+	if v != nil {
+		_c.SetBlockTime(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TransferCreate) SetCreatedAt(v time.Time) *TransferCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -135,6 +197,18 @@ func (_c *TransferCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *TransferCreate) defaults() {
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		v := transfer.DefaultBlockHeight
+		_c.mutation.SetBlockHeight(v)
+	}
+	if _, ok := _c.mutation.TxIndex(); !ok {
+		v := transfer.DefaultTxIndex
+		_c.mutation.SetTxIndex(v)
+	}
+	if _, ok := _c.mutation.MsgIndex(); !ok {
+		v := transfer.DefaultMsgIndex
+		_c.mutation.SetMsgIndex(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := transfer.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -183,6 +257,18 @@ func (_c *TransferCreate) check() error {
 			return &ValidationError{Name: "denom", err: fmt.Errorf(`ent: validator failed for field "Transfer.denom": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "Transfer.block_height"`)}
+	}
+	if _, ok := _c.mutation.TxIndex(); !ok {
+		return &ValidationError{Name: "tx_index", err: errors.New(`ent: missing required field "Transfer.tx_index"`)}
+	}
+	if _, ok := _c.mutation.MsgIndex(); !ok {
+		return &ValidationError{Name: "msg_index", err: errors.New(`ent: missing required field "Transfer.msg_index"`)}
+	}
+	if _, ok := _c.mutation.EventIndex(); !ok {
+		return &ValidationError{Name: "event_index", err: errors.New(`ent: missing required field "Transfer.event_index"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Transfer.created_at"`)}
 	}
@@ -247,6 +333,26 @@ func (_c *TransferCreate) createSpec() (*Transfer, *sqlgraph.CreateSpec) {
 		_spec.SetField(transfer.FieldDenom, field.TypeString, value)
 		_node.Denom = value
 	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(transfer.FieldBlockHeight, field.TypeInt, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.TxIndex(); ok {
+		_spec.SetField(transfer.FieldTxIndex, field.TypeInt, value)
+		_node.TxIndex = value
+	}
+	if value, ok := _c.mutation.MsgIndex(); ok {
+		_spec.SetField(transfer.FieldMsgIndex, field.TypeInt, value)
+		_node.MsgIndex = value
+	}
+	if value, ok := _c.mutation.EventIndex(); ok {
+		_spec.SetField(transfer.FieldEventIndex, field.TypeInt, value)
+		_node.EventIndex = value
+	}
+	if value, ok := _c.mutation.BlockTime(); ok {
+		_spec.SetField(transfer.FieldBlockTime, field.TypeTime, value)
+		_node.BlockTime = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(transfer.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -405,6 +511,96 @@ func (u *TransferUpsert) UpdateDenom() *TransferUpsert {
 	return u
 }
 
+// SetBlockHeight sets the "block_height" field.
+func (u *TransferUpsert) SetBlockHeight(v int) *TransferUpsert {
+	u.Set(transfer.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateBlockHeight() *TransferUpsert {
+	u.SetExcluded(transfer.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *TransferUpsert) AddBlockHeight(v int) *TransferUpsert {
+	u.Add(transfer.FieldBlockHeight, v)
+	return u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *TransferUpsert) SetTxIndex(v int) *TransferUpsert {
+	u.Set(transfer.FieldTxIndex, v)
+	return u
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateTxIndex() *TransferUpsert {
+	u.SetExcluded(transfer.FieldTxIndex)
+	return u
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *TransferUpsert) AddTxIndex(v int) *TransferUpsert {
+	u.Add(transfer.FieldTxIndex, v)
+	return u
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (u *TransferUpsert) SetMsgIndex(v int) *TransferUpsert {
+	u.Set(transfer.FieldMsgIndex, v)
+	return u
+}
+
+// UpdateMsgIndex sets the "msg_index" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateMsgIndex() *TransferUpsert {
+	u.SetExcluded(transfer.FieldMsgIndex)
+	return u
+}
+
+// AddMsgIndex adds v to the "msg_index" field.
+func (u *TransferUpsert) AddMsgIndex(v int) *TransferUpsert {
+	u.Add(transfer.FieldMsgIndex, v)
+	return u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *TransferUpsert) SetEventIndex(v int) *TransferUpsert {
+	u.Set(transfer.FieldEventIndex, v)
+	return u
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateEventIndex() *TransferUpsert {
+	u.SetExcluded(transfer.FieldEventIndex)
+	return u
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *TransferUpsert) AddEventIndex(v int) *TransferUpsert {
+	u.Add(transfer.FieldEventIndex, v)
+	return u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *TransferUpsert) SetBlockTime(v time.Time) *TransferUpsert {
+	u.Set(transfer.FieldBlockTime, v)
+	return u
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateBlockTime() *TransferUpsert {
+	u.SetExcluded(transfer.FieldBlockTime)
+	return u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *TransferUpsert) ClearBlockTime() *TransferUpsert {
+	u.SetNull(transfer.FieldBlockTime)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -575,6 +771,111 @@ func (u *TransferUpsertOne) UpdateDenom() *TransferUpsertOne {
 	})
 }
 
+// SetBlockHeight sets the "block_height" field.
+func (u *TransferUpsertOne) SetBlockHeight(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *TransferUpsertOne) AddBlockHeight(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateBlockHeight() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *TransferUpsertOne) SetTxIndex(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *TransferUpsertOne) AddTxIndex(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateTxIndex() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (u *TransferUpsertOne) SetMsgIndex(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetMsgIndex(v)
+	})
+}
+
+// AddMsgIndex adds v to the "msg_index" field.
+func (u *TransferUpsertOne) AddMsgIndex(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddMsgIndex(v)
+	})
+}
+
+// UpdateMsgIndex sets the "msg_index" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateMsgIndex() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateMsgIndex()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *TransferUpsertOne) SetEventIndex(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *TransferUpsertOne) AddEventIndex(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateEventIndex() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *TransferUpsertOne) SetBlockTime(v time.Time) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateBlockTime() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *TransferUpsertOne) ClearBlockTime() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
 // Exec executes the query.
 func (u *TransferUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -911,6 +1212,111 @@ func (u *TransferUpsertBulk) UpdateDenom() *TransferUpsertBulk {
 	})
 }
 
+// SetBlockHeight sets the "block_height" field.
+func (u *TransferUpsertBulk) SetBlockHeight(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *TransferUpsertBulk) AddBlockHeight(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateBlockHeight() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *TransferUpsertBulk) SetTxIndex(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *TransferUpsertBulk) AddTxIndex(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateTxIndex() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (u *TransferUpsertBulk) SetMsgIndex(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetMsgIndex(v)
+	})
+}
+
+// AddMsgIndex adds v to the "msg_index" field.
+func (u *TransferUpsertBulk) AddMsgIndex(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddMsgIndex(v)
+	})
+}
+
+// UpdateMsgIndex sets the "msg_index" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateMsgIndex() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateMsgIndex()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *TransferUpsertBulk) SetEventIndex(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *TransferUpsertBulk) AddEventIndex(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateEventIndex() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *TransferUpsertBulk) SetBlockTime(v time.Time) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateBlockTime() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *TransferUpsertBulk) ClearBlockTime() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
 // Exec executes the query.
 func (u *TransferUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -144,6 +145,110 @@ func (_u *TransferUpdate) SetNillableDenom(v *string) *TransferUpdate {
 	return _u
 }
 
+// SetBlockHeight sets the "block_height" field.
+func (_u *TransferUpdate) SetBlockHeight(v int) *TransferUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableBlockHeight(v *int) *TransferUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *TransferUpdate) AddBlockHeight(v int) *TransferUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *TransferUpdate) SetTxIndex(v int) *TransferUpdate {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableTxIndex(v *int) *TransferUpdate {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *TransferUpdate) AddTxIndex(v int) *TransferUpdate {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (_u *TransferUpdate) SetMsgIndex(v int) *TransferUpdate {
+	_u.mutation.ResetMsgIndex()
+	_u.mutation.SetMsgIndex(v)
+	return _u
+}
+
+// SetNillableMsgIndex sets the "msg_index" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableMsgIndex(v *int) *TransferUpdate {
+	if v != nil {
+		_u.SetMsgIndex(*v)
+	}
+	return _u
+}
+
+// AddMsgIndex adds value to the "msg_index" field.
+func (_u *TransferUpdate) AddMsgIndex(v int) *TransferUpdate {
+	_u.mutation.AddMsgIndex(v)
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *TransferUpdate) SetEventIndex(v int) *TransferUpdate {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableEventIndex(v *int) *TransferUpdate {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *TransferUpdate) AddEventIndex(v int) *TransferUpdate {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *TransferUpdate) SetBlockTime(v time.Time) *TransferUpdate {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableBlockTime(v *time.Time) *TransferUpdate {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *TransferUpdate) ClearBlockTime() *TransferUpdate {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
 // Mutation returns the TransferMutation object of the builder.
 func (_u *TransferUpdate) Mutation() *TransferMutation {
 	return _u.mutation
@@ -248,6 +353,36 @@ func (_u *TransferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Denom(); ok {
 		_spec.SetField(transfer.FieldDenom, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(transfer.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(transfer.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(transfer.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(transfer.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MsgIndex(); ok {
+		_spec.SetField(transfer.FieldMsgIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMsgIndex(); ok {
+		_spec.AddField(transfer.FieldMsgIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(transfer.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(transfer.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(transfer.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(transfer.FieldBlockTime, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transfer.Label}
@@ -385,6 +520,110 @@ func (_u *TransferUpdateOne) SetNillableDenom(v *string) *TransferUpdateOne {
 	return _u
 }
 
+// SetBlockHeight sets the "block_height" field.
+func (_u *TransferUpdateOne) SetBlockHeight(v int) *TransferUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableBlockHeight(v *int) *TransferUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *TransferUpdateOne) AddBlockHeight(v int) *TransferUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *TransferUpdateOne) SetTxIndex(v int) *TransferUpdateOne {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableTxIndex(v *int) *TransferUpdateOne {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *TransferUpdateOne) AddTxIndex(v int) *TransferUpdateOne {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetMsgIndex sets the "msg_index" field.
+func (_u *TransferUpdateOne) SetMsgIndex(v int) *TransferUpdateOne {
+	_u.mutation.ResetMsgIndex()
+	_u.mutation.SetMsgIndex(v)
+	return _u
+}
+
+// SetNillableMsgIndex sets the "msg_index" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableMsgIndex(v *int) *TransferUpdateOne {
+	if v != nil {
+		_u.SetMsgIndex(*v)
+	}
+	return _u
+}
+
+// AddMsgIndex adds value to the "msg_index" field.
+func (_u *TransferUpdateOne) AddMsgIndex(v int) *TransferUpdateOne {
+	_u.mutation.AddMsgIndex(v)
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *TransferUpdateOne) SetEventIndex(v int) *TransferUpdateOne {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableEventIndex(v *int) *TransferUpdateOne {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *TransferUpdateOne) AddEventIndex(v int) *TransferUpdateOne {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *TransferUpdateOne) SetBlockTime(v time.Time) *TransferUpdateOne {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableBlockTime(v *time.Time) *TransferUpdateOne {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *TransferUpdateOne) ClearBlockTime() *TransferUpdateOne {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
 // Mutation returns the TransferMutation object of the builder.
 func (_u *TransferUpdateOne) Mutation() *TransferMutation {
 	return _u.mutation
@@ -519,6 +758,36 @@ func (_u *TransferUpdateOne) sqlSave(ctx context.Context) (_node *Transfer, err
 	if value, ok := _u.mutation.Denom(); ok {
 		_spec.SetField(transfer.FieldDenom, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(transfer.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(transfer.FieldBlockHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(transfer.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(transfer.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MsgIndex(); ok {
+		_spec.SetField(transfer.FieldMsgIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMsgIndex(); ok {
+		_spec.AddField(transfer.FieldMsgIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(transfer.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(transfer.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(transfer.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(transfer.FieldBlockTime, field.TypeTime)
+	}
 	_node = &Transfer{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
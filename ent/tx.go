@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,14 +16,44 @@ type Tx struct {
 	config
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// AddressTransaction is the client for interacting with the AddressTransaction builders.
+	AddressTransaction *AddressTransactionClient
+	// BalanceChange is the client for interacting with the BalanceChange builders.
+	BalanceChange *BalanceChangeClient
+	// BalanceCheckpoint is the client for interacting with the BalanceCheckpoint builders.
+	BalanceCheckpoint *BalanceCheckpointClient
 	// Block is the client for interacting with the Block builders.
 	Block *BlockClient
+	// GnoEvent is the client for interacting with the GnoEvent builders.
+	GnoEvent *GnoEventClient
+	// GnoPackage is the client for interacting with the GnoPackage builders.
+	GnoPackage *GnoPackageClient
+	// GnoPackageFile is the client for interacting with the GnoPackageFile builders.
+	GnoPackageFile *GnoPackageFileClient
+	// Holding is the client for interacting with the Holding builders.
+	Holding *HoldingClient
+	// Nft is the client for interacting with the Nft builders.
+	Nft *NftClient
+	// NftTransfer is the client for interacting with the NftTransfer builders.
+	NftTransfer *NftTransferClient
+	// RealmCall is the client for interacting with the RealmCall builders.
+	RealmCall *RealmCallClient
+	// RebuildProgress is the client for interacting with the RebuildProgress builders.
+	RebuildProgress *RebuildProgressClient
 	// RestoreHistory is the client for interacting with the RestoreHistory builders.
 	RestoreHistory *RestoreHistoryClient
+	// Token is the client for interacting with the Token builders.
+	Token *TokenClient
+	// TokenStat is the client for interacting with the TokenStat builders.
+	TokenStat *TokenStatClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// Transfer is the client for interacting with the Transfer builders.
 	Transfer *TransferClient
+	// WatchRule is the client for interacting with the WatchRule builders.
+	WatchRule *WatchRuleClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -154,10 +186,25 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
+	tx.AddressTransaction = NewAddressTransactionClient(tx.config)
+	tx.BalanceChange = NewBalanceChangeClient(tx.config)
+	tx.BalanceCheckpoint = NewBalanceCheckpointClient(tx.config)
 	tx.Block = NewBlockClient(tx.config)
+	tx.GnoEvent = NewGnoEventClient(tx.config)
+	tx.GnoPackage = NewGnoPackageClient(tx.config)
+	tx.GnoPackageFile = NewGnoPackageFileClient(tx.config)
+	tx.Holding = NewHoldingClient(tx.config)
+	tx.Nft = NewNftClient(tx.config)
+	tx.NftTransfer = NewNftTransferClient(tx.config)
+	tx.RealmCall = NewRealmCallClient(tx.config)
+	tx.RebuildProgress = NewRebuildProgressClient(tx.config)
 	tx.RestoreHistory = NewRestoreHistoryClient(tx.config)
+	tx.Token = NewTokenClient(tx.config)
+	tx.TokenStat = NewTokenStatClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
 	tx.Transfer = NewTransferClient(tx.config)
+	tx.WatchRule = NewWatchRuleClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -220,3 +267,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent/watchrule"
+)
+
+// WatchRule is the model entity for the WatchRule schema.
+type WatchRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// URL the webhooks are posted to
+	URL string `json:"url,omitempty"`
+	// Secret signing the webhooks
+	Secret string `json:"-"`
+	// SHA-256 of the token of the owner managing the rule, hex encoded
+	OwnerHash string `json:"-"`
+	// Address sending or receiving the transfers, any if empty
+	Address string `json:"address,omitempty"`
+	// Token of the transfers, any if empty
+	Token string `json:"token,omitempty"`
+	// Smallest amount of the transfers
+	MinAmount float64 `json:"min_amount,omitempty"`
+	// Function of the transfers, e.g. transfer, mint or burn, any if empty
+	EventType string `json:"event_type,omitempty"`
+	// Whether transfers are matched against the rule
+	Active bool `json:"active,omitempty"`
+	// Creation time of the rule
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WatchRuleQuery when eager-loading is set.
+	Edges        WatchRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WatchRuleEdges holds the relations/edges for other nodes in the graph.
+type WatchRuleEdges struct {
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDelivery `json:"deliveries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WatchRuleEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[0] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WatchRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case watchrule.FieldActive:
+			values[i] = new(sql.NullBool)
+		case watchrule.FieldMinAmount:
+			values[i] = new(sql.NullFloat64)
+		case watchrule.FieldID:
+			values[i] = new(sql.NullInt64)
+		case watchrule.FieldURL, watchrule.FieldSecret, watchrule.FieldOwnerHash, watchrule.FieldAddress, watchrule.FieldToken, watchrule.FieldEventType:
+			values[i] = new(sql.NullString)
+		case watchrule.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WatchRule fields.
+func (_m *WatchRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case watchrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case watchrule.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case watchrule.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				_m.Secret = value.String
+			}
+		case watchrule.FieldOwnerHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_hash", values[i])
+			} else if value.Valid {
+				_m.OwnerHash = value.String
+			}
+		case watchrule.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case watchrule.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case watchrule.FieldMinAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_amount", values[i])
+			} else if value.Valid {
+				_m.MinAmount = value.Float64
+			}
+		case watchrule.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = value.String
+			}
+		case watchrule.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		case watchrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WatchRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *WatchRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDeliveries queries the "deliveries" edge of the WatchRule entity.
+func (_m *WatchRule) QueryDeliveries() *WebhookDeliveryQuery {
+	return NewWatchRuleClient(_m.config).QueryDeliveries(_m)
+}
+
+// Update returns a builder for updating this WatchRule.
+// Note that you need to call WatchRule.Unwrap() before calling this method if this WatchRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WatchRule) Update() *WatchRuleUpdateOne {
+	return NewWatchRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WatchRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WatchRule) Unwrap() *WatchRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WatchRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WatchRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("WatchRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("owner_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("min_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinAmount))
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(_m.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WatchRules is a parsable slice of WatchRule.
+type WatchRules []*WatchRule
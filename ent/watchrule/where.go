@@ -0,0 +1,659 @@
+// Code generated by ent, DO NOT EDIT.
+
+package watchrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gno.land-block-indexer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldID, id))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldURL, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldSecret, v))
+}
+
+// OwnerHash applies equality check predicate on the "owner_hash" field. It's identical to OwnerHashEQ.
+func OwnerHash(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldOwnerHash, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldAddress, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldToken, v))
+}
+
+// MinAmount applies equality check predicate on the "min_amount" field. It's identical to MinAmountEQ.
+func MinAmount(v float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldEventType, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldActive, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContainsFold(FieldURL, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// OwnerHashEQ applies the EQ predicate on the "owner_hash" field.
+func OwnerHashEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldOwnerHash, v))
+}
+
+// OwnerHashNEQ applies the NEQ predicate on the "owner_hash" field.
+func OwnerHashNEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldOwnerHash, v))
+}
+
+// OwnerHashIn applies the In predicate on the "owner_hash" field.
+func OwnerHashIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldOwnerHash, vs...))
+}
+
+// OwnerHashNotIn applies the NotIn predicate on the "owner_hash" field.
+func OwnerHashNotIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldOwnerHash, vs...))
+}
+
+// OwnerHashGT applies the GT predicate on the "owner_hash" field.
+func OwnerHashGT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldOwnerHash, v))
+}
+
+// OwnerHashGTE applies the GTE predicate on the "owner_hash" field.
+func OwnerHashGTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldOwnerHash, v))
+}
+
+// OwnerHashLT applies the LT predicate on the "owner_hash" field.
+func OwnerHashLT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldOwnerHash, v))
+}
+
+// OwnerHashLTE applies the LTE predicate on the "owner_hash" field.
+func OwnerHashLTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldOwnerHash, v))
+}
+
+// OwnerHashContains applies the Contains predicate on the "owner_hash" field.
+func OwnerHashContains(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContains(FieldOwnerHash, v))
+}
+
+// OwnerHashHasPrefix applies the HasPrefix predicate on the "owner_hash" field.
+func OwnerHashHasPrefix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasPrefix(FieldOwnerHash, v))
+}
+
+// OwnerHashHasSuffix applies the HasSuffix predicate on the "owner_hash" field.
+func OwnerHashHasSuffix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasSuffix(FieldOwnerHash, v))
+}
+
+// OwnerHashIsNil applies the IsNil predicate on the "owner_hash" field.
+func OwnerHashIsNil() predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIsNull(FieldOwnerHash))
+}
+
+// OwnerHashNotNil applies the NotNil predicate on the "owner_hash" field.
+func OwnerHashNotNil() predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotNull(FieldOwnerHash))
+}
+
+// OwnerHashEqualFold applies the EqualFold predicate on the "owner_hash" field.
+func OwnerHashEqualFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEqualFold(FieldOwnerHash, v))
+}
+
+// OwnerHashContainsFold applies the ContainsFold predicate on the "owner_hash" field.
+func OwnerHashContainsFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContainsFold(FieldOwnerHash, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIsNull(FieldAddress))
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotNull(FieldAddress))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenIsNil applies the IsNil predicate on the "token" field.
+func TokenIsNil() predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIsNull(FieldToken))
+}
+
+// TokenNotNil applies the NotNil predicate on the "token" field.
+func TokenNotNil() predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotNull(FieldToken))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContainsFold(FieldToken, v))
+}
+
+// MinAmountEQ applies the EQ predicate on the "min_amount" field.
+func MinAmountEQ(v float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// MinAmountNEQ applies the NEQ predicate on the "min_amount" field.
+func MinAmountNEQ(v float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldMinAmount, v))
+}
+
+// MinAmountIn applies the In predicate on the "min_amount" field.
+func MinAmountIn(vs ...float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldMinAmount, vs...))
+}
+
+// MinAmountNotIn applies the NotIn predicate on the "min_amount" field.
+func MinAmountNotIn(vs ...float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldMinAmount, vs...))
+}
+
+// MinAmountGT applies the GT predicate on the "min_amount" field.
+func MinAmountGT(v float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldMinAmount, v))
+}
+
+// MinAmountGTE applies the GTE predicate on the "min_amount" field.
+func MinAmountGTE(v float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldMinAmount, v))
+}
+
+// MinAmountLT applies the LT predicate on the "min_amount" field.
+func MinAmountLT(v float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldMinAmount, v))
+}
+
+// MinAmountLTE applies the LTE predicate on the "min_amount" field.
+func MinAmountLTE(v float64) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldMinAmount, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldEventType, v))
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContains(FieldEventType, v))
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasPrefix(FieldEventType, v))
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldHasSuffix(FieldEventType, v))
+}
+
+// EventTypeIsNil applies the IsNil predicate on the "event_type" field.
+func EventTypeIsNil() predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIsNull(FieldEventType))
+}
+
+// EventTypeNotNil applies the NotNil predicate on the "event_type" field.
+func EventTypeNotNil() predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotNull(FieldEventType))
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEqualFold(FieldEventType, v))
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldContainsFold(FieldEventType, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WatchRule {
+	return predicate.WatchRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasDeliveries applies the HasEdge predicate on the "deliveries" edge.
+func HasDeliveries() predicate.WatchRule {
+	return predicate.WatchRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeliveriesTable, DeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeliveriesWith applies the HasEdge predicate on the "deliveries" edge with a given conditions (other predicates).
+func HasDeliveriesWith(preds ...predicate.WebhookDelivery) predicate.WatchRule {
+	return predicate.WatchRule(func(s *sql.Selector) {
+		step := newDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WatchRule) predicate.WatchRule {
+	return predicate.WatchRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WatchRule) predicate.WatchRule {
+	return predicate.WatchRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WatchRule) predicate.WatchRule {
+	return predicate.WatchRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1055 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/watchrule"
+	"gno.land-block-indexer/ent/webhookdelivery"
+)
+
+// WatchRuleCreate is the builder for creating a WatchRule entity.
+type WatchRuleCreate struct {
+	config
+	mutation *WatchRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetURL sets the "url" field.
+func (_c *WatchRuleCreate) SetURL(v string) *WatchRuleCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *WatchRuleCreate) SetSecret(v string) *WatchRuleCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetOwnerHash sets the "owner_hash" field.
+func (_c *WatchRuleCreate) SetOwnerHash(v string) *WatchRuleCreate {
+	_c.mutation.SetOwnerHash(v)
+	return _c
+}
+
+// SetNillableOwnerHash sets the "owner_hash" field if the given value is not nil.
+func (_c *WatchRuleCreate) SetNillableOwnerHash(v *string) *WatchRuleCreate {
+	if v != nil {
+		_c.SetOwnerHash(*v)
+	}
+	return _c
+}
+
+// SetAddress sets the "address" field.
+func (_c *WatchRuleCreate) SetAddress(v string) *WatchRuleCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_c *WatchRuleCreate) SetNillableAddress(v *string) *WatchRuleCreate {
+	if v != nil {
+		_c.SetAddress(*v)
+	}
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *WatchRuleCreate) SetToken(v string) *WatchRuleCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_c *WatchRuleCreate) SetNillableToken(v *string) *WatchRuleCreate {
+	if v != nil {
+		_c.SetToken(*v)
+	}
+	return _c
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_c *WatchRuleCreate) SetMinAmount(v float64) *WatchRuleCreate {
+	_c.mutation.SetMinAmount(v)
+	return _c
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_c *WatchRuleCreate) SetNillableMinAmount(v *float64) *WatchRuleCreate {
+	if v != nil {
+		_c.SetMinAmount(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *WatchRuleCreate) SetEventType(v string) *WatchRuleCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_c *WatchRuleCreate) SetNillableEventType(v *string) *WatchRuleCreate {
+	if v != nil {
+		_c.SetEventType(*v)
+	}
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *WatchRuleCreate) SetActive(v bool) *WatchRuleCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *WatchRuleCreate) SetNillableActive(v *bool) *WatchRuleCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WatchRuleCreate) SetCreatedAt(v time.Time) *WatchRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WatchRuleCreate) SetNillableCreatedAt(v *time.Time) *WatchRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (_c *WatchRuleCreate) AddDeliveryIDs(ids ...int) *WatchRuleCreate {
+	_c.mutation.AddDeliveryIDs(ids...)
+	return _c
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (_c *WatchRuleCreate) AddDeliveries(v ...*WebhookDelivery) *WatchRuleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WatchRuleMutation object of the builder.
+func (_c *WatchRuleCreate) Mutation() *WatchRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the WatchRule in the database.
+func (_c *WatchRuleCreate) Save(ctx context.Context) (*WatchRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WatchRuleCreate) SaveX(ctx context.Context) *WatchRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WatchRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WatchRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WatchRuleCreate) defaults() {
+	if _, ok := _c.mutation.MinAmount(); !ok {
+		v := watchrule.DefaultMinAmount
+		_c.mutation.SetMinAmount(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := watchrule.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := watchrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WatchRuleCreate) check() error {
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "WatchRule.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := watchrule.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WatchRule.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "WatchRule.secret"`)}
+	}
+	if v, ok := _c.mutation.Secret(); ok {
+		if err := watchrule.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WatchRule.secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinAmount(); !ok {
+		return &ValidationError{Name: "min_amount", err: errors.New(`ent: missing required field "WatchRule.min_amount"`)}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "WatchRule.active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WatchRule.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *WatchRuleCreate) sqlSave(ctx context.Context) (*WatchRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WatchRuleCreate) createSpec() (*WatchRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WatchRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(watchrule.Table, sqlgraph.NewFieldSpec(watchrule.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(watchrule.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(watchrule.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.OwnerHash(); ok {
+		_spec.SetField(watchrule.FieldOwnerHash, field.TypeString, value)
+		_node.OwnerHash = value
+	}
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(watchrule.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(watchrule.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.MinAmount(); ok {
+		_spec.SetField(watchrule.FieldMinAmount, field.TypeFloat64, value)
+		_node.MinAmount = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(watchrule.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(watchrule.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(watchrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchrule.DeliveriesTable,
+			Columns: []string{watchrule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WatchRule.Create().
+//		SetURL(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WatchRuleUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WatchRuleCreate) OnConflict(opts ...sql.ConflictOption) *WatchRuleUpsertOne {
+	_c.conflict = opts
+	return &WatchRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WatchRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WatchRuleCreate) OnConflictColumns(columns ...string) *WatchRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WatchRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WatchRuleUpsertOne is the builder for "upsert"-ing
+	//  one WatchRule node.
+	WatchRuleUpsertOne struct {
+		create *WatchRuleCreate
+	}
+
+	// WatchRuleUpsert is the "OnConflict" setter.
+	WatchRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetURL sets the "url" field.
+func (u *WatchRuleUpsert) SetURL(v string) *WatchRuleUpsert {
+	u.Set(watchrule.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WatchRuleUpsert) UpdateURL() *WatchRuleUpsert {
+	u.SetExcluded(watchrule.FieldURL)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *WatchRuleUpsert) SetSecret(v string) *WatchRuleUpsert {
+	u.Set(watchrule.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WatchRuleUpsert) UpdateSecret() *WatchRuleUpsert {
+	u.SetExcluded(watchrule.FieldSecret)
+	return u
+}
+
+// SetOwnerHash sets the "owner_hash" field.
+func (u *WatchRuleUpsert) SetOwnerHash(v string) *WatchRuleUpsert {
+	u.Set(watchrule.FieldOwnerHash, v)
+	return u
+}
+
+// UpdateOwnerHash sets the "owner_hash" field to the value that was provided on create.
+func (u *WatchRuleUpsert) UpdateOwnerHash() *WatchRuleUpsert {
+	u.SetExcluded(watchrule.FieldOwnerHash)
+	return u
+}
+
+// ClearOwnerHash clears the value of the "owner_hash" field.
+func (u *WatchRuleUpsert) ClearOwnerHash() *WatchRuleUpsert {
+	u.SetNull(watchrule.FieldOwnerHash)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *WatchRuleUpsert) SetAddress(v string) *WatchRuleUpsert {
+	u.Set(watchrule.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *WatchRuleUpsert) UpdateAddress() *WatchRuleUpsert {
+	u.SetExcluded(watchrule.FieldAddress)
+	return u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *WatchRuleUpsert) ClearAddress() *WatchRuleUpsert {
+	u.SetNull(watchrule.FieldAddress)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *WatchRuleUpsert) SetToken(v string) *WatchRuleUpsert {
+	u.Set(watchrule.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *WatchRuleUpsert) UpdateToken() *WatchRuleUpsert {
+	u.SetExcluded(watchrule.FieldToken)
+	return u
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *WatchRuleUpsert) ClearToken() *WatchRuleUpsert {
+	u.SetNull(watchrule.FieldToken)
+	return u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *WatchRuleUpsert) SetMinAmount(v float64) *WatchRuleUpsert {
+	u.Set(watchrule.FieldMinAmount, v)
+	return u
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *WatchRuleUpsert) UpdateMinAmount() *WatchRuleUpsert {
+	u.SetExcluded(watchrule.FieldMinAmount)
+	return u
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *WatchRuleUpsert) AddMinAmount(v float64) *WatchRuleUpsert {
+	u.Add(watchrule.FieldMinAmount, v)
+	return u
+}
+
+// SetEventType sets the "event_type" field.
+func (u *WatchRuleUpsert) SetEventType(v string) *WatchRuleUpsert {
+	u.Set(watchrule.FieldEventType, v)
+	return u
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *WatchRuleUpsert) UpdateEventType() *WatchRuleUpsert {
+	u.SetExcluded(watchrule.FieldEventType)
+	return u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (u *WatchRuleUpsert) ClearEventType() *WatchRuleUpsert {
+	u.SetNull(watchrule.FieldEventType)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *WatchRuleUpsert) SetActive(v bool) *WatchRuleUpsert {
+	u.Set(watchrule.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WatchRuleUpsert) UpdateActive() *WatchRuleUpsert {
+	u.SetExcluded(watchrule.FieldActive)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WatchRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WatchRuleUpsertOne) UpdateNewValues() *WatchRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(watchrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WatchRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WatchRuleUpsertOne) Ignore() *WatchRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WatchRuleUpsertOne) DoNothing() *WatchRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WatchRuleCreate.OnConflict
+// documentation for more info.
+func (u *WatchRuleUpsertOne) Update(set func(*WatchRuleUpsert)) *WatchRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WatchRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *WatchRuleUpsertOne) SetURL(v string) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WatchRuleUpsertOne) UpdateURL() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WatchRuleUpsertOne) SetSecret(v string) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WatchRuleUpsertOne) UpdateSecret() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetOwnerHash sets the "owner_hash" field.
+func (u *WatchRuleUpsertOne) SetOwnerHash(v string) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetOwnerHash(v)
+	})
+}
+
+// UpdateOwnerHash sets the "owner_hash" field to the value that was provided on create.
+func (u *WatchRuleUpsertOne) UpdateOwnerHash() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateOwnerHash()
+	})
+}
+
+// ClearOwnerHash clears the value of the "owner_hash" field.
+func (u *WatchRuleUpsertOne) ClearOwnerHash() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.ClearOwnerHash()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *WatchRuleUpsertOne) SetAddress(v string) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *WatchRuleUpsertOne) UpdateAddress() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *WatchRuleUpsertOne) ClearAddress() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *WatchRuleUpsertOne) SetToken(v string) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *WatchRuleUpsertOne) UpdateToken() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *WatchRuleUpsertOne) ClearToken() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.ClearToken()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *WatchRuleUpsertOne) SetMinAmount(v float64) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *WatchRuleUpsertOne) AddMinAmount(v float64) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *WatchRuleUpsertOne) UpdateMinAmount() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *WatchRuleUpsertOne) SetEventType(v string) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *WatchRuleUpsertOne) UpdateEventType() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (u *WatchRuleUpsertOne) ClearEventType() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.ClearEventType()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *WatchRuleUpsertOne) SetActive(v bool) *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WatchRuleUpsertOne) UpdateActive() *WatchRuleUpsertOne {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// Exec executes the query.
+func (u *WatchRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WatchRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WatchRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WatchRuleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WatchRuleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WatchRuleCreateBulk is the builder for creating many WatchRule entities in bulk.
+type WatchRuleCreateBulk struct {
+	config
+	err      error
+	builders []*WatchRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WatchRule entities in the database.
+func (_c *WatchRuleCreateBulk) Save(ctx context.Context) ([]*WatchRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WatchRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WatchRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WatchRuleCreateBulk) SaveX(ctx context.Context) []*WatchRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WatchRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WatchRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WatchRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WatchRuleUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WatchRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *WatchRuleUpsertBulk {
+	_c.conflict = opts
+	return &WatchRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WatchRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WatchRuleCreateBulk) OnConflictColumns(columns ...string) *WatchRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WatchRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// WatchRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of WatchRule nodes.
+type WatchRuleUpsertBulk struct {
+	create *WatchRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WatchRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WatchRuleUpsertBulk) UpdateNewValues() *WatchRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(watchrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WatchRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WatchRuleUpsertBulk) Ignore() *WatchRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WatchRuleUpsertBulk) DoNothing() *WatchRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WatchRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *WatchRuleUpsertBulk) Update(set func(*WatchRuleUpsert)) *WatchRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WatchRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *WatchRuleUpsertBulk) SetURL(v string) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WatchRuleUpsertBulk) UpdateURL() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WatchRuleUpsertBulk) SetSecret(v string) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WatchRuleUpsertBulk) UpdateSecret() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetOwnerHash sets the "owner_hash" field.
+func (u *WatchRuleUpsertBulk) SetOwnerHash(v string) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetOwnerHash(v)
+	})
+}
+
+// UpdateOwnerHash sets the "owner_hash" field to the value that was provided on create.
+func (u *WatchRuleUpsertBulk) UpdateOwnerHash() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateOwnerHash()
+	})
+}
+
+// ClearOwnerHash clears the value of the "owner_hash" field.
+func (u *WatchRuleUpsertBulk) ClearOwnerHash() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.ClearOwnerHash()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *WatchRuleUpsertBulk) SetAddress(v string) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *WatchRuleUpsertBulk) UpdateAddress() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *WatchRuleUpsertBulk) ClearAddress() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *WatchRuleUpsertBulk) SetToken(v string) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *WatchRuleUpsertBulk) UpdateToken() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *WatchRuleUpsertBulk) ClearToken() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.ClearToken()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *WatchRuleUpsertBulk) SetMinAmount(v float64) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *WatchRuleUpsertBulk) AddMinAmount(v float64) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *WatchRuleUpsertBulk) UpdateMinAmount() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *WatchRuleUpsertBulk) SetEventType(v string) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *WatchRuleUpsertBulk) UpdateEventType() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (u *WatchRuleUpsertBulk) ClearEventType() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.ClearEventType()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *WatchRuleUpsertBulk) SetActive(v bool) *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WatchRuleUpsertBulk) UpdateActive() *WatchRuleUpsertBulk {
+	return u.Update(func(s *WatchRuleUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// Exec executes the query.
+func (u *WatchRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WatchRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WatchRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WatchRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,738 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/watchrule"
+	"gno.land-block-indexer/ent/webhookdelivery"
+)
+
+// WatchRuleUpdate is the builder for updating WatchRule entities.
+type WatchRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WatchRuleMutation
+}
+
+// Where appends a list predicates to the WatchRuleUpdate builder.
+func (_u *WatchRuleUpdate) Where(ps ...predicate.WatchRule) *WatchRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *WatchRuleUpdate) SetURL(v string) *WatchRuleUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *WatchRuleUpdate) SetNillableURL(v *string) *WatchRuleUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *WatchRuleUpdate) SetSecret(v string) *WatchRuleUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *WatchRuleUpdate) SetNillableSecret(v *string) *WatchRuleUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetOwnerHash sets the "owner_hash" field.
+func (_u *WatchRuleUpdate) SetOwnerHash(v string) *WatchRuleUpdate {
+	_u.mutation.SetOwnerHash(v)
+	return _u
+}
+
+// SetNillableOwnerHash sets the "owner_hash" field if the given value is not nil.
+func (_u *WatchRuleUpdate) SetNillableOwnerHash(v *string) *WatchRuleUpdate {
+	if v != nil {
+		_u.SetOwnerHash(*v)
+	}
+	return _u
+}
+
+// ClearOwnerHash clears the value of the "owner_hash" field.
+func (_u *WatchRuleUpdate) ClearOwnerHash() *WatchRuleUpdate {
+	_u.mutation.ClearOwnerHash()
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *WatchRuleUpdate) SetAddress(v string) *WatchRuleUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *WatchRuleUpdate) SetNillableAddress(v *string) *WatchRuleUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (_u *WatchRuleUpdate) ClearAddress() *WatchRuleUpdate {
+	_u.mutation.ClearAddress()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *WatchRuleUpdate) SetToken(v string) *WatchRuleUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *WatchRuleUpdate) SetNillableToken(v *string) *WatchRuleUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// ClearToken clears the value of the "token" field.
+func (_u *WatchRuleUpdate) ClearToken() *WatchRuleUpdate {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_u *WatchRuleUpdate) SetMinAmount(v float64) *WatchRuleUpdate {
+	_u.mutation.ResetMinAmount()
+	_u.mutation.SetMinAmount(v)
+	return _u
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_u *WatchRuleUpdate) SetNillableMinAmount(v *float64) *WatchRuleUpdate {
+	if v != nil {
+		_u.SetMinAmount(*v)
+	}
+	return _u
+}
+
+// AddMinAmount adds value to the "min_amount" field.
+func (_u *WatchRuleUpdate) AddMinAmount(v float64) *WatchRuleUpdate {
+	_u.mutation.AddMinAmount(v)
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *WatchRuleUpdate) SetEventType(v string) *WatchRuleUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *WatchRuleUpdate) SetNillableEventType(v *string) *WatchRuleUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *WatchRuleUpdate) ClearEventType() *WatchRuleUpdate {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *WatchRuleUpdate) SetActive(v bool) *WatchRuleUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *WatchRuleUpdate) SetNillableActive(v *bool) *WatchRuleUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (_u *WatchRuleUpdate) AddDeliveryIDs(ids ...int) *WatchRuleUpdate {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (_u *WatchRuleUpdate) AddDeliveries(v ...*WebhookDelivery) *WatchRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WatchRuleMutation object of the builder.
+func (_u *WatchRuleUpdate) Mutation() *WatchRuleMutation {
+	return _u.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (_u *WatchRuleUpdate) ClearDeliveries() *WatchRuleUpdate {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (_u *WatchRuleUpdate) RemoveDeliveryIDs(ids ...int) *WatchRuleUpdate {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (_u *WatchRuleUpdate) RemoveDeliveries(v ...*WebhookDelivery) *WatchRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WatchRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WatchRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WatchRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WatchRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WatchRuleUpdate) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := watchrule.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WatchRule.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := watchrule.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WatchRule.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WatchRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchrule.Table, watchrule.Columns, sqlgraph.NewFieldSpec(watchrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(watchrule.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(watchrule.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerHash(); ok {
+		_spec.SetField(watchrule.FieldOwnerHash, field.TypeString, value)
+	}
+	if _u.mutation.OwnerHashCleared() {
+		_spec.ClearField(watchrule.FieldOwnerHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(watchrule.FieldAddress, field.TypeString, value)
+	}
+	if _u.mutation.AddressCleared() {
+		_spec.ClearField(watchrule.FieldAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(watchrule.FieldToken, field.TypeString, value)
+	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(watchrule.FieldToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.MinAmount(); ok {
+		_spec.SetField(watchrule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinAmount(); ok {
+		_spec.AddField(watchrule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(watchrule.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(watchrule.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(watchrule.FieldActive, field.TypeBool, value)
+	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchrule.DeliveriesTable,
+			Columns: []string{watchrule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchrule.DeliveriesTable,
+			Columns: []string{watchrule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchrule.DeliveriesTable,
+			Columns: []string{watchrule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WatchRuleUpdateOne is the builder for updating a single WatchRule entity.
+type WatchRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WatchRuleMutation
+}
+
+// SetURL sets the "url" field.
+func (_u *WatchRuleUpdateOne) SetURL(v string) *WatchRuleUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *WatchRuleUpdateOne) SetNillableURL(v *string) *WatchRuleUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *WatchRuleUpdateOne) SetSecret(v string) *WatchRuleUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *WatchRuleUpdateOne) SetNillableSecret(v *string) *WatchRuleUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetOwnerHash sets the "owner_hash" field.
+func (_u *WatchRuleUpdateOne) SetOwnerHash(v string) *WatchRuleUpdateOne {
+	_u.mutation.SetOwnerHash(v)
+	return _u
+}
+
+// SetNillableOwnerHash sets the "owner_hash" field if the given value is not nil.
+func (_u *WatchRuleUpdateOne) SetNillableOwnerHash(v *string) *WatchRuleUpdateOne {
+	if v != nil {
+		_u.SetOwnerHash(*v)
+	}
+	return _u
+}
+
+// ClearOwnerHash clears the value of the "owner_hash" field.
+func (_u *WatchRuleUpdateOne) ClearOwnerHash() *WatchRuleUpdateOne {
+	_u.mutation.ClearOwnerHash()
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *WatchRuleUpdateOne) SetAddress(v string) *WatchRuleUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *WatchRuleUpdateOne) SetNillableAddress(v *string) *WatchRuleUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (_u *WatchRuleUpdateOne) ClearAddress() *WatchRuleUpdateOne {
+	_u.mutation.ClearAddress()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *WatchRuleUpdateOne) SetToken(v string) *WatchRuleUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *WatchRuleUpdateOne) SetNillableToken(v *string) *WatchRuleUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// ClearToken clears the value of the "token" field.
+func (_u *WatchRuleUpdateOne) ClearToken() *WatchRuleUpdateOne {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_u *WatchRuleUpdateOne) SetMinAmount(v float64) *WatchRuleUpdateOne {
+	_u.mutation.ResetMinAmount()
+	_u.mutation.SetMinAmount(v)
+	return _u
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_u *WatchRuleUpdateOne) SetNillableMinAmount(v *float64) *WatchRuleUpdateOne {
+	if v != nil {
+		_u.SetMinAmount(*v)
+	}
+	return _u
+}
+
+// AddMinAmount adds value to the "min_amount" field.
+func (_u *WatchRuleUpdateOne) AddMinAmount(v float64) *WatchRuleUpdateOne {
+	_u.mutation.AddMinAmount(v)
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *WatchRuleUpdateOne) SetEventType(v string) *WatchRuleUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *WatchRuleUpdateOne) SetNillableEventType(v *string) *WatchRuleUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *WatchRuleUpdateOne) ClearEventType() *WatchRuleUpdateOne {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *WatchRuleUpdateOne) SetActive(v bool) *WatchRuleUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *WatchRuleUpdateOne) SetNillableActive(v *bool) *WatchRuleUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (_u *WatchRuleUpdateOne) AddDeliveryIDs(ids ...int) *WatchRuleUpdateOne {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (_u *WatchRuleUpdateOne) AddDeliveries(v ...*WebhookDelivery) *WatchRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WatchRuleMutation object of the builder.
+func (_u *WatchRuleUpdateOne) Mutation() *WatchRuleMutation {
+	return _u.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (_u *WatchRuleUpdateOne) ClearDeliveries() *WatchRuleUpdateOne {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (_u *WatchRuleUpdateOne) RemoveDeliveryIDs(ids ...int) *WatchRuleUpdateOne {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (_u *WatchRuleUpdateOne) RemoveDeliveries(v ...*WebhookDelivery) *WatchRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WatchRuleUpdate builder.
+func (_u *WatchRuleUpdateOne) Where(ps ...predicate.WatchRule) *WatchRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WatchRuleUpdateOne) Select(field string, fields ...string) *WatchRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WatchRule entity.
+func (_u *WatchRuleUpdateOne) Save(ctx context.Context) (*WatchRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WatchRuleUpdateOne) SaveX(ctx context.Context) *WatchRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WatchRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WatchRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WatchRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := watchrule.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WatchRule.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := watchrule.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WatchRule.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WatchRuleUpdateOne) sqlSave(ctx context.Context) (_node *WatchRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchrule.Table, watchrule.Columns, sqlgraph.NewFieldSpec(watchrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WatchRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, watchrule.FieldID)
+		for _, f := range fields {
+			if !watchrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != watchrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(watchrule.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(watchrule.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerHash(); ok {
+		_spec.SetField(watchrule.FieldOwnerHash, field.TypeString, value)
+	}
+	if _u.mutation.OwnerHashCleared() {
+		_spec.ClearField(watchrule.FieldOwnerHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(watchrule.FieldAddress, field.TypeString, value)
+	}
+	if _u.mutation.AddressCleared() {
+		_spec.ClearField(watchrule.FieldAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(watchrule.FieldToken, field.TypeString, value)
+	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(watchrule.FieldToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.MinAmount(); ok {
+		_spec.SetField(watchrule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinAmount(); ok {
+		_spec.AddField(watchrule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(watchrule.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(watchrule.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(watchrule.FieldActive, field.TypeBool, value)
+	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchrule.DeliveriesTable,
+			Columns: []string{watchrule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchrule.DeliveriesTable,
+			Columns: []string{watchrule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   watchrule.DeliveriesTable,
+			Columns: []string{watchrule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WatchRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
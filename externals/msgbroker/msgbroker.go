@@ -12,3 +12,12 @@ type BlockWithTransactions struct {
 	Block        *model.Block        `json:"block"`
 	Transactions []model.Transaction `json:"transactions"`
 }
+
+// IndexedBlock is published by the event processor once a block and its
+// transfers are committed to the database. It only names the block, which
+// subscribers load from the database, as messages are limited to 256 KB.
+type IndexedBlock struct {
+	Height   int      `json:"height"`
+	Hash     string   `json:"hash"`
+	TxHashes []string `json:"tx_hashes"`
+}
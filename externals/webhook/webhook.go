@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+)
+
+const (
+	HEADER_SIGNATURE = "X-Webhook-Signature" // HMAC-SHA256 of "<timestamp>.<body>", as sha256=<hex>
+	HEADER_TIMESTAMP = "X-Webhook-Timestamp" // Unix time of the attempt, to reject replayed requests
+	HEADER_DELIVERY  = "X-Webhook-Delivery"  // ID of the delivery, identical across retries
+)
+
+// ErrPrivateAddress is returned for webhook hosts reaching the indexer host or its
+// internal network (loopback, private or link-local addresses)
+var ErrPrivateAddress = errors.New("webhook address is loopback, private or link-local")
+
+type Webhook interface {
+	// Send posts the payload signed with the secret, returning the HTTP status of the response
+	Send(ctx context.Context, url string, secret string, deliveryID int, payload []byte) (int, error)
+}
+
+// Sign returns the signature of a payload sent at the given unix time, which
+// receivers compare against the HEADER_SIGNATURE header
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsPrivateIP tells whether webhooks must not be sent to the address
+func IsPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// CheckHost resolves the host of a webhook URL, returning ErrPrivateAddress if any
+// of its addresses is private. Deliveries check the address again when connecting,
+// as the host may resolve differently later.
+func CheckHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if IsPrivateIP(ip) {
+			return ErrPrivateAddress
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if IsPrivateIP(addr.IP) {
+			return ErrPrivateAddress
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"gno.land-block-indexer/lib/log"
+)
+
+// Config for the HTTP webhook client
+type HTTPConfig struct {
+	Timeout      time.Duration // Timeout of a request (default: 10s)
+	AllowPrivate bool          // Allow loopback, private and link-local addresses, e.g. for tests
+}
+
+type webhookHTTP struct {
+	logger log.Logger
+	client *http.Client
+}
+
+// NewWebhookHTTP creates a webhook client posting JSON payloads over HTTP
+func NewWebhookHTTP(logger log.Logger, cfg *HTTPConfig) Webhook {
+	timeout := 10 * time.Second
+	if cfg != nil && cfg.Timeout > 0 {
+		timeout = cfg.Timeout
+	}
+
+	// The address is checked when connecting rather than when resolving, so neither
+	// a host resolving differently since the rule was created nor a redirect reaches
+	// the internal network
+	dialer := &net.Dialer{Timeout: timeout}
+	if cfg == nil || !cfg.AllowPrivate {
+		dialer.Control = func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || IsPrivateIP(ip) {
+				return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // A proxy would connect to the target on our behalf, unchecked
+	transport.DialContext = dialer.DialContext
+
+	return &webhookHTTP{
+		logger: logger,
+		client: &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+// Send implements Webhook.
+func (w *webhookHTTP) Send(ctx context.Context, url string, secret string, deliveryID int, payload []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("invalid webhook request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HEADER_TIMESTAMP, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HEADER_SIGNATURE, Sign(secret, timestamp, payload))
+	req.Header.Set(HEADER_DELIVERY, strconv.Itoa(deliveryID))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"gno.land-block-indexer/lib/log"
+)
+
+func TestWebhookHTTP(t *testing.T) {
+	const secret = "secret"
+	payload := []byte(`{"type":"transfer"}`)
+
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, err := strconv.ParseInt(r.Header.Get(HEADER_TIMESTAMP), 10, 64)
+		if err != nil {
+			t.Errorf("Invalid timestamp header %q", r.Header.Get(HEADER_TIMESTAMP))
+		}
+		if r.Header.Get(HEADER_SIGNATURE) != Sign(secret, timestamp, body) {
+			t.Errorf("Signature %q does not match the body", r.Header.Get(HEADER_SIGNATURE))
+		}
+		if r.Header.Get(HEADER_DELIVERY) != "42" {
+			t.Errorf("Expected delivery 42, got %q", r.Header.Get(HEADER_DELIVERY))
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhookHTTP(log.NewLogger(), &HTTPConfig{AllowPrivate: true})
+	if code, err := webhook.Send(context.Background(), server.URL, secret, 42, payload); err != nil || code != http.StatusOK {
+		t.Fatalf("Send = %d, %v", code, err)
+	}
+
+	status = http.StatusInternalServerError
+	if code, err := webhook.Send(context.Background(), server.URL, secret, 42, payload); err == nil || code != http.StatusInternalServerError {
+		t.Fatalf("Send should fail on status 500, got %d, %v", code, err)
+	}
+}
+
+func TestWebhookHTTPRejectsPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Webhook reached the loopback server")
+	}))
+	defer server.Close()
+
+	webhook := NewWebhookHTTP(log.NewLogger(), nil)
+	if _, err := webhook.Send(context.Background(), server.URL, "secret", 42, []byte("{}")); !errors.Is(err, ErrPrivateAddress) {
+		t.Fatalf("Send to %s = %v, want ErrPrivateAddress", server.URL, err)
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	for _, host := range []string{"127.0.0.1", "10.1.2.3", "192.168.0.1", "169.254.169.254", "::1", "fe80::1", "localhost"} {
+		if err := CheckHost(context.Background(), host); !errors.Is(err, ErrPrivateAddress) {
+			t.Errorf("CheckHost(%q) = %v, want ErrPrivateAddress", host, err)
+		}
+	}
+	if err := CheckHost(context.Background(), "8.8.8.8"); err != nil {
+		t.Errorf("CheckHost(8.8.8.8) = %v, want nil", err)
+	}
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac secret
+	want := "sha256=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163"
+	if got := Sign("secret", 1700000000, []byte("{}")); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+}
@@ -100,11 +100,15 @@ type Event struct {
 	} `json:"attrs"`
 }
 
+// Account is the holding of a token by an address
 type Account struct {
-	Address   string    `json:"address"`    // Address of the account
-	Token     string    `json:"token"`      // Token associated with the account
-	Amount    float64   `json:"amount"`     // Amount of the token in the account
-	CreatedAt time.Time `json:"created_at"` // Creation time of the account
+	Address          string    `json:"address"`            // Address of the account
+	Token            string    `json:"token"`              // Token associated with the account
+	Amount           float64   `json:"amount"`             // Amount of the token in the account
+	FirstSeenHeight  int       `json:"first_seen_height"`  // Height of the block of the first balance change
+	LastActiveHeight int       `json:"last_active_height"` // Height of the block of the latest balance change
+	TxCount          int       `json:"tx_count"`           // Number of transactions which changed the balance
+	CreatedAt        time.Time `json:"created_at"`         // Creation time of the account
 }
 
 type TokenBalance struct {
@@ -112,12 +116,267 @@ type TokenBalance struct {
 	Amount float64 `json:"amount"` // Amount of the token in the balance
 }
 
+type BalanceChange struct {
+	Address     string `json:"address"`      // Address of the account
+	Token       string `json:"token"`        // Token of the balance
+	BlockHeight int    `json:"block_height"` // Height of the block in which the balance changed
+	Delta       int64  `json:"delta"`        // Signed change of the balance
+	Reason      string `json:"reason"`       // Reason of the change (fee, transfer, mint, burn, genesis, ...)
+	Hash        string `json:"hash"`         // Hash of the transaction
+	EventIndex  int    `json:"event_index"`  // Index of the event, negative for fee (-1) and message (-2 - index) changes
+}
+
+type BalanceDiscrepancy struct {
+	Address              string  `json:"address"`                // Address of the account
+	Token                string  `json:"token"`                  // Token of the balance
+	Recorded             float64 `json:"recorded"`               // Balance stored in the holdings table
+	Expected             float64 `json:"expected"`               // Balance recomputed from the transfers
+	FirstDivergingHeight int     `json:"first_diverging_height"` // First height at which the history diverges, -1 if unknown
+}
+
 type Transfer struct {
+	ID          int       `json:"id"`           // ID of the transfer, increasing in indexing order
 	Func        string    `json:"func"`         // Function name of the transfer
 	FromAddress string    `json:"from_address"` // Address of the sender
 	ToAddress   string    `json:"to_address"`   // Address of the receiver
 	Token       string    `json:"token"`        // Token associated with the transfer
 	Amount      float64   `json:"amount"`       // Amount transferred
 	Denom       string    `json:"denom"`        // Denomination of the transferred amount
+	Hash        string    `json:"hash"`         // Hash of the transaction
+	BlockHeight int       `json:"block_height"` // Height of the block containing the transfer
+	TxIndex     int       `json:"tx_index"`     // Index of the transaction in the block
+	MsgIndex    int       `json:"msg_index"`    // Index of the message in the transaction, -1 if unknown
+	EventIndex  int       `json:"event_index"`  // Index of the event, negative for fee (-1) and message (-2 - index) transfers
+	BlockTime   time.Time `json:"block_time"`   // Timestamp of the block containing the transfer
 	CreatedAt   time.Time `json:"created_at"`   // Creation time of the transfer
 }
+
+type Token struct {
+	Path            string     `json:"path"`              // Package path of the token, or the denom of a native coin
+	Name            string     `json:"name"`              // Name of the token
+	Symbol          string     `json:"symbol"`            // Symbol of the token
+	Decimals        int        `json:"decimals"`          // Number of decimals of the token amounts
+	Creator         string     `json:"creator"`           // Address of the token creator
+	FirstSeenHeight int        `json:"first_seen_height"` // Height of the block in which the token was first seen
+	Stats           *TokenStat `json:"stats"`             // Supply and holder statistics, nil if not loaded
+}
+
+// TokenStat holds the supply and holder statistics of a token
+type TokenStat struct {
+	Token         string `json:"token"`          // Package path of the token, or the denom of a native coin
+	Minted        int64  `json:"minted"`         // Total amount minted, including genesis balances
+	Burned        int64  `json:"burned"`         // Total amount burned, including gas fees
+	Supply        int64  `json:"supply"`         // Current supply, minted minus burned
+	Holders       int    `json:"holders"`        // Number of accounts with a positive balance
+	TransferCount int    `json:"transfer_count"` // Number of transfers between accounts
+	LastHeight    int    `json:"last_height"`    // Height of the latest block which changed the statistics
+}
+
+// TokenHolder is an account with a positive balance of a token, ranked among its holders
+type TokenHolder struct {
+	Account
+	Rank int `json:"rank"` // Rank of the holder by decreasing balance, from 1, ties ranked by address
+}
+
+// TokenDistribution summarizes how the balances of a token are spread across its holders
+type TokenDistribution struct {
+	Token        string         `json:"token"`         // Package path of the token, or the denom of a native coin
+	Holders      int            `json:"holders"`       // Number of accounts with a positive balance
+	Supply       int64          `json:"supply"`        // Current supply, minted minus burned, as in the token statistics
+	Gini         float64        `json:"gini"`          // Gini coefficient of the balances, 0 when they are equal, near 1 when one holder has all
+	Top10Amount  int64          `json:"top10_amount"`  // Sum of the balances of the 10 largest holders
+	Top100Amount int64          `json:"top100_amount"` // Sum of the balances of the 100 largest holders
+	Buckets      []HolderBucket `json:"buckets"`       // Holders by order of magnitude of their balance, increasing
+}
+
+// HolderBucket counts the holders whose balance is in [Min, Max)
+type HolderBucket struct {
+	Min     int64 `json:"min"`     // Smallest balance of the bucket, a power of 10
+	Max     int64 `json:"max"`     // Balance above the bucket, 10 times Min, 0 if it overflows
+	Holders int   `json:"holders"` // Number of holders in the bucket
+	Amount  int64 `json:"amount"`  // Sum of the balances of the holders in the bucket
+}
+
+type Nft struct {
+	Collection   string `json:"collection"`    // Package path of the GRC721 collection
+	TokenID      string `json:"token_id"`      // Token ID within the collection
+	Owner        string `json:"owner"`         // Address of the current owner, empty once burned
+	Burned       bool   `json:"burned"`        // Whether the token has been burned
+	MintedHeight int    `json:"minted_height"` // Height of the block in which the token was minted
+	LastHeight   int    `json:"last_height"`   // Height of the block of the last ownership change
+}
+
+type NftTransfer struct {
+	Hash        string    `json:"hash"`         // Hash of the transaction
+	Func        string    `json:"func"`         // Kind of the transfer (mint, burn or transfer)
+	Collection  string    `json:"collection"`   // Package path of the GRC721 collection
+	TokenID     string    `json:"token_id"`     // Token ID within the collection
+	FromAddress string    `json:"from_address"` // Address of the previous owner
+	ToAddress   string    `json:"to_address"`   // Address of the new owner
+	BlockHeight int       `json:"block_height"` // Height of the block containing the transfer
+	CreatedAt   time.Time `json:"created_at"`   // Creation time of the transfer
+}
+
+type Package struct {
+	Path        string        `json:"path"`         // Path of the package
+	Name        string        `json:"name"`         // Name of the package
+	Namespace   string        `json:"namespace"`    // Namespace of the package path
+	Creator     string        `json:"creator"`      // Address of the package creator
+	Deposit     string        `json:"deposit"`      // Deposit paid for the deployment
+	BlockHeight int           `json:"block_height"` // Height of the block in which the package was deployed
+	Hash        string        `json:"hash"`         // Hash of the deploying transaction
+	Files       []PackageFile `json:"files"`        // Source files of the package
+	CreatedAt   time.Time     `json:"created_at"`   // Creation time of the package
+}
+
+type PackageFile struct {
+	Name string `json:"name"` // Name of the file
+	Body string `json:"body"` // Source of the file as deployed
+}
+
+type RealmCall struct {
+	Hash        string    `json:"hash"`         // Hash of the transaction
+	MsgIndex    int       `json:"msg_index"`    // Index of the message in the transaction
+	BlockHeight int       `json:"block_height"` // Height of the block containing the call
+	BlockTime   time.Time `json:"block_time"`   // Timestamp of the block containing the call
+	PkgPath     string    `json:"pkg_path"`     // Package path of the called realm
+	Func        string    `json:"func"`         // Name of the called function
+	Caller      string    `json:"caller"`       // Address of the caller
+	Args        []string  `json:"args"`         // Arguments of the call
+	Send        string    `json:"send"`         // Coins sent with the call
+	Success     bool      `json:"success"`      // Whether the transaction was successful
+}
+
+// WatchRule selects the transfers a client is notified of by webhook, empty fields match any transfer
+type WatchRule struct {
+	ID        int       `json:"id"`         // ID of the rule
+	URL       string    `json:"url"`        // URL the webhooks are posted to
+	Secret    string    `json:"-"`          // Secret signing the webhooks
+	OwnerHash string    `json:"-"`          // SHA-256 of the token of the owner managing the rule, hex encoded
+	Address   string    `json:"address"`    // Address sending or receiving the transfers
+	Token     string    `json:"token"`      // Token of the transfers
+	MinAmount float64   `json:"min_amount"` // Smallest amount of the transfers
+	EventType string    `json:"event_type"` // Function of the transfers, e.g. transfer, mint or burn
+	Active    bool      `json:"active"`     // Whether transfers are matched against the rule
+	CreatedAt time.Time `json:"created_at"` // Creation time of the rule
+}
+
+// WebhookDelivery is the webhook of a transfer matching a watch rule, with its delivery state
+type WebhookDelivery struct {
+	ID             int        `json:"id"`              // ID of the delivery
+	RuleID         int        `json:"rule_id"`         // Watch rule the transfer matched
+	Rule           *WatchRule `json:"-"`               // Watch rule, loaded for delivery
+	Hash           string     `json:"hash"`            // Hash of the transaction of the transfer
+	EventIndex     int        `json:"event_index"`     // Event index of the transfer
+	Token          string     `json:"token"`           // Token of the transfer
+	BlockHeight    int        `json:"block_height"`    // Height of the block containing the transfer
+	Payload        string     `json:"payload"`         // JSON body of the webhook
+	Status         string     `json:"status"`          // pending, delivered or failed
+	Attempts       int        `json:"attempts"`        // Number of delivery attempts
+	NextAttemptAt  time.Time  `json:"next_attempt_at"` // Time of the next delivery attempt
+	LastAttemptAt  *time.Time `json:"last_attempt_at"` // Time of the last delivery attempt, nil before the first
+	ResponseStatus int        `json:"response_status"` // HTTP status of the last attempt
+	LastError      string     `json:"last_error"`      // Error of the last attempt
+	CreatedAt      time.Time  `json:"created_at"`      // Creation time of the delivery
+}
+
+// AddressTransaction is a transaction an address took part in, with the roles it had
+type AddressTransaction struct {
+	Address     string    `json:"address"`      // Address taking part in the transaction
+	Hash        string    `json:"hash"`         // Hash of the transaction
+	Roles       []string  `json:"roles"`        // Roles of the address, e.g. signer, sender or receiver
+	BlockHeight int       `json:"block_height"` // Height of the block containing the transaction
+	TxIndex     int       `json:"tx_index"`     // Index of the transaction in the block
+	BlockTime   time.Time `json:"block_time"`   // Timestamp of the block containing the transaction
+	Success     bool      `json:"success"`      // Whether the transaction was successful
+}
+
+// GnoEvent is an event emitted by a transaction, at its position in the chain
+type GnoEvent struct {
+	Hash        string      `json:"hash"`         // Hash of the transaction
+	EventIndex  int         `json:"event_index"`  // Index of the event in the transaction
+	BlockHeight int         `json:"block_height"` // Height of the block containing the event
+	TxIndex     int         `json:"tx_index"`     // Index of the transaction in the block
+	BlockTime   time.Time   `json:"block_time"`   // Timestamp of the block containing the event
+	Type        string      `json:"type"`         // Type of the event
+	Func        string      `json:"func"`         // Function emitting the event
+	PkgPath     string      `json:"pkg_path"`     // Package path of the realm emitting the event
+	Attrs       []EventAttr `json:"attrs"`        // Attributes of the event
+	Decoded     *bool       `json:"decoded"`      // Whether a decoder handled the event, nil if unknown
+}
+
+// UndecodedEvents counts the events of a package and type no decoder handled
+type UndecodedEvents struct {
+	PkgPath    string `json:"pkg_path"`    // Package path of the realm emitting the events
+	Type       string `json:"type"`        // Type of the events
+	Count      int64  `json:"count"`       // Number of undecoded events
+	LastHeight int    `json:"last_height"` // Height of the block of the last undecoded event
+}
+
+type EventAttr struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// GnoEventFilter selects events, empty fields are not filtered on
+type GnoEventFilter struct {
+	PkgPath    string            // Package path of the realm emitting the event
+	Type       string            // Type of the event
+	Func       string            // Function emitting the event
+	Attrs      map[string]string // Attributes the event must have, by key
+	FromHeight int               // Only events in blocks at or above this height
+	ToHeight   int               // Only events in blocks at or below this height, 0 for no bound
+}
+
+// TransferFilter selects transfers, empty fields are not filtered on
+type TransferFilter struct {
+	Address    string    // Address sending or receiving the transfers, see Direction
+	Direction  string    // Side of Address: in, out or both (the default)
+	Token      string    // Token transferred
+	Func       string    // Function of the transfer, e.g. transfer, mint or burn
+	FromHeight int       // Only transfers in blocks at or above this height
+	ToHeight   int       // Only transfers in blocks at or below this height, 0 for no bound
+	From       time.Time // Only transfers in blocks at or after this time
+	To         time.Time // Only transfers in blocks before this time
+	MinAmount  float64   // Only transfers of at least this amount, 0 for no bound
+	MaxAmount  float64   // Only transfers of at most this amount, 0 for no bound
+	Ascending  bool      // Oldest transfers first instead of latest first
+}
+
+// TransferCursor is the position of the last transfer of a page, the next page
+// starting after it in the sort order
+type TransferCursor struct {
+	BlockHeight int
+	ID          int
+}
+
+// TransactionFilter selects transactions, empty fields are not filtered on
+type TransactionFilter struct {
+	Success     *bool  // Only successful or failed transactions
+	MessageType string // Type URL of a message of the transaction, e.g. exec or send
+	FromHeight  int    // Only transactions in blocks at or above this height
+	ToHeight    int    // Only transactions in blocks at or below this height, 0 for no bound
+}
+
+// BalanceFilter selects balances, empty fields are not filtered on
+type BalanceFilter struct {
+	Addresses []string // Addresses holding the balances
+	Token     string   // Token of the balances
+	Height    int      // Balances at the end of this block, the current ones if 0
+}
+
+// RealmCallFilter selects realm calls, empty fields are not filtered on
+type RealmCallFilter struct {
+	PkgPath string    // Package path of the called realm
+	Func    string    // Name of the called function
+	Caller  string    // Address of the caller
+	From    time.Time // Only calls in blocks at or after this time
+	To      time.Time // Only calls in blocks before this time
+}
+
+// SearchResult is a resource matching a search query
+type SearchResult struct {
+	Type  string // Type of the resource: block, transaction, address, package or token
+	ID    string // Height of a block, hash of a transaction, address, or path of a package or token
+	Label string // Short description of the resource
+}
@@ -2,34 +2,115 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"gno.land-block-indexer/model"
 )
 
 type Repository interface {
+	// WithTx runs fn with a repository whose operations share one database transaction
+	WithTx(ctx context.Context, fn func(repo Repository) error) error
+
 	// block operations
 	AddBlock(ctx context.Context, block *model.Block) (bool, error)
 	AddBlocks(ctx context.Context, blocks []*model.Block) error
 	GetBlock(ctx context.Context, blockNum int) (*model.Block, error)
+	GetBlockByHash(ctx context.Context, hash string) (*model.Block, error)
 	GetBlocks(ctx context.Context, offset int, limit int) ([]model.Block, error)
+	GetBlocksInRange(ctx context.Context, fromHeight int, toHeight int) ([]model.Block, error)
+	GetBlocksByHeights(ctx context.Context, heights []int) ([]model.Block, error)
+	GetHighestBlock(ctx context.Context) (*model.Block, error)
 
 	// transaction operations
 	AddTransaction(ctx context.Context, blockNum int, tx *model.Transaction) error
 	AddTransactions(ctx context.Context, blockNum int, txs []model.Transaction) error
 	GetTransaction(ctx context.Context, txHash string) (*model.Transaction, error)
 	GetTransactions(ctx context.Context, blockNum int, offset int, limit int) ([]model.Transaction, error)
+	GetTransactionsInRange(ctx context.Context, fromHeight int, toHeight int) ([]model.Transaction, error)
+	GetTransactionsByFilter(ctx context.Context, filter model.TransactionFilter, offset int, limit int) ([]model.Transaction, error)
+	GetTransactionsByHashes(ctx context.Context, hashes []string) ([]model.Transaction, error)
+	GetTransactionsByHeights(ctx context.Context, heights []int) ([]model.Transaction, error)
 
 	// account operations
 	AddAccount(ctx context.Context, account *model.Account) error
 	GetAccount(ctx context.Context, address string, token string) (*model.Account, error)
 	GetAccounts(ctx context.Context, address string, token string) ([]model.Account, error)
-	IncrementAccountBalance(ctx context.Context, address string, token string, amount int64) error
+	IncrementAccountBalance(ctx context.Context, address string, token string, amount int64, height int, txHash string) error
 
 	// token balances
 	GetBalances(ctx context.Context, address string) ([]model.TokenBalance, error)
+	AddBalanceChanges(ctx context.Context, changes []model.BalanceChange) error
+	CreateBalanceCheckpoints(ctx context.Context, height int, previousHeight int) error
+	GetBalancesAtHeight(ctx context.Context, address string, height int) ([]model.TokenBalance, error)
+	GetTokenHolders(ctx context.Context, token string, minBalance float64, offset int, limit int) ([]model.TokenHolder, error)
+	GetTokenDistribution(ctx context.Context, token string) (*model.TokenDistribution, error)
+	GetBalanceDiscrepancies(ctx context.Context) ([]model.BalanceDiscrepancy, error)
+	GetFirstDivergingHeight(ctx context.Context, address string, token string) (int, error)
+	RepairBalances(ctx context.Context, discrepancies []model.BalanceDiscrepancy) error
 
 	// transfer operations
 	AddTransfer(ctx context.Context, tx *model.Transaction, transfer *model.Transfer) error
 	AddTransfers(ctx context.Context, tx *model.Transaction, transfers []model.Transfer) error
-	GetTransfers(ctx context.Context, fromAccount, toAccount, token string) ([]model.Transfer, error)
+	GetTransfers(ctx context.Context, filter model.TransferFilter, after *model.TransferCursor, limit int) ([]model.Transfer, error)
+	GetTransfersByHashes(ctx context.Context, hashes []string) ([]model.Transfer, error)
+
+	// token operations
+	AddToken(ctx context.Context, token *model.Token) error
+	GetToken(ctx context.Context, path string) (*model.Token, error)
+	GetTokens(ctx context.Context, sort string, offset int, limit int) ([]model.Token, error)
+	GetTokensByPaths(ctx context.Context, paths []string) ([]model.Token, error)
+	GetTokensBySymbol(ctx context.Context, symbol string) ([]model.Token, error)
+	ApplyTokenStats(ctx context.Context, height int, deltas []model.TokenStat) error
+
+	// nft operations
+	UpdateNftOwner(ctx context.Context, nft *model.Nft) error
+	AddNftTransfers(ctx context.Context, tx *model.Transaction, transfers []model.NftTransfer) error
+	GetNfts(ctx context.Context, owner string, collection string) ([]model.Nft, error)
+	GetNftTransfers(ctx context.Context, collection string, tokenID string) ([]model.NftTransfer, error)
+
+	// package operations
+	AddPackage(ctx context.Context, pkg *model.Package) error
+	GetPackage(ctx context.Context, path string) (*model.Package, error)
+	GetPackages(ctx context.Context, creator string, namespace string, offset int, limit int) ([]model.Package, error)
+	GetPackagesByPrefix(ctx context.Context, prefix string, limit int) ([]model.Package, error)
+	GetPackageFile(ctx context.Context, path string, name string) (*model.PackageFile, error)
+
+	// realm call operations
+	AddRealmCalls(ctx context.Context, calls []model.RealmCall) error
+	GetRealmCalls(ctx context.Context, filter model.RealmCallFilter, offset int, limit int) ([]model.RealmCall, error)
+
+	// address activity operations
+	AddAddressTransactions(ctx context.Context, txs []model.AddressTransaction) error
+	GetAddressTransactions(ctx context.Context, address string, roles []string, offset int, limit int) ([]model.AddressTransaction, error)
+
+	// event operations
+	AddGnoEvents(ctx context.Context, events []model.GnoEvent) error
+	GetGnoEvents(ctx context.Context, filter model.GnoEventFilter, offset int, limit int) ([]model.GnoEvent, error)
+	GetUndecodedEvents(ctx context.Context, fromHeight int, toHeight int, offset int, limit int) ([]model.UndecodedEvents, error)
+
+	// webhook operations
+	AddWatchRule(ctx context.Context, rule *model.WatchRule) (*model.WatchRule, error)
+	GetWatchRule(ctx context.Context, id int) (*model.WatchRule, error)
+	GetWatchRules(ctx context.Context, activeOnly bool) ([]model.WatchRule, error)
+	GetWatchRulesByOwner(ctx context.Context, ownerHash string) ([]model.WatchRule, error)
+	DeleteWatchRule(ctx context.Context, id int) (bool, error)
+	AddWebhookDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, id int) (*model.WebhookDelivery, error)
+	GetWebhookDeliveries(ctx context.Context, ruleID int, status string, offset int, limit int) ([]model.WebhookDelivery, error)
+	GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]model.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	ReplayWebhookDelivery(ctx context.Context, id int) (bool, error)
+
+	// export operations, streaming the rows to fn one at a time
+	ExportSnapshot(ctx context.Context, fn func(repo Repository, height int) error) error
+	ExportTransfers(ctx context.Context, filter model.TransferFilter, fn func(transfer model.Transfer) error) error
+	ExportTransactions(ctx context.Context, filter model.TransactionFilter, fn func(tx model.Transaction) error) error
+	ExportBalances(ctx context.Context, filter model.BalanceFilter, fn func(account model.Account) error) error
+	ExportHolders(ctx context.Context, token string, fn func(account model.Account) error) error
+
+	// rebuild operations
+	CreateRebuildSchema(ctx context.Context) error
+	GetRebuildProgress(ctx context.Context) (int, error)
+	SetRebuildProgress(ctx context.Context, height int) error
+	SwapRebuiltTables(ctx context.Context) ([]int, error)
 }
@@ -2,13 +2,19 @@ package repository
 
 import (
 	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"gno.land-block-indexer/ent"
-	"gno.land-block-indexer/ent/account"
 	"gno.land-block-indexer/ent/block"
+	"gno.land-block-indexer/ent/holding"
+	"gno.land-block-indexer/ent/migrate"
+	"gno.land-block-indexer/ent/predicate"
 	"gno.land-block-indexer/ent/schema"
 	"gno.land-block-indexer/ent/transaction"
 	"gno.land-block-indexer/ent/transfer"
@@ -18,12 +24,16 @@ import (
 	"gno.land-block-indexer/lib/log"
 )
 
+// addTransactionsChunkSize bounds the rows of a single bulk insert of transactions
+const addTransactionsChunkSize = 1000
+
 type RepositoryEntConfig struct {
-	Host     string
-	Port     int
-	User     string
-	Password string
-	Database string
+	Host       string
+	Port       int
+	User       string
+	Password   string
+	Database   string
+	SearchPath string // Schema to work in, the default search_path of the database if empty
 }
 
 func NewRepositoryEnt(logger log.Logger, config *RepositoryEntConfig) Repository {
@@ -32,27 +42,128 @@ func NewRepositoryEnt(logger log.Logger, config *RepositoryEntConfig) Repository
 		" user="+config.User+
 		" password="+config.Password+
 		" dbname="+config.Database+
-		" sslmode=disable")
+		" sslmode=disable"+
+		searchPathOption(config.SearchPath))
 	if err != nil {
 		panic("failed to connect to database: " + err.Error())
 	}
 
+	repo := &RepositoryEnt{
+		logger: logger,
+		client: client,
+	}
+
+	// transfers used to have no position, number them before their unique index is created
+	legacyTransfers, err := repo.prepareLegacyTransfersMigration(context.Background())
+	if err != nil {
+		logger.Fatalf("failed preparing transfers migration: %v", err)
+	}
+
+	// events were only kept in the transaction responses, index them once the table exists
+	backfillEvents, err := repo.tableMissing(context.Background(), "gno_events")
+	if err != nil {
+		logger.Fatalf("failed inspecting events: %v", err)
+	}
+	// token statistics are computed from the transfers and holdings once the table exists
+	backfillTokenStats, err := repo.tableMissing(context.Background(), "token_stats")
+	if err != nil {
+		logger.Fatalf("failed inspecting token statistics: %v", err)
+	}
+	// addresses of the indexed transactions are collected once the table exists
+	backfillAddressTransactions, err := repo.tableMissing(context.Background(), "address_transactions")
+	if err != nil {
+		logger.Fatalf("failed inspecting address transactions: %v", err)
+	}
+
+	// accounts used to hold one row per (address, token), move it to holdings first
+	legacy, err := repo.prepareLegacyAccountsMigration(context.Background())
+	if err != nil {
+		logger.Fatalf("failed preparing accounts migration: %v", err)
+	}
+	if legacy {
+		// Transfers reference addresses which only exist once the accounts are migrated
+		if err := client.Schema.Create(context.Background(), migrate.WithForeignKeys(false)); err != nil {
+			logger.Fatalf("failed creating schema resources: %v", err)
+		}
+		if err := repo.migrateLegacyAccounts(context.Background()); err != nil {
+			logger.Fatalf("failed migrating accounts: %v", err)
+		}
+	}
+
 	// create the schema if it doesn't exist
 	if err := client.Schema.Create(context.Background()); err != nil {
 		logger.Fatalf("failed creating schema resources: %v", err)
 	}
+	if legacyTransfers {
+		if err := repo.migrateLegacyTransfers(context.Background()); err != nil {
+			logger.Fatalf("failed migrating transfers: %v", err)
+		}
+	}
+	if backfillEvents {
+		if err := repo.backfillGnoEvents(context.Background()); err != nil {
+			logger.Fatalf("failed backfilling events: %v", err)
+		}
+	}
+	if backfillTokenStats {
+		if err := repo.backfillTokenStats(context.Background()); err != nil {
+			logger.Fatalf("failed backfilling token statistics: %v", err)
+		}
+	}
+	if backfillAddressTransactions {
+		if err := repo.backfillAddressTransactions(context.Background()); err != nil {
+			logger.Fatalf("failed backfilling address transactions: %v", err)
+		}
+	}
 
 	// client = client.Debug() // Enable debug mode for development
 
-	return &RepositoryEnt{
-		logger: logger,
-		client: client,
-	}
+	return repo
 }
 
 type RepositoryEnt struct {
 	logger log.Logger
 	client *ent.Client
+	inTx   bool // Whether client is bound to a database transaction
+
+	exportHeight int // Height bounding the exports of a snapshot behind the latest block, 0 otherwise
+}
+
+func searchPathOption(searchPath string) string {
+	if searchPath == "" {
+		return ""
+	}
+	return " search_path=" + searchPath
+}
+
+// WithTx implements Repository.
+func (r *RepositoryEnt) WithTx(ctx context.Context, fn func(repo Repository) error) error {
+	return r.withTx(ctx, func(client *ent.Client) error {
+		return fn(&RepositoryEnt{logger: r.logger, client: client, inTx: true})
+	})
+}
+
+// withTx runs fn in a database transaction, or in the current one when the
+// repository is already bound to a transaction
+func (r *RepositoryEnt) withTx(ctx context.Context, fn func(client *ent.Client) error) error {
+	if r.inTx {
+		return fn(r.client)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return r.logger.Errorf("failed to start transaction: %v", err)
+	}
+	if err := fn(tx.Client()); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.logger.Errorf("failed to roll back transaction: %v", rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return r.logger.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
 }
 
 // GetHighestBlock implements Repository.
@@ -73,27 +184,25 @@ func (r *RepositoryEnt) GetHighestBlock(ctx context.Context) (*model.Block, erro
 }
 
 // AddBlock implements Repository.
+//
+// An existing block is detected by the insert itself rather than by a failed one,
+// as a failed statement would abort the transaction the block may be stored in.
 func (r *RepositoryEnt) AddBlock(ctx context.Context, block *model.Block) (bool, error) {
-	// Convert model.Block to ent.Block
-	// Use the ent client to create the block in the database
-	_, err := r.client.Block.Create().
-		SetHash(block.Hash).
-		SetID(block.Height). // Use Height as ID for uniqueness")
-		// SetHeight(block.Height).
-		SetTime(block.Time).
-		SetTotalTxs(block.TotalTxs).
-		SetNumTxs(block.NumTxs).
-		SetCreatedAt(time.Now()).
-		Save(ctx)
-
-	if ent.IsConstraintError(err) {
-		// If the block already exists, we can ignore the error
-		return true, nil
-	} else if err != nil {
+	result, err := r.client.ExecContext(ctx, `
+		INSERT INTO blocks (height, hash, time, total_txs, num_txs, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (height) DO NOTHING`,
+		block.Height, block.Hash, block.Time, block.TotalTxs, block.NumTxs, time.Now())
+	if err != nil {
+		return false, r.logger.Errorf("failed to add block: %v", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
 		return false, r.logger.Errorf("failed to add block: %v", err)
 	}
 
-	return false, nil
+	// If the block already exists, nothing was inserted
+	return inserted == 0, nil
 }
 
 // AddBlocks implements Repository.
@@ -146,32 +255,32 @@ func (r *RepositoryEnt) AddTransaction(ctx context.Context, blockNum int, tx *mo
 
 // AddTransactions implements Repository.
 func (r *RepositoryEnt) AddTransactions(ctx context.Context, blockNum int, txs []model.Transaction) error {
-	if len(txs) == 0 {
-		return nil
-	}
-
-	bulk := make([]*ent.TransactionCreate, len(txs))
-	for i, tx := range txs {
-		bulk[i] = r.client.Transaction.Create().
-			SetIndex(tx.Index).
-			SetHash(tx.Hash).
-			SetSuccess(tx.Success).
-			SetBlockHeight(tx.BlockHeight).
-			SetGasWanted(tx.GasWanted).
-			SetGasUsed(tx.GasUsed).
-			SetMemo(tx.Memo).
-			SetGasFee(schema.GasFee(tx.GasFee)).
-			SetMessages(convertMessagesToSchema(tx.Messages)).
-			SetResponse(convertResponseToSchema(tx.Response)).
-			SetBlockID(blockNum).
-			SetCreatedAt(time.Now())
-	}
+	// Insert in chunks to stay below the bind parameter limit for large blocks (e.g. genesis)
+	for start := 0; start < len(txs); start += addTransactionsChunkSize {
+		end := min(start+addTransactionsChunkSize, len(txs))
+
+		bulk := make([]*ent.TransactionCreate, 0, end-start)
+		for _, tx := range txs[start:end] {
+			bulk = append(bulk, r.client.Transaction.Create().
+				SetIndex(tx.Index).
+				SetHash(tx.Hash).
+				SetSuccess(tx.Success).
+				SetBlockHeight(tx.BlockHeight).
+				SetGasWanted(tx.GasWanted).
+				SetGasUsed(tx.GasUsed).
+				SetMemo(tx.Memo).
+				SetGasFee(schema.GasFee(tx.GasFee)).
+				SetMessages(convertMessagesToSchema(tx.Messages)).
+				SetResponse(convertResponseToSchema(tx.Response)).
+				SetBlockID(blockNum).
+				SetCreatedAt(time.Now()))
+		}
 
-	_, err := r.client.Transaction.CreateBulk(bulk...).Save(ctx)
-	if ent.IsConstraintError(err) {
-		// If the transactions already exist, we can ignore the error
-	} else if err != nil {
-		return r.logger.Errorf("failed to add transactions: %v", err)
+		// Transactions are only added with their newly stored block, see AddBlock
+		_, err := r.client.Transaction.CreateBulk(bulk...).Save(ctx)
+		if err != nil {
+			return r.logger.Errorf("failed to add transactions: %v", err)
+		}
 	}
 
 	return nil
@@ -183,6 +292,9 @@ func (r *RepositoryEnt) GetBlock(ctx context.Context, blockNum int) (*model.Bloc
 		Where(block.IDEQ(blockNum)).
 		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
 		return nil, r.logger.Errorf("failed to get block %d: %v", blockNum, err)
 	}
 
@@ -195,6 +307,27 @@ func (r *RepositoryEnt) GetBlock(ctx context.Context, blockNum int) (*model.Bloc
 	}, nil
 }
 
+// GetBlockByHash implements Repository.
+func (r *RepositoryEnt) GetBlockByHash(ctx context.Context, hash string) (*model.Block, error) {
+	entBlock, err := r.client.Block.Query().
+		Where(block.HashEQ(hash)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.logger.Errorf("failed to get block %s: %v", hash, err)
+	}
+
+	return &model.Block{
+		Hash:     entBlock.Hash,
+		Height:   entBlock.ID,
+		Time:     entBlock.Time,
+		TotalTxs: entBlock.TotalTxs,
+		NumTxs:   entBlock.NumTxs,
+	}, nil
+}
+
 // GetBlocks implements Repository.
 func (r *RepositoryEnt) GetBlocks(ctx context.Context, offset int, limit int) ([]model.Block, error) {
 	entBlocks, err := r.client.Block.Query().
@@ -220,27 +353,71 @@ func (r *RepositoryEnt) GetBlocks(ctx context.Context, offset int, limit int) ([
 	return blocks, nil
 }
 
+// GetBlocksInRange implements Repository.
+func (r *RepositoryEnt) GetBlocksInRange(ctx context.Context, fromHeight int, toHeight int) ([]model.Block, error) {
+	entBlocks, err := r.client.Block.Query().
+		Where(
+			block.IDGTE(fromHeight),
+			block.IDLTE(toHeight),
+		).
+		Order(ent.Asc("height")).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get blocks %d to %d: %v", fromHeight, toHeight, err)
+	}
+
+	blocks := make([]model.Block, len(entBlocks))
+	for i, entBlock := range entBlocks {
+		blocks[i] = model.Block{
+			Hash:     entBlock.Hash,
+			Height:   entBlock.ID,
+			Time:     entBlock.Time,
+			TotalTxs: entBlock.TotalTxs,
+			NumTxs:   entBlock.NumTxs,
+		}
+	}
+
+	return blocks, nil
+}
+
+// GetBlocksByHeights implements Repository.
+func (r *RepositoryEnt) GetBlocksByHeights(ctx context.Context, heights []int) ([]model.Block, error) {
+	entBlocks, err := r.client.Block.Query().
+		Where(block.IDIn(heights...)).
+		Order(ent.Asc("height")).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get blocks %v: %v", heights, err)
+	}
+
+	blocks := make([]model.Block, len(entBlocks))
+	for i, entBlock := range entBlocks {
+		blocks[i] = model.Block{
+			Hash:     entBlock.Hash,
+			Height:   entBlock.ID,
+			Time:     entBlock.Time,
+			TotalTxs: entBlock.TotalTxs,
+			NumTxs:   entBlock.NumTxs,
+		}
+	}
+
+	return blocks, nil
+}
+
 // GetTransaction implements Repository.
 func (r *RepositoryEnt) GetTransaction(ctx context.Context, txHash string) (*model.Transaction, error) {
 	entTx, err := r.client.Transaction.Query().
 		Where(transaction.HashEQ(txHash)).
-		Only(ctx)
+		First(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
 		return nil, r.logger.Errorf("failed to get transaction %s: %v", txHash, err)
 	}
 
-	return &model.Transaction{
-		Index:       entTx.Index,
-		Hash:        entTx.Hash,
-		Success:     entTx.Success,
-		BlockHeight: entTx.BlockHeight,
-		GasWanted:   entTx.GasWanted,
-		GasUsed:     entTx.GasUsed,
-		Memo:        entTx.Memo,
-		GasFee:      model.GasFee(entTx.GasFee),
-		Messages:    convertSchemaMessagesToModel(entTx.Messages),
-		Response:    convertSchemaResponseToModel(entTx.Response),
-	}, nil
+	tx := convertTransactionToModel(entTx)
+	return &tx, nil
 }
 
 // GetTransactions implements Repository.
@@ -257,32 +434,152 @@ func (r *RepositoryEnt) GetTransactions(ctx context.Context, blockNum int, offse
 
 	txs := make([]model.Transaction, len(entTxs))
 	for i, entTx := range entTxs {
-		txs[i] = model.Transaction{
-			Index:       entTx.Index,
-			Hash:        entTx.Hash,
-			Success:     entTx.Success,
-			BlockHeight: entTx.BlockHeight,
-			GasWanted:   entTx.GasWanted,
-			GasUsed:     entTx.GasUsed,
-			Memo:        entTx.Memo,
-			GasFee:      model.GasFee(entTx.GasFee),
-			Messages:    convertSchemaMessagesToModel(entTx.Messages),
-			Response:    convertSchemaResponseToModel(entTx.Response),
-		}
+		txs[i] = convertTransactionToModel(entTx)
 	}
 
 	return txs, nil
 }
 
+// GetTransactionsInRange implements Repository.
+func (r *RepositoryEnt) GetTransactionsInRange(ctx context.Context, fromHeight int, toHeight int) ([]model.Transaction, error) {
+	entTxs, err := r.client.Transaction.Query().
+		Where(
+			transaction.BlockHeightGTE(fromHeight),
+			transaction.BlockHeightLTE(toHeight),
+		).
+		Order(ent.Asc(transaction.FieldBlockHeight), ent.Asc(transaction.FieldIndex)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get transactions of blocks %d to %d: %v", fromHeight, toHeight, err)
+	}
+
+	txs := make([]model.Transaction, len(entTxs))
+	for i, entTx := range entTxs {
+		txs[i] = convertTransactionToModel(entTx)
+	}
+
+	return txs, nil
+}
+
+// GetTransactionsByFilter implements Repository.
+func (r *RepositoryEnt) GetTransactionsByFilter(ctx context.Context, filter model.TransactionFilter, offset int, limit int) ([]model.Transaction, error) {
+	predicates, err := transactionFilterPredicates(filter)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to filter transactions on message type %s: %v", filter.MessageType, err)
+	}
+
+	entTxs, err := r.client.Transaction.Query().
+		Where(predicates...).
+		Order(ent.Desc(transaction.FieldBlockHeight), ent.Desc(transaction.FieldIndex)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get transactions (message_type=%s, from=%d, to=%d): %v", filter.MessageType, filter.FromHeight, filter.ToHeight, err)
+	}
+
+	txs := make([]model.Transaction, len(entTxs))
+	for i, entTx := range entTxs {
+		txs[i] = convertTransactionToModel(entTx)
+	}
+
+	return txs, nil
+}
+
+// GetTransactionsByHashes implements Repository.
+func (r *RepositoryEnt) GetTransactionsByHashes(ctx context.Context, hashes []string) ([]model.Transaction, error) {
+	entTxs, err := r.client.Transaction.Query().
+		Where(transaction.HashIn(hashes...)).
+		Order(ent.Asc(transaction.FieldBlockHeight), ent.Asc(transaction.FieldIndex)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get %d transactions by hash: %v", len(hashes), err)
+	}
+
+	txs := make([]model.Transaction, len(entTxs))
+	for i, entTx := range entTxs {
+		txs[i] = convertTransactionToModel(entTx)
+	}
+
+	return txs, nil
+}
+
+// GetTransactionsByHeights implements Repository.
+func (r *RepositoryEnt) GetTransactionsByHeights(ctx context.Context, heights []int) ([]model.Transaction, error) {
+	entTxs, err := r.client.Transaction.Query().
+		Where(transaction.BlockHeightIn(heights...)).
+		Order(ent.Asc(transaction.FieldBlockHeight), ent.Asc(transaction.FieldIndex)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get transactions of blocks %v: %v", heights, err)
+	}
+
+	txs := make([]model.Transaction, len(entTxs))
+	for i, entTx := range entTxs {
+		txs[i] = convertTransactionToModel(entTx)
+	}
+
+	return txs, nil
+}
+
+// transactionFilterPredicates returns the predicates selecting the transactions of the filter
+func transactionFilterPredicates(filter model.TransactionFilter) ([]predicate.Transaction, error) {
+	var predicates []predicate.Transaction
+	if filter.Success != nil {
+		predicates = append(predicates, transaction.SuccessEQ(*filter.Success))
+	}
+	if filter.FromHeight > 0 {
+		predicates = append(predicates, transaction.BlockHeightGTE(filter.FromHeight))
+	}
+	if filter.ToHeight > 0 {
+		predicates = append(predicates, transaction.BlockHeightLTE(filter.ToHeight))
+	}
+	if filter.MessageType != "" {
+		contains, err := messagesContain(filter.MessageType)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, contains)
+	}
+	return predicates, nil
+}
+
+// messagesContain matches transactions having a message of the type, using the GIN index on messages
+func messagesContain(typeUrl string) (predicate.Transaction, error) {
+	contained, err := json.Marshal([]map[string]string{{"typeUrl": typeUrl}})
+	if err != nil {
+		return nil, err
+	}
+
+	return predicate.Transaction(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(transaction.FieldMessages)).WriteString(" @> ").Arg(string(contained)).WriteString("::jsonb")
+		}))
+	}), nil
+}
+
 // AddAccount implements Repository.
-func (r *RepositoryEnt) AddAccount(ctx context.Context, account *model.Account) error {
-	_, err := r.client.Account.Create().
-		SetID(account.Address).
-		SetToken(account.Token).
-		SetAmount(account.Amount).
-		Save(ctx)
+//
+// The address and its holding of the token are created if missing, which makes
+// it safe to call for every balance change. The first seen heights only move down
+// since blocks may be processed out of order.
+func (r *RepositoryEnt) AddAccount(ctx context.Context, acc *model.Account) error {
+	_, err := r.client.ExecContext(ctx, `
+		INSERT INTO accounts (address, first_seen_height, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (address) DO UPDATE SET first_seen_height = LEAST(accounts.first_seen_height, excluded.first_seen_height)`,
+		acc.Address, acc.FirstSeenHeight, time.Now())
+	if err != nil {
+		return r.logger.Errorf("failed to add account %s: %v", acc.Address, err)
+	}
+
+	_, err = r.client.ExecContext(ctx, `
+		INSERT INTO holdings (address, token, amount, first_seen_height, last_active_height, tx_count, created_at)
+		VALUES ($1, $2, $3, $4, $4, 0, $5)
+		ON CONFLICT (address, token) DO UPDATE SET first_seen_height = LEAST(holdings.first_seen_height, excluded.first_seen_height)`,
+		acc.Address, acc.Token, acc.Amount, acc.FirstSeenHeight, time.Now())
 	if err != nil {
-		return r.logger.Errorf("failed to add account %s: %v", account.Address, err)
+		return r.logger.Errorf("failed to add holding of %s for account %s: %v", acc.Token, acc.Address, err)
 	}
 
 	return nil
@@ -290,18 +587,12 @@ func (r *RepositoryEnt) AddAccount(ctx context.Context, account *model.Account)
 
 // GetAccount implements Repository.
 func (r *RepositoryEnt) GetAccount(ctx context.Context, address string, token string) (*model.Account, error) {
-	accountQuery := r.client.Account.Query()
-	if address != "" {
-		accountQuery = accountQuery.Where(
-			account.IDEQ(address),
-		)
-	}
-	if token != "" {
-		accountQuery = accountQuery.Where(
-			account.TokenEQ(token),
-		)
-	}
-	entAccount, err := accountQuery.Only(ctx)
+	entHolding, err := r.client.Holding.Query().
+		Where(
+			holding.AddressEQ(address),
+			holding.TokenEQ(token),
+		).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, nil // Account not found
@@ -309,61 +600,45 @@ func (r *RepositoryEnt) GetAccount(ctx context.Context, address string, token st
 		return nil, r.logger.Errorf("failed to get account %s: %v", address, err)
 	}
 
-	return &model.Account{
-		Address: address,
-		Token:   token,
-		Amount:  entAccount.Amount,
-	}, nil
+	acc := convertHoldingToModel(entHolding)
+	return &acc, nil
 }
 
 // GetAccounts implements Repository.
 func (r *RepositoryEnt) GetAccounts(ctx context.Context, address string, token string) ([]model.Account, error) {
-	accountQuery := r.client.Account.Query()
+	holdingQuery := r.client.Holding.Query()
 	if address != "" {
-		accountQuery = accountQuery.Where(account.IDEQ(address))
+		holdingQuery = holdingQuery.Where(holding.AddressEQ(address))
 	}
 	if token != "" {
-		accountQuery = accountQuery.Where(account.TokenEQ(token))
+		holdingQuery = holdingQuery.Where(holding.TokenEQ(token))
 	}
-	accounts, err := accountQuery.All(ctx)
+	entHoldings, err := holdingQuery.All(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, nil // No accounts found
-		}
-		return nil, r.logger.Errorf("failed to get accounts for %s: %v", address, err)
+		return nil, r.logger.Errorf("failed to get accounts %s for %s: %v", address, token, err)
 	}
 
-	accountModels := make([]model.Account, len(accounts))
-	for i, entAccount := range accounts {
-		accountModels[i] = model.Account{
-			Address: entAccount.ID,
-			Token:   entAccount.Token,
-			Amount:  entAccount.Amount,
-		}
+	accounts := make([]model.Account, len(entHoldings))
+	for i, entHolding := range entHoldings {
+		accounts[i] = convertHoldingToModel(entHolding)
 	}
 
-	return accountModels, nil
+	return accounts, nil
 }
 
 // GetBalances implements Repository.
 func (r *RepositoryEnt) GetBalances(ctx context.Context, address string) ([]model.TokenBalance, error) {
 	// group by token
 	var balances []model.TokenBalance
-	var accountQuery *ent.AccountGroupBy
-	if address == "" {
-		accountQuery = r.client.Account.Query().
-			GroupBy(account.FieldToken)
-	} else {
-		accountQuery = r.client.Account.Query().
-			Where(account.IDEQ(address)).
-			GroupBy(account.FieldToken)
-	}
-	err := accountQuery.Aggregate(ent.As(ent.Sum(account.FieldAmount), "amount")).
+	holdingQuery := r.client.Holding.Query()
+	if address != "" {
+		holdingQuery = holdingQuery.Where(holding.AddressEQ(address))
+	}
+	err := holdingQuery.
+		GroupBy(holding.FieldToken).
+		Aggregate(ent.As(ent.Sum(holding.FieldAmount), "amount")).
 		Scan(ctx, &balances)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, nil // No balances found
-		}
 		return nil, r.logger.Errorf("failed to get balances for %s: %v", address, err)
 	}
 
@@ -371,116 +646,110 @@ func (r *RepositoryEnt) GetBalances(ctx context.Context, address string) ([]mode
 }
 
 // IncrementAccountBalance implements Repository.
-func (r *RepositoryEnt) IncrementAccountBalance(ctx context.Context, address string, token string, amount int64) error {
-	// Use a transaction to ensure atomicity
-	_, err := r.client.Account.Update().
-		Where(
-			account.IDEQ(address),
-			account.TokenEQ(token),
-		).
-		AddAmount(float64(amount)).
-		Save(ctx)
+//
+// The holder count of the token statistics follows the balance as it moves between
+// zero and positive, so holders never need to be recounted.
+func (r *RepositoryEnt) IncrementAccountBalance(ctx context.Context, address string, token string, amount int64, height int, txHash string) error {
+	// A transaction changing the balance several times (fee, sends, events) is
+	// counted once, as its changes are applied one after the other
+	rows, err := r.client.QueryContext(ctx, `
+		WITH updated AS (
+			UPDATE holdings SET
+				amount = amount + $3,
+				last_active_height = GREATEST(last_active_height, $4),
+				tx_count = tx_count + CASE WHEN last_tx_hash IS NOT DISTINCT FROM $5 THEN 0 ELSE 1 END,
+				last_tx_hash = $5
+			WHERE address = $1 AND token = $2
+			RETURNING amount - $3 AS previous, amount
+		), holder AS (
+			SELECT CASE
+				WHEN previous <= 0 AND amount > 0 THEN 1
+				WHEN previous > 0 AND amount <= 0 THEN -1
+				ELSE 0
+			END AS delta
+			FROM updated
+		), stat AS (
+			INSERT INTO token_stats (token, minted, burned, supply, holders, transfer_count, last_height, updated_at)
+			SELECT $2, 0, 0, 0, delta, 0, $4, $6::timestamptz FROM holder WHERE delta <> 0
+			ON CONFLICT (token) DO UPDATE SET holders = token_stats.holders + EXCLUDED.holders
+		)
+		SELECT count(*) FROM updated`,
+		address, token, float64(amount), height, txHash, time.Now())
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return r.logger.Errorf("account %s with token %s not found: %v", address, token, err)
-		}
 		return r.logger.Errorf("failed to increment account balance for %s: %v", address, err)
 	}
+	defer rows.Close()
+
+	var updated int
+	for rows.Next() {
+		if err := rows.Scan(&updated); err != nil {
+			return r.logger.Errorf("failed to increment account balance for %s: %v", address, err)
+		}
+	}
+	if updated == 0 {
+		return r.logger.Errorf("account %s with token %s not found", address, token)
+	}
 
 	return nil
 }
 
-// AddTransfer implements Repository.
-func (r *RepositoryEnt) AddTransfer(ctx context.Context, tx *model.Transaction, transfer *model.Transfer) error {
-	createTransfer := r.client.Transfer.Create().
-		SetHash(tx.Hash).
-		SetFunc(strings.ToLower(transfer.Func)).
-		SetToken(transfer.Token).
-		SetAmount(transfer.Amount).
-		SetDenom(transfer.Denom).
-		SetCreatedAt(time.Now())
-
-	if transfer.FromAddress != "" {
-		createTransfer = createTransfer.SetFromAddress(transfer.FromAddress)
-	}
-	if transfer.ToAddress != "" {
-		createTransfer = createTransfer.SetToAddress(transfer.ToAddress)
-	}
-	_, err := createTransfer.Save(ctx)
-	if err != nil {
-		return r.logger.Errorf("failed to add transfer from %s to %s: %v", transfer.FromAddress, transfer.ToAddress, err)
+func convertHoldingToModel(entHolding *ent.Holding) model.Account {
+	return model.Account{
+		Address:          entHolding.Address,
+		Token:            entHolding.Token,
+		Amount:           entHolding.Amount,
+		FirstSeenHeight:  entHolding.FirstSeenHeight,
+		LastActiveHeight: entHolding.LastActiveHeight,
+		TxCount:          entHolding.TxCount,
+		CreatedAt:        entHolding.CreatedAt,
 	}
+}
 
-	return nil
+// AddTransfer implements Repository.
+func (r *RepositoryEnt) AddTransfer(ctx context.Context, tx *model.Transaction, transfer *model.Transfer) error {
+	return r.AddTransfers(ctx, tx, []model.Transfer{*transfer})
 }
 
 // AddTransfers implements Repository.
 func (r *RepositoryEnt) AddTransfers(ctx context.Context, tx *model.Transaction, transfers []model.Transfer) error {
-	_, err := r.client.Transfer.CreateBulk(
-		func() []*ent.TransferCreate {
-			bulk := make([]*ent.TransferCreate, len(transfers))
-			for i, transfer := range transfers {
-				bulk[i] = r.client.Transfer.Create().
-					SetHash(tx.Hash).
-					SetFunc(strings.ToLower(transfer.Func)).
-					SetToken(transfer.Token).
-					SetAmount(transfer.Amount).
-					SetDenom(transfer.Denom).
-					SetCreatedAt(time.Now())
-
-				if transfer.FromAddress != "" {
-					bulk[i].SetFromAddress(transfer.FromAddress)
-				}
-				if transfer.ToAddress != "" {
-					bulk[i].SetToAddress(transfer.ToAddress)
-				}
-			}
-			return bulk
-		}()...).Save(ctx)
-	if err != nil {
-		return r.logger.Errorf("failed to add transfers: %v", err)
+	if len(transfers) == 0 {
+		return nil
 	}
-	return nil
-}
 
-// GetTransfers implements Repository.
-func (r *RepositoryEnt) GetTransfers(ctx context.Context, fromAccountOr string, toAccountOr string, token string) ([]model.Transfer, error) {
-	transferQuery := r.client.Transfer.Query()
-	if fromAccountOr != "" {
-		transferQuery = transferQuery.Where(
-			transfer.Or(transfer.FromAddressEQ(fromAccountOr)),
-		)
-	}
-	if toAccountOr != "" {
-		transferQuery = transferQuery.Where(
-			transfer.Or(transfer.ToAddressEQ(toAccountOr)),
-		)
-	}
-	if token != "" {
-		transferQuery = transferQuery.Where(
-			transfer.TokenEQ(token),
-		)
-	}
-	transfers, err := transferQuery.Where(transfer.Func("transfer")).All(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, nil // Transfer not found
+	bulk := make([]*ent.TransferCreate, len(transfers))
+	for i, transfer := range transfers {
+		bulk[i] = r.client.Transfer.Create().
+			SetHash(tx.Hash).
+			SetFunc(strings.ToLower(transfer.Func)).
+			SetToken(transfer.Token).
+			SetAmount(transfer.Amount).
+			SetDenom(transfer.Denom).
+			SetBlockHeight(tx.BlockHeight).
+			SetTxIndex(tx.Index).
+			SetMsgIndex(transfer.MsgIndex).
+			SetEventIndex(transfer.EventIndex).
+			SetCreatedAt(time.Now())
+
+		if !transfer.BlockTime.IsZero() {
+			bulk[i].SetBlockTime(transfer.BlockTime)
 		}
-		return nil, r.logger.Errorf("failed to get transfer from %s to %s: %v", fromAccountOr, toAccountOr, err)
-	}
-
-	transferModels := make([]model.Transfer, len(transfers))
-	for i, entTransfer := range transfers {
-		transferModels[i] = model.Transfer{
-			FromAddress: entTransfer.FromAddress,
-			ToAddress:   entTransfer.ToAddress,
-			Token:       entTransfer.Token,
-			Amount:      entTransfer.Amount,
-			Denom:       entTransfer.Denom,
-			CreatedAt:   entTransfer.CreatedAt,
+		if transfer.FromAddress != "" {
+			bulk[i].SetFromAddress(transfer.FromAddress)
 		}
+		if transfer.ToAddress != "" {
+			bulk[i].SetToAddress(transfer.ToAddress)
+		}
+	}
+
+	// Transfers are keyed by their position in the transaction, replays add nothing
+	err := r.client.Transfer.CreateBulk(bulk...).
+		OnConflict(sql.ConflictColumns(transfer.FieldHash, transfer.FieldEventIndex, transfer.FieldToken)).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return r.logger.Errorf("failed to add transfers for transaction %s: %v", tx.Hash, err)
 	}
-	return transferModels, nil
+	return nil
 }
 
 // Conversion functions between model and schema types
@@ -517,6 +786,21 @@ func convertResponseToSchema(modelResponse model.Response) schema.Response {
 	}
 }
 
+func convertTransactionToModel(entTx *ent.Transaction) model.Transaction {
+	return model.Transaction{
+		Index:       entTx.Index,
+		Hash:        entTx.Hash,
+		Success:     entTx.Success,
+		BlockHeight: entTx.BlockHeight,
+		GasWanted:   entTx.GasWanted,
+		GasUsed:     entTx.GasUsed,
+		Memo:        entTx.Memo,
+		GasFee:      model.GasFee(entTx.GasFee),
+		Messages:    convertSchemaMessagesToModel(entTx.Messages),
+		Response:    convertSchemaResponseToModel(entTx.Response),
+	}
+}
+
 func convertSchemaMessagesToModel(schemaMessages []schema.Message) []model.Message {
 	modelMessages := make([]model.Message, len(schemaMessages))
 	for i, msg := range schemaMessages {
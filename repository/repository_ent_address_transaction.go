@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/addresstransaction"
+	"gno.land-block-indexer/model"
+)
+
+// AddAddressTransactions implements Repository.
+func (r *RepositoryEnt) AddAddressTransactions(ctx context.Context, txs []model.AddressTransaction) error {
+	var bulk []*ent.AddressTransactionCreate
+	for _, tx := range txs {
+		for _, role := range tx.Roles {
+			bulk = append(bulk, r.client.AddressTransaction.Create().
+				SetAddress(tx.Address).
+				SetHash(tx.Hash).
+				SetRole(role).
+				SetBlockHeight(tx.BlockHeight).
+				SetTxIndex(tx.TxIndex).
+				SetBlockTime(tx.BlockTime).
+				SetSuccess(tx.Success).
+				SetCreatedAt(time.Now()))
+		}
+	}
+	if len(bulk) == 0 {
+		return nil
+	}
+
+	// Roles of a reprocessed transaction are already indexed
+	err := r.client.AddressTransaction.CreateBulk(bulk...).
+		OnConflict(sql.ConflictColumns(addresstransaction.FieldAddress, addresstransaction.FieldHash, addresstransaction.FieldRole)).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return r.logger.Errorf("failed to add address transactions for transaction %s: %v", txs[0].Hash, err)
+	}
+
+	return nil
+}
+
+// GetAddressTransactions implements Repository.
+func (r *RepositoryEnt) GetAddressTransactions(ctx context.Context, address string, roles []string, offset int, limit int) ([]model.AddressTransaction, error) {
+	// A transaction is listed once with all the roles of the address, even when filtering on some
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT hash, block_height, tx_index, block_time, success, array_agg(role ORDER BY role)
+		FROM address_transactions
+		WHERE address = $1
+		GROUP BY hash, block_height, tx_index, block_time, success
+		HAVING COALESCE(cardinality($2::text[]), 0) = 0 OR bool_or(role = ANY($2::text[]))
+		ORDER BY block_height DESC, tx_index DESC
+		OFFSET $3 LIMIT $4`, address, pq.Array(roles), offset, limit)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get transactions of %s: %v", address, err)
+	}
+	defer rows.Close()
+
+	txs := make([]model.AddressTransaction, 0)
+	for rows.Next() {
+		tx := model.AddressTransaction{Address: address}
+		if err := rows.Scan(&tx.Hash, &tx.BlockHeight, &tx.TxIndex, &tx.BlockTime, &tx.Success, pq.Array(&tx.Roles)); err != nil {
+			return nil, r.logger.Errorf("failed to scan transaction of %s: %v", address, err)
+		}
+		txs = append(txs, tx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logger.Errorf("failed to get transactions of %s: %v", address, err)
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/balancecheckpoint"
+	"gno.land-block-indexer/model"
+)
+
+// AddBalanceChanges implements Repository.
+func (r *RepositoryEnt) AddBalanceChanges(ctx context.Context, changes []model.BalanceChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	bulk := make([]*ent.BalanceChangeCreate, len(changes))
+	for i, change := range changes {
+		bulk[i] = r.client.BalanceChange.Create().
+			SetAddress(change.Address).
+			SetToken(change.Token).
+			SetBlockHeight(change.BlockHeight).
+			SetDelta(change.Delta).
+			SetReason(change.Reason).
+			SetHash(change.Hash).
+			SetEventIndex(change.EventIndex).
+			SetCreatedAt(time.Now())
+	}
+	if _, err := r.client.BalanceChange.CreateBulk(bulk...).Save(ctx); err != nil {
+		return r.logger.Errorf("failed to add balance changes for transaction %s: %v", changes[0].Hash, err)
+	}
+
+	// Blocks may be processed out of order, checkpoints taken after a change
+	// of an earlier height no longer hold the right amount
+	for _, change := range changes {
+		_, err := r.client.BalanceCheckpoint.Delete().
+			Where(
+				balancecheckpoint.AddressEQ(change.Address),
+				balancecheckpoint.TokenEQ(change.Token),
+				balancecheckpoint.BlockHeightGTE(change.BlockHeight),
+			).
+			Exec(ctx)
+		if err != nil {
+			return r.logger.Errorf("failed to invalidate balance checkpoints of %s for %s: %v", change.Address, change.Token, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateBalanceCheckpoints implements Repository.
+//
+// A checkpoint is taken at height for every balance changed after previousHeight,
+// starting from the latest checkpoint of the balance. Balances which did not
+// change keep their latest checkpoint.
+func (r *RepositoryEnt) CreateBalanceCheckpoints(ctx context.Context, height int, previousHeight int) error {
+	_, err := r.client.ExecContext(ctx, `
+		INSERT INTO balance_checkpoints (address, token, block_height, amount, created_at)
+		SELECT p.address, p.token, $1,
+			COALESCE(cp.amount, 0) + (
+				SELECT COALESCE(SUM(c.delta), 0)::bigint FROM balance_changes c
+				WHERE c.address = p.address AND c.token = p.token
+					AND c.block_height > COALESCE(cp.block_height, -1) AND c.block_height <= $1
+			),
+			$3
+		FROM (
+			SELECT DISTINCT address, token FROM balance_changes
+			WHERE block_height > $2 AND block_height <= $1
+		) p
+		LEFT JOIN LATERAL (
+			SELECT amount, block_height FROM balance_checkpoints
+			WHERE address = p.address AND token = p.token AND block_height < $1
+			ORDER BY block_height DESC LIMIT 1
+		) cp ON true
+		ON CONFLICT (address, token, block_height) DO NOTHING`,
+		height, previousHeight, time.Now())
+	if err != nil {
+		return r.logger.Errorf("failed to create balance checkpoints at height %d: %v", height, err)
+	}
+
+	return nil
+}
+
+// GetBalancesAtHeight implements Repository.
+func (r *RepositoryEnt) GetBalancesAtHeight(ctx context.Context, address string, height int) ([]model.TokenBalance, error) {
+	// Start from the latest checkpoint at or below height and add the changes after it
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT t.token,
+			COALESCE(cp.amount, 0) + (
+				SELECT COALESCE(SUM(c.delta), 0)::bigint FROM balance_changes c
+				WHERE c.address = $1 AND c.token = t.token
+					AND c.block_height > COALESCE(cp.block_height, -1) AND c.block_height <= $2
+			)
+		FROM (
+			SELECT DISTINCT token FROM balance_changes
+			WHERE address = $1 AND block_height <= $2
+		) t
+		LEFT JOIN LATERAL (
+			SELECT amount, block_height FROM balance_checkpoints
+			WHERE address = $1 AND token = t.token AND block_height <= $2
+			ORDER BY block_height DESC LIMIT 1
+		) cp ON true
+		ORDER BY t.token`,
+		address, height)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get balances of %s at height %d: %v", address, height, err)
+	}
+	defer rows.Close()
+
+	var balances []model.TokenBalance
+	for rows.Next() {
+		var balance model.TokenBalance
+		var amount int64
+		if err := rows.Scan(&balance.Token, &amount); err != nil {
+			return nil, r.logger.Errorf("failed to scan balance of %s at height %d: %v", address, height, err)
+		}
+		balance.Amount = float64(amount)
+		balances = append(balances, balance)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logger.Errorf("failed to read balances of %s at height %d: %v", address, height, err)
+	}
+
+	return balances, nil
+}
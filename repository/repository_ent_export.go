@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/transaction"
+	"gno.land-block-indexer/ent/transfer"
+	"gno.land-block-indexer/model"
+)
+
+// Exports read their rows one at a time from the result of a single query, which
+// lib/pq streams from the connection, so they use constant memory whatever their size.
+
+// ExportSnapshot implements Repository.
+//
+// The repository passed to fn reads in a read-only repeatable read transaction,
+// so every export it runs sees the same blocks. The height is the highest one
+// below which every block is stored; when blocks above a gap are already stored,
+// the exports are bounded to it, so that they match the height they report.
+func (r *RepositoryEnt) ExportSnapshot(ctx context.Context, fn func(repo Repository, height int) error) error {
+	tx, err := r.client.BeginTx(ctx, &stdsql.TxOptions{Isolation: stdsql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return r.logger.Errorf("failed to start export transaction: %v", err)
+	}
+	// Nothing is written, the transaction is only rolled back
+	defer tx.Rollback()
+
+	// The snapshot is taken by the first query of the transaction. The height is
+	// the end of the first run of consecutive blocks, the first gap minus one.
+	rows, err := tx.Client().QueryContext(ctx, `
+		SELECT COALESCE(MIN(height) FILTER (WHERE next IS NULL OR next > height + 1), 0), COALESCE(MAX(height), 0)
+		FROM (SELECT height, LEAD(height) OVER (ORDER BY height) AS next FROM blocks) b`)
+	if err != nil {
+		return r.logger.Errorf("failed to get export snapshot height: %v", err)
+	}
+	var height, latestHeight int
+	for rows.Next() {
+		if err := rows.Scan(&height, &latestHeight); err != nil {
+			rows.Close()
+			return r.logger.Errorf("failed to scan export snapshot height: %v", err)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return r.logger.Errorf("failed to get export snapshot height: %v", err)
+	}
+
+	snapshot := &RepositoryEnt{logger: r.logger, client: tx.Client(), inTx: true}
+	if height < latestHeight {
+		snapshot.exportHeight = height
+	}
+	return fn(snapshot, height)
+}
+
+// ExportTransfers implements Repository.
+//
+// Transfers are sorted as by GetTransfers, latest first unless the filter is ascending.
+func (r *RepositoryEnt) ExportTransfers(ctx context.Context, filter model.TransferFilter, fn func(transfer model.Transfer) error) error {
+	if r.exportHeight > 0 && (filter.ToHeight == 0 || filter.ToHeight > r.exportHeight) {
+		filter.ToHeight = r.exportHeight
+	}
+	selector := sql.Dialect(dialect.Postgres).
+		Select(
+			transfer.FieldID, transfer.FieldFunc, transfer.FieldFromAddress, transfer.FieldToAddress,
+			transfer.FieldToken, transfer.FieldAmount, transfer.FieldDenom, transfer.FieldHash,
+			transfer.FieldBlockHeight, transfer.FieldTxIndex, transfer.FieldMsgIndex, transfer.FieldEventIndex,
+			transfer.FieldBlockTime,
+		).
+		From(sql.Table(transfer.Table))
+	for _, predicate := range transferFilterPredicates(filter) {
+		predicate(selector)
+	}
+	order := sql.Desc
+	if filter.Ascending {
+		order = sql.Asc
+	}
+	selector.OrderBy(order(selector.C(transfer.FieldBlockHeight)), order(selector.C(transfer.FieldID)))
+
+	query, args := selector.Query()
+	rows, err := r.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return r.logger.Errorf("failed to export transfers of %s (token=%s, func=%s): %v", filter.Address, filter.Token, filter.Func, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t model.Transfer
+		var fromAddress, toAddress stdsql.NullString
+		var blockTime stdsql.NullTime
+		if err := rows.Scan(&t.ID, &t.Func, &fromAddress, &toAddress, &t.Token, &t.Amount, &t.Denom, &t.Hash,
+			&t.BlockHeight, &t.TxIndex, &t.MsgIndex, &t.EventIndex, &blockTime); err != nil {
+			return r.logger.Errorf("failed to scan exported transfer: %v", err)
+		}
+		t.FromAddress, t.ToAddress, t.BlockTime = fromAddress.String, toAddress.String, blockTime.Time
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return r.logger.Errorf("failed to export transfers of %s: %v", filter.Address, err)
+	}
+
+	return nil
+}
+
+// ExportTransactions implements Repository.
+//
+// Transactions are sorted as by GetTransactionsByFilter, latest first.
+func (r *RepositoryEnt) ExportTransactions(ctx context.Context, filter model.TransactionFilter, fn func(tx model.Transaction) error) error {
+	if r.exportHeight > 0 && (filter.ToHeight == 0 || filter.ToHeight > r.exportHeight) {
+		filter.ToHeight = r.exportHeight
+	}
+	predicates, err := transactionFilterPredicates(filter)
+	if err != nil {
+		return r.logger.Errorf("failed to filter transactions on message type %s: %v", filter.MessageType, err)
+	}
+	selector := sql.Dialect(dialect.Postgres).
+		Select(
+			transaction.FieldIndex, transaction.FieldHash, transaction.FieldSuccess, transaction.FieldBlockHeight,
+			transaction.FieldGasWanted, transaction.FieldGasUsed, transaction.FieldMemo,
+			transaction.FieldGasFee, transaction.FieldMessages, transaction.FieldResponse,
+		).
+		From(sql.Table(transaction.Table))
+	for _, predicate := range predicates {
+		predicate(selector)
+	}
+	selector.OrderBy(sql.Desc(selector.C(transaction.FieldBlockHeight)), sql.Desc(selector.C(transaction.FieldIndex)))
+
+	query, args := selector.Query()
+	rows, err := r.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return r.logger.Errorf("failed to export transactions (message_type=%s, from=%d, to=%d): %v", filter.MessageType, filter.FromHeight, filter.ToHeight, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entTx ent.Transaction
+		var memo stdsql.NullString
+		var gasFee, messages, response []byte
+		if err := rows.Scan(&entTx.Index, &entTx.Hash, &entTx.Success, &entTx.BlockHeight,
+			&entTx.GasWanted, &entTx.GasUsed, &memo, &gasFee, &messages, &response); err != nil {
+			return r.logger.Errorf("failed to scan exported transaction: %v", err)
+		}
+		entTx.Memo = memo.String
+		if err := unmarshalOptionalJSON(gasFee, &entTx.GasFee); err != nil {
+			return r.logger.Errorf("failed to decode gas fee of transaction %s: %v", entTx.Hash, err)
+		}
+		if err := unmarshalOptionalJSON(messages, &entTx.Messages); err != nil {
+			return r.logger.Errorf("failed to decode messages of transaction %s: %v", entTx.Hash, err)
+		}
+		if err := unmarshalOptionalJSON(response, &entTx.Response); err != nil {
+			return r.logger.Errorf("failed to decode response of transaction %s: %v", entTx.Hash, err)
+		}
+		if err := fn(convertTransactionToModel(&entTx)); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return r.logger.Errorf("failed to export transactions: %v", err)
+	}
+
+	return nil
+}
+
+// ExportBalances implements Repository.
+//
+// Balances are sorted by address and token. At a height, only the amounts are
+// known, computed as by GetBalancesAtHeight.
+func (r *RepositoryEnt) ExportBalances(ctx context.Context, filter model.BalanceFilter, fn func(account model.Account) error) error {
+	if filter.Height == 0 {
+		filter.Height = r.exportHeight
+	}
+	var rows *stdsql.Rows
+	var err error
+	if filter.Height > 0 {
+		rows, err = r.client.QueryContext(ctx, `
+			SELECT k.address, k.token, 0, 0, 0,
+				COALESCE(cp.amount, 0) + (
+					SELECT COALESCE(SUM(c.delta), 0)::bigint FROM balance_changes c
+					WHERE c.address = k.address AND c.token = k.token
+						AND c.block_height > COALESCE(cp.block_height, -1) AND c.block_height <= $3
+				)
+			FROM (
+				SELECT DISTINCT address, token FROM balance_changes
+				WHERE (COALESCE(cardinality($1::text[]), 0) = 0 OR address = ANY($1::text[]))
+					AND ($2::text = '' OR token = $2) AND block_height <= $3
+			) k
+			LEFT JOIN LATERAL (
+				SELECT amount, block_height FROM balance_checkpoints
+				WHERE address = k.address AND token = k.token AND block_height <= $3
+				ORDER BY block_height DESC LIMIT 1
+			) cp ON true
+			ORDER BY k.address, k.token`,
+			pq.Array(filter.Addresses), filter.Token, filter.Height)
+	} else {
+		rows, err = r.client.QueryContext(ctx, `
+			SELECT address, token, first_seen_height, last_active_height, tx_count, amount::bigint
+			FROM holdings
+			WHERE (COALESCE(cardinality($1::text[]), 0) = 0 OR address = ANY($1::text[]))
+				AND ($2::text = '' OR token = $2)
+			ORDER BY address, token`,
+			pq.Array(filter.Addresses), filter.Token)
+	}
+	if err != nil {
+		return r.logger.Errorf("failed to export balances of %d addresses (token=%s, height=%d): %v", len(filter.Addresses), filter.Token, filter.Height, err)
+	}
+
+	return r.scanExportedAccounts(rows, fn)
+}
+
+// ExportHolders implements Repository.
+//
+// Holders are sorted by decreasing balance, then by address. In a snapshot bounded
+// below the latest block, the balances are computed as by GetBalancesAtHeight and
+// the other holding details are the current ones.
+func (r *RepositoryEnt) ExportHolders(ctx context.Context, token string, fn func(account model.Account) error) error {
+	var rows *stdsql.Rows
+	var err error
+	if r.exportHeight > 0 {
+		rows, err = r.client.QueryContext(ctx, `
+			SELECT b.address, b.token, COALESCE(h.first_seen_height, 0), COALESCE(h.last_active_height, 0),
+				COALESCE(h.tx_count, 0), b.amount
+			FROM (
+				SELECT k.address, k.token,
+					COALESCE(cp.amount, 0) + (
+						SELECT COALESCE(SUM(c.delta), 0)::bigint FROM balance_changes c
+						WHERE c.address = k.address AND c.token = k.token
+							AND c.block_height > COALESCE(cp.block_height, -1) AND c.block_height <= $2
+					) AS amount
+				FROM (
+					SELECT DISTINCT address, token FROM balance_changes
+					WHERE token = $1 AND block_height <= $2
+				) k
+				LEFT JOIN LATERAL (
+					SELECT amount, block_height FROM balance_checkpoints
+					WHERE address = k.address AND token = k.token AND block_height <= $2
+					ORDER BY block_height DESC LIMIT 1
+				) cp ON true
+			) b
+			LEFT JOIN holdings h ON h.address = b.address AND h.token = b.token
+			WHERE b.amount > 0
+			ORDER BY b.amount DESC, b.address`,
+			token, r.exportHeight)
+	} else {
+		rows, err = r.client.QueryContext(ctx, `
+			SELECT address, token, first_seen_height, last_active_height, tx_count, amount::bigint
+			FROM holdings
+			WHERE token = $1 AND amount > 0
+			ORDER BY amount DESC, address`,
+			token)
+	}
+	if err != nil {
+		return r.logger.Errorf("failed to export holders of %s: %v", token, err)
+	}
+
+	return r.scanExportedAccounts(rows, fn)
+}
+
+// scanExportedAccounts passes rows of address, token, first seen and last active
+// heights, transaction count and amount to fn, closing them
+func (r *RepositoryEnt) scanExportedAccounts(rows *stdsql.Rows, fn func(account model.Account) error) error {
+	defer rows.Close()
+
+	for rows.Next() {
+		var account model.Account
+		var amount int64
+		if err := rows.Scan(&account.Address, &account.Token, &account.FirstSeenHeight, &account.LastActiveHeight, &account.TxCount, &amount); err != nil {
+			return r.logger.Errorf("failed to scan exported balance: %v", err)
+		}
+		account.Amount = float64(amount)
+		if err := fn(account); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return r.logger.Errorf("failed to export balances: %v", err)
+	}
+
+	return nil
+}
+
+// unmarshalOptionalJSON decodes a JSON column, leaving v unset when it is NULL
+func unmarshalOptionalJSON(data []byte, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
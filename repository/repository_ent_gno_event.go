@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/gnoevent"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/schema"
+	"gno.land-block-indexer/model"
+)
+
+// AddGnoEvents implements Repository.
+func (r *RepositoryEnt) AddGnoEvents(ctx context.Context, events []model.GnoEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	bulk := make([]*ent.GnoEventCreate, len(events))
+	for i, event := range events {
+		attrs := make([]schema.EventAttr, len(event.Attrs))
+		for j, attr := range event.Attrs {
+			attrs[j] = schema.EventAttr{Key: attr.Key, Value: attr.Value}
+		}
+		bulk[i] = r.client.GnoEvent.Create().
+			SetHash(event.Hash).
+			SetEventIndex(event.EventIndex).
+			SetBlockHeight(event.BlockHeight).
+			SetTxIndex(event.TxIndex).
+			SetBlockTime(event.BlockTime).
+			SetType(event.Type).
+			SetFunc(event.Func).
+			SetPkgPath(event.PkgPath).
+			SetAttrs(attrs).
+			SetNillableDecoded(event.Decoded).
+			SetCreatedAt(time.Now())
+	}
+
+	// Events of a reprocessed transaction are already indexed
+	err := r.client.GnoEvent.CreateBulk(bulk...).
+		OnConflict(sql.ConflictColumns(gnoevent.FieldHash, gnoevent.FieldEventIndex)).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return r.logger.Errorf("failed to add events for transaction %s: %v", events[0].Hash, err)
+	}
+
+	return nil
+}
+
+// GetGnoEvents implements Repository.
+func (r *RepositoryEnt) GetGnoEvents(ctx context.Context, filter model.GnoEventFilter, offset int, limit int) ([]model.GnoEvent, error) {
+	eventQuery := r.client.GnoEvent.Query()
+	if filter.PkgPath != "" {
+		eventQuery = eventQuery.Where(gnoevent.PkgPathEQ(filter.PkgPath))
+	}
+	if filter.Type != "" {
+		eventQuery = eventQuery.Where(gnoevent.TypeEQ(filter.Type))
+	}
+	if filter.Func != "" {
+		eventQuery = eventQuery.Where(gnoevent.FuncEQ(filter.Func))
+	}
+	if filter.FromHeight > 0 {
+		eventQuery = eventQuery.Where(gnoevent.BlockHeightGTE(filter.FromHeight))
+	}
+	if filter.ToHeight > 0 {
+		eventQuery = eventQuery.Where(gnoevent.BlockHeightLTE(filter.ToHeight))
+	}
+	for key, value := range filter.Attrs {
+		contains, err := attrsContain(key, value)
+		if err != nil {
+			return nil, r.logger.Errorf("failed to filter events on attribute %s: %v", key, err)
+		}
+		eventQuery = eventQuery.Where(contains)
+	}
+
+	entEvents, err := eventQuery.
+		Order(ent.Asc(gnoevent.FieldBlockHeight), ent.Asc(gnoevent.FieldTxIndex), ent.Asc(gnoevent.FieldEventIndex)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get events (pkg_path=%s, type=%s): %v", filter.PkgPath, filter.Type, err)
+	}
+
+	events := make([]model.GnoEvent, len(entEvents))
+	for i, entEvent := range entEvents {
+		attrs := make([]model.EventAttr, len(entEvent.Attrs))
+		for j, attr := range entEvent.Attrs {
+			attrs[j] = model.EventAttr{Key: attr.Key, Value: attr.Value}
+		}
+		events[i] = model.GnoEvent{
+			Hash:        entEvent.Hash,
+			EventIndex:  entEvent.EventIndex,
+			BlockHeight: entEvent.BlockHeight,
+			TxIndex:     entEvent.TxIndex,
+			BlockTime:   entEvent.BlockTime,
+			Type:        entEvent.Type,
+			Func:        entEvent.Func,
+			PkgPath:     entEvent.PkgPath,
+			Attrs:       attrs,
+			Decoded:     entEvent.Decoded,
+		}
+	}
+
+	return events, nil
+}
+
+// GetUndecodedEvents implements Repository.
+//
+// Events stored before decoding was recorded have no decoded flag and are not counted.
+func (r *RepositoryEnt) GetUndecodedEvents(ctx context.Context, fromHeight int, toHeight int, offset int, limit int) ([]model.UndecodedEvents, error) {
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT pkg_path, type, COUNT(*), MAX(block_height)
+		FROM gno_events
+		WHERE decoded = false AND block_height >= $1 AND ($2 = 0 OR block_height <= $2)
+		GROUP BY pkg_path, type
+		ORDER BY COUNT(*) DESC, pkg_path, type
+		OFFSET $3 LIMIT $4`,
+		fromHeight, toHeight, offset, limit)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to count undecoded events: %v", err)
+	}
+	defer rows.Close()
+
+	undecoded := make([]model.UndecodedEvents, 0)
+	for rows.Next() {
+		var events model.UndecodedEvents
+		if err := rows.Scan(&events.PkgPath, &events.Type, &events.Count, &events.LastHeight); err != nil {
+			return nil, r.logger.Errorf("failed to scan undecoded events: %v", err)
+		}
+		undecoded = append(undecoded, events)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logger.Errorf("failed to count undecoded events: %v", err)
+	}
+
+	return undecoded, nil
+}
+
+// attrsContain matches events having the attribute, using the GIN index on attrs
+func attrsContain(key string, value string) (predicate.GnoEvent, error) {
+	contained, err := json.Marshal([]schema.EventAttr{{Key: key, Value: value}})
+	if err != nil {
+		return nil, err
+	}
+
+	return predicate.GnoEvent(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(gnoevent.FieldAttrs)).WriteString(" @> ").Arg(string(contained)).WriteString("::jsonb")
+		}))
+	}), nil
+}
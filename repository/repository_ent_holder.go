@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"math"
+
+	"gno.land-block-indexer/model"
+)
+
+// GetTokenHolders implements Repository.
+//
+// Holders are sorted by decreasing balance, then by address. The holders above a
+// minimum balance are the first ones of this order, so their rank follows from the offset.
+func (r *RepositoryEnt) GetTokenHolders(ctx context.Context, token string, minBalance float64, offset int, limit int) ([]model.TokenHolder, error) {
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT address, token, first_seen_height, last_active_height, tx_count, amount
+		FROM holdings
+		WHERE token = $1 AND amount > 0 AND amount >= $2
+		ORDER BY amount DESC, address
+		OFFSET $3 LIMIT $4`,
+		token, minBalance, offset, limit)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get holders of %s: %v", token, err)
+	}
+	defer rows.Close()
+
+	holders := make([]model.TokenHolder, 0)
+	for rows.Next() {
+		holder := model.TokenHolder{Rank: offset + len(holders) + 1}
+		if err := rows.Scan(&holder.Address, &holder.Token, &holder.FirstSeenHeight, &holder.LastActiveHeight, &holder.TxCount, &holder.Amount); err != nil {
+			return nil, r.logger.Errorf("failed to scan holder of %s: %v", token, err)
+		}
+		holders = append(holders, holder)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logger.Errorf("failed to get holders of %s: %v", token, err)
+	}
+
+	return holders, nil
+}
+
+// GetTokenDistribution implements Repository.
+//
+// With the n balances x sorted increasingly, the Gini coefficient is
+// 2 * sum(i * x_i) / (n * sum(x)) - (n + 1) / n. The shares are relative to the
+// supply of the token statistics, as everywhere else in the API, not to sum(x).
+func (r *RepositoryEnt) GetTokenDistribution(ctx context.Context, token string) (*model.TokenDistribution, error) {
+	distribution := &model.TokenDistribution{Token: token, Buckets: []model.HolderBucket{}}
+
+	rows, err := r.client.QueryContext(ctx, `
+		WITH h AS (
+			SELECT amount::numeric AS amount,
+				ROW_NUMBER() OVER (ORDER BY amount, address DESC) AS i,
+				COUNT(*) OVER () AS n
+			FROM holdings
+			WHERE token = $1 AND amount > 0
+		)
+		SELECT COUNT(*), COALESCE(SUM(amount), 0)::float8, COALESCE(SUM(i * amount), 0)::float8,
+			COALESCE(SUM(amount) FILTER (WHERE i > n - 10), 0)::bigint,
+			COALESCE(SUM(amount) FILTER (WHERE i > n - 100), 0)::bigint,
+			COALESCE((SELECT supply FROM token_stats WHERE token = $1), 0)
+		FROM h`,
+		token)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get distribution of %s: %v", token, err)
+	}
+	var sum, weightedSum float64
+	for rows.Next() {
+		if err := rows.Scan(&distribution.Holders, &sum, &weightedSum, &distribution.Top10Amount, &distribution.Top100Amount, &distribution.Supply); err != nil {
+			rows.Close()
+			return nil, r.logger.Errorf("failed to scan distribution of %s: %v", token, err)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, r.logger.Errorf("failed to get distribution of %s: %v", token, err)
+	}
+	if distribution.Holders > 0 && sum > 0 {
+		n := float64(distribution.Holders)
+		distribution.Gini = 2*weightedSum/(n*sum) - (n+1)/n
+	}
+
+	// Balances are bucketed by their number of digits
+	rows, err = r.client.QueryContext(ctx, `
+		SELECT length(amount::bigint::text) AS digits, COUNT(*), SUM(amount)::bigint
+		FROM holdings
+		WHERE token = $1 AND amount >= 1
+		GROUP BY digits
+		ORDER BY digits`,
+		token)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get balance buckets of %s: %v", token, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var digits int
+		var bucket model.HolderBucket
+		if err := rows.Scan(&digits, &bucket.Holders, &bucket.Amount); err != nil {
+			return nil, r.logger.Errorf("failed to scan balance bucket of %s: %v", token, err)
+		}
+		bucket.Min = int64(math.Pow10(digits - 1))
+		if digits < 19 {
+			bucket.Max = bucket.Min * 10
+		}
+		distribution.Buckets = append(distribution.Buckets, bucket)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logger.Errorf("failed to get balance buckets of %s: %v", token, err)
+	}
+
+	return distribution, nil
+}
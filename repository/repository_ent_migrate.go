@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"gno.land-block-indexer/ent"
+)
+
+// legacyAccountsTable receives the accounts of the former (address, token) layout during the migration
+const legacyAccountsTable = "accounts_legacy"
+
+// prepareLegacyAccountsMigration detects an accounts table of the former layout, where
+// each row was the balance of a token, and moves it aside with the foreign keys of
+// transfers, so the schema migration can create the accounts and holdings tables
+func (r *RepositoryEnt) prepareLegacyAccountsMigration(ctx context.Context) (bool, error) {
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = 'accounts' AND column_name = 'token'
+		), to_regclass($1) IS NOT NULL`, legacyAccountsTable)
+	if err != nil {
+		return false, r.logger.Errorf("failed to inspect the accounts table: %v", err)
+	}
+	var legacyLayout, legacyTable bool
+	for rows.Next() {
+		if err := rows.Scan(&legacyLayout, &legacyTable); err != nil {
+			rows.Close()
+			return false, r.logger.Errorf("failed to inspect the accounts table: %v", err)
+		}
+	}
+	rows.Close()
+
+	if legacyTable {
+		// A previous migration stopped after moving the table aside
+		return true, nil
+	}
+	if !legacyLayout {
+		return false, nil
+	}
+
+	r.logger.Infof("Migrating accounts to the accounts and holdings tables")
+	err = r.withTx(ctx, func(client *ent.Client) error {
+		for _, statement := range []string{
+			"ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_accounts_transfers_to",
+			"ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_accounts_transfers_from",
+			"ALTER TABLE accounts RENAME TO " + legacyAccountsTable,
+			"ALTER INDEX IF EXISTS accounts_pkey RENAME TO " + legacyAccountsTable + "_pkey",
+			"ALTER INDEX IF EXISTS account_address_token RENAME TO " + legacyAccountsTable + "_address_token",
+		} {
+			if _, err := client.ExecContext(ctx, statement); err != nil {
+				return r.logger.Errorf("failed to move the accounts table aside (%s): %v", statement, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// migrateLegacyAccounts copies the legacy accounts into the accounts and holdings
+// tables, with the activity found in the balance changes, and drops the legacy table
+func (r *RepositoryEnt) migrateLegacyAccounts(ctx context.Context) error {
+	return r.withTx(ctx, func(client *ent.Client) error {
+		for _, statement := range []string{
+			`INSERT INTO accounts (address, first_seen_height, created_at)
+				SELECT DISTINCT address, 0, $1::timestamptz FROM ` + legacyAccountsTable + `
+				ON CONFLICT (address) DO NOTHING`,
+			`INSERT INTO holdings (address, token, amount, first_seen_height, last_active_height, tx_count, created_at)
+				SELECT address, token, amount, 0, 0, 0, $1::timestamptz FROM ` + legacyAccountsTable + `
+				ON CONFLICT (address, token) DO NOTHING`,
+		} {
+			if _, err := client.ExecContext(ctx, statement, time.Now()); err != nil {
+				return r.logger.Errorf("failed to migrate legacy accounts: %v", err)
+			}
+		}
+
+		for _, statement := range []string{
+			`UPDATE holdings h SET
+				first_seen_height = c.first_seen_height,
+				last_active_height = c.last_active_height,
+				tx_count = c.tx_count
+			FROM (
+				SELECT address, token, MIN(block_height) AS first_seen_height,
+					MAX(block_height) AS last_active_height, COUNT(DISTINCT hash) AS tx_count
+				FROM balance_changes
+				GROUP BY address, token
+			) c
+			WHERE h.address = c.address AND h.token = c.token`,
+			`DROP TABLE ` + legacyAccountsTable,
+		} {
+			if _, err := client.ExecContext(ctx, statement); err != nil {
+				return r.logger.Errorf("failed to migrate legacy accounts: %v", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// legacyTransferEventIndex is the event index below which transfers recorded before
+// transfers had a position are numbered, keeping them unique per transaction
+const legacyTransferEventIndex = -1000000
+
+// prepareLegacyTransfersMigration numbers the transfers recorded without an event
+// index, so the schema migration can create the unique index on their position
+func (r *RepositoryEnt) prepareLegacyTransfersMigration(ctx context.Context) (bool, error) {
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT to_regclass('transfers') IS NOT NULL AND NOT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = 'transfers' AND column_name = 'event_index'
+		)`)
+	if err != nil {
+		return false, r.logger.Errorf("failed to inspect the transfers table: %v", err)
+	}
+	var legacyLayout bool
+	for rows.Next() {
+		if err := rows.Scan(&legacyLayout); err != nil {
+			rows.Close()
+			return false, r.logger.Errorf("failed to inspect the transfers table: %v", err)
+		}
+	}
+	rows.Close()
+
+	if !legacyLayout {
+		return false, nil
+	}
+
+	r.logger.Infof("Migrating transfers to positioned transfers")
+	err = r.withTx(ctx, func(client *ent.Client) error {
+		for _, statement := range []string{
+			"ALTER TABLE transfers ADD COLUMN event_index bigint NOT NULL DEFAULT 0",
+			`UPDATE transfers t SET event_index = n.event_index
+			FROM (
+				SELECT id, ` + strconv.Itoa(legacyTransferEventIndex) + ` - ROW_NUMBER() OVER (PARTITION BY hash ORDER BY id) AS event_index
+				FROM transfers
+			) n
+			WHERE t.id = n.id`,
+			"ALTER TABLE transfers ALTER COLUMN event_index DROP DEFAULT",
+		} {
+			if _, err := client.ExecContext(ctx, statement); err != nil {
+				return r.logger.Errorf("failed to number legacy transfers: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// migrateLegacyTransfers fills the block position and time of legacy transfers from
+// their transaction. Their order within a transaction is the insertion order, running
+// the event-processor with -rebuild records the exact positions.
+func (r *RepositoryEnt) migrateLegacyTransfers(ctx context.Context) error {
+	return r.withTx(ctx, func(client *ent.Client) error {
+		for _, statement := range []string{
+			`UPDATE transfers t SET block_height = tx.block_height, tx_index = tx.index
+			FROM transactions tx
+			WHERE t.hash = tx.hash AND t.event_index < $1`,
+			`UPDATE transfers t SET block_time = b.time
+			FROM blocks b
+			WHERE t.block_height = b.height AND t.event_index < $1`,
+		} {
+			if _, err := client.ExecContext(ctx, statement, legacyTransferEventIndex); err != nil {
+				return r.logger.Errorf("failed to migrate legacy transfers: %v", err)
+			}
+		}
+		return nil
+	})
+}
+
+// tableMissing tells whether a table is yet to be created, in which case the tables
+// derived from data already indexed are backfilled once created
+func (r *RepositoryEnt) tableMissing(ctx context.Context, table string) (bool, error) {
+	rows, err := r.client.QueryContext(ctx, "SELECT to_regclass($1) IS NULL", table)
+	if err != nil {
+		return false, r.logger.Errorf("failed to inspect the %s table: %v", table, err)
+	}
+	defer rows.Close()
+
+	var missing bool
+	for rows.Next() {
+		if err := rows.Scan(&missing); err != nil {
+			return false, r.logger.Errorf("failed to inspect the %s table: %v", table, err)
+		}
+	}
+	return missing, nil
+}
+
+// backfillGnoEvents indexes the events stored in the response of the transactions
+func (r *RepositoryEnt) backfillGnoEvents(ctx context.Context) error {
+	r.logger.Infof("Indexing the events of indexed transactions")
+	_, err := r.client.ExecContext(ctx, `
+		INSERT INTO gno_events (hash, event_index, block_height, tx_index, block_time, type, func, pkg_path, attrs, created_at)
+		SELECT t.hash, e.ordinality - 1, t.block_height, t.index, b.time,
+			COALESCE(e.event->>'type', ''), e.event->>'func', COALESCE(e.event->>'pkg_path', ''),
+			CASE WHEN jsonb_typeof(e.event->'attrs') = 'array' THEN e.event->'attrs' ELSE '[]'::jsonb END,
+			$1::timestamptz
+		FROM transactions t
+		JOIN blocks b ON b.height = t.block_height
+		CROSS JOIN LATERAL jsonb_array_elements(
+			CASE WHEN jsonb_typeof(t.response->'events') = 'array' THEN t.response->'events' ELSE '[]'::jsonb END
+		) WITH ORDINALITY AS e(event, ordinality)
+		ON CONFLICT (hash, event_index) DO NOTHING`, time.Now())
+	if err != nil {
+		return r.logger.Errorf("failed to backfill events: %v", err)
+	}
+	return nil
+}
+
+// backfillTokenStats computes the token statistics from the indexed transfers and holdings
+func (r *RepositoryEnt) backfillTokenStats(ctx context.Context) error {
+	r.logger.Infof("Computing the statistics of indexed tokens")
+	_, err := r.client.ExecContext(ctx, `
+		INSERT INTO token_stats (token, minted, burned, supply, holders, transfer_count, last_height, updated_at)
+		SELECT t.token, t.minted, t.burned, t.minted - t.burned, COALESCE(h.holders, 0), t.transfer_count, t.last_height, $1::timestamptz
+		FROM (
+			SELECT token,
+				COALESCE(SUM(amount) FILTER (WHERE func IN ('mint', 'genesis')), 0)::bigint AS minted,
+				COALESCE(SUM(amount) FILTER (WHERE func = 'burn'), 0)::bigint AS burned,
+				COUNT(*) FILTER (WHERE func = 'transfer') AS transfer_count,
+				MAX(block_height) AS last_height
+			FROM transfers
+			GROUP BY token
+		) t
+		LEFT JOIN (
+			SELECT token, COUNT(*) AS holders FROM holdings WHERE amount > 0 GROUP BY token
+		) h ON h.token = t.token
+		ON CONFLICT (token) DO NOTHING`, time.Now())
+	if err != nil {
+		return r.logger.Errorf("failed to backfill token statistics: %v", err)
+	}
+	return nil
+}
+
+// backfillAddressTransactions records the addresses of the indexed transactions with
+// their roles, found as the event-processor does in the messages and event attributes
+func (r *RepositoryEnt) backfillAddressTransactions(ctx context.Context) error {
+	r.logger.Infof("Collecting the addresses of indexed transactions")
+	_, err := r.client.ExecContext(ctx, `
+		WITH roles AS (
+			SELECT t.hash, t.block_height, t.index, t.success, a.address, a.role
+			FROM transactions t
+			CROSS JOIN LATERAL jsonb_array_elements(
+				CASE WHEN jsonb_typeof(t.messages) = 'array' THEN t.messages ELSE '[]'::jsonb END
+			) AS m(msg)
+			CROSS JOIN LATERAL (VALUES
+				(COALESCE(NULLIF(m.msg->'value'->>'from_address', ''), NULLIF(m.msg->'value'->>'caller', ''),
+					m.msg->'value'->>'creator'), 'signer'),
+				(CASE WHEN m.msg->>'route' = 'bank' AND m.msg->>'typeUrl' = 'send'
+					THEN m.msg->'value'->>'from_address' END, 'sender'),
+				(CASE WHEN m.msg->>'route' = 'bank' AND m.msg->>'typeUrl' = 'send'
+					THEN m.msg->'value'->>'to_address' END, 'receiver'),
+				(CASE WHEN m.msg->>'route' = 'vm' AND m.msg->>'typeUrl' IN ('exec', 'run')
+					THEN m.msg->'value'->>'caller' END, 'caller'),
+				(CASE WHEN m.msg->>'route' = 'vm' AND m.msg->>'typeUrl' = 'add_package'
+					THEN m.msg->'value'->>'creator' END, 'creator'),
+				(CASE WHEN m.msg->>'route' = 'genesis' AND m.msg->>'typeUrl' = 'balance'
+					THEN m.msg->'value'->>'address' END, 'receiver')
+			) AS a(address, role)
+			UNION ALL
+			SELECT t.hash, t.block_height, t.index, t.success, a.attr->>'value', 'event'
+			FROM transactions t
+			CROSS JOIN LATERAL jsonb_array_elements(
+				CASE WHEN jsonb_typeof(t.response->'events') = 'array' THEN t.response->'events' ELSE '[]'::jsonb END
+			) AS e(event)
+			CROSS JOIN LATERAL jsonb_array_elements(
+				CASE WHEN jsonb_typeof(e.event->'attrs') = 'array' THEN e.event->'attrs' ELSE '[]'::jsonb END
+			) AS a(attr)
+			WHERE a.attr->>'value' ~ '^g1[02-9ac-hj-np-z]{38}$'
+		)
+		INSERT INTO address_transactions (address, hash, role, block_height, tx_index, block_time, success, created_at)
+		SELECT r.address, r.hash, r.role, r.block_height, r.index, b.time, r.success, $1::timestamptz
+		FROM roles r
+		JOIN blocks b ON b.height = r.block_height
+		WHERE r.address <> ''
+		ON CONFLICT (address, hash, role) DO NOTHING`, time.Now())
+	if err != nil {
+		return r.logger.Errorf("failed to backfill address transactions: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/nft"
+	"gno.land-block-indexer/ent/nfttransfer"
+	"gno.land-block-indexer/model"
+)
+
+// UpdateNftOwner implements Repository.
+func (r *RepositoryEnt) UpdateNftOwner(ctx context.Context, token *model.Nft) error {
+	createNft := r.client.Nft.Create().
+		SetCollection(token.Collection).
+		SetTokenID(token.TokenID).
+		SetOwner(token.Owner).
+		SetBurned(token.Burned).
+		SetLastHeight(token.LastHeight).
+		SetCreatedAt(time.Now())
+	if token.MintedHeight != 0 {
+		createNft = createNft.SetMintedHeight(token.MintedHeight)
+	}
+
+	// Blocks may be processed out of order, so never overwrite a newer owner
+	err := createNft.
+		OnConflict(
+			sql.ConflictColumns(nft.FieldCollection, nft.FieldTokenID),
+			sql.UpdateWhere(sql.LTE(nft.Table+"."+nft.FieldLastHeight, token.LastHeight)),
+		).
+		Update(func(u *ent.NftUpsert) {
+			u.UpdateOwner()
+			u.UpdateBurned()
+			u.UpdateLastHeight()
+			if token.MintedHeight != 0 {
+				u.UpdateMintedHeight()
+			}
+		}).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return r.logger.Errorf("failed to update owner of nft %s/%s: %v", token.Collection, token.TokenID, err)
+	}
+
+	return nil
+}
+
+// AddNftTransfers implements Repository.
+func (r *RepositoryEnt) AddNftTransfers(ctx context.Context, tx *model.Transaction, transfers []model.NftTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	bulk := make([]*ent.NftTransferCreate, len(transfers))
+	for i, transfer := range transfers {
+		bulk[i] = r.client.NftTransfer.Create().
+			SetHash(tx.Hash).
+			SetFunc(strings.ToLower(transfer.Func)).
+			SetCollection(transfer.Collection).
+			SetTokenID(transfer.TokenID).
+			SetBlockHeight(tx.BlockHeight).
+			SetCreatedAt(time.Now())
+
+		if transfer.FromAddress != "" {
+			bulk[i].SetFromAddress(transfer.FromAddress)
+		}
+		if transfer.ToAddress != "" {
+			bulk[i].SetToAddress(transfer.ToAddress)
+		}
+	}
+
+	_, err := r.client.NftTransfer.CreateBulk(bulk...).Save(ctx)
+	if err != nil {
+		return r.logger.Errorf("failed to add nft transfers for transaction %s: %v", tx.Hash, err)
+	}
+
+	return nil
+}
+
+// GetNfts implements Repository.
+func (r *RepositoryEnt) GetNfts(ctx context.Context, owner string, collection string) ([]model.Nft, error) {
+	nftQuery := r.client.Nft.Query()
+	if owner != "" {
+		nftQuery = nftQuery.Where(nft.OwnerEQ(owner))
+	}
+	if collection != "" {
+		nftQuery = nftQuery.Where(nft.CollectionEQ(collection))
+	}
+	entNfts, err := nftQuery.
+		Order(ent.Asc(nft.FieldCollection), ent.Asc(nft.FieldTokenID)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get nfts of %s: %v", owner, err)
+	}
+
+	nfts := make([]model.Nft, len(entNfts))
+	for i, entNft := range entNfts {
+		nfts[i] = model.Nft{
+			Collection:   entNft.Collection,
+			TokenID:      entNft.TokenID,
+			Owner:        entNft.Owner,
+			Burned:       entNft.Burned,
+			MintedHeight: entNft.MintedHeight,
+			LastHeight:   entNft.LastHeight,
+		}
+	}
+
+	return nfts, nil
+}
+
+// GetNftTransfers implements Repository.
+func (r *RepositoryEnt) GetNftTransfers(ctx context.Context, collection string, tokenID string) ([]model.NftTransfer, error) {
+	entTransfers, err := r.client.NftTransfer.Query().
+		Where(
+			nfttransfer.CollectionEQ(collection),
+			nfttransfer.TokenIDEQ(tokenID),
+		).
+		Order(ent.Asc(nfttransfer.FieldBlockHeight), ent.Asc(nfttransfer.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get transfers of nft %s/%s: %v", collection, tokenID, err)
+	}
+
+	transfers := make([]model.NftTransfer, len(entTransfers))
+	for i, entTransfer := range entTransfers {
+		transfers[i] = model.NftTransfer{
+			Hash:        entTransfer.Hash,
+			Func:        entTransfer.Func,
+			Collection:  entTransfer.Collection,
+			TokenID:     entTransfer.TokenID,
+			FromAddress: entTransfer.FromAddress,
+			ToAddress:   entTransfer.ToAddress,
+			BlockHeight: entTransfer.BlockHeight,
+			CreatedAt:   entTransfer.CreatedAt,
+		}
+	}
+
+	return transfers, nil
+}
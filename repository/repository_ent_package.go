@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/gnopackage"
+	"gno.land-block-indexer/ent/gnopackagefile"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/model"
+)
+
+// AddPackage implements Repository.
+func (r *RepositoryEnt) AddPackage(ctx context.Context, pkg *model.Package) error {
+	// Store the package and its files together
+	return r.withTx(ctx, func(client *ent.Client) error {
+		err := client.GnoPackage.Create().
+			SetID(pkg.Path).
+			SetName(pkg.Name).
+			SetNamespace(pkg.Namespace).
+			SetCreator(pkg.Creator).
+			SetDeposit(pkg.Deposit).
+			SetBlockHeight(pkg.BlockHeight).
+			SetHash(pkg.Hash).
+			SetCreatedAt(time.Now()).
+			OnConflict(sql.ConflictColumns(gnopackage.FieldID)).
+			DoNothing().
+			Exec(ctx)
+		if errors.Is(err, stdsql.ErrNoRows) {
+			// Package paths can only be deployed once, the package is already indexed
+			return nil
+		} else if err != nil {
+			return r.logger.Errorf("failed to add package %s: %v", pkg.Path, err)
+		}
+
+		if len(pkg.Files) == 0 {
+			return nil
+		}
+		bulk := make([]*ent.GnoPackageFileCreate, len(pkg.Files))
+		for i, file := range pkg.Files {
+			bulk[i] = client.GnoPackageFile.Create().
+				SetName(file.Name).
+				SetBody(file.Body).
+				SetPackageID(pkg.Path)
+		}
+		if _, err := client.GnoPackageFile.CreateBulk(bulk...).Save(ctx); err != nil {
+			return r.logger.Errorf("failed to add files of package %s: %v", pkg.Path, err)
+		}
+
+		return nil
+	})
+}
+
+// GetPackage implements Repository.
+func (r *RepositoryEnt) GetPackage(ctx context.Context, path string) (*model.Package, error) {
+	entPackage, err := r.client.GnoPackage.Query().
+		Where(gnopackage.IDEQ(path)).
+		WithFiles(func(q *ent.GnoPackageFileQuery) {
+			q.Order(ent.Asc(gnopackagefile.FieldName))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil // Package not found
+		}
+		return nil, r.logger.Errorf("failed to get package %s: %v", path, err)
+	}
+
+	pkg := convertPackageToModel(entPackage)
+	return &pkg, nil
+}
+
+// GetPackages implements Repository.
+func (r *RepositoryEnt) GetPackages(ctx context.Context, creator string, namespace string, offset int, limit int) ([]model.Package, error) {
+	packageQuery := r.client.GnoPackage.Query()
+	if creator != "" {
+		packageQuery = packageQuery.Where(gnopackage.CreatorEQ(creator))
+	}
+	if namespace != "" {
+		packageQuery = packageQuery.Where(gnopackage.NamespaceEQ(namespace))
+	}
+	entPackages, err := packageQuery.
+		Order(ent.Desc(gnopackage.FieldBlockHeight), ent.Asc(gnopackage.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get packages (creator=%s, namespace=%s): %v", creator, namespace, err)
+	}
+
+	packages := make([]model.Package, len(entPackages))
+	for i, entPackage := range entPackages {
+		packages[i] = convertPackageToModel(entPackage)
+	}
+
+	return packages, nil
+}
+
+// GetPackagesByPrefix implements Repository.
+//
+// Packages are sorted by path, so that a realm comes before its subpackages.
+func (r *RepositoryEnt) GetPackagesByPrefix(ctx context.Context, prefix string, limit int) ([]model.Package, error) {
+	entPackages, err := r.client.GnoPackage.Query().
+		Where(predicate.GnoPackage(sql.FieldHasPrefix(gnopackage.FieldID, prefix))).
+		Order(ent.Asc(gnopackage.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get packages with prefix %s: %v", prefix, err)
+	}
+
+	packages := make([]model.Package, len(entPackages))
+	for i, entPackage := range entPackages {
+		packages[i] = convertPackageToModel(entPackage)
+	}
+
+	return packages, nil
+}
+
+// GetPackageFile implements Repository.
+func (r *RepositoryEnt) GetPackageFile(ctx context.Context, path string, name string) (*model.PackageFile, error) {
+	entFile, err := r.client.GnoPackageFile.Query().
+		Where(
+			gnopackagefile.NameEQ(name),
+			gnopackagefile.HasPackageWith(gnopackage.IDEQ(path)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil // File not found
+		}
+		return nil, r.logger.Errorf("failed to get file %s of package %s: %v", name, path, err)
+	}
+
+	return &model.PackageFile{
+		Name: entFile.Name,
+		Body: entFile.Body,
+	}, nil
+}
+
+func convertPackageToModel(entPackage *ent.GnoPackage) model.Package {
+	files := make([]model.PackageFile, len(entPackage.Edges.Files))
+	for i, file := range entPackage.Edges.Files {
+		files[i] = model.PackageFile{
+			Name: file.Name,
+			Body: file.Body,
+		}
+	}
+
+	return model.Package{
+		Path:        entPackage.ID,
+		Name:        entPackage.Name,
+		Namespace:   entPackage.Namespace,
+		Creator:     entPackage.Creator,
+		Deposit:     entPackage.Deposit,
+		BlockHeight: entPackage.BlockHeight,
+		Hash:        entPackage.Hash,
+		Files:       files,
+		CreatedAt:   entPackage.CreatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/realmcall"
+	"gno.land-block-indexer/model"
+)
+
+// AddRealmCalls implements Repository.
+func (r *RepositoryEnt) AddRealmCalls(ctx context.Context, calls []model.RealmCall) error {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	bulk := make([]*ent.RealmCallCreate, len(calls))
+	for i, call := range calls {
+		bulk[i] = r.client.RealmCall.Create().
+			SetHash(call.Hash).
+			SetMsgIndex(call.MsgIndex).
+			SetBlockHeight(call.BlockHeight).
+			SetBlockTime(call.BlockTime).
+			SetPkgPath(call.PkgPath).
+			SetFunc(call.Func).
+			SetCaller(call.Caller).
+			SetArgs(call.Args).
+			SetSend(call.Send).
+			SetSuccess(call.Success).
+			SetCreatedAt(time.Now())
+	}
+
+	// Calls of a reprocessed transaction are already indexed
+	err := r.client.RealmCall.CreateBulk(bulk...).
+		OnConflict(sql.ConflictColumns(realmcall.FieldHash, realmcall.FieldMsgIndex)).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return r.logger.Errorf("failed to add realm calls for transaction %s: %v", calls[0].Hash, err)
+	}
+
+	return nil
+}
+
+// GetRealmCalls implements Repository.
+func (r *RepositoryEnt) GetRealmCalls(ctx context.Context, filter model.RealmCallFilter, offset int, limit int) ([]model.RealmCall, error) {
+	callQuery := r.client.RealmCall.Query()
+	if filter.PkgPath != "" {
+		callQuery = callQuery.Where(realmcall.PkgPathEQ(filter.PkgPath))
+	}
+	if filter.Func != "" {
+		callQuery = callQuery.Where(realmcall.FuncEQ(filter.Func))
+	}
+	if filter.Caller != "" {
+		callQuery = callQuery.Where(realmcall.CallerEQ(filter.Caller))
+	}
+	if !filter.From.IsZero() {
+		callQuery = callQuery.Where(realmcall.BlockTimeGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		callQuery = callQuery.Where(realmcall.BlockTimeLT(filter.To))
+	}
+
+	entCalls, err := callQuery.
+		Order(ent.Desc(realmcall.FieldBlockHeight), ent.Desc(realmcall.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get realm calls (pkg_path=%s, func=%s, caller=%s): %v", filter.PkgPath, filter.Func, filter.Caller, err)
+	}
+
+	calls := make([]model.RealmCall, len(entCalls))
+	for i, entCall := range entCalls {
+		calls[i] = model.RealmCall{
+			Hash:        entCall.Hash,
+			MsgIndex:    entCall.MsgIndex,
+			BlockHeight: entCall.BlockHeight,
+			BlockTime:   entCall.BlockTime,
+			PkgPath:     entCall.PkgPath,
+			Func:        entCall.Func,
+			Caller:      entCall.Caller,
+			Args:        entCall.Args,
+			Send:        entCall.Send,
+			Success:     entCall.Success,
+		}
+	}
+
+	return calls, nil
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gno.land-block-indexer/ent"
+)
+
+// RebuildSchema is the schema the derived tables are rebuilt in before being swapped in
+const RebuildSchema = "rebuild"
+
+// rebuildOldSchema receives the replaced tables during a swap, it is dropped afterwards
+const rebuildOldSchema = "rebuild_old"
+
+// derivedTables are the tables computed from blocks and transactions, which a rebuild
+// replaces. Source tables (blocks, transactions, restore_histories) are kept.
+var derivedTables = []string{
+	"accounts",
+	"holdings",
+	"transfers",
+	"balance_changes",
+	"balance_checkpoints",
+	"nfts",
+	"nft_transfers",
+	"packages",
+	"package_files",
+	"realm_calls",
+	"tokens",
+	"gno_events",
+	"address_transactions",
+	"token_stats",
+}
+
+// CreateRebuildSchema implements Repository.
+func (r *RepositoryEnt) CreateRebuildSchema(ctx context.Context) error {
+	if _, err := r.client.ExecContext(ctx, "CREATE SCHEMA IF NOT EXISTS "+RebuildSchema); err != nil {
+		return r.logger.Errorf("failed to create schema %s: %v", RebuildSchema, err)
+	}
+	return nil
+}
+
+// GetRebuildProgress implements Repository.
+func (r *RepositoryEnt) GetRebuildProgress(ctx context.Context) (int, error) {
+	progress, err := r.client.RebuildProgress.Query().First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return -1, nil // Nothing replayed yet
+		}
+		return -1, r.logger.Errorf("failed to get rebuild progress: %v", err)
+	}
+
+	return progress.LastHeight, nil
+}
+
+// SetRebuildProgress implements Repository.
+func (r *RepositoryEnt) SetRebuildProgress(ctx context.Context, height int) error {
+	updated, err := r.client.RebuildProgress.Update().
+		SetLastHeight(height).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return r.logger.Errorf("failed to update rebuild progress to %d: %v", height, err)
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = r.client.RebuildProgress.Create().
+		SetLastHeight(height).
+		Save(ctx)
+	if err != nil {
+		return r.logger.Errorf("failed to create rebuild progress at %d: %v", height, err)
+	}
+
+	return nil
+}
+
+// SwapRebuiltTables implements Repository.
+//
+// The derived tables of the current schema are replaced by the ones of the rebuild
+// schema in a single transaction. Inserts of blocks and transactions are blocked
+// meanwhile. Replayed blocks are recorded in the blocks table of the rebuild schema;
+// since blocks are committed out of order, stored blocks may be missing from it below
+// the rebuild progress as well as above. If any are, nothing is swapped and their
+// heights are returned so the caller can replay them first.
+func (r *RepositoryEnt) SwapRebuiltTables(ctx context.Context) ([]int, error) {
+	var missingHeights []int
+	err := r.withTx(ctx, func(client *ent.Client) error {
+		if _, err := client.ExecContext(ctx, "LOCK TABLE blocks, transactions IN SHARE MODE"); err != nil {
+			return r.logger.Errorf("failed to lock blocks and transactions: %v", err)
+		}
+
+		rows, err := client.QueryContext(ctx, "SELECT current_schema()")
+		if err != nil {
+			return r.logger.Errorf("failed to get current schema: %v", err)
+		}
+		var currentSchema string
+		for rows.Next() {
+			if err := rows.Scan(&currentSchema); err != nil {
+				rows.Close()
+				return r.logger.Errorf("failed to scan current schema: %v", err)
+			}
+		}
+		rows.Close()
+
+		rows, err = client.QueryContext(ctx, `
+			SELECT b.height FROM `+currentSchema+`.blocks b
+			WHERE NOT EXISTS (SELECT 1 FROM `+RebuildSchema+`.blocks r WHERE r.height = b.height)
+			ORDER BY b.height`)
+		if err != nil {
+			return r.logger.Errorf("failed to get blocks missing from the rebuild: %v", err)
+		}
+		for rows.Next() {
+			var height int
+			if err := rows.Scan(&height); err != nil {
+				rows.Close()
+				return r.logger.Errorf("failed to scan missing block height: %v", err)
+			}
+			missingHeights = append(missingHeights, height)
+		}
+		rows.Close()
+		if len(missingHeights) > 0 {
+			return nil
+		}
+
+		statements := []string{
+			"DROP SCHEMA IF EXISTS " + rebuildOldSchema + " CASCADE",
+			"CREATE SCHEMA " + rebuildOldSchema,
+		}
+		for _, table := range derivedTables {
+			statements = append(statements,
+				"ALTER TABLE "+currentSchema+"."+table+" SET SCHEMA "+rebuildOldSchema,
+				"ALTER TABLE "+RebuildSchema+"."+table+" SET SCHEMA "+currentSchema,
+			)
+		}
+		for _, statement := range statements {
+			if _, err := client.ExecContext(ctx, statement); err != nil {
+				return r.logger.Errorf("failed to swap rebuilt tables (%s): %v", statement, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil || len(missingHeights) > 0 {
+		return missingHeights, err
+	}
+
+	// The replaced tables and what is left of the rebuild schema are no longer needed
+	for _, schemaName := range []string{rebuildOldSchema, RebuildSchema} {
+		if _, err := r.client.ExecContext(ctx, "DROP SCHEMA IF EXISTS "+schemaName+" CASCADE"); err != nil {
+			r.logger.Warnf("Failed to drop schema %s after the swap: %v", schemaName, err)
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"math"
+
+	"github.com/lib/pq"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/holding"
+	"gno.land-block-indexer/model"
+)
+
+// BalanceChangeRepair is the reason, and the transaction hash, of the balance
+// changes recording repairs, which no transaction caused
+const BalanceChangeRepair = "repair"
+
+// GetBalanceDiscrepancies implements Repository.
+//
+// The expected balance of every (address, token) is the sum of the transfers
+// received minus the transfers sent, mint, burn, fee and genesis rows included.
+func (r *RepositoryEnt) GetBalanceDiscrepancies(ctx context.Context) ([]model.BalanceDiscrepancy, error) {
+	rows, err := r.client.QueryContext(ctx, `
+		WITH expected AS (
+			SELECT address, token, SUM(amount) AS amount FROM (
+				SELECT to_address AS address, token, amount FROM transfers WHERE to_address IS NOT NULL
+				UNION ALL
+				SELECT from_address AS address, token, -amount FROM transfers WHERE from_address IS NOT NULL
+			) t
+			GROUP BY address, token
+		)
+		SELECT COALESCE(e.address, h.address), COALESCE(e.token, h.token), COALESCE(h.amount, 0), COALESCE(e.amount, 0)
+		FROM expected e
+		FULL OUTER JOIN holdings h ON h.address = e.address AND h.token = e.token
+		WHERE ROUND(COALESCE(e.amount, 0)) <> ROUND(COALESCE(h.amount, 0))
+		ORDER BY 1, 2`)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to compute balance discrepancies: %v", err)
+	}
+	defer rows.Close()
+
+	var discrepancies []model.BalanceDiscrepancy
+	for rows.Next() {
+		discrepancy := model.BalanceDiscrepancy{FirstDivergingHeight: -1}
+		if err := rows.Scan(&discrepancy.Address, &discrepancy.Token, &discrepancy.Recorded, &discrepancy.Expected); err != nil {
+			return nil, r.logger.Errorf("failed to scan balance discrepancy: %v", err)
+		}
+		discrepancies = append(discrepancies, discrepancy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logger.Errorf("failed to read balance discrepancies: %v", err)
+	}
+
+	return discrepancies, nil
+}
+
+// GetFirstDivergingHeight implements Repository.
+//
+// The transfers of the balance are summed per height (through the height of their
+// transaction) and compared with the recorded balance changes; the first height at
+// which the running sums differ is returned, or -1 if the histories agree, in which
+// case only the stored amount drifted. Heights before balance changes were recorded
+// are reported as diverging.
+func (r *RepositoryEnt) GetFirstDivergingHeight(ctx context.Context, address string, token string) (int, error) {
+	rows, err := r.client.QueryContext(ctx, `
+		WITH transferred AS (
+			SELECT tx.block_height AS height,
+				SUM(CASE WHEN tr.to_address = $1 THEN tr.amount ELSE 0 END) -
+				SUM(CASE WHEN tr.from_address = $1 THEN tr.amount ELSE 0 END) AS delta
+			FROM transfers tr
+			JOIN (SELECT DISTINCT hash, block_height FROM transactions) tx ON tx.hash = tr.hash
+			WHERE tr.token = $2 AND (tr.to_address = $1 OR tr.from_address = $1)
+			GROUP BY tx.block_height
+		), changed AS (
+			SELECT block_height AS height, SUM(delta) AS delta
+			FROM balance_changes
+			WHERE address = $1 AND token = $2
+			GROUP BY block_height
+		), running AS (
+			SELECT COALESCE(t.height, c.height) AS height,
+				SUM(COALESCE(t.delta, 0)) OVER w AS transferred,
+				SUM(COALESCE(c.delta, 0)) OVER w AS changed
+			FROM transferred t
+			FULL OUTER JOIN changed c ON c.height = t.height
+			WINDOW w AS (ORDER BY COALESCE(t.height, c.height))
+		)
+		SELECT height FROM running WHERE ROUND(transferred) <> ROUND(changed) ORDER BY height LIMIT 1`,
+		address, token)
+	if err != nil {
+		return -1, r.logger.Errorf("failed to get first diverging height of %s for %s: %v", address, token, err)
+	}
+	defer rows.Close()
+
+	height := -1
+	if rows.Next() {
+		if err := rows.Scan(&height); err != nil {
+			return -1, r.logger.Errorf("failed to scan first diverging height of %s for %s: %v", address, token, err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return -1, r.logger.Errorf("failed to read first diverging height of %s for %s: %v", address, token, err)
+	}
+
+	return height, nil
+}
+
+// RepairBalances implements Repository.
+//
+// Each correction is recorded as a balance change at the highest indexed height,
+// so the balances at a height keep matching the stored ones.
+func (r *RepositoryEnt) RepairBalances(ctx context.Context, discrepancies []model.BalanceDiscrepancy) error {
+	if len(discrepancies) == 0 {
+		return nil
+	}
+
+	// All balances are repaired or none is
+	return r.withTx(ctx, func(client *ent.Client) error {
+		txRepo := &RepositoryEnt{logger: r.logger, client: client, inTx: true}
+		highest, err := txRepo.GetHighestBlock(ctx)
+		if err != nil {
+			return err
+		}
+		changes := make([]model.BalanceChange, len(discrepancies))
+		for i, discrepancy := range discrepancies {
+			changes[i] = model.BalanceChange{
+				Address:     discrepancy.Address,
+				Token:       discrepancy.Token,
+				BlockHeight: highest.Height,
+				Delta:       int64(math.Round(discrepancy.Expected - discrepancy.Recorded)),
+				Reason:      BalanceChangeRepair,
+				Hash:        BalanceChangeRepair,
+			}
+		}
+		if err := txRepo.AddBalanceChanges(ctx, changes); err != nil {
+			return err
+		}
+
+		for _, discrepancy := range discrepancies {
+			updated, err := client.Holding.Update().
+				Where(
+					holding.AddressEQ(discrepancy.Address),
+					holding.TokenEQ(discrepancy.Token),
+				).
+				SetAmount(discrepancy.Expected).
+				Save(ctx)
+			if err != nil {
+				return r.logger.Errorf("failed to repair balance of %s for %s: %v", discrepancy.Address, discrepancy.Token, err)
+			}
+			if updated > 0 {
+				continue
+			}
+
+			err = txRepo.AddAccount(ctx, &model.Account{
+				Address: discrepancy.Address,
+				Token:   discrepancy.Token,
+				Amount:  discrepancy.Expected,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Repairs bypass the holder transitions, so the holders of the repaired tokens are recounted
+		tokens := make([]string, len(discrepancies))
+		for i, discrepancy := range discrepancies {
+			tokens[i] = discrepancy.Token
+		}
+		_, err = client.ExecContext(ctx, `
+			UPDATE token_stats s SET holders = (SELECT count(*) FROM holdings h WHERE h.token = s.token AND h.amount > 0)
+			WHERE s.token = ANY($1)`, pq.Array(tokens))
+		if err != nil {
+			return r.logger.Errorf("failed to recount holders of repaired tokens: %v", err)
+		}
+
+		return nil
+	})
+}
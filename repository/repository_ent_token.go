@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/token"
+	"gno.land-block-indexer/model"
+)
+
+const (
+	TokenSortSupply  = "supply"  // Tokens with the largest supply first
+	TokenSortHolders = "holders" // Tokens with the most holders first
+)
+
+// AddToken implements Repository.
+func (r *RepositoryEnt) AddToken(ctx context.Context, t *model.Token) error {
+	// The first registration of a token wins
+	err := r.client.Token.Create().
+		SetID(t.Path).
+		SetName(t.Name).
+		SetSymbol(t.Symbol).
+		SetDecimals(t.Decimals).
+		SetCreator(t.Creator).
+		SetFirstSeenHeight(t.FirstSeenHeight).
+		SetCreatedAt(time.Now()).
+		OnConflict(sql.ConflictColumns(token.FieldID)).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return r.logger.Errorf("failed to add token %s: %v", t.Path, err)
+	}
+
+	return nil
+}
+
+// GetToken implements Repository.
+func (r *RepositoryEnt) GetToken(ctx context.Context, path string) (*model.Token, error) {
+	stats, err := r.getTokenStat(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	entToken, err := r.client.Token.Get(ctx, path)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, r.logger.Errorf("failed to get token %s: %v", path, err)
+		}
+		if stats == nil {
+			return nil, nil
+		}
+		// Tokens whose metadata was not recognized still have statistics
+		return &model.Token{Path: path, Stats: stats}, nil
+	}
+
+	t := convertTokenToModel(entToken)
+	t.Stats = stats
+	if t.Stats == nil {
+		t.Stats = &model.TokenStat{Token: path}
+	}
+	return &t, nil
+}
+
+// tokenSortColumns maps the sort orders of GetTokens to the columns sorted on
+var tokenSortColumns = map[string]string{
+	TokenSortSupply:  "s.supply",
+	TokenSortHolders: "s.holders",
+}
+
+// GetTokens implements Repository.
+//
+// As in GetToken, tokens whose metadata was not recognized are listed with their
+// statistics, after the recognized ones when sorted by first appearance.
+func (r *RepositoryEnt) GetTokens(ctx context.Context, sort string, offset int, limit int) ([]model.Token, error) {
+	order := "t.first_seen_height ASC NULLS LAST, path ASC"
+	if column, ok := tokenSortColumns[sort]; ok {
+		order = column + " DESC NULLS LAST, path ASC"
+	}
+
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT COALESCE(t.path, s.token) AS path, COALESCE(t.name, ''), COALESCE(t.symbol, ''), COALESCE(t.decimals, 0),
+			COALESCE(t.creator, ''), COALESCE(t.first_seen_height, 0),
+			COALESCE(s.minted, 0), COALESCE(s.burned, 0), COALESCE(s.supply, 0), COALESCE(s.holders, 0),
+			COALESCE(s.transfer_count, 0), COALESCE(s.last_height, 0)
+		FROM token_stats s
+		FULL JOIN tokens t ON t.path = s.token
+		ORDER BY `+order+`
+		OFFSET $1 LIMIT $2`, offset, limit)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get tokens: %v", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]model.Token, 0)
+	for rows.Next() {
+		t := model.Token{Stats: &model.TokenStat{}}
+		err := rows.Scan(&t.Path, &t.Name, &t.Symbol, &t.Decimals, &t.Creator, &t.FirstSeenHeight,
+			&t.Stats.Minted, &t.Stats.Burned, &t.Stats.Supply, &t.Stats.Holders,
+			&t.Stats.TransferCount, &t.Stats.LastHeight)
+		if err != nil {
+			return nil, r.logger.Errorf("failed to scan token: %v", err)
+		}
+		t.Stats.Token = t.Path
+		tokens = append(tokens, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logger.Errorf("failed to get tokens: %v", err)
+	}
+
+	return tokens, nil
+}
+
+// GetTokensByPaths implements Repository.
+func (r *RepositoryEnt) GetTokensByPaths(ctx context.Context, paths []string) ([]model.Token, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	entTokens, err := r.client.Token.Query().
+		Where(token.IDIn(paths...)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get tokens %v: %v", paths, err)
+	}
+
+	tokens := make([]model.Token, len(entTokens))
+	for i, entToken := range entTokens {
+		tokens[i] = convertTokenToModel(entToken)
+	}
+
+	return tokens, nil
+}
+
+// GetTokensBySymbol implements Repository.
+//
+// Symbols are matched regardless of case, tokens are sorted by path.
+func (r *RepositoryEnt) GetTokensBySymbol(ctx context.Context, symbol string) ([]model.Token, error) {
+	entTokens, err := r.client.Token.Query().
+		Where(token.SymbolEqualFold(symbol)).
+		Order(ent.Asc(token.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get tokens with symbol %s: %v", symbol, err)
+	}
+
+	tokens := make([]model.Token, len(entTokens))
+	for i, entToken := range entTokens {
+		tokens[i] = convertTokenToModel(entToken)
+	}
+
+	return tokens, nil
+}
+
+func convertTokenToModel(entToken *ent.Token) model.Token {
+	return model.Token{
+		Path:            entToken.ID,
+		Name:            entToken.Name,
+		Symbol:          entToken.Symbol,
+		Decimals:        entToken.Decimals,
+		Creator:         entToken.Creator,
+		FirstSeenHeight: entToken.FirstSeenHeight,
+	}
+}
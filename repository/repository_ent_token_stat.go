@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/model"
+)
+
+// ApplyTokenStats implements Repository.
+func (r *RepositoryEnt) ApplyTokenStats(ctx context.Context, height int, deltas []model.TokenStat) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	tokens := make([]string, len(deltas))
+	minted := make([]int64, len(deltas))
+	burned := make([]int64, len(deltas))
+	transferCounts := make([]int64, len(deltas))
+	for i, delta := range deltas {
+		tokens[i] = delta.Token
+		minted[i] = delta.Minted
+		burned[i] = delta.Burned
+		transferCounts[i] = int64(delta.TransferCount)
+	}
+
+	// One statement updates the statistics of the block at once. Amounts are
+	// accumulated, as blocks may be processed out of order; holders are maintained
+	// by IncrementAccountBalance.
+	_, err := r.client.ExecContext(ctx, `
+		INSERT INTO token_stats (token, minted, burned, supply, holders, transfer_count, last_height, updated_at)
+		SELECT d.token, d.minted, d.burned, d.minted - d.burned, 0, d.transfer_count, $5, $6::timestamptz
+		FROM unnest($1::text[], $2::bigint[], $3::bigint[], $4::bigint[]) AS d(token, minted, burned, transfer_count)
+		ORDER BY d.token
+		ON CONFLICT (token) DO UPDATE SET
+			minted = token_stats.minted + EXCLUDED.minted,
+			burned = token_stats.burned + EXCLUDED.burned,
+			supply = token_stats.supply + EXCLUDED.supply,
+			transfer_count = token_stats.transfer_count + EXCLUDED.transfer_count,
+			last_height = GREATEST(token_stats.last_height, EXCLUDED.last_height),
+			updated_at = EXCLUDED.updated_at`,
+		pq.Array(tokens), pq.Array(minted), pq.Array(burned), pq.Array(transferCounts), height, time.Now())
+	if err != nil {
+		return r.logger.Errorf("failed to apply token statistics of block %d: %v", height, err)
+	}
+
+	return nil
+}
+
+// getTokenStat returns the statistics of a token, nil if it has none
+func (r *RepositoryEnt) getTokenStat(ctx context.Context, path string) (*model.TokenStat, error) {
+	entStat, err := r.client.TokenStat.Get(ctx, path)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.logger.Errorf("failed to get statistics of token %s: %v", path, err)
+	}
+
+	return &model.TokenStat{
+		Token:         entStat.ID,
+		Minted:        entStat.Minted,
+		Burned:        entStat.Burned,
+		Supply:        entStat.Supply,
+		Holders:       entStat.Holders,
+		TransferCount: entStat.TransferCount,
+		LastHeight:    entStat.LastHeight,
+	}, nil
+}
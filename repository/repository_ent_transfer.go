@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/predicate"
+	"gno.land-block-indexer/ent/transfer"
+	"gno.land-block-indexer/model"
+)
+
+const (
+	TransferDirectionIn   = "in"   // Transfers received by the address
+	TransferDirectionOut  = "out"  // Transfers sent by the address
+	TransferDirectionBoth = "both" // Transfers sent or received by the address
+)
+
+// GetTransfers implements Repository.
+//
+// Transfers are paginated with a keyset on (block_height, id): the page starts
+// after the given cursor in the sort order, at the first transfer if nil.
+func (r *RepositoryEnt) GetTransfers(ctx context.Context, filter model.TransferFilter, after *model.TransferCursor, limit int) ([]model.Transfer, error) {
+	transferQuery := r.client.Transfer.Query().Where(transferFilterPredicates(filter)...)
+
+	keyset := []string{transfer.FieldBlockHeight, transfer.FieldID}
+	order := ent.Desc
+	if filter.Ascending {
+		order = ent.Asc
+	}
+	if after != nil {
+		transferQuery = transferQuery.Where(func(s *sql.Selector) {
+			columns := []string{s.C(keyset[0]), s.C(keyset[1])}
+			if filter.Ascending {
+				s.Where(sql.CompositeGT(columns, after.BlockHeight, after.ID))
+			} else {
+				s.Where(sql.CompositeLT(columns, after.BlockHeight, after.ID))
+			}
+		})
+	}
+
+	entTransfers, err := transferQuery.
+		Order(order(keyset...)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get transfers of %s (token=%s, func=%s): %v", filter.Address, filter.Token, filter.Func, err)
+	}
+
+	transfers := make([]model.Transfer, len(entTransfers))
+	for i, entTransfer := range entTransfers {
+		transfers[i] = convertTransferToModel(entTransfer)
+	}
+
+	return transfers, nil
+}
+
+// GetTransfersByHashes implements Repository.
+func (r *RepositoryEnt) GetTransfersByHashes(ctx context.Context, hashes []string) ([]model.Transfer, error) {
+	entTransfers, err := r.client.Transfer.Query().
+		Where(transfer.HashIn(hashes...)).
+		Order(ent.Asc(transfer.FieldBlockHeight), ent.Asc(transfer.FieldTxIndex), ent.Asc(transfer.FieldEventIndex)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get transfers of %d transactions: %v", len(hashes), err)
+	}
+
+	transfers := make([]model.Transfer, len(entTransfers))
+	for i, entTransfer := range entTransfers {
+		transfers[i] = convertTransferToModel(entTransfer)
+	}
+
+	return transfers, nil
+}
+
+// transferFilterPredicates returns the predicates selecting the transfers of the filter
+func transferFilterPredicates(filter model.TransferFilter) []predicate.Transfer {
+	var predicates []predicate.Transfer
+	if filter.Address != "" {
+		switch filter.Direction {
+		case TransferDirectionIn:
+			predicates = append(predicates, transfer.ToAddressEQ(filter.Address))
+		case TransferDirectionOut:
+			predicates = append(predicates, transfer.FromAddressEQ(filter.Address))
+		default:
+			predicates = append(predicates, transfer.Or(
+				transfer.FromAddressEQ(filter.Address),
+				transfer.ToAddressEQ(filter.Address),
+			))
+		}
+	}
+	if filter.Token != "" {
+		predicates = append(predicates, transfer.TokenEQ(filter.Token))
+	}
+	if filter.Func != "" {
+		predicates = append(predicates, transfer.FuncEQ(strings.ToLower(filter.Func)))
+	}
+	if filter.FromHeight > 0 {
+		predicates = append(predicates, transfer.BlockHeightGTE(filter.FromHeight))
+	}
+	if filter.ToHeight > 0 {
+		predicates = append(predicates, transfer.BlockHeightLTE(filter.ToHeight))
+	}
+	if !filter.From.IsZero() {
+		predicates = append(predicates, transfer.BlockTimeGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		predicates = append(predicates, transfer.BlockTimeLT(filter.To))
+	}
+	if filter.MinAmount > 0 {
+		predicates = append(predicates, transfer.AmountGTE(filter.MinAmount))
+	}
+	if filter.MaxAmount > 0 {
+		predicates = append(predicates, transfer.AmountLTE(filter.MaxAmount))
+	}
+	return predicates
+}
+
+func convertTransferToModel(entTransfer *ent.Transfer) model.Transfer {
+	return model.Transfer{
+		ID:          entTransfer.ID,
+		Func:        entTransfer.Func,
+		FromAddress: entTransfer.FromAddress,
+		ToAddress:   entTransfer.ToAddress,
+		Token:       entTransfer.Token,
+		Amount:      entTransfer.Amount,
+		Denom:       entTransfer.Denom,
+		Hash:        entTransfer.Hash,
+		BlockHeight: entTransfer.BlockHeight,
+		TxIndex:     entTransfer.TxIndex,
+		MsgIndex:    entTransfer.MsgIndex,
+		EventIndex:  entTransfer.EventIndex,
+		BlockTime:   entTransfer.BlockTime,
+		CreatedAt:   entTransfer.CreatedAt,
+	}
+}
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gno.land-block-indexer/ent"
+	"gno.land-block-indexer/ent/watchrule"
+	"gno.land-block-indexer/ent/webhookdelivery"
+	"gno.land-block-indexer/model"
+)
+
+// AddWatchRule implements Repository.
+func (r *RepositoryEnt) AddWatchRule(ctx context.Context, rule *model.WatchRule) (*model.WatchRule, error) {
+	entRule, err := r.client.WatchRule.Create().
+		SetURL(rule.URL).
+		SetSecret(rule.Secret).
+		SetOwnerHash(rule.OwnerHash).
+		SetAddress(rule.Address).
+		SetToken(rule.Token).
+		SetMinAmount(rule.MinAmount).
+		SetEventType(rule.EventType).
+		SetActive(true).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to add watch rule for %s: %v", rule.URL, err)
+	}
+
+	return convertWatchRuleToModel(entRule), nil
+}
+
+// GetWatchRule implements Repository.
+func (r *RepositoryEnt) GetWatchRule(ctx context.Context, id int) (*model.WatchRule, error) {
+	entRule, err := r.client.WatchRule.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.logger.Errorf("failed to get watch rule %d: %v", id, err)
+	}
+
+	return convertWatchRuleToModel(entRule), nil
+}
+
+// GetWatchRules implements Repository.
+func (r *RepositoryEnt) GetWatchRules(ctx context.Context, activeOnly bool) ([]model.WatchRule, error) {
+	ruleQuery := r.client.WatchRule.Query()
+	if activeOnly {
+		ruleQuery = ruleQuery.Where(watchrule.ActiveEQ(true))
+	}
+	entRules, err := ruleQuery.Order(ent.Asc(watchrule.FieldID)).All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get watch rules: %v", err)
+	}
+
+	rules := make([]model.WatchRule, len(entRules))
+	for i, entRule := range entRules {
+		rules[i] = *convertWatchRuleToModel(entRule)
+	}
+
+	return rules, nil
+}
+
+// GetWatchRulesByOwner implements Repository.
+func (r *RepositoryEnt) GetWatchRulesByOwner(ctx context.Context, ownerHash string) ([]model.WatchRule, error) {
+	entRules, err := r.client.WatchRule.Query().
+		Where(watchrule.OwnerHashEQ(ownerHash)).
+		Order(ent.Asc(watchrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get watch rules of owner: %v", err)
+	}
+
+	rules := make([]model.WatchRule, len(entRules))
+	for i, entRule := range entRules {
+		rules[i] = *convertWatchRuleToModel(entRule)
+	}
+
+	return rules, nil
+}
+
+// DeleteWatchRule implements Repository.
+func (r *RepositoryEnt) DeleteWatchRule(ctx context.Context, id int) (bool, error) {
+	err := r.client.WatchRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, r.logger.Errorf("failed to delete watch rule %d: %v", id, err)
+	}
+
+	return true, nil
+}
+
+// AddWebhookDeliveries implements Repository.
+func (r *RepositoryEnt) AddWebhookDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	bulk := make([]*ent.WebhookDeliveryCreate, len(deliveries))
+	for i, delivery := range deliveries {
+		bulk[i] = r.client.WebhookDelivery.Create().
+			SetRuleID(delivery.RuleID).
+			SetHash(delivery.Hash).
+			SetEventIndex(delivery.EventIndex).
+			SetToken(delivery.Token).
+			SetBlockHeight(delivery.BlockHeight).
+			SetPayload(delivery.Payload).
+			SetNextAttemptAt(delivery.NextAttemptAt).
+			SetCreatedAt(time.Now())
+	}
+
+	// A reprocessed transfer is notified once
+	err := r.client.WebhookDelivery.CreateBulk(bulk...).
+		OnConflict(sql.ConflictColumns(
+			webhookdelivery.FieldRuleID,
+			webhookdelivery.FieldHash,
+			webhookdelivery.FieldEventIndex,
+			webhookdelivery.FieldToken,
+		)).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return r.logger.Errorf("failed to add webhook deliveries for transaction %s: %v", deliveries[0].Hash, err)
+	}
+
+	return nil
+}
+
+// GetWebhookDelivery implements Repository.
+func (r *RepositoryEnt) GetWebhookDelivery(ctx context.Context, id int) (*model.WebhookDelivery, error) {
+	entDelivery, err := r.client.WebhookDelivery.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.logger.Errorf("failed to get webhook delivery %d: %v", id, err)
+	}
+
+	return convertWebhookDeliveryToModel(entDelivery), nil
+}
+
+// GetWebhookDeliveries implements Repository.
+func (r *RepositoryEnt) GetWebhookDeliveries(ctx context.Context, ruleID int, status string, offset int, limit int) ([]model.WebhookDelivery, error) {
+	deliveryQuery := r.client.WebhookDelivery.Query().
+		Where(webhookdelivery.RuleIDEQ(ruleID))
+	if status != "" {
+		deliveryQuery = deliveryQuery.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(status)))
+	}
+	entDeliveries, err := deliveryQuery.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get deliveries of watch rule %d: %v", ruleID, err)
+	}
+
+	deliveries := make([]model.WebhookDelivery, len(entDeliveries))
+	for i, entDelivery := range entDeliveries {
+		deliveries[i] = *convertWebhookDeliveryToModel(entDelivery)
+	}
+
+	return deliveries, nil
+}
+
+// GetDueWebhookDeliveries implements Repository.
+func (r *RepositoryEnt) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]model.WebhookDelivery, error) {
+	entDeliveries, err := r.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		WithRule().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt), ent.Asc(webhookdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, r.logger.Errorf("failed to get due webhook deliveries: %v", err)
+	}
+
+	deliveries := make([]model.WebhookDelivery, len(entDeliveries))
+	for i, entDelivery := range entDeliveries {
+		deliveries[i] = *convertWebhookDeliveryToModel(entDelivery)
+		if entDelivery.Edges.Rule != nil {
+			deliveries[i].Rule = convertWatchRuleToModel(entDelivery.Edges.Rule)
+		}
+	}
+
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery implements Repository.
+func (r *RepositoryEnt) UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	update := r.client.WebhookDelivery.UpdateOneID(delivery.ID).
+		SetStatus(webhookdelivery.Status(delivery.Status)).
+		SetAttempts(delivery.Attempts).
+		SetNextAttemptAt(delivery.NextAttemptAt).
+		SetResponseStatus(delivery.ResponseStatus).
+		SetLastError(delivery.LastError)
+	if delivery.LastAttemptAt != nil {
+		update = update.SetLastAttemptAt(*delivery.LastAttemptAt)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return r.logger.Errorf("failed to update webhook delivery %d: %v", delivery.ID, err)
+	}
+
+	return nil
+}
+
+// ReplayWebhookDelivery implements Repository.
+func (r *RepositoryEnt) ReplayWebhookDelivery(ctx context.Context, id int) (bool, error) {
+	err := r.client.WebhookDelivery.UpdateOneID(id).
+		SetStatus(webhookdelivery.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, r.logger.Errorf("failed to replay webhook delivery %d: %v", id, err)
+	}
+
+	return true, nil
+}
+
+func convertWatchRuleToModel(entRule *ent.WatchRule) *model.WatchRule {
+	return &model.WatchRule{
+		ID:        entRule.ID,
+		URL:       entRule.URL,
+		Secret:    entRule.Secret,
+		OwnerHash: entRule.OwnerHash,
+		Address:   entRule.Address,
+		Token:     entRule.Token,
+		MinAmount: entRule.MinAmount,
+		EventType: entRule.EventType,
+		Active:    entRule.Active,
+		CreatedAt: entRule.CreatedAt,
+	}
+}
+
+func convertWebhookDeliveryToModel(entDelivery *ent.WebhookDelivery) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		ID:             entDelivery.ID,
+		RuleID:         entDelivery.RuleID,
+		Hash:           entDelivery.Hash,
+		EventIndex:     entDelivery.EventIndex,
+		Token:          entDelivery.Token,
+		BlockHeight:    entDelivery.BlockHeight,
+		Payload:        entDelivery.Payload,
+		Status:         string(entDelivery.Status),
+		Attempts:       entDelivery.Attempts,
+		NextAttemptAt:  entDelivery.NextAttemptAt,
+		LastAttemptAt:  entDelivery.LastAttemptAt,
+		ResponseStatus: entDelivery.ResponseStatus,
+		LastError:      entDelivery.LastError,
+		CreatedAt:      entDelivery.CreatedAt,
+	}
+}